@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+const usage = `Usage: admin <command> [arguments]
+
+Commands:
+  unlock <email>                      Lift the lockout of an account after too many failed sign-in attempts
+  grant <email> <role>                Grant a role to a user, built-in roles (admin) are created as needed
+  revoke <email> <role>               Remove a role from a user
+  role <name> [permission...]         Define a role, or replace its permissions, ie: role moderator "reports:*"
+`
+
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Start a new container
+	c := services.NewContainer()
+	defer func() {
+		if err := c.Shutdown(); err != nil {
+			c.Web.Logger.Fatal(err)
+		}
+	}()
+
+	var err error
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "unlock":
+		err = unlock(c, args)
+	case "grant":
+		err = grant(c, args)
+	case "revoke":
+		err = revoke(c, args)
+	case "role":
+		err = defineRole(c, args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// unlock lifts the lockout of the email address and of the profile of the account it belongs to
+func unlock(c *services.Container, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: admin unlock <email>")
+	}
+	email := strings.ToLower(args[0])
+	ctx := context.Background()
+
+	keys := []services.ThrottleKey{services.ThrottleKeyEmail(email)}
+
+	usr, err := findUser(ctx, c, email)
+	if err != nil {
+		return err
+	}
+	if usr.Edges.Profile != nil {
+		keys = append(keys, services.ThrottleKeyProfile(usr.Edges.Profile.ID))
+	}
+
+	if err := c.Throttler.Unlock(ctx, keys...); err != nil {
+		return err
+	}
+
+	fmt.Printf("Unlocked %s\n", email)
+	return nil
+}
+
+// grant grants a role to the user with the given email address
+func grant(c *services.Container, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: admin grant <email> <role>")
+	}
+	ctx := context.Background()
+
+	usr, err := findUser(ctx, c, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := c.Auth.GrantRole(ctx, usr.ID, args[1]); err != nil {
+		return err
+	}
+
+	fmt.Printf("Granted %s to %s\n", args[1], usr.Email)
+	return nil
+}
+
+// revoke removes a role from the user with the given email address
+func revoke(c *services.Container, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: admin revoke <email> <role>")
+	}
+	ctx := context.Background()
+
+	usr, err := findUser(ctx, c, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := c.Auth.RevokeRole(ctx, usr.ID, args[1]); err != nil {
+		return fmt.Errorf("unable to revoke %s: %w", args[1], err)
+	}
+
+	fmt.Printf("Revoked %s from %s\n", args[1], usr.Email)
+	return nil
+}
+
+// defineRole creates a role with the given permissions, or replaces the permissions of an existing one
+func defineRole(c *services.Container, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: admin role <name> [permission...]")
+	}
+
+	if err := c.Auth.SetRolePermissions(context.Background(), args[0], args[1:]); err != nil {
+		return err
+	}
+
+	fmt.Printf("Role %s grants: %s\n", args[0], strings.Join(args[1:], ", "))
+	return nil
+}
+
+// findUser loads the user with the given email address, along with their profile
+func findUser(ctx context.Context, c *services.Container, email string) (*ent.User, error) {
+	email = strings.ToLower(email)
+	usr, err := c.ORM.User.
+		Query().
+		Where(user.Email(email)).
+		WithProfile().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find user %s: %w", email, err)
+	}
+	return usr, nil
+}
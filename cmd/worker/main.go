@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/mikestefanello/pagoda/config"
@@ -37,6 +38,7 @@ func main() {
 				"default":  3,
 				"low":      1,
 			},
+			RetryDelayFunc: tasks.NewRetryDelayFunc(cfg.Push),
 		},
 	)
 
@@ -72,6 +74,25 @@ func main() {
 	deleteStaleNotificationsProcessor := tasks.NewDeleteStaleNotificationsProcessor(
 		c.ORM, c.Config.App.OperationalConstants.DeleteStaleNotificationAfterDays,
 	)
+	pruneAuditLogProcessor := tasks.NewPruneAuditLogProcessor(c.Audit, c.Config.Audit.Retention)
+	deleteAccountProcessor := tasks.NewDeleteAccountProcessor(
+		c.ORM, profileRepo, c.Audit, c.Config.App.OperationalConstants.PaymentsEnabled,
+	)
+	deleteDueAccountsProcessor := tasks.NewDeleteDueAccountsProcessor(c.ORM, deleteAccountProcessor)
+	exportUserDataProcessor := tasks.NewExportUserDataProcessor(
+		c.ORM, profileRepo, storageRepo, c.Mail, c.Audit, c.Tasks, c.Config,
+	)
+	deleteDataExportProcessor := tasks.NewDeleteDataExportProcessor(storageRepo)
+	pwaPushNotificationsRepo := notifierrepo.NewPwaPushNotificationsRepo(
+		c.ORM, c.Config.App.VapidPublicKey, c.Config.App.VapidPrivateKey, c.Config.Mail.FromAddress)
+	fcmPushNotificationsRepo, err := notifierrepo.NewFcmPushNotificationsRepo(
+		c.ORM, &c.Config.App.FirebaseJSONAccessKeys)
+	if err != nil {
+		log.Fatalf("could not create FCM push notifications repo: %v", err)
+	}
+	deliverPushProcessor := tasks.NewDeliverPushProcessor(
+		notifierrepo.NewPushDeliveryRepo(c.ORM, nil), pwaPushNotificationsRepo, fcmPushNotificationsRepo,
+	)
 
 	// Map task types to the handlers
 	mux := asynq.NewServeMux()
@@ -81,9 +102,62 @@ func main() {
 	mux.Handle(tasks.TypeAllDailyConvoNotifications, allDailyConvoNotificationsProcessor)
 	mux.Handle(tasks.TypeDailyConvoNotification, dailyConvoNotificationsProcessor)
 	mux.Handle(tasks.TypeDeleteStaleNotifications, deleteStaleNotificationsProcessor)
+	mux.Handle(tasks.TypePruneAuditLog, pruneAuditLogProcessor)
+	mux.Handle(tasks.TypeDeleteAccount, deleteAccountProcessor)
+	mux.Handle(tasks.TypeDeleteDueAccounts, deleteDueAccountsProcessor)
+	mux.Handle(tasks.TypeExportUserData, exportUserDataProcessor)
+	mux.Handle(tasks.TypeDeleteDataExport, deleteDataExportProcessor)
+	mux.Handle(tasks.TypeDeliverPush, deliverPushProcessor)
+
+	// Queue the periodic tasks, which only the worker picked to run the scheduler does
+	if c.Config.Tasks.RunScheduler {
+		if err := schedulePeriodicTasks(c.Tasks); err != nil {
+			log.Fatalf("failed to register scheduler task: %v", err)
+		}
+		go func() {
+			if err := c.Tasks.StartScheduler(); err != nil {
+				log.Fatalf("scheduler shutdown: %v", err)
+			}
+		}()
+	}
 
 	// Start the worker server
 	if err := srv.Run(mux); err != nil {
 		log.Fatalf("could not run worker server: %v", err)
 	}
 }
+
+// schedulePeriodicTasks registers the tasks the scheduler queues periodically
+func schedulePeriodicTasks(t *services.TaskClient) error {
+	// Delete the accounts whose deletion grace period is over, in case their own deletion task was lost
+	if err := t.
+		New(tasks.TypeDeleteDueAccounts).
+		Periodic("@every 6h").
+		Timeout(30 * time.Minute).
+		Retain(24 * time.Hour).
+		Save(); err != nil {
+		return err
+	}
+
+	// Prune the security audit log records past their retention
+	if err := t.
+		New(tasks.TypePruneAuditLog).
+		Periodic("@every 24h").
+		Timeout(10 * time.Minute).
+		Retain(24 * time.Hour).
+		Save(); err != nil {
+		return err
+	}
+
+	// Delete stale notifications along with the records of their push deliveries
+	if err := t.
+		New(tasks.TypeDeleteStaleNotifications).
+		Periodic("@every 12h").
+		Timeout(120 * time.Second).
+		Retain(24 * time.Hour).
+		Save(); err != nil {
+		return err
+	}
+
+	return nil
+}
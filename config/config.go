@@ -63,14 +63,23 @@ func SwitchEnvironment(env environment) {
 type (
 	// Config stores complete configuration
 	Config struct {
-		HTTP        HTTPConfig
-		App         AppConfig
-		Cache       CacheConfig
-		Database    DatabaseConfig
-		Mail        MailConfig
-		Phone       PhoneConfig
-		Recommender RecommenderConfig
-		Storage     StorageConfig
+		HTTP           HTTPConfig
+		App            AppConfig
+		Keyring        KeyringConfig
+		Cache          CacheConfig
+		Database       DatabaseConfig
+		Mail           MailConfig
+		OAuth          OAuthConfig
+		LoginThrottle  LoginThrottleConfig
+		RateLimit      RateLimitConfig
+		PasswordPolicy PasswordPolicyConfig
+		BotDefense     BotDefenseConfig
+		Audit          AuditConfig
+		Phone          PhoneConfig
+		Push           PushConfig
+		Tasks          TasksConfig
+		Recommender    RecommenderConfig
+		Storage        StorageConfig
 	}
 
 	// HTTPConfig stores HTTP configuration
@@ -87,6 +96,8 @@ type (
 			Certificate string
 			Key         string
 		}
+		// TrustedProxies are the address ranges of the proxies whose X-Forwarded-For header is trusted
+		TrustedProxies []string
 	}
 
 	// AppConfig stores application configuration
@@ -101,6 +112,7 @@ type (
 			Length     int
 		}
 		EmailVerificationTokenExpiration time.Duration
+		MagicLinkTokenExpiration         time.Duration
 		OperationalConstants             OperationalConstants
 		PageSize                         int
 		VapidPublicKey                   string
@@ -130,6 +142,8 @@ type (
 		ProductProPrice                                   float32
 		PaymentFailedGracePeriodInDays                    int
 		DeleteStaleNotificationAfterDays                  int
+		AccountDeletionGracePeriodInDays                  int
+		InvitationExpirationInDays                        int
 		MaxLikedQuestionHistoryFreePlan                   int
 	}
 
@@ -175,10 +189,140 @@ type (
 		ResendAPIKey string
 	}
 
+	// OAuthConfig stores the social login providers, keyed by the name used in their routes
+	OAuthConfig struct {
+		Providers map[string]OAuthProviderConfig
+	}
+
+	// OAuthProviderConfig stores the configuration of a single OAuth2/OIDC provider. When Issuer is set,
+	// endpoints are found through OIDC discovery, otherwise AuthURL, TokenURL and UserInfoURL must be set.
+	OAuthProviderConfig struct {
+		DisplayName  string
+		Issuer       string
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+		AuthURL      string
+		TokenURL     string
+		UserInfoURL  string
+	}
+
+	// LoginThrottleConfig stores how failed login and verification attempts are throttled. Attempts are
+	// counted in a sliding window per email, IP and profile. Past FreeAttempts, each attempt must wait
+	// exponentially longer, starting at BaseDelay, and reaching a max attempts limit locks the key out.
+	LoginThrottleConfig struct {
+		Window                time.Duration
+		FreeAttempts          int
+		BaseDelay             time.Duration
+		MaxDelay              time.Duration
+		MaxAttemptsPerAccount int
+		MaxAttemptsPerIP      int
+		LockoutDuration       time.Duration
+	}
+
+	// RateLimitConfig stores the rate limiting policies and where they apply. Each route group has a
+	// policy, and policies listing route names additionally apply to those routes.
+	RateLimitConfig struct {
+		Enabled bool
+		// Backend is either "redis", to share limits between nodes, or "memory"
+		Backend string
+		Groups  struct {
+			Web      string
+			External string
+			Realtime string
+		}
+		Policies map[string]RateLimitPolicy
+	}
+
+	// RateLimitPolicy is a token bucket which holds up to Burst requests and refills at a rate of
+	// Requests per Period. By lists what buckets are kept per: "ip", "user" and/or "route".
+	RateLimitPolicy struct {
+		Requests int
+		Period   time.Duration
+		Burst    int
+		By       []string
+		Routes   []string
+	}
+
+	// PasswordPolicyConfig stores the requirements new passwords must meet. MinStrength is a zxcvbn
+	// score from 0 (guessable) to 4 (very unguessable).
+	PasswordPolicyConfig struct {
+		MinLength          int
+		MinStrength        int
+		RejectPersonalInfo bool
+		// BreachedPasswordsDir holds k-anonymity range files of breached passwords, named after the first
+		// 5 hex characters of the SHA-1 of the passwords and listing "SUFFIX:COUNT" lines. Empty disables the check.
+		BreachedPasswordsDir string
+	}
+
+	// KeyringConfig stores the keys signing sessions and tokens, by ID. The active key signs everything
+	// new while the others only verify what they signed before being rotated out. App.EncryptionKey is
+	// part of the keyring as "default" unless Keys redefines it, and is the active key if none is set.
+	KeyringConfig struct {
+		ActiveKey string
+		Keys      map[string]string
+	}
+
+	// BotDefenseConfig stores how public forms tell people from bots. ProofOfWorkDifficulty is the number
+	// of leading zero bits browsers find a hash with before submitting, 0 disables the proof of work.
+	BotDefenseConfig struct {
+		MinFillTime           time.Duration
+		TokenExpiration       time.Duration
+		ProofOfWorkDifficulty int
+		Captcha               CaptchaConfig
+	}
+
+	// CaptchaConfig stores the captcha forms protected against bots show. Provider is "hcaptcha",
+	// "turnstile", "fake" to accept a local checkbox outside of production, or empty to show none.
+	CaptchaConfig struct {
+		Provider  string
+		SiteKey   string
+		SecretKey string
+	}
+
+	// AuditConfig stores how long security audit log records are kept
+	AuditConfig struct {
+		Retention time.Duration
+	}
+
+	// PhoneConfig stores how text messages are sent and the one-time codes they carry. Provider is "sns",
+	// "http" for a Twilio-compatible API or "outbox" to write them to a local file, and defaults to SNS in
+	// production and the outbox elsewhere.
 	PhoneConfig struct {
+		Provider                        string
+		OutboxPath                      string
+		HTTP                            PhoneHTTPConfig
 		SenderID                        string
 		Region                          string
 		ValidationCodeExpirationMinutes int
+		CodeLength                      int
+		MaxCodeAttempts                 int
+		CodeResendCooldown              time.Duration
+		DailyCodeCap                    int
+	}
+
+	// PhoneHTTPConfig stores the credentials of a Twilio-compatible SMS API
+	PhoneHTTPConfig struct {
+		BaseURL    string
+		AccountSID string
+		AuthToken  string
+		From       string
+	}
+
+	// PushConfig stores how push notifications are delivered. Each device gets its own task, retried up to
+	// MaxRetries times while the push service is unavailable or rate limiting, waiting as long as it asks
+	// to, or otherwise twice as long each time from RetryBaseDelay up to RetryMaxDelay.
+	PushConfig struct {
+		MaxRetries     int
+		RetryBaseDelay time.Duration
+		RetryMaxDelay  time.Duration
+		Timeout        time.Duration
+	}
+
+	// TasksConfig stores how background tasks are run. RunScheduler makes the worker queue the periodic
+	// tasks as well, which only one worker process should do so that each is queued once.
+	TasksConfig struct {
+		RunScheduler bool
 	}
 
 	RecommenderConfig struct {
@@ -194,6 +338,9 @@ type (
 		S3UseSSL                  bool
 		ProfilePhotoMaxFileSizeMB int64
 		PhotosMaxFileSizeMB       int64
+		// DataExportLinkExpiration is how long the links to download personal data exports stay valid,
+		// after which the exports get deleted
+		DataExportLinkExpiration time.Duration
 	}
 )
 
@@ -15,25 +15,44 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/auditlog"
 	"github.com/mikestefanello/pagoda/ent/emailsubscription"
 	"github.com/mikestefanello/pagoda/ent/emailsubscriptiontype"
 	"github.com/mikestefanello/pagoda/ent/emojis"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
 	"github.com/mikestefanello/pagoda/ent/filestorage"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/ent/identity"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/imagesize"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
 	"github.com/mikestefanello/pagoda/ent/invitation"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
+	"github.com/mikestefanello/pagoda/ent/organization"
+	"github.com/mikestefanello/pagoda/ent/organizationinvitation"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/passkey"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/ent/role"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
+	"github.com/mikestefanello/pagoda/ent/smsmessage"
+	"github.com/mikestefanello/pagoda/ent/throttleattempt"
+	"github.com/mikestefanello/pagoda/ent/throttlelock"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/usersession"
 
 	stdsql "database/sql"
 )
@@ -43,6 +62,10 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// EmailSubscription is the client for interacting with the EmailSubscription builders.
 	EmailSubscription *EmailSubscriptionClient
 	// EmailSubscriptionType is the client for interacting with the EmailSubscriptionType builders.
@@ -53,14 +76,22 @@ type Client struct {
 	FCMSubscriptions *FCMSubscriptionsClient
 	// FileStorage is the client for interacting with the FileStorage builders.
 	FileStorage *FileStorageClient
+	// FriendRequest is the client for interacting with the FriendRequest builders.
+	FriendRequest *FriendRequestClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
 	// ImageSize is the client for interacting with the ImageSize builders.
 	ImageSize *ImageSizeClient
+	// Impersonation is the client for interacting with the Impersonation builders.
+	Impersonation *ImpersonationClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
 	// LastSeenOnline is the client for interacting with the LastSeenOnline builders.
 	LastSeenOnline *LastSeenOnlineClient
+	// MagicLinkToken is the client for interacting with the MagicLinkToken builders.
+	MagicLinkToken *MagicLinkTokenClient
 	// MonthlySubscription is the client for interacting with the MonthlySubscription builders.
 	MonthlySubscription *MonthlySubscriptionClient
 	// Notification is the client for interacting with the Notification builders.
@@ -69,18 +100,44 @@ type Client struct {
 	NotificationPermission *NotificationPermissionClient
 	// NotificationTime is the client for interacting with the NotificationTime builders.
 	NotificationTime *NotificationTimeClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// OrganizationInvitation is the client for interacting with the OrganizationInvitation builders.
+	OrganizationInvitation *OrganizationInvitationClient
+	// OrganizationMembership is the client for interacting with the OrganizationMembership builders.
+	OrganizationMembership *OrganizationMembershipClient
+	// Passkey is the client for interacting with the Passkey builders.
+	Passkey *PasskeyClient
 	// PasswordToken is the client for interacting with the PasswordToken builders.
 	PasswordToken *PasswordTokenClient
 	// PhoneVerificationCode is the client for interacting with the PhoneVerificationCode builders.
 	PhoneVerificationCode *PhoneVerificationCodeClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// PushDelivery is the client for interacting with the PushDelivery builders.
+	PushDelivery *PushDeliveryClient
 	// PwaPushSubscription is the client for interacting with the PwaPushSubscription builders.
 	PwaPushSubscription *PwaPushSubscriptionClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// SMSMessage is the client for interacting with the SMSMessage builders.
+	SMSMessage *SMSMessageClient
 	// SentEmail is the client for interacting with the SentEmail builders.
 	SentEmail *SentEmailClient
+	// ThrottleAttempt is the client for interacting with the ThrottleAttempt builders.
+	ThrottleAttempt *ThrottleAttemptClient
+	// ThrottleLock is the client for interacting with the ThrottleLock builders.
+	ThrottleLock *ThrottleLockClient
+	// TotpSecret is the client for interacting with the TotpSecret builders.
+	TotpSecret *TotpSecretClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -92,25 +149,44 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIToken = NewAPITokenClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.EmailSubscription = NewEmailSubscriptionClient(c.config)
 	c.EmailSubscriptionType = NewEmailSubscriptionTypeClient(c.config)
 	c.Emojis = NewEmojisClient(c.config)
 	c.FCMSubscriptions = NewFCMSubscriptionsClient(c.config)
 	c.FileStorage = NewFileStorageClient(c.config)
+	c.FriendRequest = NewFriendRequestClient(c.config)
+	c.Identity = NewIdentityClient(c.config)
 	c.Image = NewImageClient(c.config)
 	c.ImageSize = NewImageSizeClient(c.config)
+	c.Impersonation = NewImpersonationClient(c.config)
 	c.Invitation = NewInvitationClient(c.config)
 	c.LastSeenOnline = NewLastSeenOnlineClient(c.config)
+	c.MagicLinkToken = NewMagicLinkTokenClient(c.config)
 	c.MonthlySubscription = NewMonthlySubscriptionClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
 	c.NotificationPermission = NewNotificationPermissionClient(c.config)
 	c.NotificationTime = NewNotificationTimeClient(c.config)
+	c.Organization = NewOrganizationClient(c.config)
+	c.OrganizationInvitation = NewOrganizationInvitationClient(c.config)
+	c.OrganizationMembership = NewOrganizationMembershipClient(c.config)
+	c.Passkey = NewPasskeyClient(c.config)
 	c.PasswordToken = NewPasswordTokenClient(c.config)
 	c.PhoneVerificationCode = NewPhoneVerificationCodeClient(c.config)
 	c.Profile = NewProfileClient(c.config)
+	c.PushDelivery = NewPushDeliveryClient(c.config)
 	c.PwaPushSubscription = NewPwaPushSubscriptionClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
+	c.Report = NewReportClient(c.config)
+	c.Role = NewRoleClient(c.config)
+	c.SMSMessage = NewSMSMessageClient(c.config)
 	c.SentEmail = NewSentEmailClient(c.config)
+	c.ThrottleAttempt = NewThrottleAttemptClient(c.config)
+	c.ThrottleLock = NewThrottleLockClient(c.config)
+	c.TotpSecret = NewTotpSecretClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserSession = NewUserSessionClient(c.config)
 }
 
 type (
@@ -203,25 +279,44 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		APIToken:               NewAPITokenClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
 		EmailSubscription:      NewEmailSubscriptionClient(cfg),
 		EmailSubscriptionType:  NewEmailSubscriptionTypeClient(cfg),
 		Emojis:                 NewEmojisClient(cfg),
 		FCMSubscriptions:       NewFCMSubscriptionsClient(cfg),
 		FileStorage:            NewFileStorageClient(cfg),
+		FriendRequest:          NewFriendRequestClient(cfg),
+		Identity:               NewIdentityClient(cfg),
 		Image:                  NewImageClient(cfg),
 		ImageSize:              NewImageSizeClient(cfg),
+		Impersonation:          NewImpersonationClient(cfg),
 		Invitation:             NewInvitationClient(cfg),
 		LastSeenOnline:         NewLastSeenOnlineClient(cfg),
+		MagicLinkToken:         NewMagicLinkTokenClient(cfg),
 		MonthlySubscription:    NewMonthlySubscriptionClient(cfg),
 		Notification:           NewNotificationClient(cfg),
 		NotificationPermission: NewNotificationPermissionClient(cfg),
 		NotificationTime:       NewNotificationTimeClient(cfg),
+		Organization:           NewOrganizationClient(cfg),
+		OrganizationInvitation: NewOrganizationInvitationClient(cfg),
+		OrganizationMembership: NewOrganizationMembershipClient(cfg),
+		Passkey:                NewPasskeyClient(cfg),
 		PasswordToken:          NewPasswordTokenClient(cfg),
 		PhoneVerificationCode:  NewPhoneVerificationCodeClient(cfg),
 		Profile:                NewProfileClient(cfg),
+		PushDelivery:           NewPushDeliveryClient(cfg),
 		PwaPushSubscription:    NewPwaPushSubscriptionClient(cfg),
+		RecoveryCode:           NewRecoveryCodeClient(cfg),
+		Report:                 NewReportClient(cfg),
+		Role:                   NewRoleClient(cfg),
+		SMSMessage:             NewSMSMessageClient(cfg),
 		SentEmail:              NewSentEmailClient(cfg),
+		ThrottleAttempt:        NewThrottleAttemptClient(cfg),
+		ThrottleLock:           NewThrottleLockClient(cfg),
+		TotpSecret:             NewTotpSecretClient(cfg),
 		User:                   NewUserClient(cfg),
+		UserSession:            NewUserSessionClient(cfg),
 	}, nil
 }
 
@@ -241,32 +336,51 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		APIToken:               NewAPITokenClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
 		EmailSubscription:      NewEmailSubscriptionClient(cfg),
 		EmailSubscriptionType:  NewEmailSubscriptionTypeClient(cfg),
 		Emojis:                 NewEmojisClient(cfg),
 		FCMSubscriptions:       NewFCMSubscriptionsClient(cfg),
 		FileStorage:            NewFileStorageClient(cfg),
+		FriendRequest:          NewFriendRequestClient(cfg),
+		Identity:               NewIdentityClient(cfg),
 		Image:                  NewImageClient(cfg),
 		ImageSize:              NewImageSizeClient(cfg),
+		Impersonation:          NewImpersonationClient(cfg),
 		Invitation:             NewInvitationClient(cfg),
 		LastSeenOnline:         NewLastSeenOnlineClient(cfg),
+		MagicLinkToken:         NewMagicLinkTokenClient(cfg),
 		MonthlySubscription:    NewMonthlySubscriptionClient(cfg),
 		Notification:           NewNotificationClient(cfg),
 		NotificationPermission: NewNotificationPermissionClient(cfg),
 		NotificationTime:       NewNotificationTimeClient(cfg),
+		Organization:           NewOrganizationClient(cfg),
+		OrganizationInvitation: NewOrganizationInvitationClient(cfg),
+		OrganizationMembership: NewOrganizationMembershipClient(cfg),
+		Passkey:                NewPasskeyClient(cfg),
 		PasswordToken:          NewPasswordTokenClient(cfg),
 		PhoneVerificationCode:  NewPhoneVerificationCodeClient(cfg),
 		Profile:                NewProfileClient(cfg),
+		PushDelivery:           NewPushDeliveryClient(cfg),
 		PwaPushSubscription:    NewPwaPushSubscriptionClient(cfg),
+		RecoveryCode:           NewRecoveryCodeClient(cfg),
+		Report:                 NewReportClient(cfg),
+		Role:                   NewRoleClient(cfg),
+		SMSMessage:             NewSMSMessageClient(cfg),
 		SentEmail:              NewSentEmailClient(cfg),
+		ThrottleAttempt:        NewThrottleAttemptClient(cfg),
+		ThrottleLock:           NewThrottleLockClient(cfg),
+		TotpSecret:             NewTotpSecretClient(cfg),
 		User:                   NewUserClient(cfg),
+		UserSession:            NewUserSessionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		EmailSubscription.
+//		APIToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -289,11 +403,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.EmailSubscription, c.EmailSubscriptionType, c.Emojis, c.FCMSubscriptions,
-		c.FileStorage, c.Image, c.ImageSize, c.Invitation, c.LastSeenOnline,
+		c.APIToken, c.AuditLog, c.EmailSubscription, c.EmailSubscriptionType, c.Emojis,
+		c.FCMSubscriptions, c.FileStorage, c.FriendRequest, c.Identity, c.Image,
+		c.ImageSize, c.Impersonation, c.Invitation, c.LastSeenOnline, c.MagicLinkToken,
 		c.MonthlySubscription, c.Notification, c.NotificationPermission,
-		c.NotificationTime, c.PasswordToken, c.PhoneVerificationCode, c.Profile,
-		c.PwaPushSubscription, c.SentEmail, c.User,
+		c.NotificationTime, c.Organization, c.OrganizationInvitation,
+		c.OrganizationMembership, c.Passkey, c.PasswordToken, c.PhoneVerificationCode,
+		c.Profile, c.PushDelivery, c.PwaPushSubscription, c.RecoveryCode, c.Report,
+		c.Role, c.SMSMessage, c.SentEmail, c.ThrottleAttempt, c.ThrottleLock,
+		c.TotpSecret, c.User, c.UserSession,
 	} {
 		n.Use(hooks...)
 	}
@@ -303,11 +421,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.EmailSubscription, c.EmailSubscriptionType, c.Emojis, c.FCMSubscriptions,
-		c.FileStorage, c.Image, c.ImageSize, c.Invitation, c.LastSeenOnline,
+		c.APIToken, c.AuditLog, c.EmailSubscription, c.EmailSubscriptionType, c.Emojis,
+		c.FCMSubscriptions, c.FileStorage, c.FriendRequest, c.Identity, c.Image,
+		c.ImageSize, c.Impersonation, c.Invitation, c.LastSeenOnline, c.MagicLinkToken,
 		c.MonthlySubscription, c.Notification, c.NotificationPermission,
-		c.NotificationTime, c.PasswordToken, c.PhoneVerificationCode, c.Profile,
-		c.PwaPushSubscription, c.SentEmail, c.User,
+		c.NotificationTime, c.Organization, c.OrganizationInvitation,
+		c.OrganizationMembership, c.Passkey, c.PasswordToken, c.PhoneVerificationCode,
+		c.Profile, c.PushDelivery, c.PwaPushSubscription, c.RecoveryCode, c.Report,
+		c.Role, c.SMSMessage, c.SentEmail, c.ThrottleAttempt, c.ThrottleLock,
+		c.TotpSecret, c.User, c.UserSession,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -316,6 +438,10 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APITokenMutation:
+		return c.APIToken.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *EmailSubscriptionMutation:
 		return c.EmailSubscription.mutate(ctx, m)
 	case *EmailSubscriptionTypeMutation:
@@ -326,14 +452,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FCMSubscriptions.mutate(ctx, m)
 	case *FileStorageMutation:
 		return c.FileStorage.mutate(ctx, m)
+	case *FriendRequestMutation:
+		return c.FriendRequest.mutate(ctx, m)
+	case *IdentityMutation:
+		return c.Identity.mutate(ctx, m)
 	case *ImageMutation:
 		return c.Image.mutate(ctx, m)
 	case *ImageSizeMutation:
 		return c.ImageSize.mutate(ctx, m)
+	case *ImpersonationMutation:
+		return c.Impersonation.mutate(ctx, m)
 	case *InvitationMutation:
 		return c.Invitation.mutate(ctx, m)
 	case *LastSeenOnlineMutation:
 		return c.LastSeenOnline.mutate(ctx, m)
+	case *MagicLinkTokenMutation:
+		return c.MagicLinkToken.mutate(ctx, m)
 	case *MonthlySubscriptionMutation:
 		return c.MonthlySubscription.mutate(ctx, m)
 	case *NotificationMutation:
@@ -342,23 +476,332 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.NotificationPermission.mutate(ctx, m)
 	case *NotificationTimeMutation:
 		return c.NotificationTime.mutate(ctx, m)
+	case *OrganizationMutation:
+		return c.Organization.mutate(ctx, m)
+	case *OrganizationInvitationMutation:
+		return c.OrganizationInvitation.mutate(ctx, m)
+	case *OrganizationMembershipMutation:
+		return c.OrganizationMembership.mutate(ctx, m)
+	case *PasskeyMutation:
+		return c.Passkey.mutate(ctx, m)
 	case *PasswordTokenMutation:
 		return c.PasswordToken.mutate(ctx, m)
 	case *PhoneVerificationCodeMutation:
 		return c.PhoneVerificationCode.mutate(ctx, m)
 	case *ProfileMutation:
 		return c.Profile.mutate(ctx, m)
+	case *PushDeliveryMutation:
+		return c.PushDelivery.mutate(ctx, m)
 	case *PwaPushSubscriptionMutation:
 		return c.PwaPushSubscription.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
+	case *ReportMutation:
+		return c.Report.mutate(ctx, m)
+	case *RoleMutation:
+		return c.Role.mutate(ctx, m)
+	case *SMSMessageMutation:
+		return c.SMSMessage.mutate(ctx, m)
 	case *SentEmailMutation:
 		return c.SentEmail.mutate(ctx, m)
+	case *ThrottleAttemptMutation:
+		return c.ThrottleAttempt.mutate(ctx, m)
+	case *ThrottleLockMutation:
+		return c.ThrottleLock.mutate(ctx, m)
+	case *TotpSecretMutation:
+		return c.TotpSecret.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserSessionMutation:
+		return c.UserSession.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// APITokenClient is a client for the APIToken schema.
+type APITokenClient struct {
+	config
+}
+
+// NewAPITokenClient returns a client for the APIToken from the given config.
+func NewAPITokenClient(c config) *APITokenClient {
+	return &APITokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitoken.Hooks(f(g(h())))`.
+func (c *APITokenClient) Use(hooks ...Hook) {
+	c.hooks.APIToken = append(c.hooks.APIToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitoken.Intercept(f(g(h())))`.
+func (c *APITokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIToken = append(c.inters.APIToken, interceptors...)
+}
+
+// Create returns a builder for creating a APIToken entity.
+func (c *APITokenClient) Create() *APITokenCreate {
+	mutation := newAPITokenMutation(c.config, OpCreate)
+	return &APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIToken entities.
+func (c *APITokenClient) CreateBulk(builders ...*APITokenCreate) *APITokenCreateBulk {
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APITokenClient) MapCreateBulk(slice any, setFunc func(*APITokenCreate, int)) *APITokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APITokenCreateBulk{err: fmt.Errorf("calling to APITokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APITokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIToken.
+func (c *APITokenClient) Update() *APITokenUpdate {
+	mutation := newAPITokenMutation(c.config, OpUpdate)
+	return &APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APITokenClient) UpdateOne(at *APIToken) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPIToken(at))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APITokenClient) UpdateOneID(id int) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPITokenID(id))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIToken.
+func (c *APITokenClient) Delete() *APITokenDelete {
+	mutation := newAPITokenMutation(c.config, OpDelete)
+	return &APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APITokenClient) DeleteOne(at *APIToken) *APITokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APITokenClient) DeleteOneID(id int) *APITokenDeleteOne {
+	builder := c.Delete().Where(apitoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APITokenDeleteOne{builder}
+}
+
+// Query returns a query builder for APIToken.
+func (c *APITokenClient) Query() *APITokenQuery {
+	return &APITokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIToken entity by its id.
+func (c *APITokenClient) Get(ctx context.Context, id int) (*APIToken, error) {
+	return c.Query().Where(apitoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APITokenClient) GetX(ctx context.Context, id int) *APIToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a APIToken.
+func (c *APITokenClient) QueryUser(at *APIToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APITokenClient) Hooks() []Hook {
+	return c.hooks.APIToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *APITokenClient) Interceptors() []Interceptor {
+	return c.inters.APIToken
+}
+
+func (c *APITokenClient) mutate(ctx context.Context, m *APITokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIToken mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // EmailSubscriptionClient is a client for the EmailSubscription schema.
 type EmailSubscriptionClient struct {
 	config
@@ -1072,107 +1515,107 @@ func (c *FileStorageClient) mutate(ctx context.Context, m *FileStorageMutation)
 	}
 }
 
-// ImageClient is a client for the Image schema.
-type ImageClient struct {
+// FriendRequestClient is a client for the FriendRequest schema.
+type FriendRequestClient struct {
 	config
 }
 
-// NewImageClient returns a client for the Image from the given config.
-func NewImageClient(c config) *ImageClient {
-	return &ImageClient{config: c}
+// NewFriendRequestClient returns a client for the FriendRequest from the given config.
+func NewFriendRequestClient(c config) *FriendRequestClient {
+	return &FriendRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `image.Hooks(f(g(h())))`.
-func (c *ImageClient) Use(hooks ...Hook) {
-	c.hooks.Image = append(c.hooks.Image, hooks...)
+// A call to `Use(f, g, h)` equals to `friendrequest.Hooks(f(g(h())))`.
+func (c *FriendRequestClient) Use(hooks ...Hook) {
+	c.hooks.FriendRequest = append(c.hooks.FriendRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `image.Intercept(f(g(h())))`.
-func (c *ImageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Image = append(c.inters.Image, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `friendrequest.Intercept(f(g(h())))`.
+func (c *FriendRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendRequest = append(c.inters.FriendRequest, interceptors...)
 }
 
-// Create returns a builder for creating a Image entity.
-func (c *ImageClient) Create() *ImageCreate {
-	mutation := newImageMutation(c.config, OpCreate)
-	return &ImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FriendRequest entity.
+func (c *FriendRequestClient) Create() *FriendRequestCreate {
+	mutation := newFriendRequestMutation(c.config, OpCreate)
+	return &FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Image entities.
-func (c *ImageClient) CreateBulk(builders ...*ImageCreate) *ImageCreateBulk {
-	return &ImageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FriendRequest entities.
+func (c *FriendRequestClient) CreateBulk(builders ...*FriendRequestCreate) *FriendRequestCreateBulk {
+	return &FriendRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ImageClient) MapCreateBulk(slice any, setFunc func(*ImageCreate, int)) *ImageCreateBulk {
+func (c *FriendRequestClient) MapCreateBulk(slice any, setFunc func(*FriendRequestCreate, int)) *FriendRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ImageCreateBulk{err: fmt.Errorf("calling to ImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FriendRequestCreateBulk{err: fmt.Errorf("calling to FriendRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ImageCreate, rv.Len())
+	builders := make([]*FriendRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ImageCreateBulk{config: c.config, builders: builders}
+	return &FriendRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Image.
-func (c *ImageClient) Update() *ImageUpdate {
-	mutation := newImageMutation(c.config, OpUpdate)
-	return &ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FriendRequest.
+func (c *FriendRequestClient) Update() *FriendRequestUpdate {
+	mutation := newFriendRequestMutation(c.config, OpUpdate)
+	return &FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ImageClient) UpdateOne(i *Image) *ImageUpdateOne {
-	mutation := newImageMutation(c.config, OpUpdateOne, withImage(i))
-	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FriendRequestClient) UpdateOne(fr *FriendRequest) *FriendRequestUpdateOne {
+	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequest(fr))
+	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ImageClient) UpdateOneID(id int) *ImageUpdateOne {
-	mutation := newImageMutation(c.config, OpUpdateOne, withImageID(id))
-	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FriendRequestClient) UpdateOneID(id int) *FriendRequestUpdateOne {
+	mutation := newFriendRequestMutation(c.config, OpUpdateOne, withFriendRequestID(id))
+	return &FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Image.
-func (c *ImageClient) Delete() *ImageDelete {
-	mutation := newImageMutation(c.config, OpDelete)
-	return &ImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FriendRequest.
+func (c *FriendRequestClient) Delete() *FriendRequestDelete {
+	mutation := newFriendRequestMutation(c.config, OpDelete)
+	return &FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ImageClient) DeleteOne(i *Image) *ImageDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *FriendRequestClient) DeleteOne(fr *FriendRequest) *FriendRequestDeleteOne {
+	return c.DeleteOneID(fr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ImageClient) DeleteOneID(id int) *ImageDeleteOne {
-	builder := c.Delete().Where(image.ID(id))
+func (c *FriendRequestClient) DeleteOneID(id int) *FriendRequestDeleteOne {
+	builder := c.Delete().Where(friendrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ImageDeleteOne{builder}
+	return &FriendRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for Image.
-func (c *ImageClient) Query() *ImageQuery {
-	return &ImageQuery{
+// Query returns a query builder for FriendRequest.
+func (c *FriendRequestClient) Query() *FriendRequestQuery {
+	return &FriendRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeImage},
+		ctx:    &QueryContext{Type: TypeFriendRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Image entity by its id.
-func (c *ImageClient) Get(ctx context.Context, id int) (*Image, error) {
-	return c.Query().Where(image.ID(id)).Only(ctx)
+// Get returns a FriendRequest entity by its id.
+func (c *FriendRequestClient) Get(ctx context.Context, id int) (*FriendRequest, error) {
+	return c.Query().Where(friendrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
+func (c *FriendRequestClient) GetX(ctx context.Context, id int) *FriendRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1180,148 +1623,164 @@ func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
 	return obj
 }
 
-// QuerySizes queries the sizes edge of a Image.
-func (c *ImageClient) QuerySizes(i *Image) *ImageSizeQuery {
-	query := (&ImageSizeClient{config: c.config}).Query()
+// QueryRequester queries the requester edge of a FriendRequest.
+func (c *FriendRequestClient) QueryRequester(fr *FriendRequest) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := fr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(image.Table, image.FieldID, id),
-			sqlgraph.To(imagesize.Table, imagesize.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, image.SizesTable, image.SizesColumn),
+			sqlgraph.From(friendrequest.Table, friendrequest.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, friendrequest.RequesterTable, friendrequest.RequesterColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a FriendRequest.
+func (c *FriendRequestClient) QueryRecipient(fr *FriendRequest) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendrequest.Table, friendrequest.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, friendrequest.RecipientTable, friendrequest.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ImageClient) Hooks() []Hook {
-	return c.hooks.Image
+func (c *FriendRequestClient) Hooks() []Hook {
+	return c.hooks.FriendRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *ImageClient) Interceptors() []Interceptor {
-	return c.inters.Image
+func (c *FriendRequestClient) Interceptors() []Interceptor {
+	return c.inters.FriendRequest
 }
 
-func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, error) {
+func (c *FriendRequestClient) mutate(ctx context.Context, m *FriendRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FriendRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FriendRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FriendRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FriendRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Image mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FriendRequest mutation op: %q", m.Op())
 	}
 }
 
-// ImageSizeClient is a client for the ImageSize schema.
-type ImageSizeClient struct {
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
 	config
 }
 
-// NewImageSizeClient returns a client for the ImageSize from the given config.
-func NewImageSizeClient(c config) *ImageSizeClient {
-	return &ImageSizeClient{config: c}
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `imagesize.Hooks(f(g(h())))`.
-func (c *ImageSizeClient) Use(hooks ...Hook) {
-	c.hooks.ImageSize = append(c.hooks.ImageSize, hooks...)
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `imagesize.Intercept(f(g(h())))`.
-func (c *ImageSizeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ImageSize = append(c.inters.ImageSize, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
+func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Identity = append(c.inters.Identity, interceptors...)
 }
 
-// Create returns a builder for creating a ImageSize entity.
-func (c *ImageSizeClient) Create() *ImageSizeCreate {
-	mutation := newImageSizeMutation(c.config, OpCreate)
-	return &ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Identity entity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ImageSize entities.
-func (c *ImageSizeClient) CreateBulk(builders ...*ImageSizeCreate) *ImageSizeCreateBulk {
-	return &ImageSizeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ImageSizeClient) MapCreateBulk(slice any, setFunc func(*ImageSizeCreate, int)) *ImageSizeCreateBulk {
+func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ImageSizeCreateBulk{err: fmt.Errorf("calling to ImageSizeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ImageSizeCreate, rv.Len())
+	builders := make([]*IdentityCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ImageSizeCreateBulk{config: c.config, builders: builders}
+	return &IdentityCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ImageSize.
-func (c *ImageSizeClient) Update() *ImageSizeUpdate {
-	mutation := newImageSizeMutation(c.config, OpUpdate)
-	return &ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ImageSizeClient) UpdateOne(is *ImageSize) *ImageSizeUpdateOne {
-	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSize(is))
-	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityClient) UpdateOne(i *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(i))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ImageSizeClient) UpdateOneID(id int) *ImageSizeUpdateOne {
-	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSizeID(id))
-	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityClient) UpdateOneID(id int) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ImageSize.
-func (c *ImageSizeClient) Delete() *ImageSizeDelete {
-	mutation := newImageSizeMutation(c.config, OpDelete)
-	return &ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ImageSizeClient) DeleteOne(is *ImageSize) *ImageSizeDeleteOne {
-	return c.DeleteOneID(is.ID)
+func (c *IdentityClient) DeleteOne(i *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ImageSizeClient) DeleteOneID(id int) *ImageSizeDeleteOne {
-	builder := c.Delete().Where(imagesize.ID(id))
+func (c *IdentityClient) DeleteOneID(id int) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ImageSizeDeleteOne{builder}
+	return &IdentityDeleteOne{builder}
 }
 
-// Query returns a query builder for ImageSize.
-func (c *ImageSizeClient) Query() *ImageSizeQuery {
-	return &ImageSizeQuery{
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeImageSize},
+		ctx:    &QueryContext{Type: TypeIdentity},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ImageSize entity by its id.
-func (c *ImageSizeClient) Get(ctx context.Context, id int) (*ImageSize, error) {
-	return c.Query().Where(imagesize.ID(id)).Only(ctx)
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id int) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ImageSizeClient) GetX(ctx context.Context, id int) *ImageSize {
+func (c *IdentityClient) GetX(ctx context.Context, id int) *Identity {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1329,164 +1788,148 @@ func (c *ImageSizeClient) GetX(ctx context.Context, id int) *ImageSize {
 	return obj
 }
 
-// QueryFile queries the file edge of a ImageSize.
-func (c *ImageSizeClient) QueryFile(is *ImageSize) *FileStorageQuery {
-	query := (&FileStorageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := is.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
-			sqlgraph.To(filestorage.Table, filestorage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, imagesize.FileTable, imagesize.FileColumn),
-		)
-		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryImage queries the image edge of a ImageSize.
-func (c *ImageSizeClient) QueryImage(is *ImageSize) *ImageQuery {
-	query := (&ImageClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Identity.
+func (c *IdentityClient) QueryUser(i *Identity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := is.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
-			sqlgraph.To(image.Table, image.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, imagesize.ImageTable, imagesize.ImageColumn),
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.UserTable, identity.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ImageSizeClient) Hooks() []Hook {
-	return c.hooks.ImageSize
+func (c *IdentityClient) Hooks() []Hook {
+	return c.hooks.Identity
 }
 
 // Interceptors returns the client interceptors.
-func (c *ImageSizeClient) Interceptors() []Interceptor {
-	return c.inters.ImageSize
+func (c *IdentityClient) Interceptors() []Interceptor {
+	return c.inters.Identity
 }
 
-func (c *ImageSizeClient) mutate(ctx context.Context, m *ImageSizeMutation) (Value, error) {
+func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ImageSize mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Identity mutation op: %q", m.Op())
 	}
 }
 
-// InvitationClient is a client for the Invitation schema.
-type InvitationClient struct {
+// ImageClient is a client for the Image schema.
+type ImageClient struct {
 	config
 }
 
-// NewInvitationClient returns a client for the Invitation from the given config.
-func NewInvitationClient(c config) *InvitationClient {
-	return &InvitationClient{config: c}
+// NewImageClient returns a client for the Image from the given config.
+func NewImageClient(c config) *ImageClient {
+	return &ImageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
-func (c *InvitationClient) Use(hooks ...Hook) {
-	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+// A call to `Use(f, g, h)` equals to `image.Hooks(f(g(h())))`.
+func (c *ImageClient) Use(hooks ...Hook) {
+	c.hooks.Image = append(c.hooks.Image, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
-func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `image.Intercept(f(g(h())))`.
+func (c *ImageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Image = append(c.inters.Image, interceptors...)
 }
 
-// Create returns a builder for creating a Invitation entity.
-func (c *InvitationClient) Create() *InvitationCreate {
-	mutation := newInvitationMutation(c.config, OpCreate)
-	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Image entity.
+func (c *ImageClient) Create() *ImageCreate {
+	mutation := newImageMutation(c.config, OpCreate)
+	return &ImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invitation entities.
-func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Image entities.
+func (c *ImageClient) CreateBulk(builders ...*ImageCreate) *ImageCreateBulk {
+	return &ImageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+func (c *ImageClient) MapCreateBulk(slice any, setFunc func(*ImageCreate, int)) *ImageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImageCreateBulk{err: fmt.Errorf("calling to ImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvitationCreate, rv.Len())
+	builders := make([]*ImageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+	return &ImageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invitation.
-func (c *InvitationClient) Update() *InvitationUpdate {
-	mutation := newInvitationMutation(c.config, OpUpdate)
-	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Image.
+func (c *ImageClient) Update() *ImageUpdate {
+	mutation := newImageMutation(c.config, OpUpdate)
+	return &ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageClient) UpdateOne(i *Image) *ImageUpdateOne {
+	mutation := newImageMutation(c.config, OpUpdateOne, withImage(i))
+	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageClient) UpdateOneID(id int) *ImageUpdateOne {
+	mutation := newImageMutation(c.config, OpUpdateOne, withImageID(id))
+	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invitation.
-func (c *InvitationClient) Delete() *InvitationDelete {
-	mutation := newInvitationMutation(c.config, OpDelete)
-	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Image.
+func (c *ImageClient) Delete() *ImageDelete {
+	mutation := newImageMutation(c.config, OpDelete)
+	return &ImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+func (c *ImageClient) DeleteOne(i *Image) *ImageDeleteOne {
 	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
-	builder := c.Delete().Where(invitation.ID(id))
+func (c *ImageClient) DeleteOneID(id int) *ImageDeleteOne {
+	builder := c.Delete().Where(image.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvitationDeleteOne{builder}
+	return &ImageDeleteOne{builder}
 }
 
-// Query returns a query builder for Invitation.
-func (c *InvitationClient) Query() *InvitationQuery {
-	return &InvitationQuery{
+// Query returns a query builder for Image.
+func (c *ImageClient) Query() *ImageQuery {
+	return &ImageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvitation},
+		ctx:    &QueryContext{Type: TypeImage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invitation entity by its id.
-func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
-	return c.Query().Where(invitation.ID(id)).Only(ctx)
+// Get returns a Image entity by its id.
+func (c *ImageClient) Get(ctx context.Context, id int) (*Image, error) {
+	return c.Query().Where(image.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
+func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1494,15 +1937,15 @@ func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
 	return obj
 }
 
-// QueryInviter queries the inviter edge of a Invitation.
-func (c *InvitationClient) QueryInviter(i *Invitation) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QuerySizes queries the sizes edge of a Image.
+func (c *ImageClient) QuerySizes(i *Image) *ImageSizeQuery {
+	query := (&ImageSizeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invitation.InviterTable, invitation.InviterColumn),
+			sqlgraph.From(image.Table, image.FieldID, id),
+			sqlgraph.To(imagesize.Table, imagesize.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, image.SizesTable, image.SizesColumn),
 		)
 		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
@@ -1511,131 +1954,131 @@ func (c *InvitationClient) QueryInviter(i *Invitation) *ProfileQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *InvitationClient) Hooks() []Hook {
-	return c.hooks.Invitation
+func (c *ImageClient) Hooks() []Hook {
+	return c.hooks.Image
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvitationClient) Interceptors() []Interceptor {
-	return c.inters.Invitation
+func (c *ImageClient) Interceptors() []Interceptor {
+	return c.inters.Image
 }
 
-func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Image mutation op: %q", m.Op())
 	}
 }
 
-// LastSeenOnlineClient is a client for the LastSeenOnline schema.
-type LastSeenOnlineClient struct {
+// ImageSizeClient is a client for the ImageSize schema.
+type ImageSizeClient struct {
 	config
 }
 
-// NewLastSeenOnlineClient returns a client for the LastSeenOnline from the given config.
-func NewLastSeenOnlineClient(c config) *LastSeenOnlineClient {
-	return &LastSeenOnlineClient{config: c}
+// NewImageSizeClient returns a client for the ImageSize from the given config.
+func NewImageSizeClient(c config) *ImageSizeClient {
+	return &ImageSizeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lastseenonline.Hooks(f(g(h())))`.
-func (c *LastSeenOnlineClient) Use(hooks ...Hook) {
-	c.hooks.LastSeenOnline = append(c.hooks.LastSeenOnline, hooks...)
+// A call to `Use(f, g, h)` equals to `imagesize.Hooks(f(g(h())))`.
+func (c *ImageSizeClient) Use(hooks ...Hook) {
+	c.hooks.ImageSize = append(c.hooks.ImageSize, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lastseenonline.Intercept(f(g(h())))`.
-func (c *LastSeenOnlineClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LastSeenOnline = append(c.inters.LastSeenOnline, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `imagesize.Intercept(f(g(h())))`.
+func (c *ImageSizeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImageSize = append(c.inters.ImageSize, interceptors...)
 }
 
-// Create returns a builder for creating a LastSeenOnline entity.
-func (c *LastSeenOnlineClient) Create() *LastSeenOnlineCreate {
-	mutation := newLastSeenOnlineMutation(c.config, OpCreate)
-	return &LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ImageSize entity.
+func (c *ImageSizeClient) Create() *ImageSizeCreate {
+	mutation := newImageSizeMutation(c.config, OpCreate)
+	return &ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LastSeenOnline entities.
-func (c *LastSeenOnlineClient) CreateBulk(builders ...*LastSeenOnlineCreate) *LastSeenOnlineCreateBulk {
-	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ImageSize entities.
+func (c *ImageSizeClient) CreateBulk(builders ...*ImageSizeCreate) *ImageSizeCreateBulk {
+	return &ImageSizeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LastSeenOnlineClient) MapCreateBulk(slice any, setFunc func(*LastSeenOnlineCreate, int)) *LastSeenOnlineCreateBulk {
+func (c *ImageSizeClient) MapCreateBulk(slice any, setFunc func(*ImageSizeCreate, int)) *ImageSizeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LastSeenOnlineCreateBulk{err: fmt.Errorf("calling to LastSeenOnlineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImageSizeCreateBulk{err: fmt.Errorf("calling to ImageSizeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LastSeenOnlineCreate, rv.Len())
+	builders := make([]*ImageSizeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
+	return &ImageSizeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LastSeenOnline.
-func (c *LastSeenOnlineClient) Update() *LastSeenOnlineUpdate {
-	mutation := newLastSeenOnlineMutation(c.config, OpUpdate)
-	return &LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ImageSize.
+func (c *ImageSizeClient) Update() *ImageSizeUpdate {
+	mutation := newImageSizeMutation(c.config, OpUpdate)
+	return &ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LastSeenOnlineClient) UpdateOne(lso *LastSeenOnline) *LastSeenOnlineUpdateOne {
-	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnline(lso))
-	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageSizeClient) UpdateOne(is *ImageSize) *ImageSizeUpdateOne {
+	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSize(is))
+	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LastSeenOnlineClient) UpdateOneID(id int) *LastSeenOnlineUpdateOne {
-	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnlineID(id))
-	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageSizeClient) UpdateOneID(id int) *ImageSizeUpdateOne {
+	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSizeID(id))
+	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LastSeenOnline.
-func (c *LastSeenOnlineClient) Delete() *LastSeenOnlineDelete {
-	mutation := newLastSeenOnlineMutation(c.config, OpDelete)
-	return &LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ImageSize.
+func (c *ImageSizeClient) Delete() *ImageSizeDelete {
+	mutation := newImageSizeMutation(c.config, OpDelete)
+	return &ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LastSeenOnlineClient) DeleteOne(lso *LastSeenOnline) *LastSeenOnlineDeleteOne {
-	return c.DeleteOneID(lso.ID)
+func (c *ImageSizeClient) DeleteOne(is *ImageSize) *ImageSizeDeleteOne {
+	return c.DeleteOneID(is.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LastSeenOnlineClient) DeleteOneID(id int) *LastSeenOnlineDeleteOne {
-	builder := c.Delete().Where(lastseenonline.ID(id))
+func (c *ImageSizeClient) DeleteOneID(id int) *ImageSizeDeleteOne {
+	builder := c.Delete().Where(imagesize.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LastSeenOnlineDeleteOne{builder}
+	return &ImageSizeDeleteOne{builder}
 }
 
-// Query returns a query builder for LastSeenOnline.
-func (c *LastSeenOnlineClient) Query() *LastSeenOnlineQuery {
-	return &LastSeenOnlineQuery{
+// Query returns a query builder for ImageSize.
+func (c *ImageSizeClient) Query() *ImageSizeQuery {
+	return &ImageSizeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLastSeenOnline},
+		ctx:    &QueryContext{Type: TypeImageSize},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LastSeenOnline entity by its id.
-func (c *LastSeenOnlineClient) Get(ctx context.Context, id int) (*LastSeenOnline, error) {
-	return c.Query().Where(lastseenonline.ID(id)).Only(ctx)
+// Get returns a ImageSize entity by its id.
+func (c *ImageSizeClient) Get(ctx context.Context, id int) (*ImageSize, error) {
+	return c.Query().Where(imagesize.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LastSeenOnlineClient) GetX(ctx context.Context, id int) *LastSeenOnline {
+func (c *ImageSizeClient) GetX(ctx context.Context, id int) *ImageSize {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1643,148 +2086,164 @@ func (c *LastSeenOnlineClient) GetX(ctx context.Context, id int) *LastSeenOnline
 	return obj
 }
 
-// QueryUser queries the user edge of a LastSeenOnline.
-func (c *LastSeenOnlineClient) QueryUser(lso *LastSeenOnline) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryFile queries the file edge of a ImageSize.
+func (c *ImageSizeClient) QueryFile(is *ImageSize) *FileStorageQuery {
+	query := (&FileStorageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lso.ID
+		id := is.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lastseenonline.Table, lastseenonline.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lastseenonline.UserTable, lastseenonline.UserColumn),
+			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
+			sqlgraph.To(filestorage.Table, filestorage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, imagesize.FileTable, imagesize.FileColumn),
 		)
-		fromV = sqlgraph.Neighbors(lso.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImage queries the image edge of a ImageSize.
+func (c *ImageSizeClient) QueryImage(is *ImageSize) *ImageQuery {
+	query := (&ImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := is.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
+			sqlgraph.To(image.Table, image.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, imagesize.ImageTable, imagesize.ImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LastSeenOnlineClient) Hooks() []Hook {
-	return c.hooks.LastSeenOnline
+func (c *ImageSizeClient) Hooks() []Hook {
+	return c.hooks.ImageSize
 }
 
 // Interceptors returns the client interceptors.
-func (c *LastSeenOnlineClient) Interceptors() []Interceptor {
-	return c.inters.LastSeenOnline
+func (c *ImageSizeClient) Interceptors() []Interceptor {
+	return c.inters.ImageSize
 }
 
-func (c *LastSeenOnlineClient) mutate(ctx context.Context, m *LastSeenOnlineMutation) (Value, error) {
+func (c *ImageSizeClient) mutate(ctx context.Context, m *ImageSizeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LastSeenOnline mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ImageSize mutation op: %q", m.Op())
 	}
 }
 
-// MonthlySubscriptionClient is a client for the MonthlySubscription schema.
-type MonthlySubscriptionClient struct {
+// ImpersonationClient is a client for the Impersonation schema.
+type ImpersonationClient struct {
 	config
 }
 
-// NewMonthlySubscriptionClient returns a client for the MonthlySubscription from the given config.
-func NewMonthlySubscriptionClient(c config) *MonthlySubscriptionClient {
-	return &MonthlySubscriptionClient{config: c}
+// NewImpersonationClient returns a client for the Impersonation from the given config.
+func NewImpersonationClient(c config) *ImpersonationClient {
+	return &ImpersonationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `monthlysubscription.Hooks(f(g(h())))`.
-func (c *MonthlySubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.MonthlySubscription = append(c.hooks.MonthlySubscription, hooks...)
+// A call to `Use(f, g, h)` equals to `impersonation.Hooks(f(g(h())))`.
+func (c *ImpersonationClient) Use(hooks ...Hook) {
+	c.hooks.Impersonation = append(c.hooks.Impersonation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `monthlysubscription.Intercept(f(g(h())))`.
-func (c *MonthlySubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MonthlySubscription = append(c.inters.MonthlySubscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `impersonation.Intercept(f(g(h())))`.
+func (c *ImpersonationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Impersonation = append(c.inters.Impersonation, interceptors...)
 }
 
-// Create returns a builder for creating a MonthlySubscription entity.
-func (c *MonthlySubscriptionClient) Create() *MonthlySubscriptionCreate {
-	mutation := newMonthlySubscriptionMutation(c.config, OpCreate)
-	return &MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Impersonation entity.
+func (c *ImpersonationClient) Create() *ImpersonationCreate {
+	mutation := newImpersonationMutation(c.config, OpCreate)
+	return &ImpersonationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MonthlySubscription entities.
-func (c *MonthlySubscriptionClient) CreateBulk(builders ...*MonthlySubscriptionCreate) *MonthlySubscriptionCreateBulk {
-	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Impersonation entities.
+func (c *ImpersonationClient) CreateBulk(builders ...*ImpersonationCreate) *ImpersonationCreateBulk {
+	return &ImpersonationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MonthlySubscriptionClient) MapCreateBulk(slice any, setFunc func(*MonthlySubscriptionCreate, int)) *MonthlySubscriptionCreateBulk {
+func (c *ImpersonationClient) MapCreateBulk(slice any, setFunc func(*ImpersonationCreate, int)) *ImpersonationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MonthlySubscriptionCreateBulk{err: fmt.Errorf("calling to MonthlySubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImpersonationCreateBulk{err: fmt.Errorf("calling to ImpersonationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MonthlySubscriptionCreate, rv.Len())
+	builders := make([]*ImpersonationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
+	return &ImpersonationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MonthlySubscription.
-func (c *MonthlySubscriptionClient) Update() *MonthlySubscriptionUpdate {
-	mutation := newMonthlySubscriptionMutation(c.config, OpUpdate)
-	return &MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Impersonation.
+func (c *ImpersonationClient) Update() *ImpersonationUpdate {
+	mutation := newImpersonationMutation(c.config, OpUpdate)
+	return &ImpersonationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MonthlySubscriptionClient) UpdateOne(ms *MonthlySubscription) *MonthlySubscriptionUpdateOne {
-	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscription(ms))
-	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImpersonationClient) UpdateOne(i *Impersonation) *ImpersonationUpdateOne {
+	mutation := newImpersonationMutation(c.config, OpUpdateOne, withImpersonation(i))
+	return &ImpersonationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MonthlySubscriptionClient) UpdateOneID(id int) *MonthlySubscriptionUpdateOne {
-	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscriptionID(id))
-	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImpersonationClient) UpdateOneID(id int) *ImpersonationUpdateOne {
+	mutation := newImpersonationMutation(c.config, OpUpdateOne, withImpersonationID(id))
+	return &ImpersonationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MonthlySubscription.
-func (c *MonthlySubscriptionClient) Delete() *MonthlySubscriptionDelete {
-	mutation := newMonthlySubscriptionMutation(c.config, OpDelete)
-	return &MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Impersonation.
+func (c *ImpersonationClient) Delete() *ImpersonationDelete {
+	mutation := newImpersonationMutation(c.config, OpDelete)
+	return &ImpersonationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MonthlySubscriptionClient) DeleteOne(ms *MonthlySubscription) *MonthlySubscriptionDeleteOne {
-	return c.DeleteOneID(ms.ID)
+func (c *ImpersonationClient) DeleteOne(i *Impersonation) *ImpersonationDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MonthlySubscriptionClient) DeleteOneID(id int) *MonthlySubscriptionDeleteOne {
-	builder := c.Delete().Where(monthlysubscription.ID(id))
+func (c *ImpersonationClient) DeleteOneID(id int) *ImpersonationDeleteOne {
+	builder := c.Delete().Where(impersonation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MonthlySubscriptionDeleteOne{builder}
+	return &ImpersonationDeleteOne{builder}
 }
 
-// Query returns a query builder for MonthlySubscription.
-func (c *MonthlySubscriptionClient) Query() *MonthlySubscriptionQuery {
-	return &MonthlySubscriptionQuery{
+// Query returns a query builder for Impersonation.
+func (c *ImpersonationClient) Query() *ImpersonationQuery {
+	return &ImpersonationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMonthlySubscription},
+		ctx:    &QueryContext{Type: TypeImpersonation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MonthlySubscription entity by its id.
-func (c *MonthlySubscriptionClient) Get(ctx context.Context, id int) (*MonthlySubscription, error) {
-	return c.Query().Where(monthlysubscription.ID(id)).Only(ctx)
+// Get returns a Impersonation entity by its id.
+func (c *ImpersonationClient) Get(ctx context.Context, id int) (*Impersonation, error) {
+	return c.Query().Where(impersonation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MonthlySubscriptionClient) GetX(ctx context.Context, id int) *MonthlySubscription {
+func (c *ImpersonationClient) GetX(ctx context.Context, id int) *Impersonation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1792,164 +2251,164 @@ func (c *MonthlySubscriptionClient) GetX(ctx context.Context, id int) *MonthlySu
 	return obj
 }
 
-// QueryBenefactors queries the benefactors edge of a MonthlySubscription.
-func (c *MonthlySubscriptionClient) QueryBenefactors(ms *MonthlySubscription) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryAdmin queries the admin edge of a Impersonation.
+func (c *ImpersonationClient) QueryAdmin(i *Impersonation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ms.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, monthlysubscription.BenefactorsTable, monthlysubscription.BenefactorsPrimaryKey...),
+			sqlgraph.From(impersonation.Table, impersonation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, impersonation.AdminTable, impersonation.AdminColumn),
 		)
-		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryPayer queries the payer edge of a MonthlySubscription.
-func (c *MonthlySubscriptionClient) QueryPayer(ms *MonthlySubscription) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Impersonation.
+func (c *ImpersonationClient) QueryUser(i *Impersonation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ms.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, monthlysubscription.PayerTable, monthlysubscription.PayerColumn),
+			sqlgraph.From(impersonation.Table, impersonation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, impersonation.UserTable, impersonation.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MonthlySubscriptionClient) Hooks() []Hook {
-	return c.hooks.MonthlySubscription
+func (c *ImpersonationClient) Hooks() []Hook {
+	return c.hooks.Impersonation
 }
 
 // Interceptors returns the client interceptors.
-func (c *MonthlySubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.MonthlySubscription
+func (c *ImpersonationClient) Interceptors() []Interceptor {
+	return c.inters.Impersonation
 }
 
-func (c *MonthlySubscriptionClient) mutate(ctx context.Context, m *MonthlySubscriptionMutation) (Value, error) {
+func (c *ImpersonationClient) mutate(ctx context.Context, m *ImpersonationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImpersonationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImpersonationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImpersonationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImpersonationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MonthlySubscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Impersonation mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*InvitationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &InvitationDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeInvitation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1957,149 +2416,164 @@ func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a Notification.
-func (c *NotificationClient) QueryProfile(n *Notification) *ProfileQuery {
+// QueryInviter queries the inviter edge of a Invitation.
+func (c *InvitationClient) QueryInviter(i *Invitation) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notification.ProfileTable, notification.ProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.InviterTable, invitation.InviterColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitee queries the invitee edge of a Invitation.
+func (c *InvitationClient) QueryInvitee(i *Invitation) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invitation.InviteeTable, invitation.InviteeColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	hooks := c.hooks.Notification
-	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *InvitationClient) Interceptors() []Interceptor {
+	return c.inters.Invitation
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
 	}
 }
 
-// NotificationPermissionClient is a client for the NotificationPermission schema.
-type NotificationPermissionClient struct {
+// LastSeenOnlineClient is a client for the LastSeenOnline schema.
+type LastSeenOnlineClient struct {
 	config
 }
 
-// NewNotificationPermissionClient returns a client for the NotificationPermission from the given config.
-func NewNotificationPermissionClient(c config) *NotificationPermissionClient {
-	return &NotificationPermissionClient{config: c}
+// NewLastSeenOnlineClient returns a client for the LastSeenOnline from the given config.
+func NewLastSeenOnlineClient(c config) *LastSeenOnlineClient {
+	return &LastSeenOnlineClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notificationpermission.Hooks(f(g(h())))`.
-func (c *NotificationPermissionClient) Use(hooks ...Hook) {
-	c.hooks.NotificationPermission = append(c.hooks.NotificationPermission, hooks...)
+// A call to `Use(f, g, h)` equals to `lastseenonline.Hooks(f(g(h())))`.
+func (c *LastSeenOnlineClient) Use(hooks ...Hook) {
+	c.hooks.LastSeenOnline = append(c.hooks.LastSeenOnline, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notificationpermission.Intercept(f(g(h())))`.
-func (c *NotificationPermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NotificationPermission = append(c.inters.NotificationPermission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `lastseenonline.Intercept(f(g(h())))`.
+func (c *LastSeenOnlineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LastSeenOnline = append(c.inters.LastSeenOnline, interceptors...)
 }
 
-// Create returns a builder for creating a NotificationPermission entity.
-func (c *NotificationPermissionClient) Create() *NotificationPermissionCreate {
-	mutation := newNotificationPermissionMutation(c.config, OpCreate)
-	return &NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LastSeenOnline entity.
+func (c *LastSeenOnlineClient) Create() *LastSeenOnlineCreate {
+	mutation := newLastSeenOnlineMutation(c.config, OpCreate)
+	return &LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NotificationPermission entities.
-func (c *NotificationPermissionClient) CreateBulk(builders ...*NotificationPermissionCreate) *NotificationPermissionCreateBulk {
-	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LastSeenOnline entities.
+func (c *LastSeenOnlineClient) CreateBulk(builders ...*LastSeenOnlineCreate) *LastSeenOnlineCreateBulk {
+	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationPermissionClient) MapCreateBulk(slice any, setFunc func(*NotificationPermissionCreate, int)) *NotificationPermissionCreateBulk {
+func (c *LastSeenOnlineClient) MapCreateBulk(slice any, setFunc func(*LastSeenOnlineCreate, int)) *LastSeenOnlineCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationPermissionCreateBulk{err: fmt.Errorf("calling to NotificationPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LastSeenOnlineCreateBulk{err: fmt.Errorf("calling to LastSeenOnlineClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationPermissionCreate, rv.Len())
+	builders := make([]*LastSeenOnlineCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
+	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NotificationPermission.
-func (c *NotificationPermissionClient) Update() *NotificationPermissionUpdate {
-	mutation := newNotificationPermissionMutation(c.config, OpUpdate)
-	return &NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LastSeenOnline.
+func (c *LastSeenOnlineClient) Update() *LastSeenOnlineUpdate {
+	mutation := newLastSeenOnlineMutation(c.config, OpUpdate)
+	return &LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationPermissionClient) UpdateOne(np *NotificationPermission) *NotificationPermissionUpdateOne {
-	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermission(np))
-	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LastSeenOnlineClient) UpdateOne(lso *LastSeenOnline) *LastSeenOnlineUpdateOne {
+	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnline(lso))
+	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationPermissionClient) UpdateOneID(id int) *NotificationPermissionUpdateOne {
-	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermissionID(id))
-	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LastSeenOnlineClient) UpdateOneID(id int) *LastSeenOnlineUpdateOne {
+	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnlineID(id))
+	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationPermission.
-func (c *NotificationPermissionClient) Delete() *NotificationPermissionDelete {
-	mutation := newNotificationPermissionMutation(c.config, OpDelete)
-	return &NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LastSeenOnline.
+func (c *LastSeenOnlineClient) Delete() *LastSeenOnlineDelete {
+	mutation := newLastSeenOnlineMutation(c.config, OpDelete)
+	return &LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationPermissionClient) DeleteOne(np *NotificationPermission) *NotificationPermissionDeleteOne {
-	return c.DeleteOneID(np.ID)
+func (c *LastSeenOnlineClient) DeleteOne(lso *LastSeenOnline) *LastSeenOnlineDeleteOne {
+	return c.DeleteOneID(lso.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationPermissionClient) DeleteOneID(id int) *NotificationPermissionDeleteOne {
-	builder := c.Delete().Where(notificationpermission.ID(id))
+func (c *LastSeenOnlineClient) DeleteOneID(id int) *LastSeenOnlineDeleteOne {
+	builder := c.Delete().Where(lastseenonline.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationPermissionDeleteOne{builder}
+	return &LastSeenOnlineDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationPermission.
-func (c *NotificationPermissionClient) Query() *NotificationPermissionQuery {
-	return &NotificationPermissionQuery{
+// Query returns a query builder for LastSeenOnline.
+func (c *LastSeenOnlineClient) Query() *LastSeenOnlineQuery {
+	return &LastSeenOnlineQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationPermission},
+		ctx:    &QueryContext{Type: TypeLastSeenOnline},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationPermission entity by its id.
-func (c *NotificationPermissionClient) Get(ctx context.Context, id int) (*NotificationPermission, error) {
-	return c.Query().Where(notificationpermission.ID(id)).Only(ctx)
+// Get returns a LastSeenOnline entity by its id.
+func (c *LastSeenOnlineClient) Get(ctx context.Context, id int) (*LastSeenOnline, error) {
+	return c.Query().Where(lastseenonline.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationPermissionClient) GetX(ctx context.Context, id int) *NotificationPermission {
+func (c *LastSeenOnlineClient) GetX(ctx context.Context, id int) *LastSeenOnline {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2107,148 +2581,148 @@ func (c *NotificationPermissionClient) GetX(ctx context.Context, id int) *Notifi
 	return obj
 }
 
-// QueryProfile queries the profile edge of a NotificationPermission.
-func (c *NotificationPermissionClient) QueryProfile(np *NotificationPermission) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryUser queries the user edge of a LastSeenOnline.
+func (c *LastSeenOnlineClient) QueryUser(lso *LastSeenOnline) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := np.ID
+		id := lso.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notificationpermission.Table, notificationpermission.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notificationpermission.ProfileTable, notificationpermission.ProfileColumn),
+			sqlgraph.From(lastseenonline.Table, lastseenonline.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lastseenonline.UserTable, lastseenonline.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(lso.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationPermissionClient) Hooks() []Hook {
-	return c.hooks.NotificationPermission
+func (c *LastSeenOnlineClient) Hooks() []Hook {
+	return c.hooks.LastSeenOnline
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationPermissionClient) Interceptors() []Interceptor {
-	return c.inters.NotificationPermission
+func (c *LastSeenOnlineClient) Interceptors() []Interceptor {
+	return c.inters.LastSeenOnline
 }
 
-func (c *NotificationPermissionClient) mutate(ctx context.Context, m *NotificationPermissionMutation) (Value, error) {
+func (c *LastSeenOnlineClient) mutate(ctx context.Context, m *LastSeenOnlineMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NotificationPermission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LastSeenOnline mutation op: %q", m.Op())
 	}
 }
 
-// NotificationTimeClient is a client for the NotificationTime schema.
-type NotificationTimeClient struct {
+// MagicLinkTokenClient is a client for the MagicLinkToken schema.
+type MagicLinkTokenClient struct {
 	config
 }
 
-// NewNotificationTimeClient returns a client for the NotificationTime from the given config.
-func NewNotificationTimeClient(c config) *NotificationTimeClient {
-	return &NotificationTimeClient{config: c}
+// NewMagicLinkTokenClient returns a client for the MagicLinkToken from the given config.
+func NewMagicLinkTokenClient(c config) *MagicLinkTokenClient {
+	return &MagicLinkTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notificationtime.Hooks(f(g(h())))`.
-func (c *NotificationTimeClient) Use(hooks ...Hook) {
-	c.hooks.NotificationTime = append(c.hooks.NotificationTime, hooks...)
+// A call to `Use(f, g, h)` equals to `magiclinktoken.Hooks(f(g(h())))`.
+func (c *MagicLinkTokenClient) Use(hooks ...Hook) {
+	c.hooks.MagicLinkToken = append(c.hooks.MagicLinkToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notificationtime.Intercept(f(g(h())))`.
-func (c *NotificationTimeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NotificationTime = append(c.inters.NotificationTime, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `magiclinktoken.Intercept(f(g(h())))`.
+func (c *MagicLinkTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MagicLinkToken = append(c.inters.MagicLinkToken, interceptors...)
 }
 
-// Create returns a builder for creating a NotificationTime entity.
-func (c *NotificationTimeClient) Create() *NotificationTimeCreate {
-	mutation := newNotificationTimeMutation(c.config, OpCreate)
-	return &NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MagicLinkToken entity.
+func (c *MagicLinkTokenClient) Create() *MagicLinkTokenCreate {
+	mutation := newMagicLinkTokenMutation(c.config, OpCreate)
+	return &MagicLinkTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NotificationTime entities.
-func (c *NotificationTimeClient) CreateBulk(builders ...*NotificationTimeCreate) *NotificationTimeCreateBulk {
-	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MagicLinkToken entities.
+func (c *MagicLinkTokenClient) CreateBulk(builders ...*MagicLinkTokenCreate) *MagicLinkTokenCreateBulk {
+	return &MagicLinkTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationTimeClient) MapCreateBulk(slice any, setFunc func(*NotificationTimeCreate, int)) *NotificationTimeCreateBulk {
+func (c *MagicLinkTokenClient) MapCreateBulk(slice any, setFunc func(*MagicLinkTokenCreate, int)) *MagicLinkTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationTimeCreateBulk{err: fmt.Errorf("calling to NotificationTimeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MagicLinkTokenCreateBulk{err: fmt.Errorf("calling to MagicLinkTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationTimeCreate, rv.Len())
+	builders := make([]*MagicLinkTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+	return &MagicLinkTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NotificationTime.
-func (c *NotificationTimeClient) Update() *NotificationTimeUpdate {
-	mutation := newNotificationTimeMutation(c.config, OpUpdate)
-	return &NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MagicLinkToken.
+func (c *MagicLinkTokenClient) Update() *MagicLinkTokenUpdate {
+	mutation := newMagicLinkTokenMutation(c.config, OpUpdate)
+	return &MagicLinkTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationTimeClient) UpdateOne(nt *NotificationTime) *NotificationTimeUpdateOne {
-	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTime(nt))
-	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MagicLinkTokenClient) UpdateOne(mlt *MagicLinkToken) *MagicLinkTokenUpdateOne {
+	mutation := newMagicLinkTokenMutation(c.config, OpUpdateOne, withMagicLinkToken(mlt))
+	return &MagicLinkTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationTimeClient) UpdateOneID(id int) *NotificationTimeUpdateOne {
-	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTimeID(id))
-	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MagicLinkTokenClient) UpdateOneID(id int) *MagicLinkTokenUpdateOne {
+	mutation := newMagicLinkTokenMutation(c.config, OpUpdateOne, withMagicLinkTokenID(id))
+	return &MagicLinkTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationTime.
-func (c *NotificationTimeClient) Delete() *NotificationTimeDelete {
-	mutation := newNotificationTimeMutation(c.config, OpDelete)
-	return &NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MagicLinkToken.
+func (c *MagicLinkTokenClient) Delete() *MagicLinkTokenDelete {
+	mutation := newMagicLinkTokenMutation(c.config, OpDelete)
+	return &MagicLinkTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationTimeClient) DeleteOne(nt *NotificationTime) *NotificationTimeDeleteOne {
-	return c.DeleteOneID(nt.ID)
+func (c *MagicLinkTokenClient) DeleteOne(mlt *MagicLinkToken) *MagicLinkTokenDeleteOne {
+	return c.DeleteOneID(mlt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationTimeClient) DeleteOneID(id int) *NotificationTimeDeleteOne {
-	builder := c.Delete().Where(notificationtime.ID(id))
+func (c *MagicLinkTokenClient) DeleteOneID(id int) *MagicLinkTokenDeleteOne {
+	builder := c.Delete().Where(magiclinktoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationTimeDeleteOne{builder}
+	return &MagicLinkTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationTime.
-func (c *NotificationTimeClient) Query() *NotificationTimeQuery {
-	return &NotificationTimeQuery{
+// Query returns a query builder for MagicLinkToken.
+func (c *MagicLinkTokenClient) Query() *MagicLinkTokenQuery {
+	return &MagicLinkTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationTime},
+		ctx:    &QueryContext{Type: TypeMagicLinkToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationTime entity by its id.
-func (c *NotificationTimeClient) Get(ctx context.Context, id int) (*NotificationTime, error) {
-	return c.Query().Where(notificationtime.ID(id)).Only(ctx)
+// Get returns a MagicLinkToken entity by its id.
+func (c *MagicLinkTokenClient) Get(ctx context.Context, id int) (*MagicLinkToken, error) {
+	return c.Query().Where(magiclinktoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationTimeClient) GetX(ctx context.Context, id int) *NotificationTime {
+func (c *MagicLinkTokenClient) GetX(ctx context.Context, id int) *MagicLinkToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2256,148 +2730,148 @@ func (c *NotificationTimeClient) GetX(ctx context.Context, id int) *Notification
 	return obj
 }
 
-// QueryProfile queries the profile edge of a NotificationTime.
-func (c *NotificationTimeClient) QueryProfile(nt *NotificationTime) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryUser queries the user edge of a MagicLinkToken.
+func (c *MagicLinkTokenClient) QueryUser(mlt *MagicLinkToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nt.ID
+		id := mlt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notificationtime.Table, notificationtime.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notificationtime.ProfileTable, notificationtime.ProfileColumn),
+			sqlgraph.From(magiclinktoken.Table, magiclinktoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, magiclinktoken.UserTable, magiclinktoken.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(nt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(mlt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationTimeClient) Hooks() []Hook {
-	return c.hooks.NotificationTime
+func (c *MagicLinkTokenClient) Hooks() []Hook {
+	return c.hooks.MagicLinkToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationTimeClient) Interceptors() []Interceptor {
-	return c.inters.NotificationTime
+func (c *MagicLinkTokenClient) Interceptors() []Interceptor {
+	return c.inters.MagicLinkToken
 }
 
-func (c *NotificationTimeClient) mutate(ctx context.Context, m *NotificationTimeMutation) (Value, error) {
+func (c *MagicLinkTokenClient) mutate(ctx context.Context, m *MagicLinkTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MagicLinkTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MagicLinkTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MagicLinkTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MagicLinkTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NotificationTime mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MagicLinkToken mutation op: %q", m.Op())
 	}
 }
 
-// PasswordTokenClient is a client for the PasswordToken schema.
-type PasswordTokenClient struct {
+// MonthlySubscriptionClient is a client for the MonthlySubscription schema.
+type MonthlySubscriptionClient struct {
 	config
 }
 
-// NewPasswordTokenClient returns a client for the PasswordToken from the given config.
-func NewPasswordTokenClient(c config) *PasswordTokenClient {
-	return &PasswordTokenClient{config: c}
+// NewMonthlySubscriptionClient returns a client for the MonthlySubscription from the given config.
+func NewMonthlySubscriptionClient(c config) *MonthlySubscriptionClient {
+	return &MonthlySubscriptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `passwordtoken.Hooks(f(g(h())))`.
-func (c *PasswordTokenClient) Use(hooks ...Hook) {
-	c.hooks.PasswordToken = append(c.hooks.PasswordToken, hooks...)
+// A call to `Use(f, g, h)` equals to `monthlysubscription.Hooks(f(g(h())))`.
+func (c *MonthlySubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.MonthlySubscription = append(c.hooks.MonthlySubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `passwordtoken.Intercept(f(g(h())))`.
-func (c *PasswordTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PasswordToken = append(c.inters.PasswordToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `monthlysubscription.Intercept(f(g(h())))`.
+func (c *MonthlySubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MonthlySubscription = append(c.inters.MonthlySubscription, interceptors...)
 }
 
-// Create returns a builder for creating a PasswordToken entity.
-func (c *PasswordTokenClient) Create() *PasswordTokenCreate {
-	mutation := newPasswordTokenMutation(c.config, OpCreate)
-	return &PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MonthlySubscription entity.
+func (c *MonthlySubscriptionClient) Create() *MonthlySubscriptionCreate {
+	mutation := newMonthlySubscriptionMutation(c.config, OpCreate)
+	return &MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PasswordToken entities.
-func (c *PasswordTokenClient) CreateBulk(builders ...*PasswordTokenCreate) *PasswordTokenCreateBulk {
-	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MonthlySubscription entities.
+func (c *MonthlySubscriptionClient) CreateBulk(builders ...*MonthlySubscriptionCreate) *MonthlySubscriptionCreateBulk {
+	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PasswordTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordTokenCreate, int)) *PasswordTokenCreateBulk {
+func (c *MonthlySubscriptionClient) MapCreateBulk(slice any, setFunc func(*MonthlySubscriptionCreate, int)) *MonthlySubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PasswordTokenCreateBulk{err: fmt.Errorf("calling to PasswordTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MonthlySubscriptionCreateBulk{err: fmt.Errorf("calling to MonthlySubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PasswordTokenCreate, rv.Len())
+	builders := make([]*MonthlySubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PasswordToken.
-func (c *PasswordTokenClient) Update() *PasswordTokenUpdate {
-	mutation := newPasswordTokenMutation(c.config, OpUpdate)
-	return &PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MonthlySubscription.
+func (c *MonthlySubscriptionClient) Update() *MonthlySubscriptionUpdate {
+	mutation := newMonthlySubscriptionMutation(c.config, OpUpdate)
+	return &MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PasswordTokenClient) UpdateOne(pt *PasswordToken) *PasswordTokenUpdateOne {
-	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordToken(pt))
-	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MonthlySubscriptionClient) UpdateOne(ms *MonthlySubscription) *MonthlySubscriptionUpdateOne {
+	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscription(ms))
+	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PasswordTokenClient) UpdateOneID(id int) *PasswordTokenUpdateOne {
-	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordTokenID(id))
-	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MonthlySubscriptionClient) UpdateOneID(id int) *MonthlySubscriptionUpdateOne {
+	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscriptionID(id))
+	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PasswordToken.
-func (c *PasswordTokenClient) Delete() *PasswordTokenDelete {
-	mutation := newPasswordTokenMutation(c.config, OpDelete)
-	return &PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MonthlySubscription.
+func (c *MonthlySubscriptionClient) Delete() *MonthlySubscriptionDelete {
+	mutation := newMonthlySubscriptionMutation(c.config, OpDelete)
+	return &MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PasswordTokenClient) DeleteOne(pt *PasswordToken) *PasswordTokenDeleteOne {
-	return c.DeleteOneID(pt.ID)
+func (c *MonthlySubscriptionClient) DeleteOne(ms *MonthlySubscription) *MonthlySubscriptionDeleteOne {
+	return c.DeleteOneID(ms.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PasswordTokenClient) DeleteOneID(id int) *PasswordTokenDeleteOne {
-	builder := c.Delete().Where(passwordtoken.ID(id))
+func (c *MonthlySubscriptionClient) DeleteOneID(id int) *MonthlySubscriptionDeleteOne {
+	builder := c.Delete().Where(monthlysubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PasswordTokenDeleteOne{builder}
+	return &MonthlySubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for PasswordToken.
-func (c *PasswordTokenClient) Query() *PasswordTokenQuery {
-	return &PasswordTokenQuery{
+// Query returns a query builder for MonthlySubscription.
+func (c *MonthlySubscriptionClient) Query() *MonthlySubscriptionQuery {
+	return &MonthlySubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePasswordToken},
+		ctx:    &QueryContext{Type: TypeMonthlySubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PasswordToken entity by its id.
-func (c *PasswordTokenClient) Get(ctx context.Context, id int) (*PasswordToken, error) {
-	return c.Query().Where(passwordtoken.ID(id)).Only(ctx)
+// Get returns a MonthlySubscription entity by its id.
+func (c *MonthlySubscriptionClient) Get(ctx context.Context, id int) (*MonthlySubscription, error) {
+	return c.Query().Where(monthlysubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
+func (c *MonthlySubscriptionClient) GetX(ctx context.Context, id int) *MonthlySubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2405,148 +2879,180 @@ func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
 	return obj
 }
 
-// QueryUser queries the user edge of a PasswordToken.
-func (c *PasswordTokenClient) QueryUser(pt *PasswordToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryBenefactors queries the benefactors edge of a MonthlySubscription.
+func (c *MonthlySubscriptionClient) QueryBenefactors(ms *MonthlySubscription) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pt.ID
+		id := ms.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(passwordtoken.Table, passwordtoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, passwordtoken.UserTable, passwordtoken.UserColumn),
+			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, monthlysubscription.BenefactorsTable, monthlysubscription.BenefactorsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayer queries the payer edge of a MonthlySubscription.
+func (c *MonthlySubscriptionClient) QueryPayer(ms *MonthlySubscription) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, monthlysubscription.PayerTable, monthlysubscription.PayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a MonthlySubscription.
+func (c *MonthlySubscriptionClient) QueryOrganization(ms *MonthlySubscription) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, monthlysubscription.OrganizationTable, monthlysubscription.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PasswordTokenClient) Hooks() []Hook {
-	return c.hooks.PasswordToken
+func (c *MonthlySubscriptionClient) Hooks() []Hook {
+	return c.hooks.MonthlySubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *PasswordTokenClient) Interceptors() []Interceptor {
-	return c.inters.PasswordToken
+func (c *MonthlySubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.MonthlySubscription
 }
 
-func (c *PasswordTokenClient) mutate(ctx context.Context, m *PasswordTokenMutation) (Value, error) {
+func (c *MonthlySubscriptionClient) mutate(ctx context.Context, m *MonthlySubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PasswordToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MonthlySubscription mutation op: %q", m.Op())
 	}
 }
 
-// PhoneVerificationCodeClient is a client for the PhoneVerificationCode schema.
-type PhoneVerificationCodeClient struct {
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
 	config
 }
 
-// NewPhoneVerificationCodeClient returns a client for the PhoneVerificationCode from the given config.
-func NewPhoneVerificationCodeClient(c config) *PhoneVerificationCodeClient {
-	return &PhoneVerificationCodeClient{config: c}
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `phoneverificationcode.Hooks(f(g(h())))`.
-func (c *PhoneVerificationCodeClient) Use(hooks ...Hook) {
-	c.hooks.PhoneVerificationCode = append(c.hooks.PhoneVerificationCode, hooks...)
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `phoneverificationcode.Intercept(f(g(h())))`.
-func (c *PhoneVerificationCodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PhoneVerificationCode = append(c.inters.PhoneVerificationCode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
 }
 
-// Create returns a builder for creating a PhoneVerificationCode entity.
-func (c *PhoneVerificationCodeClient) Create() *PhoneVerificationCodeCreate {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpCreate)
-	return &PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PhoneVerificationCode entities.
-func (c *PhoneVerificationCodeClient) CreateBulk(builders ...*PhoneVerificationCodeCreate) *PhoneVerificationCodeCreateBulk {
-	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PhoneVerificationCodeClient) MapCreateBulk(slice any, setFunc func(*PhoneVerificationCodeCreate, int)) *PhoneVerificationCodeCreateBulk {
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PhoneVerificationCodeCreateBulk{err: fmt.Errorf("calling to PhoneVerificationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PhoneVerificationCodeCreate, rv.Len())
+	builders := make([]*NotificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) Update() *PhoneVerificationCodeUpdate {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdate)
-	return &PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PhoneVerificationCodeClient) UpdateOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeUpdateOne {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCode(pvc))
-	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PhoneVerificationCodeClient) UpdateOneID(id int) *PhoneVerificationCodeUpdateOne {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCodeID(id))
-	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) Delete() *PhoneVerificationCodeDelete {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpDelete)
-	return &PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PhoneVerificationCodeClient) DeleteOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeDeleteOne {
-	return c.DeleteOneID(pvc.ID)
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PhoneVerificationCodeClient) DeleteOneID(id int) *PhoneVerificationCodeDeleteOne {
-	builder := c.Delete().Where(phoneverificationcode.ID(id))
+func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PhoneVerificationCodeDeleteOne{builder}
+	return &NotificationDeleteOne{builder}
 }
 
-// Query returns a query builder for PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) Query() *PhoneVerificationCodeQuery {
-	return &PhoneVerificationCodeQuery{
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePhoneVerificationCode},
+		ctx:    &QueryContext{Type: TypeNotification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PhoneVerificationCode entity by its id.
-func (c *PhoneVerificationCodeClient) Get(ctx context.Context, id int) (*PhoneVerificationCode, error) {
-	return c.Query().Where(phoneverificationcode.ID(id)).Only(ctx)
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PhoneVerificationCodeClient) GetX(ctx context.Context, id int) *PhoneVerificationCode {
+func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2554,148 +3060,149 @@ func (c *PhoneVerificationCodeClient) GetX(ctx context.Context, id int) *PhoneVe
 	return obj
 }
 
-// QueryProfile queries the profile edge of a PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) QueryProfile(pvc *PhoneVerificationCode) *ProfileQuery {
+// QueryProfile queries the profile edge of a Notification.
+func (c *NotificationClient) QueryProfile(n *Notification) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pvc.ID
+		id := n.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(phoneverificationcode.Table, phoneverificationcode.FieldID, id),
+			sqlgraph.From(notification.Table, notification.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, phoneverificationcode.ProfileTable, phoneverificationcode.ProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.ProfileTable, notification.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pvc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PhoneVerificationCodeClient) Hooks() []Hook {
-	return c.hooks.PhoneVerificationCode
+func (c *NotificationClient) Hooks() []Hook {
+	hooks := c.hooks.Notification
+	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PhoneVerificationCodeClient) Interceptors() []Interceptor {
-	return c.inters.PhoneVerificationCode
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
 }
 
-func (c *PhoneVerificationCodeClient) mutate(ctx context.Context, m *PhoneVerificationCodeMutation) (Value, error) {
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PhoneVerificationCode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
 	}
 }
 
-// ProfileClient is a client for the Profile schema.
-type ProfileClient struct {
+// NotificationPermissionClient is a client for the NotificationPermission schema.
+type NotificationPermissionClient struct {
 	config
 }
 
-// NewProfileClient returns a client for the Profile from the given config.
-func NewProfileClient(c config) *ProfileClient {
-	return &ProfileClient{config: c}
+// NewNotificationPermissionClient returns a client for the NotificationPermission from the given config.
+func NewNotificationPermissionClient(c config) *NotificationPermissionClient {
+	return &NotificationPermissionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
-func (c *ProfileClient) Use(hooks ...Hook) {
-	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+// A call to `Use(f, g, h)` equals to `notificationpermission.Hooks(f(g(h())))`.
+func (c *NotificationPermissionClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPermission = append(c.hooks.NotificationPermission, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
-func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Profile = append(c.inters.Profile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notificationpermission.Intercept(f(g(h())))`.
+func (c *NotificationPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPermission = append(c.inters.NotificationPermission, interceptors...)
 }
 
-// Create returns a builder for creating a Profile entity.
-func (c *ProfileClient) Create() *ProfileCreate {
-	mutation := newProfileMutation(c.config, OpCreate)
-	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NotificationPermission entity.
+func (c *NotificationPermissionClient) Create() *NotificationPermissionCreate {
+	mutation := newNotificationPermissionMutation(c.config, OpCreate)
+	return &NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Profile entities.
-func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NotificationPermission entities.
+func (c *NotificationPermissionClient) CreateBulk(builders ...*NotificationPermissionCreate) *NotificationPermissionCreateBulk {
+	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+func (c *NotificationPermissionClient) MapCreateBulk(slice any, setFunc func(*NotificationPermissionCreate, int)) *NotificationPermissionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationPermissionCreateBulk{err: fmt.Errorf("calling to NotificationPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfileCreate, rv.Len())
+	builders := make([]*NotificationPermissionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Profile.
-func (c *ProfileClient) Update() *ProfileUpdate {
-	mutation := newProfileMutation(c.config, OpUpdate)
-	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NotificationPermission.
+func (c *NotificationPermissionClient) Update() *NotificationPermissionUpdate {
+	mutation := newNotificationPermissionMutation(c.config, OpUpdate)
+	return &NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfileClient) UpdateOne(pr *Profile) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(pr))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationPermissionClient) UpdateOne(np *NotificationPermission) *NotificationPermissionUpdateOne {
+	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermission(np))
+	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfileClient) UpdateOneID(id int) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationPermissionClient) UpdateOneID(id int) *NotificationPermissionUpdateOne {
+	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermissionID(id))
+	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Profile.
-func (c *ProfileClient) Delete() *ProfileDelete {
-	mutation := newProfileMutation(c.config, OpDelete)
-	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NotificationPermission.
+func (c *NotificationPermissionClient) Delete() *NotificationPermissionDelete {
+	mutation := newNotificationPermissionMutation(c.config, OpDelete)
+	return &NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfileClient) DeleteOne(pr *Profile) *ProfileDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *NotificationPermissionClient) DeleteOne(np *NotificationPermission) *NotificationPermissionDeleteOne {
+	return c.DeleteOneID(np.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfileClient) DeleteOneID(id int) *ProfileDeleteOne {
-	builder := c.Delete().Where(profile.ID(id))
+func (c *NotificationPermissionClient) DeleteOneID(id int) *NotificationPermissionDeleteOne {
+	builder := c.Delete().Where(notificationpermission.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfileDeleteOne{builder}
+	return &NotificationPermissionDeleteOne{builder}
 }
 
-// Query returns a query builder for Profile.
-func (c *ProfileClient) Query() *ProfileQuery {
-	return &ProfileQuery{
+// Query returns a query builder for NotificationPermission.
+func (c *NotificationPermissionClient) Query() *NotificationPermissionQuery {
+	return &NotificationPermissionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfile},
+		ctx:    &QueryContext{Type: TypeNotificationPermission},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Profile entity by its id.
-func (c *ProfileClient) Get(ctx context.Context, id int) (*Profile, error) {
-	return c.Query().Where(profile.ID(id)).Only(ctx)
+// Get returns a NotificationPermission entity by its id.
+func (c *NotificationPermissionClient) Get(ctx context.Context, id int) (*NotificationPermission, error) {
+	return c.Query().Where(notificationpermission.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
+func (c *NotificationPermissionClient) GetX(ctx context.Context, id int) *NotificationPermission {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2703,340 +3210,2916 @@ func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
 	return obj
 }
 
-// QueryFriends queries the friends edge of a Profile.
-func (c *ProfileClient) QueryFriends(pr *Profile) *ProfileQuery {
+// QueryProfile queries the profile edge of a NotificationPermission.
+func (c *NotificationPermissionClient) QueryProfile(np *NotificationPermission) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := np.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.From(notificationpermission.Table, notificationpermission.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, profile.FriendsTable, profile.FriendsPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationpermission.ProfileTable, notificationpermission.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryPhotos queries the photos edge of a Profile.
-func (c *ProfileClient) QueryPhotos(pr *Profile) *ImageQuery {
-	query := (&ImageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(image.Table, image.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhotosTable, profile.PhotosColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *NotificationPermissionClient) Hooks() []Hook {
+	return c.hooks.NotificationPermission
 }
 
-// QueryProfileImage queries the profile_image edge of a Profile.
-func (c *ProfileClient) QueryProfileImage(pr *Profile) *ImageQuery {
-	query := (&ImageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(image.Table, image.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, profile.ProfileImageTable, profile.ProfileImageColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *NotificationPermissionClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPermission
 }
 
-// QueryNotifications queries the notifications edge of a Profile.
-func (c *ProfileClient) QueryNotifications(pr *Profile) *NotificationQuery {
-	query := (&NotificationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(notification.Table, notification.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationsTable, profile.NotificationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+func (c *NotificationPermissionClient) mutate(ctx context.Context, m *NotificationPermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPermission mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryInvitations queries the invitations edge of a Profile.
-func (c *ProfileClient) QueryInvitations(pr *Profile) *InvitationQuery {
-	query := (&InvitationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(invitation.Table, invitation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.InvitationsTable, profile.InvitationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// NotificationTimeClient is a client for the NotificationTime schema.
+type NotificationTimeClient struct {
+	config
 }
 
-// QueryFcmPushSubscriptions queries the fcm_push_subscriptions edge of a Profile.
-func (c *ProfileClient) QueryFcmPushSubscriptions(pr *Profile) *FCMSubscriptionsQuery {
-	query := (&FCMSubscriptionsClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(fcmsubscriptions.Table, fcmsubscriptions.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.FcmPushSubscriptionsTable, profile.FcmPushSubscriptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// NewNotificationTimeClient returns a client for the NotificationTime from the given config.
+func NewNotificationTimeClient(c config) *NotificationTimeClient {
+	return &NotificationTimeClient{config: c}
 }
 
-// QueryPwaPushSubscriptions queries the pwa_push_subscriptions edge of a Profile.
-func (c *ProfileClient) QueryPwaPushSubscriptions(pr *Profile) *PwaPushSubscriptionQuery {
-	query := (&PwaPushSubscriptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationtime.Hooks(f(g(h())))`.
+func (c *NotificationTimeClient) Use(hooks ...Hook) {
+	c.hooks.NotificationTime = append(c.hooks.NotificationTime, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationtime.Intercept(f(g(h())))`.
+func (c *NotificationTimeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationTime = append(c.inters.NotificationTime, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationTime entity.
+func (c *NotificationTimeClient) Create() *NotificationTimeCreate {
+	mutation := newNotificationTimeMutation(c.config, OpCreate)
+	return &NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationTime entities.
+func (c *NotificationTimeClient) CreateBulk(builders ...*NotificationTimeCreate) *NotificationTimeCreateBulk {
+	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationTimeClient) MapCreateBulk(slice any, setFunc func(*NotificationTimeCreate, int)) *NotificationTimeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationTimeCreateBulk{err: fmt.Errorf("calling to NotificationTimeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationTimeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationTime.
+func (c *NotificationTimeClient) Update() *NotificationTimeUpdate {
+	mutation := newNotificationTimeMutation(c.config, OpUpdate)
+	return &NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationTimeClient) UpdateOne(nt *NotificationTime) *NotificationTimeUpdateOne {
+	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTime(nt))
+	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationTimeClient) UpdateOneID(id int) *NotificationTimeUpdateOne {
+	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTimeID(id))
+	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationTime.
+func (c *NotificationTimeClient) Delete() *NotificationTimeDelete {
+	mutation := newNotificationTimeMutation(c.config, OpDelete)
+	return &NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationTimeClient) DeleteOne(nt *NotificationTime) *NotificationTimeDeleteOne {
+	return c.DeleteOneID(nt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationTimeClient) DeleteOneID(id int) *NotificationTimeDeleteOne {
+	builder := c.Delete().Where(notificationtime.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationTimeDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationTime.
+func (c *NotificationTimeClient) Query() *NotificationTimeQuery {
+	return &NotificationTimeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationTime},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationTime entity by its id.
+func (c *NotificationTimeClient) Get(ctx context.Context, id int) (*NotificationTime, error) {
+	return c.Query().Where(notificationtime.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationTimeClient) GetX(ctx context.Context, id int) *NotificationTime {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a NotificationTime.
+func (c *NotificationTimeClient) QueryProfile(nt *NotificationTime) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationtime.Table, notificationtime.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationtime.ProfileTable, notificationtime.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(nt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationTimeClient) Hooks() []Hook {
+	return c.hooks.NotificationTime
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationTimeClient) Interceptors() []Interceptor {
+	return c.inters.NotificationTime
+}
+
+func (c *NotificationTimeClient) mutate(ctx context.Context, m *NotificationTimeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationTime mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMemberships queries the memberships edge of a Organization.
+func (c *OrganizationClient) QueryMemberships(o *Organization) *OrganizationMembershipQuery {
+	query := (&OrganizationMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organizationmembership.Table, organizationmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.MembershipsTable, organization.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Organization.
+func (c *OrganizationClient) QueryInvitations(o *Organization) *OrganizationInvitationQuery {
+	query := (&OrganizationInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organizationinvitation.Table, organizationinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.InvitationsTable, organization.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscriptions queries the subscriptions edge of a Organization.
+func (c *OrganizationClient) QuerySubscriptions(o *Organization) *MonthlySubscriptionQuery {
+	query := (&MonthlySubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(monthlysubscription.Table, monthlysubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, organization.SubscriptionsTable, organization.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationInvitationClient is a client for the OrganizationInvitation schema.
+type OrganizationInvitationClient struct {
+	config
+}
+
+// NewOrganizationInvitationClient returns a client for the OrganizationInvitation from the given config.
+func NewOrganizationInvitationClient(c config) *OrganizationInvitationClient {
+	return &OrganizationInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizationinvitation.Hooks(f(g(h())))`.
+func (c *OrganizationInvitationClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationInvitation = append(c.hooks.OrganizationInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organizationinvitation.Intercept(f(g(h())))`.
+func (c *OrganizationInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationInvitation = append(c.inters.OrganizationInvitation, interceptors...)
+}
+
+// Create returns a builder for creating a OrganizationInvitation entity.
+func (c *OrganizationInvitationClient) Create() *OrganizationInvitationCreate {
+	mutation := newOrganizationInvitationMutation(c.config, OpCreate)
+	return &OrganizationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrganizationInvitation entities.
+func (c *OrganizationInvitationClient) CreateBulk(builders ...*OrganizationInvitationCreate) *OrganizationInvitationCreateBulk {
+	return &OrganizationInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationInvitationClient) MapCreateBulk(slice any, setFunc func(*OrganizationInvitationCreate, int)) *OrganizationInvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationInvitationCreateBulk{err: fmt.Errorf("calling to OrganizationInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationInvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrganizationInvitation.
+func (c *OrganizationInvitationClient) Update() *OrganizationInvitationUpdate {
+	mutation := newOrganizationInvitationMutation(c.config, OpUpdate)
+	return &OrganizationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationInvitationClient) UpdateOne(oi *OrganizationInvitation) *OrganizationInvitationUpdateOne {
+	mutation := newOrganizationInvitationMutation(c.config, OpUpdateOne, withOrganizationInvitation(oi))
+	return &OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationInvitationClient) UpdateOneID(id int) *OrganizationInvitationUpdateOne {
+	mutation := newOrganizationInvitationMutation(c.config, OpUpdateOne, withOrganizationInvitationID(id))
+	return &OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrganizationInvitation.
+func (c *OrganizationInvitationClient) Delete() *OrganizationInvitationDelete {
+	mutation := newOrganizationInvitationMutation(c.config, OpDelete)
+	return &OrganizationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationInvitationClient) DeleteOne(oi *OrganizationInvitation) *OrganizationInvitationDeleteOne {
+	return c.DeleteOneID(oi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationInvitationClient) DeleteOneID(id int) *OrganizationInvitationDeleteOne {
+	builder := c.Delete().Where(organizationinvitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationInvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for OrganizationInvitation.
+func (c *OrganizationInvitationClient) Query() *OrganizationInvitationQuery {
+	return &OrganizationInvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizationInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrganizationInvitation entity by its id.
+func (c *OrganizationInvitationClient) Get(ctx context.Context, id int) (*OrganizationInvitation, error) {
+	return c.Query().Where(organizationinvitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationInvitationClient) GetX(ctx context.Context, id int) *OrganizationInvitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a OrganizationInvitation.
+func (c *OrganizationInvitationClient) QueryOrganization(oi *OrganizationInvitation) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationinvitation.Table, organizationinvitation.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationinvitation.OrganizationTable, organizationinvitation.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(oi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInviter queries the inviter edge of a OrganizationInvitation.
+func (c *OrganizationInvitationClient) QueryInviter(oi *OrganizationInvitation) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationinvitation.Table, organizationinvitation.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, organizationinvitation.InviterTable, organizationinvitation.InviterColumn),
+		)
+		fromV = sqlgraph.Neighbors(oi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationInvitationClient) Hooks() []Hook {
+	return c.hooks.OrganizationInvitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationInvitationClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationInvitation
+}
+
+func (c *OrganizationInvitationClient) mutate(ctx context.Context, m *OrganizationInvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrganizationInvitation mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationMembershipClient is a client for the OrganizationMembership schema.
+type OrganizationMembershipClient struct {
+	config
+}
+
+// NewOrganizationMembershipClient returns a client for the OrganizationMembership from the given config.
+func NewOrganizationMembershipClient(c config) *OrganizationMembershipClient {
+	return &OrganizationMembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizationmembership.Hooks(f(g(h())))`.
+func (c *OrganizationMembershipClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationMembership = append(c.hooks.OrganizationMembership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organizationmembership.Intercept(f(g(h())))`.
+func (c *OrganizationMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationMembership = append(c.inters.OrganizationMembership, interceptors...)
+}
+
+// Create returns a builder for creating a OrganizationMembership entity.
+func (c *OrganizationMembershipClient) Create() *OrganizationMembershipCreate {
+	mutation := newOrganizationMembershipMutation(c.config, OpCreate)
+	return &OrganizationMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrganizationMembership entities.
+func (c *OrganizationMembershipClient) CreateBulk(builders ...*OrganizationMembershipCreate) *OrganizationMembershipCreateBulk {
+	return &OrganizationMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationMembershipClient) MapCreateBulk(slice any, setFunc func(*OrganizationMembershipCreate, int)) *OrganizationMembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationMembershipCreateBulk{err: fmt.Errorf("calling to OrganizationMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationMembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrganizationMembership.
+func (c *OrganizationMembershipClient) Update() *OrganizationMembershipUpdate {
+	mutation := newOrganizationMembershipMutation(c.config, OpUpdate)
+	return &OrganizationMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationMembershipClient) UpdateOne(om *OrganizationMembership) *OrganizationMembershipUpdateOne {
+	mutation := newOrganizationMembershipMutation(c.config, OpUpdateOne, withOrganizationMembership(om))
+	return &OrganizationMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationMembershipClient) UpdateOneID(id int) *OrganizationMembershipUpdateOne {
+	mutation := newOrganizationMembershipMutation(c.config, OpUpdateOne, withOrganizationMembershipID(id))
+	return &OrganizationMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrganizationMembership.
+func (c *OrganizationMembershipClient) Delete() *OrganizationMembershipDelete {
+	mutation := newOrganizationMembershipMutation(c.config, OpDelete)
+	return &OrganizationMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationMembershipClient) DeleteOne(om *OrganizationMembership) *OrganizationMembershipDeleteOne {
+	return c.DeleteOneID(om.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationMembershipClient) DeleteOneID(id int) *OrganizationMembershipDeleteOne {
+	builder := c.Delete().Where(organizationmembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for OrganizationMembership.
+func (c *OrganizationMembershipClient) Query() *OrganizationMembershipQuery {
+	return &OrganizationMembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizationMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrganizationMembership entity by its id.
+func (c *OrganizationMembershipClient) Get(ctx context.Context, id int) (*OrganizationMembership, error) {
+	return c.Query().Where(organizationmembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationMembershipClient) GetX(ctx context.Context, id int) *OrganizationMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a OrganizationMembership.
+func (c *OrganizationMembershipClient) QueryOrganization(om *OrganizationMembership) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationmembership.Table, organizationmembership.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationmembership.OrganizationTable, organizationmembership.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfile queries the profile edge of a OrganizationMembership.
+func (c *OrganizationMembershipClient) QueryProfile(om *OrganizationMembership) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationmembership.Table, organizationmembership.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationmembership.ProfileTable, organizationmembership.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationMembershipClient) Hooks() []Hook {
+	return c.hooks.OrganizationMembership
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationMembershipClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationMembership
+}
+
+func (c *OrganizationMembershipClient) mutate(ctx context.Context, m *OrganizationMembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrganizationMembership mutation op: %q", m.Op())
+	}
+}
+
+// PasskeyClient is a client for the Passkey schema.
+type PasskeyClient struct {
+	config
+}
+
+// NewPasskeyClient returns a client for the Passkey from the given config.
+func NewPasskeyClient(c config) *PasskeyClient {
+	return &PasskeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passkey.Hooks(f(g(h())))`.
+func (c *PasskeyClient) Use(hooks ...Hook) {
+	c.hooks.Passkey = append(c.hooks.Passkey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passkey.Intercept(f(g(h())))`.
+func (c *PasskeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Passkey = append(c.inters.Passkey, interceptors...)
+}
+
+// Create returns a builder for creating a Passkey entity.
+func (c *PasskeyClient) Create() *PasskeyCreate {
+	mutation := newPasskeyMutation(c.config, OpCreate)
+	return &PasskeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Passkey entities.
+func (c *PasskeyClient) CreateBulk(builders ...*PasskeyCreate) *PasskeyCreateBulk {
+	return &PasskeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasskeyClient) MapCreateBulk(slice any, setFunc func(*PasskeyCreate, int)) *PasskeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasskeyCreateBulk{err: fmt.Errorf("calling to PasskeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasskeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasskeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Passkey.
+func (c *PasskeyClient) Update() *PasskeyUpdate {
+	mutation := newPasskeyMutation(c.config, OpUpdate)
+	return &PasskeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasskeyClient) UpdateOne(pa *Passkey) *PasskeyUpdateOne {
+	mutation := newPasskeyMutation(c.config, OpUpdateOne, withPasskey(pa))
+	return &PasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasskeyClient) UpdateOneID(id int) *PasskeyUpdateOne {
+	mutation := newPasskeyMutation(c.config, OpUpdateOne, withPasskeyID(id))
+	return &PasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Passkey.
+func (c *PasskeyClient) Delete() *PasskeyDelete {
+	mutation := newPasskeyMutation(c.config, OpDelete)
+	return &PasskeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasskeyClient) DeleteOne(pa *Passkey) *PasskeyDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasskeyClient) DeleteOneID(id int) *PasskeyDeleteOne {
+	builder := c.Delete().Where(passkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasskeyDeleteOne{builder}
+}
+
+// Query returns a query builder for Passkey.
+func (c *PasskeyClient) Query() *PasskeyQuery {
+	return &PasskeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasskey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Passkey entity by its id.
+func (c *PasskeyClient) Get(ctx context.Context, id int) (*Passkey, error) {
+	return c.Query().Where(passkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasskeyClient) GetX(ctx context.Context, id int) *Passkey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Passkey.
+func (c *PasskeyClient) QueryUser(pa *Passkey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passkey.Table, passkey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passkey.UserTable, passkey.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasskeyClient) Hooks() []Hook {
+	return c.hooks.Passkey
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasskeyClient) Interceptors() []Interceptor {
+	return c.inters.Passkey
+}
+
+func (c *PasskeyClient) mutate(ctx context.Context, m *PasskeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasskeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasskeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasskeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Passkey mutation op: %q", m.Op())
+	}
+}
+
+// PasswordTokenClient is a client for the PasswordToken schema.
+type PasswordTokenClient struct {
+	config
+}
+
+// NewPasswordTokenClient returns a client for the PasswordToken from the given config.
+func NewPasswordTokenClient(c config) *PasswordTokenClient {
+	return &PasswordTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordtoken.Hooks(f(g(h())))`.
+func (c *PasswordTokenClient) Use(hooks ...Hook) {
+	c.hooks.PasswordToken = append(c.hooks.PasswordToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordtoken.Intercept(f(g(h())))`.
+func (c *PasswordTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordToken = append(c.inters.PasswordToken, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordToken entity.
+func (c *PasswordTokenClient) Create() *PasswordTokenCreate {
+	mutation := newPasswordTokenMutation(c.config, OpCreate)
+	return &PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordToken entities.
+func (c *PasswordTokenClient) CreateBulk(builders ...*PasswordTokenCreate) *PasswordTokenCreateBulk {
+	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordTokenCreate, int)) *PasswordTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordTokenCreateBulk{err: fmt.Errorf("calling to PasswordTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordToken.
+func (c *PasswordTokenClient) Update() *PasswordTokenUpdate {
+	mutation := newPasswordTokenMutation(c.config, OpUpdate)
+	return &PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordTokenClient) UpdateOne(pt *PasswordToken) *PasswordTokenUpdateOne {
+	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordToken(pt))
+	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordTokenClient) UpdateOneID(id int) *PasswordTokenUpdateOne {
+	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordTokenID(id))
+	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordToken.
+func (c *PasswordTokenClient) Delete() *PasswordTokenDelete {
+	mutation := newPasswordTokenMutation(c.config, OpDelete)
+	return &PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordTokenClient) DeleteOne(pt *PasswordToken) *PasswordTokenDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordTokenClient) DeleteOneID(id int) *PasswordTokenDeleteOne {
+	builder := c.Delete().Where(passwordtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordToken.
+func (c *PasswordTokenClient) Query() *PasswordTokenQuery {
+	return &PasswordTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordToken entity by its id.
+func (c *PasswordTokenClient) Get(ctx context.Context, id int) (*PasswordToken, error) {
+	return c.Query().Where(passwordtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordToken.
+func (c *PasswordTokenClient) QueryUser(pt *PasswordToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordtoken.Table, passwordtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, passwordtoken.UserTable, passwordtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordTokenClient) Hooks() []Hook {
+	return c.hooks.PasswordToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordTokenClient) Interceptors() []Interceptor {
+	return c.inters.PasswordToken
+}
+
+func (c *PasswordTokenClient) mutate(ctx context.Context, m *PasswordTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordToken mutation op: %q", m.Op())
+	}
+}
+
+// PhoneVerificationCodeClient is a client for the PhoneVerificationCode schema.
+type PhoneVerificationCodeClient struct {
+	config
+}
+
+// NewPhoneVerificationCodeClient returns a client for the PhoneVerificationCode from the given config.
+func NewPhoneVerificationCodeClient(c config) *PhoneVerificationCodeClient {
+	return &PhoneVerificationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `phoneverificationcode.Hooks(f(g(h())))`.
+func (c *PhoneVerificationCodeClient) Use(hooks ...Hook) {
+	c.hooks.PhoneVerificationCode = append(c.hooks.PhoneVerificationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `phoneverificationcode.Intercept(f(g(h())))`.
+func (c *PhoneVerificationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PhoneVerificationCode = append(c.inters.PhoneVerificationCode, interceptors...)
+}
+
+// Create returns a builder for creating a PhoneVerificationCode entity.
+func (c *PhoneVerificationCodeClient) Create() *PhoneVerificationCodeCreate {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpCreate)
+	return &PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PhoneVerificationCode entities.
+func (c *PhoneVerificationCodeClient) CreateBulk(builders ...*PhoneVerificationCodeCreate) *PhoneVerificationCodeCreateBulk {
+	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PhoneVerificationCodeClient) MapCreateBulk(slice any, setFunc func(*PhoneVerificationCodeCreate, int)) *PhoneVerificationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PhoneVerificationCodeCreateBulk{err: fmt.Errorf("calling to PhoneVerificationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PhoneVerificationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) Update() *PhoneVerificationCodeUpdate {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdate)
+	return &PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PhoneVerificationCodeClient) UpdateOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeUpdateOne {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCode(pvc))
+	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PhoneVerificationCodeClient) UpdateOneID(id int) *PhoneVerificationCodeUpdateOne {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCodeID(id))
+	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) Delete() *PhoneVerificationCodeDelete {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpDelete)
+	return &PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PhoneVerificationCodeClient) DeleteOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeDeleteOne {
+	return c.DeleteOneID(pvc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PhoneVerificationCodeClient) DeleteOneID(id int) *PhoneVerificationCodeDeleteOne {
+	builder := c.Delete().Where(phoneverificationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PhoneVerificationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) Query() *PhoneVerificationCodeQuery {
+	return &PhoneVerificationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePhoneVerificationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PhoneVerificationCode entity by its id.
+func (c *PhoneVerificationCodeClient) Get(ctx context.Context, id int) (*PhoneVerificationCode, error) {
+	return c.Query().Where(phoneverificationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PhoneVerificationCodeClient) GetX(ctx context.Context, id int) *PhoneVerificationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) QueryProfile(pvc *PhoneVerificationCode) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pvc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(phoneverificationcode.Table, phoneverificationcode.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, phoneverificationcode.ProfileTable, phoneverificationcode.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pvc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PhoneVerificationCodeClient) Hooks() []Hook {
+	return c.hooks.PhoneVerificationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *PhoneVerificationCodeClient) Interceptors() []Interceptor {
+	return c.inters.PhoneVerificationCode
+}
+
+func (c *PhoneVerificationCodeClient) mutate(ctx context.Context, m *PhoneVerificationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PhoneVerificationCode mutation op: %q", m.Op())
+	}
+}
+
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
+	config
+}
+
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
+}
+
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileClient) UpdateOne(pr *Profile) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(pr))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileClient) UpdateOneID(id int) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileClient) DeleteOne(pr *Profile) *ProfileDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileClient) DeleteOneID(id int) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id int) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFriends queries the friends edge of a Profile.
+func (c *ProfileClient) QueryFriends(pr *Profile) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.FriendsTable, profile.FriendsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentFriendRequests queries the sent_friend_requests edge of a Profile.
+func (c *ProfileClient) QuerySentFriendRequests(pr *Profile) *FriendRequestQuery {
+	query := (&FriendRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(friendrequest.Table, friendrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SentFriendRequestsTable, profile.SentFriendRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceivedFriendRequests queries the received_friend_requests edge of a Profile.
+func (c *ProfileClient) QueryReceivedFriendRequests(pr *Profile) *FriendRequestQuery {
+	query := (&FriendRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(friendrequest.Table, friendrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReceivedFriendRequestsTable, profile.ReceivedFriendRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedBy queries the blocked_by edge of a Profile.
+func (c *ProfileClient) QueryBlockedBy(pr *Profile) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, profile.BlockedByTable, profile.BlockedByPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlocked queries the blocked edge of a Profile.
+func (c *ProfileClient) QueryBlocked(pr *Profile) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.BlockedTable, profile.BlockedPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReportsSent queries the reports_sent edge of a Profile.
+func (c *ProfileClient) QueryReportsSent(pr *Profile) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReportsSentTable, profile.ReportsSentColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReportsReceived queries the reports_received edge of a Profile.
+func (c *ProfileClient) QueryReportsReceived(pr *Profile) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReportsReceivedTable, profile.ReportsReceivedColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPhotos queries the photos edge of a Profile.
+func (c *ProfileClient) QueryPhotos(pr *Profile) *ImageQuery {
+	query := (&ImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(image.Table, image.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhotosTable, profile.PhotosColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfileImage queries the profile_image edge of a Profile.
+func (c *ProfileClient) QueryProfileImage(pr *Profile) *ImageQuery {
+	query := (&ImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(image.Table, image.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, profile.ProfileImageTable, profile.ProfileImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a Profile.
+func (c *ProfileClient) QueryNotifications(pr *Profile) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationsTable, profile.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Profile.
+func (c *ProfileClient) QueryInvitations(pr *Profile) *InvitationQuery {
+	query := (&InvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.InvitationsTable, profile.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganizationMemberships queries the organization_memberships edge of a Profile.
+func (c *ProfileClient) QueryOrganizationMemberships(pr *Profile) *OrganizationMembershipQuery {
+	query := (&OrganizationMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(organizationmembership.Table, organizationmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.OrganizationMembershipsTable, profile.OrganizationMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFcmPushSubscriptions queries the fcm_push_subscriptions edge of a Profile.
+func (c *ProfileClient) QueryFcmPushSubscriptions(pr *Profile) *FCMSubscriptionsQuery {
+	query := (&FCMSubscriptionsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(fcmsubscriptions.Table, fcmsubscriptions.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.FcmPushSubscriptionsTable, profile.FcmPushSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPwaPushSubscriptions queries the pwa_push_subscriptions edge of a Profile.
+func (c *ProfileClient) QueryPwaPushSubscriptions(pr *Profile) *PwaPushSubscriptionQuery {
+	query := (&PwaPushSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
 			sqlgraph.To(pwapushsubscription.Table, pwapushsubscription.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, profile.PwaPushSubscriptionsTable, profile.PwaPushSubscriptionsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPushDeliveries queries the push_deliveries edge of a Profile.
+func (c *ProfileClient) QueryPushDeliveries(pr *Profile) *PushDeliveryQuery {
+	query := (&PushDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(pushdelivery.Table, pushdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PushDeliveriesTable, profile.PushDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationPermissions queries the notification_permissions edge of a Profile.
+func (c *ProfileClient) QueryNotificationPermissions(pr *Profile) *NotificationPermissionQuery {
+	query := (&NotificationPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(notificationpermission.Table, notificationpermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationPermissionsTable, profile.NotificationPermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationTimes queries the notification_times edge of a Profile.
+func (c *ProfileClient) QueryNotificationTimes(pr *Profile) *NotificationTimeQuery {
+	query := (&NotificationTimeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(notificationtime.Table, notificationtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationTimesTable, profile.NotificationTimesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPhoneVerificationCode queries the phone_verification_code edge of a Profile.
+func (c *ProfileClient) QueryPhoneVerificationCode(pr *Profile) *PhoneVerificationCodeQuery {
+	query := (&PhoneVerificationCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(phoneverificationcode.Table, phoneverificationcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhoneVerificationCodeTable, profile.PhoneVerificationCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentEmails queries the sent_emails edge of a Profile.
+func (c *ProfileClient) QuerySentEmails(pr *Profile) *SentEmailQuery {
+	query := (&SentEmailClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(sentemail.Table, sentemail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SentEmailsTable, profile.SentEmailsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Profile.
+func (c *ProfileClient) QueryUser(pr *Profile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, profile.UserTable, profile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscription queries the subscription edge of a Profile.
+func (c *ProfileClient) QuerySubscription(pr *Profile) *MonthlySubscriptionQuery {
+	query := (&MonthlySubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(monthlysubscription.Table, monthlysubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, profile.SubscriptionTable, profile.SubscriptionPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
+}
+
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+	}
+}
+
+// PushDeliveryClient is a client for the PushDelivery schema.
+type PushDeliveryClient struct {
+	config
+}
+
+// NewPushDeliveryClient returns a client for the PushDelivery from the given config.
+func NewPushDeliveryClient(c config) *PushDeliveryClient {
+	return &PushDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pushdelivery.Hooks(f(g(h())))`.
+func (c *PushDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.PushDelivery = append(c.hooks.PushDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pushdelivery.Intercept(f(g(h())))`.
+func (c *PushDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PushDelivery = append(c.inters.PushDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a PushDelivery entity.
+func (c *PushDeliveryClient) Create() *PushDeliveryCreate {
+	mutation := newPushDeliveryMutation(c.config, OpCreate)
+	return &PushDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PushDelivery entities.
+func (c *PushDeliveryClient) CreateBulk(builders ...*PushDeliveryCreate) *PushDeliveryCreateBulk {
+	return &PushDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PushDeliveryClient) MapCreateBulk(slice any, setFunc func(*PushDeliveryCreate, int)) *PushDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PushDeliveryCreateBulk{err: fmt.Errorf("calling to PushDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PushDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PushDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PushDelivery.
+func (c *PushDeliveryClient) Update() *PushDeliveryUpdate {
+	mutation := newPushDeliveryMutation(c.config, OpUpdate)
+	return &PushDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PushDeliveryClient) UpdateOne(pd *PushDelivery) *PushDeliveryUpdateOne {
+	mutation := newPushDeliveryMutation(c.config, OpUpdateOne, withPushDelivery(pd))
+	return &PushDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PushDeliveryClient) UpdateOneID(id int) *PushDeliveryUpdateOne {
+	mutation := newPushDeliveryMutation(c.config, OpUpdateOne, withPushDeliveryID(id))
+	return &PushDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PushDelivery.
+func (c *PushDeliveryClient) Delete() *PushDeliveryDelete {
+	mutation := newPushDeliveryMutation(c.config, OpDelete)
+	return &PushDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PushDeliveryClient) DeleteOne(pd *PushDelivery) *PushDeliveryDeleteOne {
+	return c.DeleteOneID(pd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PushDeliveryClient) DeleteOneID(id int) *PushDeliveryDeleteOne {
+	builder := c.Delete().Where(pushdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for PushDelivery.
+func (c *PushDeliveryClient) Query() *PushDeliveryQuery {
+	return &PushDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePushDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PushDelivery entity by its id.
+func (c *PushDeliveryClient) Get(ctx context.Context, id int) (*PushDelivery, error) {
+	return c.Query().Where(pushdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushDeliveryClient) GetX(ctx context.Context, id int) *PushDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a PushDelivery.
+func (c *PushDeliveryClient) QueryProfile(pd *PushDelivery) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pushdelivery.Table, pushdelivery.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pushdelivery.ProfileTable, pushdelivery.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PushDeliveryClient) Hooks() []Hook {
+	return c.hooks.PushDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *PushDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.PushDelivery
+}
+
+func (c *PushDeliveryClient) mutate(ctx context.Context, m *PushDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PushDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PushDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PushDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PushDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PushDelivery mutation op: %q", m.Op())
+	}
+}
+
+// PwaPushSubscriptionClient is a client for the PwaPushSubscription schema.
+type PwaPushSubscriptionClient struct {
+	config
+}
+
+// NewPwaPushSubscriptionClient returns a client for the PwaPushSubscription from the given config.
+func NewPwaPushSubscriptionClient(c config) *PwaPushSubscriptionClient {
+	return &PwaPushSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pwapushsubscription.Hooks(f(g(h())))`.
+func (c *PwaPushSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.PwaPushSubscription = append(c.hooks.PwaPushSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pwapushsubscription.Intercept(f(g(h())))`.
+func (c *PwaPushSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PwaPushSubscription = append(c.inters.PwaPushSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a PwaPushSubscription entity.
+func (c *PwaPushSubscriptionClient) Create() *PwaPushSubscriptionCreate {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpCreate)
+	return &PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PwaPushSubscription entities.
+func (c *PwaPushSubscriptionClient) CreateBulk(builders ...*PwaPushSubscriptionCreate) *PwaPushSubscriptionCreateBulk {
+	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PwaPushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PwaPushSubscriptionCreate, int)) *PwaPushSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PwaPushSubscriptionCreateBulk{err: fmt.Errorf("calling to PwaPushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PwaPushSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) Update() *PwaPushSubscriptionUpdate {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdate)
+	return &PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PwaPushSubscriptionClient) UpdateOne(pps *PwaPushSubscription) *PwaPushSubscriptionUpdateOne {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscription(pps))
+	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PwaPushSubscriptionClient) UpdateOneID(id int) *PwaPushSubscriptionUpdateOne {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscriptionID(id))
+	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) Delete() *PwaPushSubscriptionDelete {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpDelete)
+	return &PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PwaPushSubscriptionClient) DeleteOne(pps *PwaPushSubscription) *PwaPushSubscriptionDeleteOne {
+	return c.DeleteOneID(pps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PwaPushSubscriptionClient) DeleteOneID(id int) *PwaPushSubscriptionDeleteOne {
+	builder := c.Delete().Where(pwapushsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PwaPushSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) Query() *PwaPushSubscriptionQuery {
+	return &PwaPushSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePwaPushSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PwaPushSubscription entity by its id.
+func (c *PwaPushSubscriptionClient) Get(ctx context.Context, id int) (*PwaPushSubscription, error) {
+	return c.Query().Where(pwapushsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PwaPushSubscriptionClient) GetX(ctx context.Context, id int) *PwaPushSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) QueryProfile(pps *PwaPushSubscription) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pwapushsubscription.Table, pwapushsubscription.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pwapushsubscription.ProfileTable, pwapushsubscription.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PwaPushSubscriptionClient) Hooks() []Hook {
+	return c.hooks.PwaPushSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *PwaPushSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.PwaPushSubscription
+}
+
+func (c *PwaPushSubscriptionClient) mutate(ctx context.Context, m *PwaPushSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PwaPushSubscription mutation op: %q", m.Op())
+	}
+}
+
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(rc *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(rc))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id int) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(rc *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id int) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id int) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id int) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(rc *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
+// ReportClient is a client for the Report schema.
+type ReportClient struct {
+	config
+}
+
+// NewReportClient returns a client for the Report from the given config.
+func NewReportClient(c config) *ReportClient {
+	return &ReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
+func (c *ReportClient) Use(hooks ...Hook) {
+	c.hooks.Report = append(c.hooks.Report, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
+func (c *ReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Report = append(c.inters.Report, interceptors...)
+}
+
+// Create returns a builder for creating a Report entity.
+func (c *ReportClient) Create() *ReportCreate {
+	mutation := newReportMutation(c.config, OpCreate)
+	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Report entities.
+func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Report.
+func (c *ReportClient) Update() *ReportUpdate {
+	mutation := newReportMutation(c.config, OpUpdate)
+	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportClient) UpdateOne(r *Report) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReport(r))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportClient) UpdateOneID(id int) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Report.
+func (c *ReportClient) Delete() *ReportDelete {
+	mutation := newReportMutation(c.config, OpDelete)
+	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportClient) DeleteOne(r *Report) *ReportDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportClient) DeleteOneID(id int) *ReportDeleteOne {
+	builder := c.Delete().Where(report.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportDeleteOne{builder}
+}
+
+// Query returns a query builder for Report.
+func (c *ReportClient) Query() *ReportQuery {
+	return &ReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Report entity by its id.
+func (c *ReportClient) Get(ctx context.Context, id int) (*Report, error) {
+	return c.Query().Where(report.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportClient) GetX(ctx context.Context, id int) *Report {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReporter queries the reporter edge of a Report.
+func (c *ReportClient) QueryReporter(r *Report) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.ReporterTable, report.ReporterColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReported queries the reported edge of a Report.
+func (c *ReportClient) QueryReported(r *Report) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.ReportedTable, report.ReportedColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResolvedBy queries the resolved_by edge of a Report.
+func (c *ReportClient) QueryResolvedBy(r *Report) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.ResolvedByTable, report.ResolvedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReportClient) Hooks() []Hook {
+	return c.hooks.Report
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportClient) Interceptors() []Interceptor {
+	return c.inters.Report
+}
+
+func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id int) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id int) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Role.
+func (c *RoleClient) QueryUsers(r *Role) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryNotificationPermissions queries the notification_permissions edge of a Profile.
-func (c *ProfileClient) QueryNotificationPermissions(pr *Profile) *NotificationPermissionQuery {
-	query := (&NotificationPermissionClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// SMSMessageClient is a client for the SMSMessage schema.
+type SMSMessageClient struct {
+	config
+}
+
+// NewSMSMessageClient returns a client for the SMSMessage from the given config.
+func NewSMSMessageClient(c config) *SMSMessageClient {
+	return &SMSMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `smsmessage.Hooks(f(g(h())))`.
+func (c *SMSMessageClient) Use(hooks ...Hook) {
+	c.hooks.SMSMessage = append(c.hooks.SMSMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `smsmessage.Intercept(f(g(h())))`.
+func (c *SMSMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SMSMessage = append(c.inters.SMSMessage, interceptors...)
+}
+
+// Create returns a builder for creating a SMSMessage entity.
+func (c *SMSMessageClient) Create() *SMSMessageCreate {
+	mutation := newSMSMessageMutation(c.config, OpCreate)
+	return &SMSMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SMSMessage entities.
+func (c *SMSMessageClient) CreateBulk(builders ...*SMSMessageCreate) *SMSMessageCreateBulk {
+	return &SMSMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SMSMessageClient) MapCreateBulk(slice any, setFunc func(*SMSMessageCreate, int)) *SMSMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SMSMessageCreateBulk{err: fmt.Errorf("calling to SMSMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SMSMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SMSMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SMSMessage.
+func (c *SMSMessageClient) Update() *SMSMessageUpdate {
+	mutation := newSMSMessageMutation(c.config, OpUpdate)
+	return &SMSMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SMSMessageClient) UpdateOne(sm *SMSMessage) *SMSMessageUpdateOne {
+	mutation := newSMSMessageMutation(c.config, OpUpdateOne, withSMSMessage(sm))
+	return &SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SMSMessageClient) UpdateOneID(id int) *SMSMessageUpdateOne {
+	mutation := newSMSMessageMutation(c.config, OpUpdateOne, withSMSMessageID(id))
+	return &SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SMSMessage.
+func (c *SMSMessageClient) Delete() *SMSMessageDelete {
+	mutation := newSMSMessageMutation(c.config, OpDelete)
+	return &SMSMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SMSMessageClient) DeleteOne(sm *SMSMessage) *SMSMessageDeleteOne {
+	return c.DeleteOneID(sm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SMSMessageClient) DeleteOneID(id int) *SMSMessageDeleteOne {
+	builder := c.Delete().Where(smsmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SMSMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for SMSMessage.
+func (c *SMSMessageClient) Query() *SMSMessageQuery {
+	return &SMSMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSMSMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SMSMessage entity by its id.
+func (c *SMSMessageClient) Get(ctx context.Context, id int) (*SMSMessage, error) {
+	return c.Query().Where(smsmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SMSMessageClient) GetX(ctx context.Context, id int) *SMSMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SMSMessageClient) Hooks() []Hook {
+	return c.hooks.SMSMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *SMSMessageClient) Interceptors() []Interceptor {
+	return c.inters.SMSMessage
+}
+
+func (c *SMSMessageClient) mutate(ctx context.Context, m *SMSMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SMSMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SMSMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SMSMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SMSMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SMSMessage mutation op: %q", m.Op())
+	}
+}
+
+// SentEmailClient is a client for the SentEmail schema.
+type SentEmailClient struct {
+	config
+}
+
+// NewSentEmailClient returns a client for the SentEmail from the given config.
+func NewSentEmailClient(c config) *SentEmailClient {
+	return &SentEmailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sentemail.Hooks(f(g(h())))`.
+func (c *SentEmailClient) Use(hooks ...Hook) {
+	c.hooks.SentEmail = append(c.hooks.SentEmail, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sentemail.Intercept(f(g(h())))`.
+func (c *SentEmailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SentEmail = append(c.inters.SentEmail, interceptors...)
+}
+
+// Create returns a builder for creating a SentEmail entity.
+func (c *SentEmailClient) Create() *SentEmailCreate {
+	mutation := newSentEmailMutation(c.config, OpCreate)
+	return &SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SentEmail entities.
+func (c *SentEmailClient) CreateBulk(builders ...*SentEmailCreate) *SentEmailCreateBulk {
+	return &SentEmailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SentEmailClient) MapCreateBulk(slice any, setFunc func(*SentEmailCreate, int)) *SentEmailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SentEmailCreateBulk{err: fmt.Errorf("calling to SentEmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SentEmailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SentEmailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SentEmail.
+func (c *SentEmailClient) Update() *SentEmailUpdate {
+	mutation := newSentEmailMutation(c.config, OpUpdate)
+	return &SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SentEmailClient) UpdateOne(se *SentEmail) *SentEmailUpdateOne {
+	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmail(se))
+	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SentEmailClient) UpdateOneID(id int) *SentEmailUpdateOne {
+	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmailID(id))
+	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SentEmail.
+func (c *SentEmailClient) Delete() *SentEmailDelete {
+	mutation := newSentEmailMutation(c.config, OpDelete)
+	return &SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SentEmailClient) DeleteOne(se *SentEmail) *SentEmailDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SentEmailClient) DeleteOneID(id int) *SentEmailDeleteOne {
+	builder := c.Delete().Where(sentemail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SentEmailDeleteOne{builder}
+}
+
+// Query returns a query builder for SentEmail.
+func (c *SentEmailClient) Query() *SentEmailQuery {
+	return &SentEmailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSentEmail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SentEmail entity by its id.
+func (c *SentEmailClient) Get(ctx context.Context, id int) (*SentEmail, error) {
+	return c.Query().Where(sentemail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SentEmailClient) GetX(ctx context.Context, id int) *SentEmail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a SentEmail.
+func (c *SentEmailClient) QueryProfile(se *SentEmail) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := se.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(notificationpermission.Table, notificationpermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationPermissionsTable, profile.NotificationPermissionsColumn),
+			sqlgraph.From(sentemail.Table, sentemail.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sentemail.ProfileTable, sentemail.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryNotificationTimes queries the notification_times edge of a Profile.
-func (c *ProfileClient) QueryNotificationTimes(pr *Profile) *NotificationTimeQuery {
-	query := (&NotificationTimeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(notificationtime.Table, notificationtime.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationTimesTable, profile.NotificationTimesColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *SentEmailClient) Hooks() []Hook {
+	return c.hooks.SentEmail
 }
 
-// QueryPhoneVerificationCode queries the phone_verification_code edge of a Profile.
-func (c *ProfileClient) QueryPhoneVerificationCode(pr *Profile) *PhoneVerificationCodeQuery {
-	query := (&PhoneVerificationCodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(phoneverificationcode.Table, phoneverificationcode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhoneVerificationCodeTable, profile.PhoneVerificationCodeColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Interceptors returns the client interceptors.
+func (c *SentEmailClient) Interceptors() []Interceptor {
+	return c.inters.SentEmail
+}
+
+func (c *SentEmailClient) mutate(ctx context.Context, m *SentEmailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SentEmail mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QuerySentEmails queries the sent_emails edge of a Profile.
-func (c *ProfileClient) QuerySentEmails(pr *Profile) *SentEmailQuery {
-	query := (&SentEmailClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(sentemail.Table, sentemail.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.SentEmailsTable, profile.SentEmailsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// ThrottleAttemptClient is a client for the ThrottleAttempt schema.
+type ThrottleAttemptClient struct {
+	config
+}
+
+// NewThrottleAttemptClient returns a client for the ThrottleAttempt from the given config.
+func NewThrottleAttemptClient(c config) *ThrottleAttemptClient {
+	return &ThrottleAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `throttleattempt.Hooks(f(g(h())))`.
+func (c *ThrottleAttemptClient) Use(hooks ...Hook) {
+	c.hooks.ThrottleAttempt = append(c.hooks.ThrottleAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `throttleattempt.Intercept(f(g(h())))`.
+func (c *ThrottleAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ThrottleAttempt = append(c.inters.ThrottleAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a ThrottleAttempt entity.
+func (c *ThrottleAttemptClient) Create() *ThrottleAttemptCreate {
+	mutation := newThrottleAttemptMutation(c.config, OpCreate)
+	return &ThrottleAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ThrottleAttempt entities.
+func (c *ThrottleAttemptClient) CreateBulk(builders ...*ThrottleAttemptCreate) *ThrottleAttemptCreateBulk {
+	return &ThrottleAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ThrottleAttemptClient) MapCreateBulk(slice any, setFunc func(*ThrottleAttemptCreate, int)) *ThrottleAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ThrottleAttemptCreateBulk{err: fmt.Errorf("calling to ThrottleAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*ThrottleAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ThrottleAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryUser queries the user edge of a Profile.
-func (c *ProfileClient) QueryUser(pr *Profile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, profile.UserTable, profile.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for ThrottleAttempt.
+func (c *ThrottleAttemptClient) Update() *ThrottleAttemptUpdate {
+	mutation := newThrottleAttemptMutation(c.config, OpUpdate)
+	return &ThrottleAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ThrottleAttemptClient) UpdateOne(ta *ThrottleAttempt) *ThrottleAttemptUpdateOne {
+	mutation := newThrottleAttemptMutation(c.config, OpUpdateOne, withThrottleAttempt(ta))
+	return &ThrottleAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ThrottleAttemptClient) UpdateOneID(id int) *ThrottleAttemptUpdateOne {
+	mutation := newThrottleAttemptMutation(c.config, OpUpdateOne, withThrottleAttemptID(id))
+	return &ThrottleAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ThrottleAttempt.
+func (c *ThrottleAttemptClient) Delete() *ThrottleAttemptDelete {
+	mutation := newThrottleAttemptMutation(c.config, OpDelete)
+	return &ThrottleAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ThrottleAttemptClient) DeleteOne(ta *ThrottleAttempt) *ThrottleAttemptDeleteOne {
+	return c.DeleteOneID(ta.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ThrottleAttemptClient) DeleteOneID(id int) *ThrottleAttemptDeleteOne {
+	builder := c.Delete().Where(throttleattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ThrottleAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for ThrottleAttempt.
+func (c *ThrottleAttemptClient) Query() *ThrottleAttemptQuery {
+	return &ThrottleAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeThrottleAttempt},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QuerySubscription queries the subscription edge of a Profile.
-func (c *ProfileClient) QuerySubscription(pr *Profile) *MonthlySubscriptionQuery {
-	query := (&MonthlySubscriptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(monthlysubscription.Table, monthlysubscription.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, profile.SubscriptionTable, profile.SubscriptionPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ThrottleAttempt entity by its id.
+func (c *ThrottleAttemptClient) Get(ctx context.Context, id int) (*ThrottleAttempt, error) {
+	return c.Query().Where(throttleattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ThrottleAttemptClient) GetX(ctx context.Context, id int) *ThrottleAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *ProfileClient) Hooks() []Hook {
-	return c.hooks.Profile
+func (c *ThrottleAttemptClient) Hooks() []Hook {
+	return c.hooks.ThrottleAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfileClient) Interceptors() []Interceptor {
-	return c.inters.Profile
+func (c *ThrottleAttemptClient) Interceptors() []Interceptor {
+	return c.inters.ThrottleAttempt
 }
 
-func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+func (c *ThrottleAttemptClient) mutate(ctx context.Context, m *ThrottleAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThrottleAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThrottleAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThrottleAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ThrottleAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ThrottleAttempt mutation op: %q", m.Op())
 	}
 }
 
-// PwaPushSubscriptionClient is a client for the PwaPushSubscription schema.
-type PwaPushSubscriptionClient struct {
+// ThrottleLockClient is a client for the ThrottleLock schema.
+type ThrottleLockClient struct {
 	config
 }
 
-// NewPwaPushSubscriptionClient returns a client for the PwaPushSubscription from the given config.
-func NewPwaPushSubscriptionClient(c config) *PwaPushSubscriptionClient {
-	return &PwaPushSubscriptionClient{config: c}
+// NewThrottleLockClient returns a client for the ThrottleLock from the given config.
+func NewThrottleLockClient(c config) *ThrottleLockClient {
+	return &ThrottleLockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pwapushsubscription.Hooks(f(g(h())))`.
-func (c *PwaPushSubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.PwaPushSubscription = append(c.hooks.PwaPushSubscription, hooks...)
+// A call to `Use(f, g, h)` equals to `throttlelock.Hooks(f(g(h())))`.
+func (c *ThrottleLockClient) Use(hooks ...Hook) {
+	c.hooks.ThrottleLock = append(c.hooks.ThrottleLock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pwapushsubscription.Intercept(f(g(h())))`.
-func (c *PwaPushSubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PwaPushSubscription = append(c.inters.PwaPushSubscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `throttlelock.Intercept(f(g(h())))`.
+func (c *ThrottleLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ThrottleLock = append(c.inters.ThrottleLock, interceptors...)
 }
 
-// Create returns a builder for creating a PwaPushSubscription entity.
-func (c *PwaPushSubscriptionClient) Create() *PwaPushSubscriptionCreate {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpCreate)
-	return &PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ThrottleLock entity.
+func (c *ThrottleLockClient) Create() *ThrottleLockCreate {
+	mutation := newThrottleLockMutation(c.config, OpCreate)
+	return &ThrottleLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PwaPushSubscription entities.
-func (c *PwaPushSubscriptionClient) CreateBulk(builders ...*PwaPushSubscriptionCreate) *PwaPushSubscriptionCreateBulk {
-	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ThrottleLock entities.
+func (c *ThrottleLockClient) CreateBulk(builders ...*ThrottleLockCreate) *ThrottleLockCreateBulk {
+	return &ThrottleLockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PwaPushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PwaPushSubscriptionCreate, int)) *PwaPushSubscriptionCreateBulk {
+func (c *ThrottleLockClient) MapCreateBulk(slice any, setFunc func(*ThrottleLockCreate, int)) *ThrottleLockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PwaPushSubscriptionCreateBulk{err: fmt.Errorf("calling to PwaPushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ThrottleLockCreateBulk{err: fmt.Errorf("calling to ThrottleLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PwaPushSubscriptionCreate, rv.Len())
+	builders := make([]*ThrottleLockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+	return &ThrottleLockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) Update() *PwaPushSubscriptionUpdate {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdate)
-	return &PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ThrottleLock.
+func (c *ThrottleLockClient) Update() *ThrottleLockUpdate {
+	mutation := newThrottleLockMutation(c.config, OpUpdate)
+	return &ThrottleLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PwaPushSubscriptionClient) UpdateOne(pps *PwaPushSubscription) *PwaPushSubscriptionUpdateOne {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscription(pps))
-	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ThrottleLockClient) UpdateOne(tl *ThrottleLock) *ThrottleLockUpdateOne {
+	mutation := newThrottleLockMutation(c.config, OpUpdateOne, withThrottleLock(tl))
+	return &ThrottleLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PwaPushSubscriptionClient) UpdateOneID(id int) *PwaPushSubscriptionUpdateOne {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscriptionID(id))
-	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ThrottleLockClient) UpdateOneID(id int) *ThrottleLockUpdateOne {
+	mutation := newThrottleLockMutation(c.config, OpUpdateOne, withThrottleLockID(id))
+	return &ThrottleLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) Delete() *PwaPushSubscriptionDelete {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpDelete)
-	return &PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ThrottleLock.
+func (c *ThrottleLockClient) Delete() *ThrottleLockDelete {
+	mutation := newThrottleLockMutation(c.config, OpDelete)
+	return &ThrottleLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PwaPushSubscriptionClient) DeleteOne(pps *PwaPushSubscription) *PwaPushSubscriptionDeleteOne {
-	return c.DeleteOneID(pps.ID)
+func (c *ThrottleLockClient) DeleteOne(tl *ThrottleLock) *ThrottleLockDeleteOne {
+	return c.DeleteOneID(tl.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PwaPushSubscriptionClient) DeleteOneID(id int) *PwaPushSubscriptionDeleteOne {
-	builder := c.Delete().Where(pwapushsubscription.ID(id))
+func (c *ThrottleLockClient) DeleteOneID(id int) *ThrottleLockDeleteOne {
+	builder := c.Delete().Where(throttlelock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PwaPushSubscriptionDeleteOne{builder}
+	return &ThrottleLockDeleteOne{builder}
 }
 
-// Query returns a query builder for PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) Query() *PwaPushSubscriptionQuery {
-	return &PwaPushSubscriptionQuery{
+// Query returns a query builder for ThrottleLock.
+func (c *ThrottleLockClient) Query() *ThrottleLockQuery {
+	return &ThrottleLockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePwaPushSubscription},
+		ctx:    &QueryContext{Type: TypeThrottleLock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PwaPushSubscription entity by its id.
-func (c *PwaPushSubscriptionClient) Get(ctx context.Context, id int) (*PwaPushSubscription, error) {
-	return c.Query().Where(pwapushsubscription.ID(id)).Only(ctx)
+// Get returns a ThrottleLock entity by its id.
+func (c *ThrottleLockClient) Get(ctx context.Context, id int) (*ThrottleLock, error) {
+	return c.Query().Where(throttlelock.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PwaPushSubscriptionClient) GetX(ctx context.Context, id int) *PwaPushSubscription {
+func (c *ThrottleLockClient) GetX(ctx context.Context, id int) *ThrottleLock {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3044,148 +6127,132 @@ func (c *PwaPushSubscriptionClient) GetX(ctx context.Context, id int) *PwaPushSu
 	return obj
 }
 
-// QueryProfile queries the profile edge of a PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) QueryProfile(pps *PwaPushSubscription) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pps.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pwapushsubscription.Table, pwapushsubscription.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pwapushsubscription.ProfileTable, pwapushsubscription.ProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(pps.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PwaPushSubscriptionClient) Hooks() []Hook {
-	return c.hooks.PwaPushSubscription
+func (c *ThrottleLockClient) Hooks() []Hook {
+	return c.hooks.ThrottleLock
 }
 
 // Interceptors returns the client interceptors.
-func (c *PwaPushSubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.PwaPushSubscription
+func (c *ThrottleLockClient) Interceptors() []Interceptor {
+	return c.inters.ThrottleLock
 }
 
-func (c *PwaPushSubscriptionClient) mutate(ctx context.Context, m *PwaPushSubscriptionMutation) (Value, error) {
+func (c *ThrottleLockClient) mutate(ctx context.Context, m *ThrottleLockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThrottleLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThrottleLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThrottleLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ThrottleLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PwaPushSubscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ThrottleLock mutation op: %q", m.Op())
 	}
 }
 
-// SentEmailClient is a client for the SentEmail schema.
-type SentEmailClient struct {
+// TotpSecretClient is a client for the TotpSecret schema.
+type TotpSecretClient struct {
 	config
 }
 
-// NewSentEmailClient returns a client for the SentEmail from the given config.
-func NewSentEmailClient(c config) *SentEmailClient {
-	return &SentEmailClient{config: c}
+// NewTotpSecretClient returns a client for the TotpSecret from the given config.
+func NewTotpSecretClient(c config) *TotpSecretClient {
+	return &TotpSecretClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `sentemail.Hooks(f(g(h())))`.
-func (c *SentEmailClient) Use(hooks ...Hook) {
-	c.hooks.SentEmail = append(c.hooks.SentEmail, hooks...)
+// A call to `Use(f, g, h)` equals to `totpsecret.Hooks(f(g(h())))`.
+func (c *TotpSecretClient) Use(hooks ...Hook) {
+	c.hooks.TotpSecret = append(c.hooks.TotpSecret, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `sentemail.Intercept(f(g(h())))`.
-func (c *SentEmailClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SentEmail = append(c.inters.SentEmail, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `totpsecret.Intercept(f(g(h())))`.
+func (c *TotpSecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TotpSecret = append(c.inters.TotpSecret, interceptors...)
 }
 
-// Create returns a builder for creating a SentEmail entity.
-func (c *SentEmailClient) Create() *SentEmailCreate {
-	mutation := newSentEmailMutation(c.config, OpCreate)
-	return &SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TotpSecret entity.
+func (c *TotpSecretClient) Create() *TotpSecretCreate {
+	mutation := newTotpSecretMutation(c.config, OpCreate)
+	return &TotpSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SentEmail entities.
-func (c *SentEmailClient) CreateBulk(builders ...*SentEmailCreate) *SentEmailCreateBulk {
-	return &SentEmailCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TotpSecret entities.
+func (c *TotpSecretClient) CreateBulk(builders ...*TotpSecretCreate) *TotpSecretCreateBulk {
+	return &TotpSecretCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SentEmailClient) MapCreateBulk(slice any, setFunc func(*SentEmailCreate, int)) *SentEmailCreateBulk {
+func (c *TotpSecretClient) MapCreateBulk(slice any, setFunc func(*TotpSecretCreate, int)) *TotpSecretCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SentEmailCreateBulk{err: fmt.Errorf("calling to SentEmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TotpSecretCreateBulk{err: fmt.Errorf("calling to TotpSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SentEmailCreate, rv.Len())
+	builders := make([]*TotpSecretCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SentEmailCreateBulk{config: c.config, builders: builders}
+	return &TotpSecretCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SentEmail.
-func (c *SentEmailClient) Update() *SentEmailUpdate {
-	mutation := newSentEmailMutation(c.config, OpUpdate)
-	return &SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TotpSecret.
+func (c *TotpSecretClient) Update() *TotpSecretUpdate {
+	mutation := newTotpSecretMutation(c.config, OpUpdate)
+	return &TotpSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SentEmailClient) UpdateOne(se *SentEmail) *SentEmailUpdateOne {
-	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmail(se))
-	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TotpSecretClient) UpdateOne(ts *TotpSecret) *TotpSecretUpdateOne {
+	mutation := newTotpSecretMutation(c.config, OpUpdateOne, withTotpSecret(ts))
+	return &TotpSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SentEmailClient) UpdateOneID(id int) *SentEmailUpdateOne {
-	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmailID(id))
-	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TotpSecretClient) UpdateOneID(id int) *TotpSecretUpdateOne {
+	mutation := newTotpSecretMutation(c.config, OpUpdateOne, withTotpSecretID(id))
+	return &TotpSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SentEmail.
-func (c *SentEmailClient) Delete() *SentEmailDelete {
-	mutation := newSentEmailMutation(c.config, OpDelete)
-	return &SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TotpSecret.
+func (c *TotpSecretClient) Delete() *TotpSecretDelete {
+	mutation := newTotpSecretMutation(c.config, OpDelete)
+	return &TotpSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SentEmailClient) DeleteOne(se *SentEmail) *SentEmailDeleteOne {
-	return c.DeleteOneID(se.ID)
+func (c *TotpSecretClient) DeleteOne(ts *TotpSecret) *TotpSecretDeleteOne {
+	return c.DeleteOneID(ts.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SentEmailClient) DeleteOneID(id int) *SentEmailDeleteOne {
-	builder := c.Delete().Where(sentemail.ID(id))
+func (c *TotpSecretClient) DeleteOneID(id int) *TotpSecretDeleteOne {
+	builder := c.Delete().Where(totpsecret.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SentEmailDeleteOne{builder}
+	return &TotpSecretDeleteOne{builder}
 }
 
-// Query returns a query builder for SentEmail.
-func (c *SentEmailClient) Query() *SentEmailQuery {
-	return &SentEmailQuery{
+// Query returns a query builder for TotpSecret.
+func (c *TotpSecretClient) Query() *TotpSecretQuery {
+	return &TotpSecretQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSentEmail},
+		ctx:    &QueryContext{Type: TypeTotpSecret},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SentEmail entity by its id.
-func (c *SentEmailClient) Get(ctx context.Context, id int) (*SentEmail, error) {
-	return c.Query().Where(sentemail.ID(id)).Only(ctx)
+// Get returns a TotpSecret entity by its id.
+func (c *TotpSecretClient) Get(ctx context.Context, id int) (*TotpSecret, error) {
+	return c.Query().Where(totpsecret.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SentEmailClient) GetX(ctx context.Context, id int) *SentEmail {
+func (c *TotpSecretClient) GetX(ctx context.Context, id int) *TotpSecret {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3193,44 +6260,44 @@ func (c *SentEmailClient) GetX(ctx context.Context, id int) *SentEmail {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a SentEmail.
-func (c *SentEmailClient) QueryProfile(se *SentEmail) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryUser queries the user edge of a TotpSecret.
+func (c *TotpSecretClient) QueryUser(ts *TotpSecret) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := se.ID
+		id := ts.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(sentemail.Table, sentemail.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, sentemail.ProfileTable, sentemail.ProfileColumn),
+			sqlgraph.From(totpsecret.Table, totpsecret.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, totpsecret.UserTable, totpsecret.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SentEmailClient) Hooks() []Hook {
-	return c.hooks.SentEmail
+func (c *TotpSecretClient) Hooks() []Hook {
+	return c.hooks.TotpSecret
 }
 
 // Interceptors returns the client interceptors.
-func (c *SentEmailClient) Interceptors() []Interceptor {
-	return c.inters.SentEmail
+func (c *TotpSecretClient) Interceptors() []Interceptor {
+	return c.inters.TotpSecret
 }
 
-func (c *SentEmailClient) mutate(ctx context.Context, m *SentEmailMutation) (Value, error) {
+func (c *TotpSecretClient) mutate(ctx context.Context, m *TotpSecretMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TotpSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TotpSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TotpSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TotpSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SentEmail mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TotpSecret mutation op: %q", m.Op())
 	}
 }
 
@@ -3390,6 +6457,182 @@ func (c *UserClient) QueryLastSeenAt(u *User) *LastSeenOnlineQuery {
 	return query
 }
 
+// QueryTotpSecret queries the totp_secret edge of a User.
+func (c *UserClient) QueryTotpSecret(u *User) *TotpSecretQuery {
+	query := (&TotpSecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(totpsecret.Table, totpsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.TotpSecretTable, user.TotpSecretColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(u *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPasskeys queries the passkeys edge of a User.
+func (c *UserClient) QueryPasskeys(u *User) *PasskeyQuery {
+	query := (&PasskeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(passkey.Table, passkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasskeysTable, user.PasskeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMagicLinkTokens queries the magic_link_tokens edge of a User.
+func (c *UserClient) QueryMagicLinkTokens(u *User) *MagicLinkTokenQuery {
+	query := (&MagicLinkTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(magiclinktoken.Table, magiclinktoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MagicLinkTokensTable, user.MagicLinkTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(u *User) *UserSessionQuery {
+	query := (&UserSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usersession.Table, usersession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a User.
+func (c *UserClient) QueryAPITokens(u *User) *APITokenQuery {
+	query := (&APITokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APITokensTable, user.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a User.
+func (c *UserClient) QueryRoles(u *User) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImpersonationsPerformed queries the impersonations_performed edge of a User.
+func (c *UserClient) QueryImpersonationsPerformed(u *User) *ImpersonationQuery {
+	query := (&ImpersonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(impersonation.Table, impersonation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ImpersonationsPerformedTable, user.ImpersonationsPerformedColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImpersonations queries the impersonations edge of a User.
+func (c *UserClient) QueryImpersonations(u *User) *ImpersonationQuery {
+	query := (&ImpersonationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(impersonation.Table, impersonation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ImpersonationsTable, user.ImpersonationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReportsResolved queries the reports_resolved edge of a User.
+func (c *UserClient) QueryReportsResolved(u *User) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsResolvedTable, user.ReportsResolvedColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -3416,20 +6659,176 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserSessionClient is a client for the UserSession schema.
+type UserSessionClient struct {
+	config
+}
+
+// NewUserSessionClient returns a client for the UserSession from the given config.
+func NewUserSessionClient(c config) *UserSessionClient {
+	return &UserSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usersession.Hooks(f(g(h())))`.
+func (c *UserSessionClient) Use(hooks ...Hook) {
+	c.hooks.UserSession = append(c.hooks.UserSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usersession.Intercept(f(g(h())))`.
+func (c *UserSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserSession = append(c.inters.UserSession, interceptors...)
+}
+
+// Create returns a builder for creating a UserSession entity.
+func (c *UserSessionClient) Create() *UserSessionCreate {
+	mutation := newUserSessionMutation(c.config, OpCreate)
+	return &UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserSession entities.
+func (c *UserSessionClient) CreateBulk(builders ...*UserSessionCreate) *UserSessionCreateBulk {
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserSessionClient) MapCreateBulk(slice any, setFunc func(*UserSessionCreate, int)) *UserSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserSessionCreateBulk{err: fmt.Errorf("calling to UserSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserSession.
+func (c *UserSessionClient) Update() *UserSessionUpdate {
+	mutation := newUserSessionMutation(c.config, OpUpdate)
+	return &UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserSessionClient) UpdateOne(us *UserSession) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSession(us))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserSessionClient) UpdateOneID(id int) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSessionID(id))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserSession.
+func (c *UserSessionClient) Delete() *UserSessionDelete {
+	mutation := newUserSessionMutation(c.config, OpDelete)
+	return &UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserSessionClient) DeleteOne(us *UserSession) *UserSessionDeleteOne {
+	return c.DeleteOneID(us.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserSessionClient) DeleteOneID(id int) *UserSessionDeleteOne {
+	builder := c.Delete().Where(usersession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserSession.
+func (c *UserSessionClient) Query() *UserSessionQuery {
+	return &UserSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserSession entity by its id.
+func (c *UserSessionClient) Get(ctx context.Context, id int) (*UserSession, error) {
+	return c.Query().Where(usersession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserSessionClient) GetX(ctx context.Context, id int) *UserSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserSession.
+func (c *UserSessionClient) QueryUser(us *UserSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := us.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usersession.Table, usersession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usersession.UserTable, usersession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(us.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserSessionClient) Hooks() []Hook {
+	return c.hooks.UserSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserSessionClient) Interceptors() []Interceptor {
+	return c.inters.UserSession
+}
+
+func (c *UserSessionClient) mutate(ctx context.Context, m *UserSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserSession mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		EmailSubscription, EmailSubscriptionType, Emojis, FCMSubscriptions, FileStorage,
-		Image, ImageSize, Invitation, LastSeenOnline, MonthlySubscription,
-		Notification, NotificationPermission, NotificationTime, PasswordToken,
-		PhoneVerificationCode, Profile, PwaPushSubscription, SentEmail, User []ent.Hook
+		APIToken, AuditLog, EmailSubscription, EmailSubscriptionType, Emojis,
+		FCMSubscriptions, FileStorage, FriendRequest, Identity, Image, ImageSize,
+		Impersonation, Invitation, LastSeenOnline, MagicLinkToken, MonthlySubscription,
+		Notification, NotificationPermission, NotificationTime, Organization,
+		OrganizationInvitation, OrganizationMembership, Passkey, PasswordToken,
+		PhoneVerificationCode, Profile, PushDelivery, PwaPushSubscription,
+		RecoveryCode, Report, Role, SMSMessage, SentEmail, ThrottleAttempt,
+		ThrottleLock, TotpSecret, User, UserSession []ent.Hook
 	}
 	inters struct {
-		EmailSubscription, EmailSubscriptionType, Emojis, FCMSubscriptions, FileStorage,
-		Image, ImageSize, Invitation, LastSeenOnline, MonthlySubscription,
-		Notification, NotificationPermission, NotificationTime, PasswordToken,
-		PhoneVerificationCode, Profile, PwaPushSubscription, SentEmail,
-		User []ent.Interceptor
+		APIToken, AuditLog, EmailSubscription, EmailSubscriptionType, Emojis,
+		FCMSubscriptions, FileStorage, FriendRequest, Identity, Image, ImageSize,
+		Impersonation, Invitation, LastSeenOnline, MagicLinkToken, MonthlySubscription,
+		Notification, NotificationPermission, NotificationTime, Organization,
+		OrganizationInvitation, OrganizationMembership, Passkey, PasswordToken,
+		PhoneVerificationCode, Profile, PushDelivery, PwaPushSubscription,
+		RecoveryCode, Report, Role, SMSMessage, SentEmail, ThrottleAttempt,
+		ThrottleLock, TotpSecret, User, UserSession []ent.Interceptor
 	}
 )
 
@@ -12,25 +12,44 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/auditlog"
 	"github.com/mikestefanello/pagoda/ent/emailsubscription"
 	"github.com/mikestefanello/pagoda/ent/emailsubscriptiontype"
 	"github.com/mikestefanello/pagoda/ent/emojis"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
 	"github.com/mikestefanello/pagoda/ent/filestorage"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/ent/identity"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/imagesize"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
 	"github.com/mikestefanello/pagoda/ent/invitation"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
+	"github.com/mikestefanello/pagoda/ent/organization"
+	"github.com/mikestefanello/pagoda/ent/organizationinvitation"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/passkey"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/ent/role"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
+	"github.com/mikestefanello/pagoda/ent/smsmessage"
+	"github.com/mikestefanello/pagoda/ent/throttleattempt"
+	"github.com/mikestefanello/pagoda/ent/throttlelock"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/usersession"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -91,25 +110,44 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apitoken.Table:               apitoken.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
 			emailsubscription.Table:      emailsubscription.ValidColumn,
 			emailsubscriptiontype.Table:  emailsubscriptiontype.ValidColumn,
 			emojis.Table:                 emojis.ValidColumn,
 			fcmsubscriptions.Table:       fcmsubscriptions.ValidColumn,
 			filestorage.Table:            filestorage.ValidColumn,
+			friendrequest.Table:          friendrequest.ValidColumn,
+			identity.Table:               identity.ValidColumn,
 			image.Table:                  image.ValidColumn,
 			imagesize.Table:              imagesize.ValidColumn,
+			impersonation.Table:          impersonation.ValidColumn,
 			invitation.Table:             invitation.ValidColumn,
 			lastseenonline.Table:         lastseenonline.ValidColumn,
+			magiclinktoken.Table:         magiclinktoken.ValidColumn,
 			monthlysubscription.Table:    monthlysubscription.ValidColumn,
 			notification.Table:           notification.ValidColumn,
 			notificationpermission.Table: notificationpermission.ValidColumn,
 			notificationtime.Table:       notificationtime.ValidColumn,
+			organization.Table:           organization.ValidColumn,
+			organizationinvitation.Table: organizationinvitation.ValidColumn,
+			organizationmembership.Table: organizationmembership.ValidColumn,
+			passkey.Table:                passkey.ValidColumn,
 			passwordtoken.Table:          passwordtoken.ValidColumn,
 			phoneverificationcode.Table:  phoneverificationcode.ValidColumn,
 			profile.Table:                profile.ValidColumn,
+			pushdelivery.Table:           pushdelivery.ValidColumn,
 			pwapushsubscription.Table:    pwapushsubscription.ValidColumn,
+			recoverycode.Table:           recoverycode.ValidColumn,
+			report.Table:                 report.ValidColumn,
+			role.Table:                   role.ValidColumn,
+			smsmessage.Table:             smsmessage.ValidColumn,
 			sentemail.Table:              sentemail.ValidColumn,
+			throttleattempt.Table:        throttleattempt.ValidColumn,
+			throttlelock.Table:           throttlelock.ValidColumn,
+			totpsecret.Table:             totpsecret.ValidColumn,
 			user.Table:                   user.ValidColumn,
+			usersession.Table:            usersession.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// FriendRequestDelete is the builder for deleting a FriendRequest entity.
+type FriendRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *FriendRequestMutation
+}
+
+// Where appends a list predicates to the FriendRequestDelete builder.
+func (frd *FriendRequestDelete) Where(ps ...predicate.FriendRequest) *FriendRequestDelete {
+	frd.mutation.Where(ps...)
+	return frd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (frd *FriendRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, frd.sqlExec, frd.mutation, frd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frd *FriendRequestDelete) ExecX(ctx context.Context) int {
+	n, err := frd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (frd *FriendRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(friendrequest.Table, sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt))
+	if ps := frd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, frd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	frd.mutation.done = true
+	return affected, err
+}
+
+// FriendRequestDeleteOne is the builder for deleting a single FriendRequest entity.
+type FriendRequestDeleteOne struct {
+	frd *FriendRequestDelete
+}
+
+// Where appends a list predicates to the FriendRequestDelete builder.
+func (frdo *FriendRequestDeleteOne) Where(ps ...predicate.FriendRequest) *FriendRequestDeleteOne {
+	frdo.frd.mutation.Where(ps...)
+	return frdo
+}
+
+// Exec executes the deletion query.
+func (frdo *FriendRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := frdo.frd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{friendrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frdo *FriendRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := frdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -23,6 +23,8 @@ type Image struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Type holds the value of the "type" field.
 	Type image.Type `json:"type,omitempty"`
+	// When a moderator hid the image, which is not shown anymore
+	HiddenAt *time.Time `json:"hidden_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ImageQuery when eager-loading is set.
 	Edges          ImageEdges `json:"edges"`
@@ -57,7 +59,7 @@ func (*Image) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case image.FieldType:
 			values[i] = new(sql.NullString)
-		case image.FieldCreatedAt, image.FieldUpdatedAt:
+		case image.FieldCreatedAt, image.FieldUpdatedAt, image.FieldHiddenAt:
 			values[i] = new(sql.NullTime)
 		case image.ForeignKeys[0]: // profile_photos
 			values[i] = new(sql.NullInt64)
@@ -100,6 +102,13 @@ func (i *Image) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Type = image.Type(value.String)
 			}
+		case image.FieldHiddenAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_at", values[j])
+			} else if value.Valid {
+				i.HiddenAt = new(time.Time)
+				*i.HiddenAt = value.Time
+			}
 		case image.ForeignKeys[0]:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field profile_photos", value)
@@ -156,6 +165,11 @@ func (i *Image) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", i.Type))
+	builder.WriteString(", ")
+	if v := i.HiddenAt; v != nil {
+		builder.WriteString("hidden_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
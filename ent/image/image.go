@@ -21,6 +21,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldHiddenAt holds the string denoting the hidden_at field in the database.
+	FieldHiddenAt = "hidden_at"
 	// EdgeSizes holds the string denoting the sizes edge name in mutations.
 	EdgeSizes = "sizes"
 	// Table holds the table name of the image in the database.
@@ -40,6 +42,7 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldType,
+	FieldHiddenAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "images"
@@ -118,6 +121,11 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByHiddenAt orders the results by the hidden_at field.
+func ByHiddenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHiddenAt, opts...).ToFunc()
+}
+
 // BySizesCount orders the results by sizes count.
 func BySizesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -57,6 +57,20 @@ func (ic *ImageCreate) SetType(i image.Type) *ImageCreate {
 	return ic
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (ic *ImageCreate) SetHiddenAt(t time.Time) *ImageCreate {
+	ic.mutation.SetHiddenAt(t)
+	return ic
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (ic *ImageCreate) SetNillableHiddenAt(t *time.Time) *ImageCreate {
+	if t != nil {
+		ic.SetHiddenAt(*t)
+	}
+	return ic
+}
+
 // AddSizeIDs adds the "sizes" edge to the ImageSize entity by IDs.
 func (ic *ImageCreate) AddSizeIDs(ids ...int) *ImageCreate {
 	ic.mutation.AddSizeIDs(ids...)
@@ -172,6 +186,10 @@ func (ic *ImageCreate) createSpec() (*Image, *sqlgraph.CreateSpec) {
 		_spec.SetField(image.FieldType, field.TypeEnum, value)
 		_node.Type = value
 	}
+	if value, ok := ic.mutation.HiddenAt(); ok {
+		_spec.SetField(image.FieldHiddenAt, field.TypeTime, value)
+		_node.HiddenAt = &value
+	}
 	if nodes := ic.mutation.SizesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -264,6 +282,24 @@ func (u *ImageUpsert) UpdateType() *ImageUpsert {
 	return u
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *ImageUpsert) SetHiddenAt(v time.Time) *ImageUpsert {
+	u.Set(image.FieldHiddenAt, v)
+	return u
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *ImageUpsert) UpdateHiddenAt() *ImageUpsert {
+	u.SetExcluded(image.FieldHiddenAt)
+	return u
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *ImageUpsert) ClearHiddenAt() *ImageUpsert {
+	u.SetNull(image.FieldHiddenAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -337,6 +373,27 @@ func (u *ImageUpsertOne) UpdateType() *ImageUpsertOne {
 	})
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *ImageUpsertOne) SetHiddenAt(v time.Time) *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *ImageUpsertOne) UpdateHiddenAt() *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *ImageUpsertOne) ClearHiddenAt() *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ImageUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -576,6 +633,27 @@ func (u *ImageUpsertBulk) UpdateType() *ImageUpsertBulk {
 	})
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *ImageUpsertBulk) SetHiddenAt(v time.Time) *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *ImageUpsertBulk) UpdateHiddenAt() *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *ImageUpsertBulk) ClearHiddenAt() *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ImageUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
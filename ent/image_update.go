@@ -49,6 +49,26 @@ func (iu *ImageUpdate) SetNillableType(i *image.Type) *ImageUpdate {
 	return iu
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (iu *ImageUpdate) SetHiddenAt(t time.Time) *ImageUpdate {
+	iu.mutation.SetHiddenAt(t)
+	return iu
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (iu *ImageUpdate) SetNillableHiddenAt(t *time.Time) *ImageUpdate {
+	if t != nil {
+		iu.SetHiddenAt(*t)
+	}
+	return iu
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (iu *ImageUpdate) ClearHiddenAt() *ImageUpdate {
+	iu.mutation.ClearHiddenAt()
+	return iu
+}
+
 // AddSizeIDs adds the "sizes" edge to the ImageSize entity by IDs.
 func (iu *ImageUpdate) AddSizeIDs(ids ...int) *ImageUpdate {
 	iu.mutation.AddSizeIDs(ids...)
@@ -154,6 +174,12 @@ func (iu *ImageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.GetType(); ok {
 		_spec.SetField(image.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := iu.mutation.HiddenAt(); ok {
+		_spec.SetField(image.FieldHiddenAt, field.TypeTime, value)
+	}
+	if iu.mutation.HiddenAtCleared() {
+		_spec.ClearField(image.FieldHiddenAt, field.TypeTime)
+	}
 	if iu.mutation.SizesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -239,6 +265,26 @@ func (iuo *ImageUpdateOne) SetNillableType(i *image.Type) *ImageUpdateOne {
 	return iuo
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (iuo *ImageUpdateOne) SetHiddenAt(t time.Time) *ImageUpdateOne {
+	iuo.mutation.SetHiddenAt(t)
+	return iuo
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (iuo *ImageUpdateOne) SetNillableHiddenAt(t *time.Time) *ImageUpdateOne {
+	if t != nil {
+		iuo.SetHiddenAt(*t)
+	}
+	return iuo
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (iuo *ImageUpdateOne) ClearHiddenAt() *ImageUpdateOne {
+	iuo.mutation.ClearHiddenAt()
+	return iuo
+}
+
 // AddSizeIDs adds the "sizes" edge to the ImageSize entity by IDs.
 func (iuo *ImageUpdateOne) AddSizeIDs(ids ...int) *ImageUpdateOne {
 	iuo.mutation.AddSizeIDs(ids...)
@@ -374,6 +420,12 @@ func (iuo *ImageUpdateOne) sqlSave(ctx context.Context) (_node *Image, err error
 	if value, ok := iuo.mutation.GetType(); ok {
 		_spec.SetField(image.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := iuo.mutation.HiddenAt(); ok {
+		_spec.SetField(image.FieldHiddenAt, field.TypeTime, value)
+	}
+	if iuo.mutation.HiddenAtCleared() {
+		_spec.ClearField(image.FieldHiddenAt, field.TypeTime)
+	}
 	if iuo.mutation.SizesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -26,9 +26,16 @@ type Invitation struct {
 	InviteeName string `json:"invitee_name,omitempty"`
 	// ConfirmationCode holds the value of the "confirmation_code" field.
 	ConfirmationCode string `json:"confirmation_code,omitempty"`
+	// After that time, the invitation cannot be accepted anymore.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// Set when the inviter revoked the invitation before it was accepted.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InvitationQuery when eager-loading is set.
 	Edges               InvitationEdges `json:"edges"`
+	invitation_invitee  *int
 	profile_invitations *int
 	selectValues        sql.SelectValues
 }
@@ -37,9 +44,11 @@ type Invitation struct {
 type InvitationEdges struct {
 	// The profile who created the invitation.
 	Inviter *Profile `json:"inviter,omitempty"`
+	// The profile who accepted the invitation.
+	Invitee *Profile `json:"invitee,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // InviterOrErr returns the Inviter value or an error if the edge
@@ -53,6 +62,17 @@ func (e InvitationEdges) InviterOrErr() (*Profile, error) {
 	return nil, &NotLoadedError{edge: "inviter"}
 }
 
+// InviteeOrErr returns the Invitee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvitationEdges) InviteeOrErr() (*Profile, error) {
+	if e.Invitee != nil {
+		return e.Invitee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "invitee"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Invitation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -62,9 +82,11 @@ func (*Invitation) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case invitation.FieldInviteeName, invitation.FieldConfirmationCode:
 			values[i] = new(sql.NullString)
-		case invitation.FieldCreatedAt, invitation.FieldUpdatedAt:
+		case invitation.FieldCreatedAt, invitation.FieldUpdatedAt, invitation.FieldExpiresAt, invitation.FieldAcceptedAt, invitation.FieldRevokedAt:
 			values[i] = new(sql.NullTime)
-		case invitation.ForeignKeys[0]: // profile_invitations
+		case invitation.ForeignKeys[0]: // invitation_invitee
+			values[i] = new(sql.NullInt64)
+		case invitation.ForeignKeys[1]: // profile_invitations
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -111,7 +133,34 @@ func (i *Invitation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.ConfirmationCode = value.String
 			}
+		case invitation.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = value.Time
+			}
+		case invitation.FieldAcceptedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[j])
+			} else if value.Valid {
+				i.AcceptedAt = new(time.Time)
+				*i.AcceptedAt = value.Time
+			}
+		case invitation.FieldRevokedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[j])
+			} else if value.Valid {
+				i.RevokedAt = new(time.Time)
+				*i.RevokedAt = value.Time
+			}
 		case invitation.ForeignKeys[0]:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invitation_invitee", value)
+			} else if value.Valid {
+				i.invitation_invitee = new(int)
+				*i.invitation_invitee = int(value.Int64)
+			}
+		case invitation.ForeignKeys[1]:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field profile_invitations", value)
 			} else if value.Valid {
@@ -136,6 +185,11 @@ func (i *Invitation) QueryInviter() *ProfileQuery {
 	return NewInvitationClient(i.config).QueryInviter(i)
 }
 
+// QueryInvitee queries the "invitee" edge of the Invitation entity.
+func (i *Invitation) QueryInvitee() *ProfileQuery {
+	return NewInvitationClient(i.config).QueryInvitee(i)
+}
+
 // Update returns a builder for updating this Invitation.
 // Note that you need to call Invitation.Unwrap() before calling this method if this Invitation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -170,6 +224,19 @@ func (i *Invitation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("confirmation_code=")
 	builder.WriteString(i.ConfirmationCode)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(i.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
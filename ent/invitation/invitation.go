@@ -22,8 +22,16 @@ const (
 	FieldInviteeName = "invitee_name"
 	// FieldConfirmationCode holds the string denoting the confirmation_code field in the database.
 	FieldConfirmationCode = "confirmation_code"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldAcceptedAt holds the string denoting the accepted_at field in the database.
+	FieldAcceptedAt = "accepted_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
 	// EdgeInviter holds the string denoting the inviter edge name in mutations.
 	EdgeInviter = "inviter"
+	// EdgeInvitee holds the string denoting the invitee edge name in mutations.
+	EdgeInvitee = "invitee"
 	// Table holds the table name of the invitation in the database.
 	Table = "invitations"
 	// InviterTable is the table that holds the inviter relation/edge.
@@ -33,6 +41,13 @@ const (
 	InviterInverseTable = "profiles"
 	// InviterColumn is the table column denoting the inviter relation/edge.
 	InviterColumn = "profile_invitations"
+	// InviteeTable is the table that holds the invitee relation/edge.
+	InviteeTable = "invitations"
+	// InviteeInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	InviteeInverseTable = "profiles"
+	// InviteeColumn is the table column denoting the invitee relation/edge.
+	InviteeColumn = "invitation_invitee"
 )
 
 // Columns holds all SQL columns for invitation fields.
@@ -42,11 +57,15 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldInviteeName,
 	FieldConfirmationCode,
+	FieldExpiresAt,
+	FieldAcceptedAt,
+	FieldRevokedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "invitations"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"invitation_invitee",
 	"profile_invitations",
 }
 
@@ -106,12 +125,34 @@ func ByConfirmationCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConfirmationCode, opts...).ToFunc()
 }
 
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByAcceptedAt orders the results by the accepted_at field.
+func ByAcceptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAt, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
 // ByInviterField orders the results by inviter field.
 func ByInviterField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newInviterStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByInviteeField orders the results by invitee field.
+func ByInviteeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInviteeStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newInviterStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -119,3 +160,10 @@ func newInviterStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, InviterTable, InviterColumn),
 	)
 }
+func newInviteeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InviteeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InviteeTable, InviteeColumn),
+	)
+}
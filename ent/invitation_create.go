@@ -63,6 +63,40 @@ func (ic *InvitationCreate) SetConfirmationCode(s string) *InvitationCreate {
 	return ic
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InvitationCreate) SetExpiresAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (ic *InvitationCreate) SetAcceptedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetAcceptedAt(t)
+	return ic
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableAcceptedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetAcceptedAt(*t)
+	}
+	return ic
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ic *InvitationCreate) SetRevokedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetRevokedAt(t)
+	return ic
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableRevokedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetRevokedAt(*t)
+	}
+	return ic
+}
+
 // SetInviterID sets the "inviter" edge to the Profile entity by ID.
 func (ic *InvitationCreate) SetInviterID(id int) *InvitationCreate {
 	ic.mutation.SetInviterID(id)
@@ -74,6 +108,25 @@ func (ic *InvitationCreate) SetInviter(p *Profile) *InvitationCreate {
 	return ic.SetInviterID(p.ID)
 }
 
+// SetInviteeID sets the "invitee" edge to the Profile entity by ID.
+func (ic *InvitationCreate) SetInviteeID(id int) *InvitationCreate {
+	ic.mutation.SetInviteeID(id)
+	return ic
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the Profile entity by ID if the given value is not nil.
+func (ic *InvitationCreate) SetNillableInviteeID(id *int) *InvitationCreate {
+	if id != nil {
+		ic = ic.SetInviteeID(*id)
+	}
+	return ic
+}
+
+// SetInvitee sets the "invitee" edge to the Profile entity.
+func (ic *InvitationCreate) SetInvitee(p *Profile) *InvitationCreate {
+	return ic.SetInviteeID(p.ID)
+}
+
 // Mutation returns the InvitationMutation object of the builder.
 func (ic *InvitationCreate) Mutation() *InvitationMutation {
 	return ic.mutation
@@ -143,6 +196,9 @@ func (ic *InvitationCreate) check() error {
 			return &ValidationError{Name: "confirmation_code", err: fmt.Errorf(`ent: validator failed for field "Invitation.confirmation_code": %w`, err)}
 		}
 	}
+	if _, ok := ic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Invitation.expires_at"`)}
+	}
 	if len(ic.mutation.InviterIDs()) == 0 {
 		return &ValidationError{Name: "inviter", err: errors.New(`ent: missing required edge "Invitation.inviter"`)}
 	}
@@ -189,6 +245,18 @@ func (ic *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
 		_spec.SetField(invitation.FieldConfirmationCode, field.TypeString, value)
 		_node.ConfirmationCode = value
 	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ic.mutation.AcceptedAt(); ok {
+		_spec.SetField(invitation.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if value, ok := ic.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
 	if nodes := ic.mutation.InviterIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -206,6 +274,23 @@ func (ic *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
 		_node.profile_invitations = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invitation_invitee = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -294,6 +379,54 @@ func (u *InvitationUpsert) UpdateConfirmationCode() *InvitationUpsert {
 	return u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsert) SetExpiresAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateExpiresAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldExpiresAt)
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *InvitationUpsert) SetAcceptedAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateAcceptedAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *InvitationUpsert) ClearAcceptedAt() *InvitationUpsert {
+	u.SetNull(invitation.FieldAcceptedAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationUpsert) SetRevokedAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateRevokedAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationUpsert) ClearRevokedAt() *InvitationUpsert {
+	u.SetNull(invitation.FieldRevokedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -381,6 +514,62 @@ func (u *InvitationUpsertOne) UpdateConfirmationCode() *InvitationUpsertOne {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsertOne) SetExpiresAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateExpiresAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *InvitationUpsertOne) SetAcceptedAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateAcceptedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *InvitationUpsertOne) ClearAcceptedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationUpsertOne) SetRevokedAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateRevokedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationUpsertOne) ClearRevokedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *InvitationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -634,6 +823,62 @@ func (u *InvitationUpsertBulk) UpdateConfirmationCode() *InvitationUpsertBulk {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsertBulk) SetExpiresAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateExpiresAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *InvitationUpsertBulk) SetAcceptedAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateAcceptedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *InvitationUpsertBulk) ClearAcceptedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationUpsertBulk) SetRevokedAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateRevokedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationUpsertBulk) ClearRevokedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *InvitationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -24,6 +24,7 @@ type InvitationQuery struct {
 	inters      []Interceptor
 	predicates  []predicate.Invitation
 	withInviter *ProfileQuery
+	withInvitee *ProfileQuery
 	withFKs     bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -83,6 +84,28 @@ func (iq *InvitationQuery) QueryInviter() *ProfileQuery {
 	return query
 }
 
+// QueryInvitee chains the current query on the "invitee" edge.
+func (iq *InvitationQuery) QueryInvitee() *ProfileQuery {
+	query := (&ProfileClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invitation.InviteeTable, invitation.InviteeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Invitation entity from the query.
 // Returns a *NotFoundError when no Invitation was found.
 func (iq *InvitationQuery) First(ctx context.Context) (*Invitation, error) {
@@ -276,6 +299,7 @@ func (iq *InvitationQuery) Clone() *InvitationQuery {
 		inters:      append([]Interceptor{}, iq.inters...),
 		predicates:  append([]predicate.Invitation{}, iq.predicates...),
 		withInviter: iq.withInviter.Clone(),
+		withInvitee: iq.withInvitee.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -293,6 +317,17 @@ func (iq *InvitationQuery) WithInviter(opts ...func(*ProfileQuery)) *InvitationQ
 	return iq
 }
 
+// WithInvitee tells the query-builder to eager-load the nodes that are connected to
+// the "invitee" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *InvitationQuery) WithInvitee(opts ...func(*ProfileQuery)) *InvitationQuery {
+	query := (&ProfileClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withInvitee = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,11 +407,12 @@ func (iq *InvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*I
 		nodes       = []*Invitation{}
 		withFKs     = iq.withFKs
 		_spec       = iq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			iq.withInviter != nil,
+			iq.withInvitee != nil,
 		}
 	)
-	if iq.withInviter != nil {
+	if iq.withInviter != nil || iq.withInvitee != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -406,6 +442,12 @@ func (iq *InvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*I
 			return nil, err
 		}
 	}
+	if query := iq.withInvitee; query != nil {
+		if err := iq.loadInvitee(ctx, query, nodes, nil,
+			func(n *Invitation, e *Profile) { n.Edges.Invitee = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,6 +483,38 @@ func (iq *InvitationQuery) loadInviter(ctx context.Context, query *ProfileQuery,
 	}
 	return nil
 }
+func (iq *InvitationQuery) loadInvitee(ctx context.Context, query *ProfileQuery, nodes []*Invitation, init func(*Invitation), assign func(*Invitation, *Profile)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Invitation)
+	for i := range nodes {
+		if nodes[i].invitation_invitee == nil {
+			continue
+		}
+		fk := *nodes[i].invitation_invitee
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(profile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "invitation_invitee" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (iq *InvitationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
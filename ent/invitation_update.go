@@ -63,6 +63,60 @@ func (iu *InvitationUpdate) SetNillableConfirmationCode(s *string) *InvitationUp
 	return iu
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableExpiresAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (iu *InvitationUpdate) SetAcceptedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetAcceptedAt(t)
+	return iu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableAcceptedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetAcceptedAt(*t)
+	}
+	return iu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (iu *InvitationUpdate) ClearAcceptedAt() *InvitationUpdate {
+	iu.mutation.ClearAcceptedAt()
+	return iu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iu *InvitationUpdate) SetRevokedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetRevokedAt(t)
+	return iu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRevokedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetRevokedAt(*t)
+	}
+	return iu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iu *InvitationUpdate) ClearRevokedAt() *InvitationUpdate {
+	iu.mutation.ClearRevokedAt()
+	return iu
+}
+
 // SetInviterID sets the "inviter" edge to the Profile entity by ID.
 func (iu *InvitationUpdate) SetInviterID(id int) *InvitationUpdate {
 	iu.mutation.SetInviterID(id)
@@ -74,6 +128,25 @@ func (iu *InvitationUpdate) SetInviter(p *Profile) *InvitationUpdate {
 	return iu.SetInviterID(p.ID)
 }
 
+// SetInviteeID sets the "invitee" edge to the Profile entity by ID.
+func (iu *InvitationUpdate) SetInviteeID(id int) *InvitationUpdate {
+	iu.mutation.SetInviteeID(id)
+	return iu
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the Profile entity by ID if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableInviteeID(id *int) *InvitationUpdate {
+	if id != nil {
+		iu = iu.SetInviteeID(*id)
+	}
+	return iu
+}
+
+// SetInvitee sets the "invitee" edge to the Profile entity.
+func (iu *InvitationUpdate) SetInvitee(p *Profile) *InvitationUpdate {
+	return iu.SetInviteeID(p.ID)
+}
+
 // Mutation returns the InvitationMutation object of the builder.
 func (iu *InvitationUpdate) Mutation() *InvitationMutation {
 	return iu.mutation
@@ -85,6 +158,12 @@ func (iu *InvitationUpdate) ClearInviter() *InvitationUpdate {
 	return iu
 }
 
+// ClearInvitee clears the "invitee" edge to the Profile entity.
+func (iu *InvitationUpdate) ClearInvitee() *InvitationUpdate {
+	iu.mutation.ClearInvitee()
+	return iu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
 	iu.defaults()
@@ -160,6 +239,21 @@ func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.ConfirmationCode(); ok {
 		_spec.SetField(invitation.FieldConfirmationCode, field.TypeString, value)
 	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.AcceptedAt(); ok {
+		_spec.SetField(invitation.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if iu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(invitation.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iu.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
 	if iu.mutation.InviterCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -189,6 +283,35 @@ func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{invitation.Label}
@@ -243,6 +366,60 @@ func (iuo *InvitationUpdateOne) SetNillableConfirmationCode(s *string) *Invitati
 	return iuo
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (iuo *InvitationUpdateOne) SetAcceptedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetAcceptedAt(t)
+	return iuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableAcceptedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetAcceptedAt(*t)
+	}
+	return iuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (iuo *InvitationUpdateOne) ClearAcceptedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearAcceptedAt()
+	return iuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iuo *InvitationUpdateOne) SetRevokedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetRevokedAt(t)
+	return iuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRevokedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetRevokedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iuo *InvitationUpdateOne) ClearRevokedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearRevokedAt()
+	return iuo
+}
+
 // SetInviterID sets the "inviter" edge to the Profile entity by ID.
 func (iuo *InvitationUpdateOne) SetInviterID(id int) *InvitationUpdateOne {
 	iuo.mutation.SetInviterID(id)
@@ -254,6 +431,25 @@ func (iuo *InvitationUpdateOne) SetInviter(p *Profile) *InvitationUpdateOne {
 	return iuo.SetInviterID(p.ID)
 }
 
+// SetInviteeID sets the "invitee" edge to the Profile entity by ID.
+func (iuo *InvitationUpdateOne) SetInviteeID(id int) *InvitationUpdateOne {
+	iuo.mutation.SetInviteeID(id)
+	return iuo
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the Profile entity by ID if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableInviteeID(id *int) *InvitationUpdateOne {
+	if id != nil {
+		iuo = iuo.SetInviteeID(*id)
+	}
+	return iuo
+}
+
+// SetInvitee sets the "invitee" edge to the Profile entity.
+func (iuo *InvitationUpdateOne) SetInvitee(p *Profile) *InvitationUpdateOne {
+	return iuo.SetInviteeID(p.ID)
+}
+
 // Mutation returns the InvitationMutation object of the builder.
 func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
 	return iuo.mutation
@@ -265,6 +461,12 @@ func (iuo *InvitationUpdateOne) ClearInviter() *InvitationUpdateOne {
 	return iuo
 }
 
+// ClearInvitee clears the "invitee" edge to the Profile entity.
+func (iuo *InvitationUpdateOne) ClearInvitee() *InvitationUpdateOne {
+	iuo.mutation.ClearInvitee()
+	return iuo
+}
+
 // Where appends a list predicates to the InvitationUpdate builder.
 func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -370,6 +572,21 @@ func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation,
 	if value, ok := iuo.mutation.ConfirmationCode(); ok {
 		_spec.SetField(invitation.FieldConfirmationCode, field.TypeString, value)
 	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.AcceptedAt(); ok {
+		_spec.SetField(invitation.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(invitation.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
 	if iuo.mutation.InviterCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -399,6 +616,35 @@ func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Invitation{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
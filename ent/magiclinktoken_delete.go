@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// MagicLinkTokenDelete is the builder for deleting a MagicLinkToken entity.
+type MagicLinkTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *MagicLinkTokenMutation
+}
+
+// Where appends a list predicates to the MagicLinkTokenDelete builder.
+func (mltd *MagicLinkTokenDelete) Where(ps ...predicate.MagicLinkToken) *MagicLinkTokenDelete {
+	mltd.mutation.Where(ps...)
+	return mltd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mltd *MagicLinkTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mltd.sqlExec, mltd.mutation, mltd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mltd *MagicLinkTokenDelete) ExecX(ctx context.Context) int {
+	n, err := mltd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mltd *MagicLinkTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(magiclinktoken.Table, sqlgraph.NewFieldSpec(magiclinktoken.FieldID, field.TypeInt))
+	if ps := mltd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mltd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mltd.mutation.done = true
+	return affected, err
+}
+
+// MagicLinkTokenDeleteOne is the builder for deleting a single MagicLinkToken entity.
+type MagicLinkTokenDeleteOne struct {
+	mltd *MagicLinkTokenDelete
+}
+
+// Where appends a list predicates to the MagicLinkTokenDelete builder.
+func (mltdo *MagicLinkTokenDeleteOne) Where(ps ...predicate.MagicLinkToken) *MagicLinkTokenDeleteOne {
+	mltdo.mltd.mutation.Where(ps...)
+	return mltdo
+}
+
+// Exec executes the deletion query.
+func (mltdo *MagicLinkTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := mltdo.mltd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{magiclinktoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mltdo *MagicLinkTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := mltdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
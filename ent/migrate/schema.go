@@ -9,6 +9,82 @@ import (
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "hint", Type: field.TypeString, Default: ""},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_api_tokens",
+				Columns:    []*schema.Column{APITokensColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apitoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APITokensColumns[9]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "impersonator_id", Type: field.TypeInt, Nullable: true},
+		{Name: "target_type", Type: field.TypeString, Default: ""},
+		{Name: "target_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4], AuditLogsColumns[5]},
+			},
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[10]},
+			},
+		},
+	}
 	// EmailSubscriptionsColumns holds the columns for the "email_subscriptions" table.
 	EmailSubscriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -106,12 +182,74 @@ var (
 			},
 		},
 	}
+	// FriendRequestsColumns holds the columns for the "friend_requests" table.
+	FriendRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "cancelled"}, Default: "pending"},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile_sent_friend_requests", Type: field.TypeInt},
+		{Name: "profile_received_friend_requests", Type: field.TypeInt},
+	}
+	// FriendRequestsTable holds the schema information for the "friend_requests" table.
+	FriendRequestsTable = &schema.Table{
+		Name:       "friend_requests",
+		Columns:    FriendRequestsColumns,
+		PrimaryKey: []*schema.Column{FriendRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "friend_requests_profiles_sent_friend_requests",
+				Columns:    []*schema.Column{FriendRequestsColumns[5]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "friend_requests_profiles_received_friend_requests",
+				Columns:    []*schema.Column{FriendRequestsColumns[6]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[3], IdentitiesColumns[4]},
+			},
+		},
+	}
 	// ImagesColumns holds the columns for the "images" table.
 	ImagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"profile_photo", "profile_gallery"}},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
 		{Name: "profile_photos", Type: field.TypeInt, Nullable: true},
 	}
 	// ImagesTable holds the schema information for the "images" table.
@@ -122,7 +260,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "images_profiles_photos",
-				Columns:    []*schema.Column{ImagesColumns[4]},
+				Columns:    []*schema.Column{ImagesColumns[5]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -159,6 +297,50 @@ var (
 			},
 		},
 	}
+	// ImpersonationsColumns holds the columns for the "impersonations" table.
+	ImpersonationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "admin_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ImpersonationsTable holds the schema information for the "impersonations" table.
+	ImpersonationsTable = &schema.Table{
+		Name:       "impersonations",
+		Columns:    ImpersonationsColumns,
+		PrimaryKey: []*schema.Column{ImpersonationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "impersonations_users_impersonations_performed",
+				Columns:    []*schema.Column{ImpersonationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "impersonations_users_impersonations",
+				Columns:    []*schema.Column{ImpersonationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "impersonation_admin_id",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[7]},
+			},
+			{
+				Name:    "impersonation_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationsColumns[8]},
+			},
+		},
+	}
 	// InvitationsColumns holds the columns for the "invitations" table.
 	InvitationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -166,6 +348,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "invitee_name", Type: field.TypeString},
 		{Name: "confirmation_code", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "invitation_invitee", Type: field.TypeInt, Nullable: true},
 		{Name: "profile_invitations", Type: field.TypeInt},
 	}
 	// InvitationsTable holds the schema information for the "invitations" table.
@@ -174,9 +360,15 @@ var (
 		Columns:    InvitationsColumns,
 		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_profiles_invitee",
+				Columns:    []*schema.Column{InvitationsColumns[8]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "invitations_profiles_invitations",
-				Columns:    []*schema.Column{InvitationsColumns[5]},
+				Columns:    []*schema.Column{InvitationsColumns[9]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -202,6 +394,37 @@ var (
 			},
 		},
 	}
+	// MagicLinkTokensColumns holds the columns for the "magic_link_tokens" table.
+	MagicLinkTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// MagicLinkTokensTable holds the schema information for the "magic_link_tokens" table.
+	MagicLinkTokensTable = &schema.Table{
+		Name:       "magic_link_tokens",
+		Columns:    MagicLinkTokensColumns,
+		PrimaryKey: []*schema.Column{MagicLinkTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "magic_link_tokens_users_magic_link_tokens",
+				Columns:    []*schema.Column{MagicLinkTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "magiclinktoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MagicLinkTokensColumns[6]},
+			},
+		},
+	}
 	// MonthlySubscriptionsColumns holds the columns for the "monthly_subscriptions" table.
 	MonthlySubscriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -214,7 +437,8 @@ var (
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "expired_on", Type: field.TypeTime, Nullable: true},
 		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
-		{Name: "paying_profile_id", Type: field.TypeInt},
+		{Name: "paying_profile_id", Type: field.TypeInt, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 	}
 	// MonthlySubscriptionsTable holds the schema information for the "monthly_subscriptions" table.
 	MonthlySubscriptionsTable = &schema.Table{
@@ -228,6 +452,12 @@ var (
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "monthly_subscriptions_organizations_organization",
+				Columns:    []*schema.Column{MonthlySubscriptionsColumns[11]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -235,6 +465,11 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{MonthlySubscriptionsColumns[10], MonthlySubscriptionsColumns[4]},
 			},
+			{
+				Name:    "monthlysubscription_organization_id_is_active",
+				Unique:  true,
+				Columns: []*schema.Column{MonthlySubscriptionsColumns[11], MonthlySubscriptionsColumns[4]},
+			},
 		},
 	}
 	// NotificationsColumns holds the columns for the "notifications" table.
@@ -242,7 +477,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "mutual_question_answered", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "connection_request_accepted", "platform_update", "connection_reacted_to_answer", "committed_relationship_request", "breakup", "contact_request", "new_homefeed_qa_object", "payment_failed", "daily_conversation_reminder"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "platform_update", "payment_failed", "daily_conversation_reminder", "invitation_accepted", "friend_request_received", "friend_request_accepted", "friend_request_declined", "friend_request_cancelled", "report_resolved", "moderation_action"}},
 		{Name: "title", Type: field.TypeString, Default: ""},
 		{Name: "text", Type: field.TypeString},
 		{Name: "link", Type: field.TypeString, Nullable: true},
@@ -303,7 +538,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "mutual_question_answered", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "connection_request_accepted", "platform_update", "connection_reacted_to_answer", "committed_relationship_request", "breakup", "contact_request", "new_homefeed_qa_object", "payment_failed", "daily_conversation_reminder"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "platform_update", "payment_failed", "daily_conversation_reminder", "invitation_accepted", "friend_request_received", "friend_request_accepted", "friend_request_declined", "friend_request_cancelled", "report_resolved", "moderation_action"}},
 		{Name: "send_minute", Type: field.TypeInt},
 		{Name: "profile_id", Type: field.TypeInt},
 	}
@@ -328,6 +563,128 @@ var (
 			},
 		},
 	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "stripe_id", Type: field.TypeString, Unique: true, Nullable: true},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// OrganizationInvitationsColumns holds the columns for the "organization_invitations" table.
+	OrganizationInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_invitations", Type: field.TypeInt},
+		{Name: "organization_invitation_inviter", Type: field.TypeInt, Nullable: true},
+	}
+	// OrganizationInvitationsTable holds the schema information for the "organization_invitations" table.
+	OrganizationInvitationsTable = &schema.Table{
+		Name:       "organization_invitations",
+		Columns:    OrganizationInvitationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_invitations_organizations_invitations",
+				Columns:    []*schema.Column{OrganizationInvitationsColumns[9]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "organization_invitations_profiles_inviter",
+				Columns:    []*schema.Column{OrganizationInvitationsColumns[10]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// OrganizationMembershipsColumns holds the columns for the "organization_memberships" table.
+	OrganizationMembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "profile_id", Type: field.TypeInt},
+	}
+	// OrganizationMembershipsTable holds the schema information for the "organization_memberships" table.
+	OrganizationMembershipsTable = &schema.Table{
+		Name:       "organization_memberships",
+		Columns:    OrganizationMembershipsColumns,
+		PrimaryKey: []*schema.Column{OrganizationMembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_memberships_organizations_memberships",
+				Columns:    []*schema.Column{OrganizationMembershipsColumns[4]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "organization_memberships_profiles_organization_memberships",
+				Columns:    []*schema.Column{OrganizationMembershipsColumns[5]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationmembership_organization_id_profile_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationMembershipsColumns[4], OrganizationMembershipsColumns[5]},
+			},
+		},
+	}
+	// PasskeysColumns holds the columns for the "passkeys" table.
+	PasskeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PasskeysTable holds the schema information for the "passkeys" table.
+	PasskeysTable = &schema.Table{
+		Name:       "passkeys",
+		Columns:    PasskeysColumns,
+		PrimaryKey: []*schema.Column{PasskeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "passkeys_users_passkeys",
+				Columns:    []*schema.Column{PasskeysColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passkey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeysColumns[13]},
+			},
+		},
+	}
 	// PasswordTokensColumns holds the columns for the "password_tokens" table.
 	PasswordTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -355,7 +712,11 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "code", Type: field.TypeString},
-		{Name: "profile_id", Type: field.TypeInt},
+		{Name: "phone_number_e164", Type: field.TypeString, Nullable: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"verify", "login"}, Default: "verify"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "invalidated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile_id", Type: field.TypeInt, Nullable: true},
 	}
 	// PhoneVerificationCodesTable holds the schema information for the "phone_verification_codes" table.
 	PhoneVerificationCodesTable = &schema.Table{
@@ -365,16 +726,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "phone_verification_codes_profiles_phone_verification_code",
-				Columns:    []*schema.Column{PhoneVerificationCodesColumns[4]},
+				Columns:    []*schema.Column{PhoneVerificationCodesColumns[8]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "phoneverificationcode_code_profile_id",
-				Unique:  true,
-				Columns: []*schema.Column{PhoneVerificationCodesColumns[3], PhoneVerificationCodesColumns[4]},
+				Name:    "phoneverificationcode_phone_number_e164_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PhoneVerificationCodesColumns[4], PhoneVerificationCodesColumns[1]},
 			},
 		},
 	}
@@ -414,6 +775,41 @@ var (
 			},
 		},
 	}
+	// PushDeliveriesColumns holds the columns for the "push_deliveries" table.
+	PushDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"push", "fcm_push"}},
+		{Name: "device", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "sent", "retrying", "failed", "pruned"}, Default: "queued"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile_id", Type: field.TypeInt},
+	}
+	// PushDeliveriesTable holds the schema information for the "push_deliveries" table.
+	PushDeliveriesTable = &schema.Table{
+		Name:       "push_deliveries",
+		Columns:    PushDeliveriesColumns,
+		PrimaryKey: []*schema.Column{PushDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "push_deliveries_profiles_push_deliveries",
+				Columns:    []*schema.Column{PushDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pushdelivery_profile_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PushDeliveriesColumns[10], PushDeliveriesColumns[1]},
+			},
+		},
+	}
 	// PwaPushSubscriptionsColumns holds the columns for the "pwa_push_subscriptions" table.
 	PwaPushSubscriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -445,6 +841,121 @@ var (
 			},
 		},
 	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"spam", "harassment", "inappropriate_content", "impersonation", "other"}},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"profile", "photo"}},
+		{Name: "target_id", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "dismissed", "photo_hidden", "user_suspended"}, Default: "pending"},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile_reports_sent", Type: field.TypeInt},
+		{Name: "profile_reports_received", Type: field.TypeInt},
+		{Name: "user_reports_resolved", Type: field.TypeInt, Nullable: true},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reports_profiles_reports_sent",
+				Columns:    []*schema.Column{ReportsColumns[9]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reports_profiles_reports_received",
+				Columns:    []*schema.Column{ReportsColumns[10]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reports_users_reports_resolved",
+				Columns:    []*schema.Column{ReportsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[7], ReportsColumns[1]},
+			},
+			{
+				Name:    "report_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[5], ReportsColumns[6]},
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
+	// SmsMessagesColumns holds the columns for the "sms_messages" table.
+	SmsMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "phone_number_e164", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "provider_message_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"sent", "delivered", "undelivered", "failed"}, Default: "sent"},
+		{Name: "error_code", Type: field.TypeString, Nullable: true},
+	}
+	// SmsMessagesTable holds the schema information for the "sms_messages" table.
+	SmsMessagesTable = &schema.Table{
+		Name:       "sms_messages",
+		Columns:    SmsMessagesColumns,
+		PrimaryKey: []*schema.Column{SmsMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "smsmessage_provider_provider_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{SmsMessagesColumns[4], SmsMessagesColumns[5]},
+			},
+		},
+	}
 	// SentEmailsColumns holds the columns for the "sent_emails" table.
 	SentEmailsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -467,6 +978,62 @@ var (
 			},
 		},
 	}
+	// ThrottleAttemptsColumns holds the columns for the "throttle_attempts" table.
+	ThrottleAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "attempted_at", Type: field.TypeTime},
+	}
+	// ThrottleAttemptsTable holds the schema information for the "throttle_attempts" table.
+	ThrottleAttemptsTable = &schema.Table{
+		Name:       "throttle_attempts",
+		Columns:    ThrottleAttemptsColumns,
+		PrimaryKey: []*schema.Column{ThrottleAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "throttleattempt_key_attempted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ThrottleAttemptsColumns[1], ThrottleAttemptsColumns[2]},
+			},
+		},
+	}
+	// ThrottleLocksColumns holds the columns for the "throttle_locks" table.
+	ThrottleLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "locked_until", Type: field.TypeTime},
+	}
+	// ThrottleLocksTable holds the schema information for the "throttle_locks" table.
+	ThrottleLocksTable = &schema.Table{
+		Name:       "throttle_locks",
+		Columns:    ThrottleLocksColumns,
+		PrimaryKey: []*schema.Column{ThrottleLocksColumns[0]},
+	}
+	// TotpSecretsColumns holds the columns for the "totp_secrets" table.
+	TotpSecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "encrypted_secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "user_id", Type: field.TypeInt, Unique: true},
+	}
+	// TotpSecretsTable holds the schema information for the "totp_secrets" table.
+	TotpSecretsTable = &schema.Table{
+		Name:       "totp_secrets",
+		Columns:    TotpSecretsColumns,
+		PrimaryKey: []*schema.Column{TotpSecretsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "totp_secrets_users_totp_secret",
+				Columns:    []*schema.Column{TotpSecretsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -477,6 +1044,8 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "verified", Type: field.TypeBool, Default: false},
 		{Name: "last_online", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -484,6 +1053,41 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserSessionsColumns holds the columns for the "user_sessions" table.
+	UserSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "browser", Type: field.TypeString, Default: ""},
+		{Name: "os", Type: field.TypeString, Default: ""},
+		{Name: "device", Type: field.TypeString, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserSessionsTable holds the schema information for the "user_sessions" table.
+	UserSessionsTable = &schema.Table{
+		Name:       "user_sessions",
+		Columns:    UserSessionsColumns,
+		PrimaryKey: []*schema.Column{UserSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_sessions_users_sessions",
+				Columns:    []*schema.Column{UserSessionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usersession_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserSessionsColumns[10]},
+			},
+		},
+	}
 	// EmailSubscriptionSubscriptionsColumns holds the columns for the "email_subscription_subscriptions" table.
 	EmailSubscriptionSubscriptionsColumns = []*schema.Column{
 		{Name: "email_subscription_id", Type: field.TypeInt},
@@ -559,50 +1163,142 @@ var (
 			},
 		},
 	}
+	// ProfileBlockedColumns holds the columns for the "profile_blocked" table.
+	ProfileBlockedColumns = []*schema.Column{
+		{Name: "profile_id", Type: field.TypeInt},
+		{Name: "blocked_by_id", Type: field.TypeInt},
+	}
+	// ProfileBlockedTable holds the schema information for the "profile_blocked" table.
+	ProfileBlockedTable = &schema.Table{
+		Name:       "profile_blocked",
+		Columns:    ProfileBlockedColumns,
+		PrimaryKey: []*schema.Column{ProfileBlockedColumns[0], ProfileBlockedColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "profile_blocked_profile_id",
+				Columns:    []*schema.Column{ProfileBlockedColumns[0]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "profile_blocked_blocked_by_id",
+				Columns:    []*schema.Column{ProfileBlockedColumns[1]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0], UserRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_roles_user_id",
+				Columns:    []*schema.Column{UserRolesColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_roles_role_id",
+				Columns:    []*schema.Column{UserRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
+		AuditLogsTable,
 		EmailSubscriptionsTable,
 		EmailSubscriptionTypesTable,
 		EmojisTable,
 		FcmSubscriptionsTable,
 		FileStoragesTable,
+		FriendRequestsTable,
+		IdentitiesTable,
 		ImagesTable,
 		ImageSizesTable,
+		ImpersonationsTable,
 		InvitationsTable,
 		LastSeenOnlinesTable,
+		MagicLinkTokensTable,
 		MonthlySubscriptionsTable,
 		NotificationsTable,
 		NotificationPermissionsTable,
 		NotificationTimesTable,
+		OrganizationsTable,
+		OrganizationInvitationsTable,
+		OrganizationMembershipsTable,
+		PasskeysTable,
 		PasswordTokensTable,
 		PhoneVerificationCodesTable,
 		ProfilesTable,
+		PushDeliveriesTable,
 		PwaPushSubscriptionsTable,
+		RecoveryCodesTable,
+		ReportsTable,
+		RolesTable,
+		SmsMessagesTable,
 		SentEmailsTable,
+		ThrottleAttemptsTable,
+		ThrottleLocksTable,
+		TotpSecretsTable,
 		UsersTable,
+		UserSessionsTable,
 		EmailSubscriptionSubscriptionsTable,
 		MonthlySubscriptionBenefactorsTable,
 		ProfileFriendsTable,
+		ProfileBlockedTable,
+		UserRolesTable,
 	}
 )
 
 func init() {
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
 	FcmSubscriptionsTable.ForeignKeys[0].RefTable = ProfilesTable
+	FriendRequestsTable.ForeignKeys[0].RefTable = ProfilesTable
+	FriendRequestsTable.ForeignKeys[1].RefTable = ProfilesTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	ImagesTable.ForeignKeys[0].RefTable = ProfilesTable
 	ImageSizesTable.ForeignKeys[0].RefTable = ImagesTable
 	ImageSizesTable.ForeignKeys[1].RefTable = FileStoragesTable
+	ImpersonationsTable.ForeignKeys[0].RefTable = UsersTable
+	ImpersonationsTable.ForeignKeys[1].RefTable = UsersTable
 	InvitationsTable.ForeignKeys[0].RefTable = ProfilesTable
+	InvitationsTable.ForeignKeys[1].RefTable = ProfilesTable
 	LastSeenOnlinesTable.ForeignKeys[0].RefTable = UsersTable
+	MagicLinkTokensTable.ForeignKeys[0].RefTable = UsersTable
 	MonthlySubscriptionsTable.ForeignKeys[0].RefTable = ProfilesTable
+	MonthlySubscriptionsTable.ForeignKeys[1].RefTable = OrganizationsTable
 	NotificationsTable.ForeignKeys[0].RefTable = ProfilesTable
 	NotificationPermissionsTable.ForeignKeys[0].RefTable = ProfilesTable
 	NotificationTimesTable.ForeignKeys[0].RefTable = ProfilesTable
+	OrganizationInvitationsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrganizationInvitationsTable.ForeignKeys[1].RefTable = ProfilesTable
+	OrganizationMembershipsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrganizationMembershipsTable.ForeignKeys[1].RefTable = ProfilesTable
+	PasskeysTable.ForeignKeys[0].RefTable = UsersTable
 	PasswordTokensTable.ForeignKeys[0].RefTable = UsersTable
 	PhoneVerificationCodesTable.ForeignKeys[0].RefTable = ProfilesTable
 	ProfilesTable.ForeignKeys[0].RefTable = ImagesTable
 	ProfilesTable.ForeignKeys[1].RefTable = UsersTable
+	PushDeliveriesTable.ForeignKeys[0].RefTable = ProfilesTable
 	PwaPushSubscriptionsTable.ForeignKeys[0].RefTable = ProfilesTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	ReportsTable.ForeignKeys[0].RefTable = ProfilesTable
+	ReportsTable.ForeignKeys[1].RefTable = ProfilesTable
+	ReportsTable.ForeignKeys[2].RefTable = UsersTable
 	SentEmailsTable.ForeignKeys[0].RefTable = ProfilesTable
+	TotpSecretsTable.ForeignKeys[0].RefTable = UsersTable
+	UserSessionsTable.ForeignKeys[0].RefTable = UsersTable
 	EmailSubscriptionSubscriptionsTable.ForeignKeys[0].RefTable = EmailSubscriptionsTable
 	EmailSubscriptionSubscriptionsTable.ForeignKeys[1].RefTable = EmailSubscriptionTypesTable
 	MonthlySubscriptionBenefactorsTable.ForeignKeys[0].RefTable = MonthlySubscriptionsTable
@@ -611,4 +1307,8 @@ func init() {
 	ProfileFriendsTable.ForeignKeys[0].RefTable = ProfilesTable
 	ProfileFriendsTable.ForeignKeys[1].RefTable = ProfilesTable
 	ProfileFriendsTable.Annotation = &entsql.Annotation{}
+	ProfileBlockedTable.ForeignKeys[0].RefTable = ProfilesTable
+	ProfileBlockedTable.ForeignKeys[1].RefTable = ProfilesTable
+	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organization"
 	"github.com/mikestefanello/pagoda/ent/profile"
 )
 
@@ -36,8 +37,10 @@ type MonthlySubscription struct {
 	ExpiredOn *time.Time `json:"expired_on,omitempty"`
 	// Cancelling is effective after current period ends.
 	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
-	// PayingProfileID holds the value of the "paying_profile_id" field.
-	PayingProfileID int `json:"paying_profile_id,omitempty"`
+	// Not set when the subscription is billed to an organization.
+	PayingProfileID *int `json:"paying_profile_id,omitempty"`
+	// Set when the subscription is billed to an organization rather than a single payer.
+	OrganizationID *int `json:"organization_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MonthlySubscriptionQuery when eager-loading is set.
 	Edges        MonthlySubscriptionEdges `json:"edges"`
@@ -50,9 +53,11 @@ type MonthlySubscriptionEdges struct {
 	Benefactors []*Profile `json:"benefactors,omitempty"`
 	// Who is paying for this subscription
 	Payer *Profile `json:"payer,omitempty"`
+	// Which organization is paying for this subscription
+	Organization *Organization `json:"organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // BenefactorsOrErr returns the Benefactors value or an error if the edge
@@ -75,6 +80,17 @@ func (e MonthlySubscriptionEdges) PayerOrErr() (*Profile, error) {
 	return nil, &NotLoadedError{edge: "payer"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MonthlySubscriptionEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*MonthlySubscription) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -82,7 +98,7 @@ func (*MonthlySubscription) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case monthlysubscription.FieldIsActive, monthlysubscription.FieldPaid, monthlysubscription.FieldIsTrial:
 			values[i] = new(sql.NullBool)
-		case monthlysubscription.FieldID, monthlysubscription.FieldPayingProfileID:
+		case monthlysubscription.FieldID, monthlysubscription.FieldPayingProfileID, monthlysubscription.FieldOrganizationID:
 			values[i] = new(sql.NullInt64)
 		case monthlysubscription.FieldProduct:
 			values[i] = new(sql.NullString)
@@ -170,7 +186,15 @@ func (ms *MonthlySubscription) assignValues(columns []string, values []any) erro
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field paying_profile_id", values[i])
 			} else if value.Valid {
-				ms.PayingProfileID = int(value.Int64)
+				ms.PayingProfileID = new(int)
+				*ms.PayingProfileID = int(value.Int64)
+			}
+		case monthlysubscription.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				ms.OrganizationID = new(int)
+				*ms.OrganizationID = int(value.Int64)
 			}
 		default:
 			ms.selectValues.Set(columns[i], values[i])
@@ -195,6 +219,11 @@ func (ms *MonthlySubscription) QueryPayer() *ProfileQuery {
 	return NewMonthlySubscriptionClient(ms.config).QueryPayer(ms)
 }
 
+// QueryOrganization queries the "organization" edge of the MonthlySubscription entity.
+func (ms *MonthlySubscription) QueryOrganization() *OrganizationQuery {
+	return NewMonthlySubscriptionClient(ms.config).QueryOrganization(ms)
+}
+
 // Update returns a builder for updating this MonthlySubscription.
 // Note that you need to call MonthlySubscription.Unwrap() before calling this method if this MonthlySubscription
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -251,8 +280,15 @@ func (ms *MonthlySubscription) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
-	builder.WriteString("paying_profile_id=")
-	builder.WriteString(fmt.Sprintf("%v", ms.PayingProfileID))
+	if v := ms.PayingProfileID; v != nil {
+		builder.WriteString("paying_profile_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ms.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
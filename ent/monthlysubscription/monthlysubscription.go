@@ -35,10 +35,14 @@ const (
 	FieldCancelledAt = "cancelled_at"
 	// FieldPayingProfileID holds the string denoting the paying_profile_id field in the database.
 	FieldPayingProfileID = "paying_profile_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
 	// EdgeBenefactors holds the string denoting the benefactors edge name in mutations.
 	EdgeBenefactors = "benefactors"
 	// EdgePayer holds the string denoting the payer edge name in mutations.
 	EdgePayer = "payer"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
 	// Table holds the table name of the monthlysubscription in the database.
 	Table = "monthly_subscriptions"
 	// BenefactorsTable is the table that holds the benefactors relation/edge. The primary key declared below.
@@ -53,6 +57,13 @@ const (
 	PayerInverseTable = "profiles"
 	// PayerColumn is the table column denoting the payer relation/edge.
 	PayerColumn = "paying_profile_id"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "monthly_subscriptions"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
 )
 
 // Columns holds all SQL columns for monthlysubscription fields.
@@ -68,6 +79,7 @@ var Columns = []string{
 	FieldExpiredOn,
 	FieldCancelledAt,
 	FieldPayingProfileID,
+	FieldOrganizationID,
 }
 
 var (
@@ -185,6 +197,11 @@ func ByPayingProfileID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPayingProfileID, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
 // ByBenefactorsCount orders the results by benefactors count.
 func ByBenefactorsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -205,6 +222,13 @@ func ByPayerField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPayerStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newBenefactorsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -219,3 +243,10 @@ func newPayerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, PayerTable, PayerColumn),
 	)
 }
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+	)
+}
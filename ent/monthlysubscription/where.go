@@ -100,6 +100,11 @@ func PayingProfileID(v int) predicate.MonthlySubscription {
 	return predicate.MonthlySubscription(sql.FieldEQ(FieldPayingProfileID, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.MonthlySubscription {
 	return predicate.MonthlySubscription(sql.FieldEQ(FieldCreatedAt, v))
@@ -400,6 +405,46 @@ func PayingProfileIDNotIn(vs ...int) predicate.MonthlySubscription {
 	return predicate.MonthlySubscription(sql.FieldNotIn(FieldPayingProfileID, vs...))
 }
 
+// PayingProfileIDIsNil applies the IsNil predicate on the "paying_profile_id" field.
+func PayingProfileIDIsNil() predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldIsNull(FieldPayingProfileID))
+}
+
+// PayingProfileIDNotNil applies the NotNil predicate on the "paying_profile_id" field.
+func PayingProfileIDNotNil() predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldNotNull(FieldPayingProfileID))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(sql.FieldNotNull(FieldOrganizationID))
+}
+
 // HasBenefactors applies the HasEdge predicate on the "benefactors" edge.
 func HasBenefactors() predicate.MonthlySubscription {
 	return predicate.MonthlySubscription(func(s *sql.Selector) {
@@ -446,6 +491,29 @@ func HasPayerWith(preds ...predicate.Profile) predicate.MonthlySubscription {
 	})
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.MonthlySubscription {
+	return predicate.MonthlySubscription(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.MonthlySubscription) predicate.MonthlySubscription {
 	return predicate.MonthlySubscription(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organization"
 	"github.com/mikestefanello/pagoda/ent/profile"
 )
 
@@ -155,6 +156,28 @@ func (msc *MonthlySubscriptionCreate) SetPayingProfileID(i int) *MonthlySubscrip
 	return msc
 }
 
+// SetNillablePayingProfileID sets the "paying_profile_id" field if the given value is not nil.
+func (msc *MonthlySubscriptionCreate) SetNillablePayingProfileID(i *int) *MonthlySubscriptionCreate {
+	if i != nil {
+		msc.SetPayingProfileID(*i)
+	}
+	return msc
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (msc *MonthlySubscriptionCreate) SetOrganizationID(i int) *MonthlySubscriptionCreate {
+	msc.mutation.SetOrganizationID(i)
+	return msc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (msc *MonthlySubscriptionCreate) SetNillableOrganizationID(i *int) *MonthlySubscriptionCreate {
+	if i != nil {
+		msc.SetOrganizationID(*i)
+	}
+	return msc
+}
+
 // AddBenefactorIDs adds the "benefactors" edge to the Profile entity by IDs.
 func (msc *MonthlySubscriptionCreate) AddBenefactorIDs(ids ...int) *MonthlySubscriptionCreate {
 	msc.mutation.AddBenefactorIDs(ids...)
@@ -176,11 +199,24 @@ func (msc *MonthlySubscriptionCreate) SetPayerID(id int) *MonthlySubscriptionCre
 	return msc
 }
 
+// SetNillablePayerID sets the "payer" edge to the Profile entity by ID if the given value is not nil.
+func (msc *MonthlySubscriptionCreate) SetNillablePayerID(id *int) *MonthlySubscriptionCreate {
+	if id != nil {
+		msc = msc.SetPayerID(*id)
+	}
+	return msc
+}
+
 // SetPayer sets the "payer" edge to the Profile entity.
 func (msc *MonthlySubscriptionCreate) SetPayer(p *Profile) *MonthlySubscriptionCreate {
 	return msc.SetPayerID(p.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (msc *MonthlySubscriptionCreate) SetOrganization(o *Organization) *MonthlySubscriptionCreate {
+	return msc.SetOrganizationID(o.ID)
+}
+
 // Mutation returns the MonthlySubscriptionMutation object of the builder.
 func (msc *MonthlySubscriptionCreate) Mutation() *MonthlySubscriptionMutation {
 	return msc.mutation
@@ -267,12 +303,6 @@ func (msc *MonthlySubscriptionCreate) check() error {
 	if _, ok := msc.mutation.IsTrial(); !ok {
 		return &ValidationError{Name: "is_trial", err: errors.New(`ent: missing required field "MonthlySubscription.is_trial"`)}
 	}
-	if _, ok := msc.mutation.PayingProfileID(); !ok {
-		return &ValidationError{Name: "paying_profile_id", err: errors.New(`ent: missing required field "MonthlySubscription.paying_profile_id"`)}
-	}
-	if len(msc.mutation.PayerIDs()) == 0 {
-		return &ValidationError{Name: "payer", err: errors.New(`ent: missing required edge "MonthlySubscription.payer"`)}
-	}
 	return nil
 }
 
@@ -366,7 +396,24 @@ func (msc *MonthlySubscriptionCreate) createSpec() (*MonthlySubscription, *sqlgr
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.PayingProfileID = nodes[0]
+		_node.PayingProfileID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := msc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   monthlysubscription.OrganizationTable,
+			Columns: []string{monthlysubscription.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -547,6 +594,30 @@ func (u *MonthlySubscriptionUpsert) UpdatePayingProfileID() *MonthlySubscription
 	return u
 }
 
+// ClearPayingProfileID clears the value of the "paying_profile_id" field.
+func (u *MonthlySubscriptionUpsert) ClearPayingProfileID() *MonthlySubscriptionUpsert {
+	u.SetNull(monthlysubscription.FieldPayingProfileID)
+	return u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *MonthlySubscriptionUpsert) SetOrganizationID(v int) *MonthlySubscriptionUpsert {
+	u.Set(monthlysubscription.FieldOrganizationID, v)
+	return u
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *MonthlySubscriptionUpsert) UpdateOrganizationID() *MonthlySubscriptionUpsert {
+	u.SetExcluded(monthlysubscription.FieldOrganizationID)
+	return u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *MonthlySubscriptionUpsert) ClearOrganizationID() *MonthlySubscriptionUpsert {
+	u.SetNull(monthlysubscription.FieldOrganizationID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -739,6 +810,34 @@ func (u *MonthlySubscriptionUpsertOne) UpdatePayingProfileID() *MonthlySubscript
 	})
 }
 
+// ClearPayingProfileID clears the value of the "paying_profile_id" field.
+func (u *MonthlySubscriptionUpsertOne) ClearPayingProfileID() *MonthlySubscriptionUpsertOne {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.ClearPayingProfileID()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *MonthlySubscriptionUpsertOne) SetOrganizationID(v int) *MonthlySubscriptionUpsertOne {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *MonthlySubscriptionUpsertOne) UpdateOrganizationID() *MonthlySubscriptionUpsertOne {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *MonthlySubscriptionUpsertOne) ClearOrganizationID() *MonthlySubscriptionUpsertOne {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
 // Exec executes the query.
 func (u *MonthlySubscriptionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1097,6 +1196,34 @@ func (u *MonthlySubscriptionUpsertBulk) UpdatePayingProfileID() *MonthlySubscrip
 	})
 }
 
+// ClearPayingProfileID clears the value of the "paying_profile_id" field.
+func (u *MonthlySubscriptionUpsertBulk) ClearPayingProfileID() *MonthlySubscriptionUpsertBulk {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.ClearPayingProfileID()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *MonthlySubscriptionUpsertBulk) SetOrganizationID(v int) *MonthlySubscriptionUpsertBulk {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *MonthlySubscriptionUpsertBulk) UpdateOrganizationID() *MonthlySubscriptionUpsertBulk {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *MonthlySubscriptionUpsertBulk) ClearOrganizationID() *MonthlySubscriptionUpsertBulk {
+	return u.Update(func(s *MonthlySubscriptionUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
 // Exec executes the query.
 func (u *MonthlySubscriptionUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
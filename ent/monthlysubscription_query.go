@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organization"
 	"github.com/mikestefanello/pagoda/ent/predicate"
 	"github.com/mikestefanello/pagoda/ent/profile"
 )
@@ -20,12 +21,13 @@ import (
 // MonthlySubscriptionQuery is the builder for querying MonthlySubscription entities.
 type MonthlySubscriptionQuery struct {
 	config
-	ctx             *QueryContext
-	order           []monthlysubscription.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.MonthlySubscription
-	withBenefactors *ProfileQuery
-	withPayer       *ProfileQuery
+	ctx              *QueryContext
+	order            []monthlysubscription.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.MonthlySubscription
+	withBenefactors  *ProfileQuery
+	withPayer        *ProfileQuery
+	withOrganization *OrganizationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (msq *MonthlySubscriptionQuery) QueryPayer() *ProfileQuery {
 	return query
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (msq *MonthlySubscriptionQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: msq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := msq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := msq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, monthlysubscription.OrganizationTable, monthlysubscription.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(msq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first MonthlySubscription entity from the query.
 // Returns a *NotFoundError when no MonthlySubscription was found.
 func (msq *MonthlySubscriptionQuery) First(ctx context.Context) (*MonthlySubscription, error) {
@@ -293,13 +317,14 @@ func (msq *MonthlySubscriptionQuery) Clone() *MonthlySubscriptionQuery {
 		return nil
 	}
 	return &MonthlySubscriptionQuery{
-		config:          msq.config,
-		ctx:             msq.ctx.Clone(),
-		order:           append([]monthlysubscription.OrderOption{}, msq.order...),
-		inters:          append([]Interceptor{}, msq.inters...),
-		predicates:      append([]predicate.MonthlySubscription{}, msq.predicates...),
-		withBenefactors: msq.withBenefactors.Clone(),
-		withPayer:       msq.withPayer.Clone(),
+		config:           msq.config,
+		ctx:              msq.ctx.Clone(),
+		order:            append([]monthlysubscription.OrderOption{}, msq.order...),
+		inters:           append([]Interceptor{}, msq.inters...),
+		predicates:       append([]predicate.MonthlySubscription{}, msq.predicates...),
+		withBenefactors:  msq.withBenefactors.Clone(),
+		withPayer:        msq.withPayer.Clone(),
+		withOrganization: msq.withOrganization.Clone(),
 		// clone intermediate query.
 		sql:  msq.sql.Clone(),
 		path: msq.path,
@@ -328,6 +353,17 @@ func (msq *MonthlySubscriptionQuery) WithPayer(opts ...func(*ProfileQuery)) *Mon
 	return msq
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (msq *MonthlySubscriptionQuery) WithOrganization(opts ...func(*OrganizationQuery)) *MonthlySubscriptionQuery {
+	query := (&OrganizationClient{config: msq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	msq.withOrganization = query
+	return msq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +442,10 @@ func (msq *MonthlySubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryH
 	var (
 		nodes       = []*MonthlySubscription{}
 		_spec       = msq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			msq.withBenefactors != nil,
 			msq.withPayer != nil,
+			msq.withOrganization != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -442,6 +479,12 @@ func (msq *MonthlySubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryH
 			return nil, err
 		}
 	}
+	if query := msq.withOrganization; query != nil {
+		if err := msq.loadOrganization(ctx, query, nodes, nil,
+			func(n *MonthlySubscription, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -510,7 +553,10 @@ func (msq *MonthlySubscriptionQuery) loadPayer(ctx context.Context, query *Profi
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*MonthlySubscription)
 	for i := range nodes {
-		fk := nodes[i].PayingProfileID
+		if nodes[i].PayingProfileID == nil {
+			continue
+		}
+		fk := *nodes[i].PayingProfileID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -535,6 +581,38 @@ func (msq *MonthlySubscriptionQuery) loadPayer(ctx context.Context, query *Profi
 	}
 	return nil
 }
+func (msq *MonthlySubscriptionQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*MonthlySubscription, init func(*MonthlySubscription), assign func(*MonthlySubscription, *Organization)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MonthlySubscription)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (msq *MonthlySubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := msq.querySpec()
@@ -564,6 +642,9 @@ func (msq *MonthlySubscriptionQuery) querySpec() *sqlgraph.QuerySpec {
 		if msq.withPayer != nil {
 			_spec.Node.AddColumnOnce(monthlysubscription.FieldPayingProfileID)
 		}
+		if msq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(monthlysubscription.FieldOrganizationID)
+		}
 	}
 	if ps := msq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
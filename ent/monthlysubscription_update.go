@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organization"
 	"github.com/mikestefanello/pagoda/ent/predicate"
 	"github.com/mikestefanello/pagoda/ent/profile"
 )
@@ -165,6 +166,32 @@ func (msu *MonthlySubscriptionUpdate) SetNillablePayingProfileID(i *int) *Monthl
 	return msu
 }
 
+// ClearPayingProfileID clears the value of the "paying_profile_id" field.
+func (msu *MonthlySubscriptionUpdate) ClearPayingProfileID() *MonthlySubscriptionUpdate {
+	msu.mutation.ClearPayingProfileID()
+	return msu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (msu *MonthlySubscriptionUpdate) SetOrganizationID(i int) *MonthlySubscriptionUpdate {
+	msu.mutation.SetOrganizationID(i)
+	return msu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (msu *MonthlySubscriptionUpdate) SetNillableOrganizationID(i *int) *MonthlySubscriptionUpdate {
+	if i != nil {
+		msu.SetOrganizationID(*i)
+	}
+	return msu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (msu *MonthlySubscriptionUpdate) ClearOrganizationID() *MonthlySubscriptionUpdate {
+	msu.mutation.ClearOrganizationID()
+	return msu
+}
+
 // AddBenefactorIDs adds the "benefactors" edge to the Profile entity by IDs.
 func (msu *MonthlySubscriptionUpdate) AddBenefactorIDs(ids ...int) *MonthlySubscriptionUpdate {
 	msu.mutation.AddBenefactorIDs(ids...)
@@ -186,11 +213,24 @@ func (msu *MonthlySubscriptionUpdate) SetPayerID(id int) *MonthlySubscriptionUpd
 	return msu
 }
 
+// SetNillablePayerID sets the "payer" edge to the Profile entity by ID if the given value is not nil.
+func (msu *MonthlySubscriptionUpdate) SetNillablePayerID(id *int) *MonthlySubscriptionUpdate {
+	if id != nil {
+		msu = msu.SetPayerID(*id)
+	}
+	return msu
+}
+
 // SetPayer sets the "payer" edge to the Profile entity.
 func (msu *MonthlySubscriptionUpdate) SetPayer(p *Profile) *MonthlySubscriptionUpdate {
 	return msu.SetPayerID(p.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (msu *MonthlySubscriptionUpdate) SetOrganization(o *Organization) *MonthlySubscriptionUpdate {
+	return msu.SetOrganizationID(o.ID)
+}
+
 // Mutation returns the MonthlySubscriptionMutation object of the builder.
 func (msu *MonthlySubscriptionUpdate) Mutation() *MonthlySubscriptionMutation {
 	return msu.mutation
@@ -223,6 +263,12 @@ func (msu *MonthlySubscriptionUpdate) ClearPayer() *MonthlySubscriptionUpdate {
 	return msu
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (msu *MonthlySubscriptionUpdate) ClearOrganization() *MonthlySubscriptionUpdate {
+	msu.mutation.ClearOrganization()
+	return msu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (msu *MonthlySubscriptionUpdate) Save(ctx context.Context) (int, error) {
 	msu.defaults()
@@ -266,9 +312,6 @@ func (msu *MonthlySubscriptionUpdate) check() error {
 			return &ValidationError{Name: "product", err: fmt.Errorf(`ent: validator failed for field "MonthlySubscription.product": %w`, err)}
 		}
 	}
-	if msu.mutation.PayerCleared() && len(msu.mutation.PayerIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "MonthlySubscription.payer"`)
-	}
 	return nil
 }
 
@@ -391,6 +434,35 @@ func (msu *MonthlySubscriptionUpdate) sqlSave(ctx context.Context) (n int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if msu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   monthlysubscription.OrganizationTable,
+			Columns: []string{monthlysubscription.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   monthlysubscription.OrganizationTable,
+			Columns: []string{monthlysubscription.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, msu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{monthlysubscription.Label}
@@ -547,6 +619,32 @@ func (msuo *MonthlySubscriptionUpdateOne) SetNillablePayingProfileID(i *int) *Mo
 	return msuo
 }
 
+// ClearPayingProfileID clears the value of the "paying_profile_id" field.
+func (msuo *MonthlySubscriptionUpdateOne) ClearPayingProfileID() *MonthlySubscriptionUpdateOne {
+	msuo.mutation.ClearPayingProfileID()
+	return msuo
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (msuo *MonthlySubscriptionUpdateOne) SetOrganizationID(i int) *MonthlySubscriptionUpdateOne {
+	msuo.mutation.SetOrganizationID(i)
+	return msuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (msuo *MonthlySubscriptionUpdateOne) SetNillableOrganizationID(i *int) *MonthlySubscriptionUpdateOne {
+	if i != nil {
+		msuo.SetOrganizationID(*i)
+	}
+	return msuo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (msuo *MonthlySubscriptionUpdateOne) ClearOrganizationID() *MonthlySubscriptionUpdateOne {
+	msuo.mutation.ClearOrganizationID()
+	return msuo
+}
+
 // AddBenefactorIDs adds the "benefactors" edge to the Profile entity by IDs.
 func (msuo *MonthlySubscriptionUpdateOne) AddBenefactorIDs(ids ...int) *MonthlySubscriptionUpdateOne {
 	msuo.mutation.AddBenefactorIDs(ids...)
@@ -568,11 +666,24 @@ func (msuo *MonthlySubscriptionUpdateOne) SetPayerID(id int) *MonthlySubscriptio
 	return msuo
 }
 
+// SetNillablePayerID sets the "payer" edge to the Profile entity by ID if the given value is not nil.
+func (msuo *MonthlySubscriptionUpdateOne) SetNillablePayerID(id *int) *MonthlySubscriptionUpdateOne {
+	if id != nil {
+		msuo = msuo.SetPayerID(*id)
+	}
+	return msuo
+}
+
 // SetPayer sets the "payer" edge to the Profile entity.
 func (msuo *MonthlySubscriptionUpdateOne) SetPayer(p *Profile) *MonthlySubscriptionUpdateOne {
 	return msuo.SetPayerID(p.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (msuo *MonthlySubscriptionUpdateOne) SetOrganization(o *Organization) *MonthlySubscriptionUpdateOne {
+	return msuo.SetOrganizationID(o.ID)
+}
+
 // Mutation returns the MonthlySubscriptionMutation object of the builder.
 func (msuo *MonthlySubscriptionUpdateOne) Mutation() *MonthlySubscriptionMutation {
 	return msuo.mutation
@@ -605,6 +716,12 @@ func (msuo *MonthlySubscriptionUpdateOne) ClearPayer() *MonthlySubscriptionUpdat
 	return msuo
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (msuo *MonthlySubscriptionUpdateOne) ClearOrganization() *MonthlySubscriptionUpdateOne {
+	msuo.mutation.ClearOrganization()
+	return msuo
+}
+
 // Where appends a list predicates to the MonthlySubscriptionUpdate builder.
 func (msuo *MonthlySubscriptionUpdateOne) Where(ps ...predicate.MonthlySubscription) *MonthlySubscriptionUpdateOne {
 	msuo.mutation.Where(ps...)
@@ -661,9 +778,6 @@ func (msuo *MonthlySubscriptionUpdateOne) check() error {
 			return &ValidationError{Name: "product", err: fmt.Errorf(`ent: validator failed for field "MonthlySubscription.product": %w`, err)}
 		}
 	}
-	if msuo.mutation.PayerCleared() && len(msuo.mutation.PayerIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "MonthlySubscription.payer"`)
-	}
 	return nil
 }
 
@@ -803,6 +917,35 @@ func (msuo *MonthlySubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *M
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if msuo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   monthlysubscription.OrganizationTable,
+			Columns: []string{monthlysubscription.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msuo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   monthlysubscription.OrganizationTable,
+			Columns: []string{monthlysubscription.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &MonthlySubscription{config: msuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
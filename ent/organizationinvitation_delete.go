@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/organizationinvitation"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// OrganizationInvitationDelete is the builder for deleting a OrganizationInvitation entity.
+type OrganizationInvitationDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationInvitationMutation
+}
+
+// Where appends a list predicates to the OrganizationInvitationDelete builder.
+func (oid *OrganizationInvitationDelete) Where(ps ...predicate.OrganizationInvitation) *OrganizationInvitationDelete {
+	oid.mutation.Where(ps...)
+	return oid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (oid *OrganizationInvitationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, oid.sqlExec, oid.mutation, oid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oid *OrganizationInvitationDelete) ExecX(ctx context.Context) int {
+	n, err := oid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (oid *OrganizationInvitationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(organizationinvitation.Table, sqlgraph.NewFieldSpec(organizationinvitation.FieldID, field.TypeInt))
+	if ps := oid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, oid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	oid.mutation.done = true
+	return affected, err
+}
+
+// OrganizationInvitationDeleteOne is the builder for deleting a single OrganizationInvitation entity.
+type OrganizationInvitationDeleteOne struct {
+	oid *OrganizationInvitationDelete
+}
+
+// Where appends a list predicates to the OrganizationInvitationDelete builder.
+func (oido *OrganizationInvitationDeleteOne) Where(ps ...predicate.OrganizationInvitation) *OrganizationInvitationDeleteOne {
+	oido.oid.mutation.Where(ps...)
+	return oido
+}
+
+// Exec executes the deletion query.
+func (oido *OrganizationInvitationDeleteOne) Exec(ctx context.Context) error {
+	n, err := oido.oid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{organizationinvitation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oido *OrganizationInvitationDeleteOne) ExecX(ctx context.Context) {
+	if err := oido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// OrganizationMembershipDelete is the builder for deleting a OrganizationMembership entity.
+type OrganizationMembershipDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationMembershipMutation
+}
+
+// Where appends a list predicates to the OrganizationMembershipDelete builder.
+func (omd *OrganizationMembershipDelete) Where(ps ...predicate.OrganizationMembership) *OrganizationMembershipDelete {
+	omd.mutation.Where(ps...)
+	return omd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (omd *OrganizationMembershipDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, omd.sqlExec, omd.mutation, omd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omd *OrganizationMembershipDelete) ExecX(ctx context.Context) int {
+	n, err := omd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (omd *OrganizationMembershipDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(organizationmembership.Table, sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt))
+	if ps := omd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, omd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	omd.mutation.done = true
+	return affected, err
+}
+
+// OrganizationMembershipDeleteOne is the builder for deleting a single OrganizationMembership entity.
+type OrganizationMembershipDeleteOne struct {
+	omd *OrganizationMembershipDelete
+}
+
+// Where appends a list predicates to the OrganizationMembershipDelete builder.
+func (omdo *OrganizationMembershipDeleteOne) Where(ps ...predicate.OrganizationMembership) *OrganizationMembershipDeleteOne {
+	omdo.omd.mutation.Where(ps...)
+	return omdo
+}
+
+// Exec executes the deletion query.
+func (omdo *OrganizationMembershipDeleteOne) Exec(ctx context.Context) error {
+	n, err := omdo.omd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{organizationmembership.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omdo *OrganizationMembershipDeleteOne) ExecX(ctx context.Context) {
+	if err := omdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -23,9 +23,17 @@ type PhoneVerificationCode struct {
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// The verification code
-	Code string `json:"code,omitempty"`
-	// ProfileID holds the value of the "profile_id" field.
-	ProfileID int `json:"profile_id,omitempty"`
+	Code string `json:"-"`
+	// The profile verifying its phone number, unset for codes signing in or registering with one
+	ProfileID *int `json:"profile_id,omitempty"`
+	// Phone number the code was sent to, in E164 format
+	PhoneNumberE164 string `json:"phone_number_e164,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose phoneverificationcode.Purpose `json:"purpose,omitempty"`
+	// Number of times the code was checked
+	Attempts int `json:"attempts,omitempty"`
+	// When the code was used, replaced by a newer one or ran out of attempts
+	InvalidatedAt *time.Time `json:"invalidated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PhoneVerificationCodeQuery when eager-loading is set.
 	Edges        PhoneVerificationCodeEdges `json:"edges"`
@@ -57,11 +65,11 @@ func (*PhoneVerificationCode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case phoneverificationcode.FieldID, phoneverificationcode.FieldProfileID:
+		case phoneverificationcode.FieldID, phoneverificationcode.FieldProfileID, phoneverificationcode.FieldAttempts:
 			values[i] = new(sql.NullInt64)
-		case phoneverificationcode.FieldCode:
+		case phoneverificationcode.FieldCode, phoneverificationcode.FieldPhoneNumberE164, phoneverificationcode.FieldPurpose:
 			values[i] = new(sql.NullString)
-		case phoneverificationcode.FieldCreatedAt, phoneverificationcode.FieldUpdatedAt:
+		case phoneverificationcode.FieldCreatedAt, phoneverificationcode.FieldUpdatedAt, phoneverificationcode.FieldInvalidatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -106,7 +114,33 @@ func (pvc *PhoneVerificationCode) assignValues(columns []string, values []any) e
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field profile_id", values[i])
 			} else if value.Valid {
-				pvc.ProfileID = int(value.Int64)
+				pvc.ProfileID = new(int)
+				*pvc.ProfileID = int(value.Int64)
+			}
+		case phoneverificationcode.FieldPhoneNumberE164:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone_number_e164", values[i])
+			} else if value.Valid {
+				pvc.PhoneNumberE164 = value.String
+			}
+		case phoneverificationcode.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				pvc.Purpose = phoneverificationcode.Purpose(value.String)
+			}
+		case phoneverificationcode.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				pvc.Attempts = int(value.Int64)
+			}
+		case phoneverificationcode.FieldInvalidatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field invalidated_at", values[i])
+			} else if value.Valid {
+				pvc.InvalidatedAt = new(time.Time)
+				*pvc.InvalidatedAt = value.Time
 			}
 		default:
 			pvc.selectValues.Set(columns[i], values[i])
@@ -155,11 +189,26 @@ func (pvc *PhoneVerificationCode) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(pvc.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	builder.WriteString("code=")
-	builder.WriteString(pvc.Code)
+	builder.WriteString("code=<sensitive>")
+	builder.WriteString(", ")
+	if v := pvc.ProfileID; v != nil {
+		builder.WriteString("profile_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
-	builder.WriteString("profile_id=")
-	builder.WriteString(fmt.Sprintf("%v", pvc.ProfileID))
+	builder.WriteString("phone_number_e164=")
+	builder.WriteString(pvc.PhoneNumberE164)
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", pvc.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pvc.Attempts))
+	builder.WriteString(", ")
+	if v := pvc.InvalidatedAt; v != nil {
+		builder.WriteString("invalidated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package phoneverificationcode
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,6 +23,14 @@ const (
 	FieldCode = "code"
 	// FieldProfileID holds the string denoting the profile_id field in the database.
 	FieldProfileID = "profile_id"
+	// FieldPhoneNumberE164 holds the string denoting the phone_number_e164 field in the database.
+	FieldPhoneNumberE164 = "phone_number_e164"
+	// FieldPurpose holds the string denoting the purpose field in the database.
+	FieldPurpose = "purpose"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldInvalidatedAt holds the string denoting the invalidated_at field in the database.
+	FieldInvalidatedAt = "invalidated_at"
 	// EdgeProfile holds the string denoting the profile edge name in mutations.
 	EdgeProfile = "profile"
 	// Table holds the table name of the phoneverificationcode in the database.
@@ -42,6 +51,10 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldCode,
 	FieldProfileID,
+	FieldPhoneNumberE164,
+	FieldPurpose,
+	FieldAttempts,
+	FieldInvalidatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -61,8 +74,36 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
 )
 
+// Purpose defines the type for the "purpose" enum field.
+type Purpose string
+
+// PurposeVerify is the default value of the Purpose enum.
+const DefaultPurpose = PurposeVerify
+
+// Purpose values.
+const (
+	PurposeVerify Purpose = "verify"
+	PurposeLogin  Purpose = "login"
+)
+
+func (pu Purpose) String() string {
+	return string(pu)
+}
+
+// PurposeValidator is a validator for the "purpose" field enum values. It is called by the builders before save.
+func PurposeValidator(pu Purpose) error {
+	switch pu {
+	case PurposeVerify, PurposeLogin:
+		return nil
+	default:
+		return fmt.Errorf("phoneverificationcode: invalid enum value for purpose field: %q", pu)
+	}
+}
+
 // OrderOption defines the ordering options for the PhoneVerificationCode queries.
 type OrderOption func(*sql.Selector)
 
@@ -91,6 +132,26 @@ func ByProfileID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProfileID, opts...).ToFunc()
 }
 
+// ByPhoneNumberE164 orders the results by the phone_number_e164 field.
+func ByPhoneNumberE164(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneNumberE164, opts...).ToFunc()
+}
+
+// ByPurpose orders the results by the purpose field.
+func ByPurpose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurpose, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByInvalidatedAt orders the results by the invalidated_at field.
+func ByInvalidatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvalidatedAt, opts...).ToFunc()
+}
+
 // ByProfileField orders the results by profile field.
 func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
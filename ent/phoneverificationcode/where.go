@@ -75,6 +75,21 @@ func ProfileID(v int) predicate.PhoneVerificationCode {
 	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldProfileID, v))
 }
 
+// PhoneNumberE164 applies equality check predicate on the "phone_number_e164" field. It's identical to PhoneNumberE164EQ.
+func PhoneNumberE164(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldPhoneNumberE164, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldAttempts, v))
+}
+
+// InvalidatedAt applies equality check predicate on the "invalidated_at" field. It's identical to InvalidatedAtEQ.
+func InvalidatedAt(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldInvalidatedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PhoneVerificationCode {
 	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldCreatedAt, v))
@@ -240,6 +255,201 @@ func ProfileIDNotIn(vs ...int) predicate.PhoneVerificationCode {
 	return predicate.PhoneVerificationCode(sql.FieldNotIn(FieldProfileID, vs...))
 }
 
+// ProfileIDIsNil applies the IsNil predicate on the "profile_id" field.
+func ProfileIDIsNil() predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIsNull(FieldProfileID))
+}
+
+// ProfileIDNotNil applies the NotNil predicate on the "profile_id" field.
+func ProfileIDNotNil() predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotNull(FieldProfileID))
+}
+
+// PhoneNumberE164EQ applies the EQ predicate on the "phone_number_e164" field.
+func PhoneNumberE164EQ(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164NEQ applies the NEQ predicate on the "phone_number_e164" field.
+func PhoneNumberE164NEQ(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNEQ(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164In applies the In predicate on the "phone_number_e164" field.
+func PhoneNumberE164In(vs ...string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIn(FieldPhoneNumberE164, vs...))
+}
+
+// PhoneNumberE164NotIn applies the NotIn predicate on the "phone_number_e164" field.
+func PhoneNumberE164NotIn(vs ...string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotIn(FieldPhoneNumberE164, vs...))
+}
+
+// PhoneNumberE164GT applies the GT predicate on the "phone_number_e164" field.
+func PhoneNumberE164GT(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldGT(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164GTE applies the GTE predicate on the "phone_number_e164" field.
+func PhoneNumberE164GTE(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldGTE(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164LT applies the LT predicate on the "phone_number_e164" field.
+func PhoneNumberE164LT(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldLT(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164LTE applies the LTE predicate on the "phone_number_e164" field.
+func PhoneNumberE164LTE(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldLTE(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164Contains applies the Contains predicate on the "phone_number_e164" field.
+func PhoneNumberE164Contains(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldContains(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164HasPrefix applies the HasPrefix predicate on the "phone_number_e164" field.
+func PhoneNumberE164HasPrefix(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldHasPrefix(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164HasSuffix applies the HasSuffix predicate on the "phone_number_e164" field.
+func PhoneNumberE164HasSuffix(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldHasSuffix(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164IsNil applies the IsNil predicate on the "phone_number_e164" field.
+func PhoneNumberE164IsNil() predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIsNull(FieldPhoneNumberE164))
+}
+
+// PhoneNumberE164NotNil applies the NotNil predicate on the "phone_number_e164" field.
+func PhoneNumberE164NotNil() predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotNull(FieldPhoneNumberE164))
+}
+
+// PhoneNumberE164EqualFold applies the EqualFold predicate on the "phone_number_e164" field.
+func PhoneNumberE164EqualFold(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEqualFold(FieldPhoneNumberE164, v))
+}
+
+// PhoneNumberE164ContainsFold applies the ContainsFold predicate on the "phone_number_e164" field.
+func PhoneNumberE164ContainsFold(v string) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldContainsFold(FieldPhoneNumberE164, v))
+}
+
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v Purpose) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v Purpose) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...Purpose) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...Purpose) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldLTE(FieldAttempts, v))
+}
+
+// InvalidatedAtEQ applies the EQ predicate on the "invalidated_at" field.
+func InvalidatedAtEQ(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldEQ(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtNEQ applies the NEQ predicate on the "invalidated_at" field.
+func InvalidatedAtNEQ(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNEQ(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtIn applies the In predicate on the "invalidated_at" field.
+func InvalidatedAtIn(vs ...time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIn(FieldInvalidatedAt, vs...))
+}
+
+// InvalidatedAtNotIn applies the NotIn predicate on the "invalidated_at" field.
+func InvalidatedAtNotIn(vs ...time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotIn(FieldInvalidatedAt, vs...))
+}
+
+// InvalidatedAtGT applies the GT predicate on the "invalidated_at" field.
+func InvalidatedAtGT(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldGT(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtGTE applies the GTE predicate on the "invalidated_at" field.
+func InvalidatedAtGTE(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldGTE(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtLT applies the LT predicate on the "invalidated_at" field.
+func InvalidatedAtLT(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldLT(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtLTE applies the LTE predicate on the "invalidated_at" field.
+func InvalidatedAtLTE(v time.Time) predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldLTE(FieldInvalidatedAt, v))
+}
+
+// InvalidatedAtIsNil applies the IsNil predicate on the "invalidated_at" field.
+func InvalidatedAtIsNil() predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldIsNull(FieldInvalidatedAt))
+}
+
+// InvalidatedAtNotNil applies the NotNil predicate on the "invalidated_at" field.
+func InvalidatedAtNotNil() predicate.PhoneVerificationCode {
+	return predicate.PhoneVerificationCode(sql.FieldNotNull(FieldInvalidatedAt))
+}
+
 // HasProfile applies the HasEdge predicate on the "profile" edge.
 func HasProfile() predicate.PhoneVerificationCode {
 	return predicate.PhoneVerificationCode(func(s *sql.Selector) {
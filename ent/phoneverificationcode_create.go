@@ -63,6 +63,70 @@ func (pvcc *PhoneVerificationCodeCreate) SetProfileID(i int) *PhoneVerificationC
 	return pvcc
 }
 
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (pvcc *PhoneVerificationCodeCreate) SetNillableProfileID(i *int) *PhoneVerificationCodeCreate {
+	if i != nil {
+		pvcc.SetProfileID(*i)
+	}
+	return pvcc
+}
+
+// SetPhoneNumberE164 sets the "phone_number_e164" field.
+func (pvcc *PhoneVerificationCodeCreate) SetPhoneNumberE164(s string) *PhoneVerificationCodeCreate {
+	pvcc.mutation.SetPhoneNumberE164(s)
+	return pvcc
+}
+
+// SetNillablePhoneNumberE164 sets the "phone_number_e164" field if the given value is not nil.
+func (pvcc *PhoneVerificationCodeCreate) SetNillablePhoneNumberE164(s *string) *PhoneVerificationCodeCreate {
+	if s != nil {
+		pvcc.SetPhoneNumberE164(*s)
+	}
+	return pvcc
+}
+
+// SetPurpose sets the "purpose" field.
+func (pvcc *PhoneVerificationCodeCreate) SetPurpose(ph phoneverificationcode.Purpose) *PhoneVerificationCodeCreate {
+	pvcc.mutation.SetPurpose(ph)
+	return pvcc
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (pvcc *PhoneVerificationCodeCreate) SetNillablePurpose(ph *phoneverificationcode.Purpose) *PhoneVerificationCodeCreate {
+	if ph != nil {
+		pvcc.SetPurpose(*ph)
+	}
+	return pvcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (pvcc *PhoneVerificationCodeCreate) SetAttempts(i int) *PhoneVerificationCodeCreate {
+	pvcc.mutation.SetAttempts(i)
+	return pvcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pvcc *PhoneVerificationCodeCreate) SetNillableAttempts(i *int) *PhoneVerificationCodeCreate {
+	if i != nil {
+		pvcc.SetAttempts(*i)
+	}
+	return pvcc
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (pvcc *PhoneVerificationCodeCreate) SetInvalidatedAt(t time.Time) *PhoneVerificationCodeCreate {
+	pvcc.mutation.SetInvalidatedAt(t)
+	return pvcc
+}
+
+// SetNillableInvalidatedAt sets the "invalidated_at" field if the given value is not nil.
+func (pvcc *PhoneVerificationCodeCreate) SetNillableInvalidatedAt(t *time.Time) *PhoneVerificationCodeCreate {
+	if t != nil {
+		pvcc.SetInvalidatedAt(*t)
+	}
+	return pvcc
+}
+
 // SetProfile sets the "profile" edge to the Profile entity.
 func (pvcc *PhoneVerificationCodeCreate) SetProfile(p *Profile) *PhoneVerificationCodeCreate {
 	return pvcc.SetProfileID(p.ID)
@@ -111,6 +175,14 @@ func (pvcc *PhoneVerificationCodeCreate) defaults() {
 		v := phoneverificationcode.DefaultUpdatedAt()
 		pvcc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pvcc.mutation.Purpose(); !ok {
+		v := phoneverificationcode.DefaultPurpose
+		pvcc.mutation.SetPurpose(v)
+	}
+	if _, ok := pvcc.mutation.Attempts(); !ok {
+		v := phoneverificationcode.DefaultAttempts
+		pvcc.mutation.SetAttempts(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -124,11 +196,16 @@ func (pvcc *PhoneVerificationCodeCreate) check() error {
 	if _, ok := pvcc.mutation.Code(); !ok {
 		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "PhoneVerificationCode.code"`)}
 	}
-	if _, ok := pvcc.mutation.ProfileID(); !ok {
-		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "PhoneVerificationCode.profile_id"`)}
+	if _, ok := pvcc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "PhoneVerificationCode.purpose"`)}
+	}
+	if v, ok := pvcc.mutation.Purpose(); ok {
+		if err := phoneverificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "PhoneVerificationCode.purpose": %w`, err)}
+		}
 	}
-	if len(pvcc.mutation.ProfileIDs()) == 0 {
-		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "PhoneVerificationCode.profile"`)}
+	if _, ok := pvcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "PhoneVerificationCode.attempts"`)}
 	}
 	return nil
 }
@@ -169,6 +246,22 @@ func (pvcc *PhoneVerificationCodeCreate) createSpec() (*PhoneVerificationCode, *
 		_spec.SetField(phoneverificationcode.FieldCode, field.TypeString, value)
 		_node.Code = value
 	}
+	if value, ok := pvcc.mutation.PhoneNumberE164(); ok {
+		_spec.SetField(phoneverificationcode.FieldPhoneNumberE164, field.TypeString, value)
+		_node.PhoneNumberE164 = value
+	}
+	if value, ok := pvcc.mutation.Purpose(); ok {
+		_spec.SetField(phoneverificationcode.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := pvcc.mutation.Attempts(); ok {
+		_spec.SetField(phoneverificationcode.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := pvcc.mutation.InvalidatedAt(); ok {
+		_spec.SetField(phoneverificationcode.FieldInvalidatedAt, field.TypeTime, value)
+		_node.InvalidatedAt = &value
+	}
 	if nodes := pvcc.mutation.ProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -183,7 +276,7 @@ func (pvcc *PhoneVerificationCodeCreate) createSpec() (*PhoneVerificationCode, *
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.ProfileID = nodes[0]
+		_node.ProfileID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -274,6 +367,78 @@ func (u *PhoneVerificationCodeUpsert) UpdateProfileID() *PhoneVerificationCodeUp
 	return u
 }
 
+// ClearProfileID clears the value of the "profile_id" field.
+func (u *PhoneVerificationCodeUpsert) ClearProfileID() *PhoneVerificationCodeUpsert {
+	u.SetNull(phoneverificationcode.FieldProfileID)
+	return u
+}
+
+// SetPhoneNumberE164 sets the "phone_number_e164" field.
+func (u *PhoneVerificationCodeUpsert) SetPhoneNumberE164(v string) *PhoneVerificationCodeUpsert {
+	u.Set(phoneverificationcode.FieldPhoneNumberE164, v)
+	return u
+}
+
+// UpdatePhoneNumberE164 sets the "phone_number_e164" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsert) UpdatePhoneNumberE164() *PhoneVerificationCodeUpsert {
+	u.SetExcluded(phoneverificationcode.FieldPhoneNumberE164)
+	return u
+}
+
+// ClearPhoneNumberE164 clears the value of the "phone_number_e164" field.
+func (u *PhoneVerificationCodeUpsert) ClearPhoneNumberE164() *PhoneVerificationCodeUpsert {
+	u.SetNull(phoneverificationcode.FieldPhoneNumberE164)
+	return u
+}
+
+// SetPurpose sets the "purpose" field.
+func (u *PhoneVerificationCodeUpsert) SetPurpose(v phoneverificationcode.Purpose) *PhoneVerificationCodeUpsert {
+	u.Set(phoneverificationcode.FieldPurpose, v)
+	return u
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsert) UpdatePurpose() *PhoneVerificationCodeUpsert {
+	u.SetExcluded(phoneverificationcode.FieldPurpose)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PhoneVerificationCodeUpsert) SetAttempts(v int) *PhoneVerificationCodeUpsert {
+	u.Set(phoneverificationcode.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsert) UpdateAttempts() *PhoneVerificationCodeUpsert {
+	u.SetExcluded(phoneverificationcode.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PhoneVerificationCodeUpsert) AddAttempts(v int) *PhoneVerificationCodeUpsert {
+	u.Add(phoneverificationcode.FieldAttempts, v)
+	return u
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (u *PhoneVerificationCodeUpsert) SetInvalidatedAt(v time.Time) *PhoneVerificationCodeUpsert {
+	u.Set(phoneverificationcode.FieldInvalidatedAt, v)
+	return u
+}
+
+// UpdateInvalidatedAt sets the "invalidated_at" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsert) UpdateInvalidatedAt() *PhoneVerificationCodeUpsert {
+	u.SetExcluded(phoneverificationcode.FieldInvalidatedAt)
+	return u
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (u *PhoneVerificationCodeUpsert) ClearInvalidatedAt() *PhoneVerificationCodeUpsert {
+	u.SetNull(phoneverificationcode.FieldInvalidatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -361,6 +526,90 @@ func (u *PhoneVerificationCodeUpsertOne) UpdateProfileID() *PhoneVerificationCod
 	})
 }
 
+// ClearProfileID clears the value of the "profile_id" field.
+func (u *PhoneVerificationCodeUpsertOne) ClearProfileID() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.ClearProfileID()
+	})
+}
+
+// SetPhoneNumberE164 sets the "phone_number_e164" field.
+func (u *PhoneVerificationCodeUpsertOne) SetPhoneNumberE164(v string) *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetPhoneNumberE164(v)
+	})
+}
+
+// UpdatePhoneNumberE164 sets the "phone_number_e164" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertOne) UpdatePhoneNumberE164() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdatePhoneNumberE164()
+	})
+}
+
+// ClearPhoneNumberE164 clears the value of the "phone_number_e164" field.
+func (u *PhoneVerificationCodeUpsertOne) ClearPhoneNumberE164() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.ClearPhoneNumberE164()
+	})
+}
+
+// SetPurpose sets the "purpose" field.
+func (u *PhoneVerificationCodeUpsertOne) SetPurpose(v phoneverificationcode.Purpose) *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetPurpose(v)
+	})
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertOne) UpdatePurpose() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdatePurpose()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PhoneVerificationCodeUpsertOne) SetAttempts(v int) *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PhoneVerificationCodeUpsertOne) AddAttempts(v int) *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertOne) UpdateAttempts() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (u *PhoneVerificationCodeUpsertOne) SetInvalidatedAt(v time.Time) *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetInvalidatedAt(v)
+	})
+}
+
+// UpdateInvalidatedAt sets the "invalidated_at" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertOne) UpdateInvalidatedAt() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdateInvalidatedAt()
+	})
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (u *PhoneVerificationCodeUpsertOne) ClearInvalidatedAt() *PhoneVerificationCodeUpsertOne {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.ClearInvalidatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *PhoneVerificationCodeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -614,6 +863,90 @@ func (u *PhoneVerificationCodeUpsertBulk) UpdateProfileID() *PhoneVerificationCo
 	})
 }
 
+// ClearProfileID clears the value of the "profile_id" field.
+func (u *PhoneVerificationCodeUpsertBulk) ClearProfileID() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.ClearProfileID()
+	})
+}
+
+// SetPhoneNumberE164 sets the "phone_number_e164" field.
+func (u *PhoneVerificationCodeUpsertBulk) SetPhoneNumberE164(v string) *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetPhoneNumberE164(v)
+	})
+}
+
+// UpdatePhoneNumberE164 sets the "phone_number_e164" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertBulk) UpdatePhoneNumberE164() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdatePhoneNumberE164()
+	})
+}
+
+// ClearPhoneNumberE164 clears the value of the "phone_number_e164" field.
+func (u *PhoneVerificationCodeUpsertBulk) ClearPhoneNumberE164() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.ClearPhoneNumberE164()
+	})
+}
+
+// SetPurpose sets the "purpose" field.
+func (u *PhoneVerificationCodeUpsertBulk) SetPurpose(v phoneverificationcode.Purpose) *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetPurpose(v)
+	})
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertBulk) UpdatePurpose() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdatePurpose()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PhoneVerificationCodeUpsertBulk) SetAttempts(v int) *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PhoneVerificationCodeUpsertBulk) AddAttempts(v int) *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertBulk) UpdateAttempts() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (u *PhoneVerificationCodeUpsertBulk) SetInvalidatedAt(v time.Time) *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.SetInvalidatedAt(v)
+	})
+}
+
+// UpdateInvalidatedAt sets the "invalidated_at" field to the value that was provided on create.
+func (u *PhoneVerificationCodeUpsertBulk) UpdateInvalidatedAt() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.UpdateInvalidatedAt()
+	})
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (u *PhoneVerificationCodeUpsertBulk) ClearInvalidatedAt() *PhoneVerificationCodeUpsertBulk {
+	return u.Update(func(s *PhoneVerificationCodeUpsert) {
+		s.ClearInvalidatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *PhoneVerificationCodeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
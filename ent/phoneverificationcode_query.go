@@ -405,7 +405,10 @@ func (pvcq *PhoneVerificationCodeQuery) loadProfile(ctx context.Context, query *
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*PhoneVerificationCode)
 	for i := range nodes {
-		fk := nodes[i].ProfileID
+		if nodes[i].ProfileID == nil {
+			continue
+		}
+		fk := *nodes[i].ProfileID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
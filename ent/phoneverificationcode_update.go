@@ -63,6 +63,87 @@ func (pvcu *PhoneVerificationCodeUpdate) SetNillableProfileID(i *int) *PhoneVeri
 	return pvcu
 }
 
+// ClearProfileID clears the value of the "profile_id" field.
+func (pvcu *PhoneVerificationCodeUpdate) ClearProfileID() *PhoneVerificationCodeUpdate {
+	pvcu.mutation.ClearProfileID()
+	return pvcu
+}
+
+// SetPhoneNumberE164 sets the "phone_number_e164" field.
+func (pvcu *PhoneVerificationCodeUpdate) SetPhoneNumberE164(s string) *PhoneVerificationCodeUpdate {
+	pvcu.mutation.SetPhoneNumberE164(s)
+	return pvcu
+}
+
+// SetNillablePhoneNumberE164 sets the "phone_number_e164" field if the given value is not nil.
+func (pvcu *PhoneVerificationCodeUpdate) SetNillablePhoneNumberE164(s *string) *PhoneVerificationCodeUpdate {
+	if s != nil {
+		pvcu.SetPhoneNumberE164(*s)
+	}
+	return pvcu
+}
+
+// ClearPhoneNumberE164 clears the value of the "phone_number_e164" field.
+func (pvcu *PhoneVerificationCodeUpdate) ClearPhoneNumberE164() *PhoneVerificationCodeUpdate {
+	pvcu.mutation.ClearPhoneNumberE164()
+	return pvcu
+}
+
+// SetPurpose sets the "purpose" field.
+func (pvcu *PhoneVerificationCodeUpdate) SetPurpose(ph phoneverificationcode.Purpose) *PhoneVerificationCodeUpdate {
+	pvcu.mutation.SetPurpose(ph)
+	return pvcu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (pvcu *PhoneVerificationCodeUpdate) SetNillablePurpose(ph *phoneverificationcode.Purpose) *PhoneVerificationCodeUpdate {
+	if ph != nil {
+		pvcu.SetPurpose(*ph)
+	}
+	return pvcu
+}
+
+// SetAttempts sets the "attempts" field.
+func (pvcu *PhoneVerificationCodeUpdate) SetAttempts(i int) *PhoneVerificationCodeUpdate {
+	pvcu.mutation.ResetAttempts()
+	pvcu.mutation.SetAttempts(i)
+	return pvcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pvcu *PhoneVerificationCodeUpdate) SetNillableAttempts(i *int) *PhoneVerificationCodeUpdate {
+	if i != nil {
+		pvcu.SetAttempts(*i)
+	}
+	return pvcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pvcu *PhoneVerificationCodeUpdate) AddAttempts(i int) *PhoneVerificationCodeUpdate {
+	pvcu.mutation.AddAttempts(i)
+	return pvcu
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (pvcu *PhoneVerificationCodeUpdate) SetInvalidatedAt(t time.Time) *PhoneVerificationCodeUpdate {
+	pvcu.mutation.SetInvalidatedAt(t)
+	return pvcu
+}
+
+// SetNillableInvalidatedAt sets the "invalidated_at" field if the given value is not nil.
+func (pvcu *PhoneVerificationCodeUpdate) SetNillableInvalidatedAt(t *time.Time) *PhoneVerificationCodeUpdate {
+	if t != nil {
+		pvcu.SetInvalidatedAt(*t)
+	}
+	return pvcu
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (pvcu *PhoneVerificationCodeUpdate) ClearInvalidatedAt() *PhoneVerificationCodeUpdate {
+	pvcu.mutation.ClearInvalidatedAt()
+	return pvcu
+}
+
 // SetProfile sets the "profile" edge to the Profile entity.
 func (pvcu *PhoneVerificationCodeUpdate) SetProfile(p *Profile) *PhoneVerificationCodeUpdate {
 	return pvcu.SetProfileID(p.ID)
@@ -117,8 +198,10 @@ func (pvcu *PhoneVerificationCodeUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pvcu *PhoneVerificationCodeUpdate) check() error {
-	if pvcu.mutation.ProfileCleared() && len(pvcu.mutation.ProfileIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "PhoneVerificationCode.profile"`)
+	if v, ok := pvcu.mutation.Purpose(); ok {
+		if err := phoneverificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "PhoneVerificationCode.purpose": %w`, err)}
+		}
 	}
 	return nil
 }
@@ -141,6 +224,27 @@ func (pvcu *PhoneVerificationCodeUpdate) sqlSave(ctx context.Context) (n int, er
 	if value, ok := pvcu.mutation.Code(); ok {
 		_spec.SetField(phoneverificationcode.FieldCode, field.TypeString, value)
 	}
+	if value, ok := pvcu.mutation.PhoneNumberE164(); ok {
+		_spec.SetField(phoneverificationcode.FieldPhoneNumberE164, field.TypeString, value)
+	}
+	if pvcu.mutation.PhoneNumberE164Cleared() {
+		_spec.ClearField(phoneverificationcode.FieldPhoneNumberE164, field.TypeString)
+	}
+	if value, ok := pvcu.mutation.Purpose(); ok {
+		_spec.SetField(phoneverificationcode.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := pvcu.mutation.Attempts(); ok {
+		_spec.SetField(phoneverificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pvcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(phoneverificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pvcu.mutation.InvalidatedAt(); ok {
+		_spec.SetField(phoneverificationcode.FieldInvalidatedAt, field.TypeTime, value)
+	}
+	if pvcu.mutation.InvalidatedAtCleared() {
+		_spec.ClearField(phoneverificationcode.FieldInvalidatedAt, field.TypeTime)
+	}
 	if pvcu.mutation.ProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -224,6 +328,87 @@ func (pvcuo *PhoneVerificationCodeUpdateOne) SetNillableProfileID(i *int) *Phone
 	return pvcuo
 }
 
+// ClearProfileID clears the value of the "profile_id" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) ClearProfileID() *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.ClearProfileID()
+	return pvcuo
+}
+
+// SetPhoneNumberE164 sets the "phone_number_e164" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetPhoneNumberE164(s string) *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.SetPhoneNumberE164(s)
+	return pvcuo
+}
+
+// SetNillablePhoneNumberE164 sets the "phone_number_e164" field if the given value is not nil.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetNillablePhoneNumberE164(s *string) *PhoneVerificationCodeUpdateOne {
+	if s != nil {
+		pvcuo.SetPhoneNumberE164(*s)
+	}
+	return pvcuo
+}
+
+// ClearPhoneNumberE164 clears the value of the "phone_number_e164" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) ClearPhoneNumberE164() *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.ClearPhoneNumberE164()
+	return pvcuo
+}
+
+// SetPurpose sets the "purpose" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetPurpose(ph phoneverificationcode.Purpose) *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.SetPurpose(ph)
+	return pvcuo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetNillablePurpose(ph *phoneverificationcode.Purpose) *PhoneVerificationCodeUpdateOne {
+	if ph != nil {
+		pvcuo.SetPurpose(*ph)
+	}
+	return pvcuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetAttempts(i int) *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.ResetAttempts()
+	pvcuo.mutation.SetAttempts(i)
+	return pvcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetNillableAttempts(i *int) *PhoneVerificationCodeUpdateOne {
+	if i != nil {
+		pvcuo.SetAttempts(*i)
+	}
+	return pvcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) AddAttempts(i int) *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.AddAttempts(i)
+	return pvcuo
+}
+
+// SetInvalidatedAt sets the "invalidated_at" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetInvalidatedAt(t time.Time) *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.SetInvalidatedAt(t)
+	return pvcuo
+}
+
+// SetNillableInvalidatedAt sets the "invalidated_at" field if the given value is not nil.
+func (pvcuo *PhoneVerificationCodeUpdateOne) SetNillableInvalidatedAt(t *time.Time) *PhoneVerificationCodeUpdateOne {
+	if t != nil {
+		pvcuo.SetInvalidatedAt(*t)
+	}
+	return pvcuo
+}
+
+// ClearInvalidatedAt clears the value of the "invalidated_at" field.
+func (pvcuo *PhoneVerificationCodeUpdateOne) ClearInvalidatedAt() *PhoneVerificationCodeUpdateOne {
+	pvcuo.mutation.ClearInvalidatedAt()
+	return pvcuo
+}
+
 // SetProfile sets the "profile" edge to the Profile entity.
 func (pvcuo *PhoneVerificationCodeUpdateOne) SetProfile(p *Profile) *PhoneVerificationCodeUpdateOne {
 	return pvcuo.SetProfileID(p.ID)
@@ -291,8 +476,10 @@ func (pvcuo *PhoneVerificationCodeUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pvcuo *PhoneVerificationCodeUpdateOne) check() error {
-	if pvcuo.mutation.ProfileCleared() && len(pvcuo.mutation.ProfileIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "PhoneVerificationCode.profile"`)
+	if v, ok := pvcuo.mutation.Purpose(); ok {
+		if err := phoneverificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "PhoneVerificationCode.purpose": %w`, err)}
+		}
 	}
 	return nil
 }
@@ -332,6 +519,27 @@ func (pvcuo *PhoneVerificationCodeUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := pvcuo.mutation.Code(); ok {
 		_spec.SetField(phoneverificationcode.FieldCode, field.TypeString, value)
 	}
+	if value, ok := pvcuo.mutation.PhoneNumberE164(); ok {
+		_spec.SetField(phoneverificationcode.FieldPhoneNumberE164, field.TypeString, value)
+	}
+	if pvcuo.mutation.PhoneNumberE164Cleared() {
+		_spec.ClearField(phoneverificationcode.FieldPhoneNumberE164, field.TypeString)
+	}
+	if value, ok := pvcuo.mutation.Purpose(); ok {
+		_spec.SetField(phoneverificationcode.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := pvcuo.mutation.Attempts(); ok {
+		_spec.SetField(phoneverificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pvcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(phoneverificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := pvcuo.mutation.InvalidatedAt(); ok {
+		_spec.SetField(phoneverificationcode.FieldInvalidatedAt, field.TypeTime, value)
+	}
+	if pvcuo.mutation.InvalidatedAtCleared() {
+		_spec.ClearField(phoneverificationcode.FieldInvalidatedAt, field.TypeTime)
+	}
 	if pvcuo.mutation.ProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
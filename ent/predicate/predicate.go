@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIToken is the predicate function for apitoken builders.
+type APIToken func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // EmailSubscription is the predicate function for emailsubscription builders.
 type EmailSubscription func(*sql.Selector)
 
@@ -21,18 +27,30 @@ type FCMSubscriptions func(*sql.Selector)
 // FileStorage is the predicate function for filestorage builders.
 type FileStorage func(*sql.Selector)
 
+// FriendRequest is the predicate function for friendrequest builders.
+type FriendRequest func(*sql.Selector)
+
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
 // Image is the predicate function for image builders.
 type Image func(*sql.Selector)
 
 // ImageSize is the predicate function for imagesize builders.
 type ImageSize func(*sql.Selector)
 
+// Impersonation is the predicate function for impersonation builders.
+type Impersonation func(*sql.Selector)
+
 // Invitation is the predicate function for invitation builders.
 type Invitation func(*sql.Selector)
 
 // LastSeenOnline is the predicate function for lastseenonline builders.
 type LastSeenOnline func(*sql.Selector)
 
+// MagicLinkToken is the predicate function for magiclinktoken builders.
+type MagicLinkToken func(*sql.Selector)
+
 // MonthlySubscription is the predicate function for monthlysubscription builders.
 type MonthlySubscription func(*sql.Selector)
 
@@ -45,6 +63,18 @@ type NotificationPermission func(*sql.Selector)
 // NotificationTime is the predicate function for notificationtime builders.
 type NotificationTime func(*sql.Selector)
 
+// Organization is the predicate function for organization builders.
+type Organization func(*sql.Selector)
+
+// OrganizationInvitation is the predicate function for organizationinvitation builders.
+type OrganizationInvitation func(*sql.Selector)
+
+// OrganizationMembership is the predicate function for organizationmembership builders.
+type OrganizationMembership func(*sql.Selector)
+
+// Passkey is the predicate function for passkey builders.
+type Passkey func(*sql.Selector)
+
 // PasswordToken is the predicate function for passwordtoken builders.
 type PasswordToken func(*sql.Selector)
 
@@ -54,11 +84,38 @@ type PhoneVerificationCode func(*sql.Selector)
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
 
+// PushDelivery is the predicate function for pushdelivery builders.
+type PushDelivery func(*sql.Selector)
+
 // PwaPushSubscription is the predicate function for pwapushsubscription builders.
 type PwaPushSubscription func(*sql.Selector)
 
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
+// Role is the predicate function for role builders.
+type Role func(*sql.Selector)
+
+// SMSMessage is the predicate function for smsmessage builders.
+type SMSMessage func(*sql.Selector)
+
 // SentEmail is the predicate function for sentemail builders.
 type SentEmail func(*sql.Selector)
 
+// ThrottleAttempt is the predicate function for throttleattempt builders.
+type ThrottleAttempt func(*sql.Selector)
+
+// ThrottleLock is the predicate function for throttlelock builders.
+type ThrottleLock func(*sql.Selector)
+
+// TotpSecret is the predicate function for totpsecret builders.
+type TotpSecret func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserSession is the predicate function for usersession builders.
+type UserSession func(*sql.Selector)
@@ -51,6 +51,18 @@ type Profile struct {
 type ProfileEdges struct {
 	// Who the profile is friends/connected to.
 	Friends []*Profile `json:"friends,omitempty"`
+	// Friend requests the profile sent.
+	SentFriendRequests []*FriendRequest `json:"sent_friend_requests,omitempty"`
+	// Friend requests the profile received.
+	ReceivedFriendRequests []*FriendRequest `json:"received_friend_requests,omitempty"`
+	// Profiles this profile blocked, which cannot request its friendship nor see it.
+	BlockedBy []*Profile `json:"blocked_by,omitempty"`
+	// Blocked holds the value of the blocked edge.
+	Blocked []*Profile `json:"blocked,omitempty"`
+	// Reports the profile filed about others.
+	ReportsSent []*Report `json:"reports_sent,omitempty"`
+	// Reports filed about the profile or its photos.
+	ReportsReceived []*Report `json:"reports_received,omitempty"`
 	// Photos associated to that profile, not including the profile picture.
 	Photos []*Image `json:"photos,omitempty"`
 	// ProfileImage holds the value of the profile_image edge.
@@ -59,10 +71,14 @@ type ProfileEdges struct {
 	Notifications []*Notification `json:"notifications,omitempty"`
 	// Invitations holds the value of the invitations edge.
 	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Organizations the profile is part of, along with its role in them.
+	OrganizationMemberships []*OrganizationMembership `json:"organization_memberships,omitempty"`
 	// Track FCM push notification subscriptions, used for iOS
 	FcmPushSubscriptions []*FCMSubscriptions `json:"fcm_push_subscriptions,omitempty"`
 	// Track PWA push notification subscriptions, used for all device types but iOS
 	PwaPushSubscriptions []*PwaPushSubscription `json:"pwa_push_subscriptions,omitempty"`
+	// Push notifications sent to the devices of this profile, one per device
+	PushDeliveries []*PushDelivery `json:"push_deliveries,omitempty"`
 	// NotificationPermissions holds the value of the notification_permissions edge.
 	NotificationPermissions []*NotificationPermission `json:"notification_permissions,omitempty"`
 	// Times at which a notification type should be sent to a profile
@@ -77,7 +93,7 @@ type ProfileEdges struct {
 	Subscription []*MonthlySubscription `json:"subscription,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [13]bool
+	loadedTypes [21]bool
 }
 
 // FriendsOrErr returns the Friends value or an error if the edge
@@ -89,10 +105,64 @@ func (e ProfileEdges) FriendsOrErr() ([]*Profile, error) {
 	return nil, &NotLoadedError{edge: "friends"}
 }
 
+// SentFriendRequestsOrErr returns the SentFriendRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) SentFriendRequestsOrErr() ([]*FriendRequest, error) {
+	if e.loadedTypes[1] {
+		return e.SentFriendRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_friend_requests"}
+}
+
+// ReceivedFriendRequestsOrErr returns the ReceivedFriendRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ReceivedFriendRequestsOrErr() ([]*FriendRequest, error) {
+	if e.loadedTypes[2] {
+		return e.ReceivedFriendRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "received_friend_requests"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) BlockedByOrErr() ([]*Profile, error) {
+	if e.loadedTypes[3] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// BlockedOrErr returns the Blocked value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) BlockedOrErr() ([]*Profile, error) {
+	if e.loadedTypes[4] {
+		return e.Blocked, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked"}
+}
+
+// ReportsSentOrErr returns the ReportsSent value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ReportsSentOrErr() ([]*Report, error) {
+	if e.loadedTypes[5] {
+		return e.ReportsSent, nil
+	}
+	return nil, &NotLoadedError{edge: "reports_sent"}
+}
+
+// ReportsReceivedOrErr returns the ReportsReceived value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ReportsReceivedOrErr() ([]*Report, error) {
+	if e.loadedTypes[6] {
+		return e.ReportsReceived, nil
+	}
+	return nil, &NotLoadedError{edge: "reports_received"}
+}
+
 // PhotosOrErr returns the Photos value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) PhotosOrErr() ([]*Image, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[7] {
 		return e.Photos, nil
 	}
 	return nil, &NotLoadedError{edge: "photos"}
@@ -103,7 +173,7 @@ func (e ProfileEdges) PhotosOrErr() ([]*Image, error) {
 func (e ProfileEdges) ProfileImageOrErr() (*Image, error) {
 	if e.ProfileImage != nil {
 		return e.ProfileImage, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[8] {
 		return nil, &NotFoundError{label: image.Label}
 	}
 	return nil, &NotLoadedError{edge: "profile_image"}
@@ -112,7 +182,7 @@ func (e ProfileEdges) ProfileImageOrErr() (*Image, error) {
 // NotificationsOrErr returns the Notifications value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) NotificationsOrErr() ([]*Notification, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[9] {
 		return e.Notifications, nil
 	}
 	return nil, &NotLoadedError{edge: "notifications"}
@@ -121,16 +191,25 @@ func (e ProfileEdges) NotificationsOrErr() ([]*Notification, error) {
 // InvitationsOrErr returns the Invitations value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) InvitationsOrErr() ([]*Invitation, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[10] {
 		return e.Invitations, nil
 	}
 	return nil, &NotLoadedError{edge: "invitations"}
 }
 
+// OrganizationMembershipsOrErr returns the OrganizationMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) OrganizationMembershipsOrErr() ([]*OrganizationMembership, error) {
+	if e.loadedTypes[11] {
+		return e.OrganizationMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "organization_memberships"}
+}
+
 // FcmPushSubscriptionsOrErr returns the FcmPushSubscriptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) FcmPushSubscriptionsOrErr() ([]*FCMSubscriptions, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[12] {
 		return e.FcmPushSubscriptions, nil
 	}
 	return nil, &NotLoadedError{edge: "fcm_push_subscriptions"}
@@ -139,16 +218,25 @@ func (e ProfileEdges) FcmPushSubscriptionsOrErr() ([]*FCMSubscriptions, error) {
 // PwaPushSubscriptionsOrErr returns the PwaPushSubscriptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) PwaPushSubscriptionsOrErr() ([]*PwaPushSubscription, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[13] {
 		return e.PwaPushSubscriptions, nil
 	}
 	return nil, &NotLoadedError{edge: "pwa_push_subscriptions"}
 }
 
+// PushDeliveriesOrErr returns the PushDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) PushDeliveriesOrErr() ([]*PushDelivery, error) {
+	if e.loadedTypes[14] {
+		return e.PushDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "push_deliveries"}
+}
+
 // NotificationPermissionsOrErr returns the NotificationPermissions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) NotificationPermissionsOrErr() ([]*NotificationPermission, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[15] {
 		return e.NotificationPermissions, nil
 	}
 	return nil, &NotLoadedError{edge: "notification_permissions"}
@@ -157,7 +245,7 @@ func (e ProfileEdges) NotificationPermissionsOrErr() ([]*NotificationPermission,
 // NotificationTimesOrErr returns the NotificationTimes value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) NotificationTimesOrErr() ([]*NotificationTime, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[16] {
 		return e.NotificationTimes, nil
 	}
 	return nil, &NotLoadedError{edge: "notification_times"}
@@ -166,7 +254,7 @@ func (e ProfileEdges) NotificationTimesOrErr() ([]*NotificationTime, error) {
 // PhoneVerificationCodeOrErr returns the PhoneVerificationCode value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) PhoneVerificationCodeOrErr() ([]*PhoneVerificationCode, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[17] {
 		return e.PhoneVerificationCode, nil
 	}
 	return nil, &NotLoadedError{edge: "phone_verification_code"}
@@ -175,7 +263,7 @@ func (e ProfileEdges) PhoneVerificationCodeOrErr() ([]*PhoneVerificationCode, er
 // SentEmailsOrErr returns the SentEmails value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) SentEmailsOrErr() ([]*SentEmail, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[18] {
 		return e.SentEmails, nil
 	}
 	return nil, &NotLoadedError{edge: "sent_emails"}
@@ -186,7 +274,7 @@ func (e ProfileEdges) SentEmailsOrErr() ([]*SentEmail, error) {
 func (e ProfileEdges) UserOrErr() (*User, error) {
 	if e.User != nil {
 		return e.User, nil
-	} else if e.loadedTypes[11] {
+	} else if e.loadedTypes[19] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
@@ -195,7 +283,7 @@ func (e ProfileEdges) UserOrErr() (*User, error) {
 // SubscriptionOrErr returns the Subscription value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProfileEdges) SubscriptionOrErr() ([]*MonthlySubscription, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[20] {
 		return e.Subscription, nil
 	}
 	return nil, &NotLoadedError{edge: "subscription"}
@@ -331,6 +419,36 @@ func (pr *Profile) QueryFriends() *ProfileQuery {
 	return NewProfileClient(pr.config).QueryFriends(pr)
 }
 
+// QuerySentFriendRequests queries the "sent_friend_requests" edge of the Profile entity.
+func (pr *Profile) QuerySentFriendRequests() *FriendRequestQuery {
+	return NewProfileClient(pr.config).QuerySentFriendRequests(pr)
+}
+
+// QueryReceivedFriendRequests queries the "received_friend_requests" edge of the Profile entity.
+func (pr *Profile) QueryReceivedFriendRequests() *FriendRequestQuery {
+	return NewProfileClient(pr.config).QueryReceivedFriendRequests(pr)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the Profile entity.
+func (pr *Profile) QueryBlockedBy() *ProfileQuery {
+	return NewProfileClient(pr.config).QueryBlockedBy(pr)
+}
+
+// QueryBlocked queries the "blocked" edge of the Profile entity.
+func (pr *Profile) QueryBlocked() *ProfileQuery {
+	return NewProfileClient(pr.config).QueryBlocked(pr)
+}
+
+// QueryReportsSent queries the "reports_sent" edge of the Profile entity.
+func (pr *Profile) QueryReportsSent() *ReportQuery {
+	return NewProfileClient(pr.config).QueryReportsSent(pr)
+}
+
+// QueryReportsReceived queries the "reports_received" edge of the Profile entity.
+func (pr *Profile) QueryReportsReceived() *ReportQuery {
+	return NewProfileClient(pr.config).QueryReportsReceived(pr)
+}
+
 // QueryPhotos queries the "photos" edge of the Profile entity.
 func (pr *Profile) QueryPhotos() *ImageQuery {
 	return NewProfileClient(pr.config).QueryPhotos(pr)
@@ -351,6 +469,11 @@ func (pr *Profile) QueryInvitations() *InvitationQuery {
 	return NewProfileClient(pr.config).QueryInvitations(pr)
 }
 
+// QueryOrganizationMemberships queries the "organization_memberships" edge of the Profile entity.
+func (pr *Profile) QueryOrganizationMemberships() *OrganizationMembershipQuery {
+	return NewProfileClient(pr.config).QueryOrganizationMemberships(pr)
+}
+
 // QueryFcmPushSubscriptions queries the "fcm_push_subscriptions" edge of the Profile entity.
 func (pr *Profile) QueryFcmPushSubscriptions() *FCMSubscriptionsQuery {
 	return NewProfileClient(pr.config).QueryFcmPushSubscriptions(pr)
@@ -361,6 +484,11 @@ func (pr *Profile) QueryPwaPushSubscriptions() *PwaPushSubscriptionQuery {
 	return NewProfileClient(pr.config).QueryPwaPushSubscriptions(pr)
 }
 
+// QueryPushDeliveries queries the "push_deliveries" edge of the Profile entity.
+func (pr *Profile) QueryPushDeliveries() *PushDeliveryQuery {
+	return NewProfileClient(pr.config).QueryPushDeliveries(pr)
+}
+
 // QueryNotificationPermissions queries the "notification_permissions" edge of the Profile entity.
 func (pr *Profile) QueryNotificationPermissions() *NotificationPermissionQuery {
 	return NewProfileClient(pr.config).QueryNotificationPermissions(pr)
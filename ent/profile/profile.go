@@ -36,6 +36,18 @@ const (
 	FieldStripeID = "stripe_id"
 	// EdgeFriends holds the string denoting the friends edge name in mutations.
 	EdgeFriends = "friends"
+	// EdgeSentFriendRequests holds the string denoting the sent_friend_requests edge name in mutations.
+	EdgeSentFriendRequests = "sent_friend_requests"
+	// EdgeReceivedFriendRequests holds the string denoting the received_friend_requests edge name in mutations.
+	EdgeReceivedFriendRequests = "received_friend_requests"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeBlocked holds the string denoting the blocked edge name in mutations.
+	EdgeBlocked = "blocked"
+	// EdgeReportsSent holds the string denoting the reports_sent edge name in mutations.
+	EdgeReportsSent = "reports_sent"
+	// EdgeReportsReceived holds the string denoting the reports_received edge name in mutations.
+	EdgeReportsReceived = "reports_received"
 	// EdgePhotos holds the string denoting the photos edge name in mutations.
 	EdgePhotos = "photos"
 	// EdgeProfileImage holds the string denoting the profile_image edge name in mutations.
@@ -44,10 +56,14 @@ const (
 	EdgeNotifications = "notifications"
 	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
 	EdgeInvitations = "invitations"
+	// EdgeOrganizationMemberships holds the string denoting the organization_memberships edge name in mutations.
+	EdgeOrganizationMemberships = "organization_memberships"
 	// EdgeFcmPushSubscriptions holds the string denoting the fcm_push_subscriptions edge name in mutations.
 	EdgeFcmPushSubscriptions = "fcm_push_subscriptions"
 	// EdgePwaPushSubscriptions holds the string denoting the pwa_push_subscriptions edge name in mutations.
 	EdgePwaPushSubscriptions = "pwa_push_subscriptions"
+	// EdgePushDeliveries holds the string denoting the push_deliveries edge name in mutations.
+	EdgePushDeliveries = "push_deliveries"
 	// EdgeNotificationPermissions holds the string denoting the notification_permissions edge name in mutations.
 	EdgeNotificationPermissions = "notification_permissions"
 	// EdgeNotificationTimes holds the string denoting the notification_times edge name in mutations.
@@ -64,6 +80,38 @@ const (
 	Table = "profiles"
 	// FriendsTable is the table that holds the friends relation/edge. The primary key declared below.
 	FriendsTable = "profile_friends"
+	// SentFriendRequestsTable is the table that holds the sent_friend_requests relation/edge.
+	SentFriendRequestsTable = "friend_requests"
+	// SentFriendRequestsInverseTable is the table name for the FriendRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "friendrequest" package.
+	SentFriendRequestsInverseTable = "friend_requests"
+	// SentFriendRequestsColumn is the table column denoting the sent_friend_requests relation/edge.
+	SentFriendRequestsColumn = "profile_sent_friend_requests"
+	// ReceivedFriendRequestsTable is the table that holds the received_friend_requests relation/edge.
+	ReceivedFriendRequestsTable = "friend_requests"
+	// ReceivedFriendRequestsInverseTable is the table name for the FriendRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "friendrequest" package.
+	ReceivedFriendRequestsInverseTable = "friend_requests"
+	// ReceivedFriendRequestsColumn is the table column denoting the received_friend_requests relation/edge.
+	ReceivedFriendRequestsColumn = "profile_received_friend_requests"
+	// BlockedByTable is the table that holds the blocked_by relation/edge. The primary key declared below.
+	BlockedByTable = "profile_blocked"
+	// BlockedTable is the table that holds the blocked relation/edge. The primary key declared below.
+	BlockedTable = "profile_blocked"
+	// ReportsSentTable is the table that holds the reports_sent relation/edge.
+	ReportsSentTable = "reports"
+	// ReportsSentInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsSentInverseTable = "reports"
+	// ReportsSentColumn is the table column denoting the reports_sent relation/edge.
+	ReportsSentColumn = "profile_reports_sent"
+	// ReportsReceivedTable is the table that holds the reports_received relation/edge.
+	ReportsReceivedTable = "reports"
+	// ReportsReceivedInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsReceivedInverseTable = "reports"
+	// ReportsReceivedColumn is the table column denoting the reports_received relation/edge.
+	ReportsReceivedColumn = "profile_reports_received"
 	// PhotosTable is the table that holds the photos relation/edge.
 	PhotosTable = "images"
 	// PhotosInverseTable is the table name for the Image entity.
@@ -92,6 +140,13 @@ const (
 	InvitationsInverseTable = "invitations"
 	// InvitationsColumn is the table column denoting the invitations relation/edge.
 	InvitationsColumn = "profile_invitations"
+	// OrganizationMembershipsTable is the table that holds the organization_memberships relation/edge.
+	OrganizationMembershipsTable = "organization_memberships"
+	// OrganizationMembershipsInverseTable is the table name for the OrganizationMembership entity.
+	// It exists in this package in order to avoid circular dependency with the "organizationmembership" package.
+	OrganizationMembershipsInverseTable = "organization_memberships"
+	// OrganizationMembershipsColumn is the table column denoting the organization_memberships relation/edge.
+	OrganizationMembershipsColumn = "profile_id"
 	// FcmPushSubscriptionsTable is the table that holds the fcm_push_subscriptions relation/edge.
 	FcmPushSubscriptionsTable = "fcm_subscriptions"
 	// FcmPushSubscriptionsInverseTable is the table name for the FCMSubscriptions entity.
@@ -106,6 +161,13 @@ const (
 	PwaPushSubscriptionsInverseTable = "pwa_push_subscriptions"
 	// PwaPushSubscriptionsColumn is the table column denoting the pwa_push_subscriptions relation/edge.
 	PwaPushSubscriptionsColumn = "profile_id"
+	// PushDeliveriesTable is the table that holds the push_deliveries relation/edge.
+	PushDeliveriesTable = "push_deliveries"
+	// PushDeliveriesInverseTable is the table name for the PushDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "pushdelivery" package.
+	PushDeliveriesInverseTable = "push_deliveries"
+	// PushDeliveriesColumn is the table column denoting the push_deliveries relation/edge.
+	PushDeliveriesColumn = "profile_id"
 	// NotificationPermissionsTable is the table that holds the notification_permissions relation/edge.
 	NotificationPermissionsTable = "notification_permissions"
 	// NotificationPermissionsInverseTable is the table name for the NotificationPermission entity.
@@ -174,6 +236,12 @@ var (
 	// FriendsPrimaryKey and FriendsColumn2 are the table columns denoting the
 	// primary key for the friends relation (M2M).
 	FriendsPrimaryKey = []string{"profile_id", "friend_id"}
+	// BlockedByPrimaryKey and BlockedByColumn2 are the table columns denoting the
+	// primary key for the blocked_by relation (M2M).
+	BlockedByPrimaryKey = []string{"profile_id", "blocked_by_id"}
+	// BlockedPrimaryKey and BlockedColumn2 are the table columns denoting the
+	// primary key for the blocked relation (M2M).
+	BlockedPrimaryKey = []string{"profile_id", "blocked_by_id"}
 	// SubscriptionPrimaryKey and SubscriptionColumn2 are the table columns denoting the
 	// primary key for the subscription relation (M2M).
 	SubscriptionPrimaryKey = []string{"monthly_subscription_id", "profile_id"}
@@ -277,6 +345,90 @@ func ByFriends(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// BySentFriendRequestsCount orders the results by sent_friend_requests count.
+func BySentFriendRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentFriendRequestsStep(), opts...)
+	}
+}
+
+// BySentFriendRequests orders the results by sent_friend_requests terms.
+func BySentFriendRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentFriendRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedFriendRequestsCount orders the results by received_friend_requests count.
+func ByReceivedFriendRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedFriendRequestsStep(), opts...)
+	}
+}
+
+// ByReceivedFriendRequests orders the results by received_friend_requests terms.
+func ByReceivedFriendRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedFriendRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedCount orders the results by blocked count.
+func ByBlockedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedStep(), opts...)
+	}
+}
+
+// ByBlocked orders the results by blocked terms.
+func ByBlocked(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReportsSentCount orders the results by reports_sent count.
+func ByReportsSentCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsSentStep(), opts...)
+	}
+}
+
+// ByReportsSent orders the results by reports_sent terms.
+func ByReportsSent(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsSentStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReportsReceivedCount orders the results by reports_received count.
+func ByReportsReceivedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsReceivedStep(), opts...)
+	}
+}
+
+// ByReportsReceived orders the results by reports_received terms.
+func ByReportsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsReceivedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByPhotosCount orders the results by photos count.
 func ByPhotosCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -326,6 +478,20 @@ func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByOrganizationMembershipsCount orders the results by organization_memberships count.
+func ByOrganizationMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrganizationMembershipsStep(), opts...)
+	}
+}
+
+// ByOrganizationMemberships orders the results by organization_memberships terms.
+func ByOrganizationMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByFcmPushSubscriptionsCount orders the results by fcm_push_subscriptions count.
 func ByFcmPushSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -354,6 +520,20 @@ func ByPwaPushSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpt
 	}
 }
 
+// ByPushDeliveriesCount orders the results by push_deliveries count.
+func ByPushDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPushDeliveriesStep(), opts...)
+	}
+}
+
+// ByPushDeliveries orders the results by push_deliveries terms.
+func ByPushDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPushDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByNotificationPermissionsCount orders the results by notification_permissions count.
 func ByNotificationPermissionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -437,6 +617,48 @@ func newFriendsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, FriendsTable, FriendsPrimaryKey...),
 	)
 }
+func newSentFriendRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentFriendRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentFriendRequestsTable, SentFriendRequestsColumn),
+	)
+}
+func newReceivedFriendRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedFriendRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedFriendRequestsTable, ReceivedFriendRequestsColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+	)
+}
+func newBlockedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlockedTable, BlockedPrimaryKey...),
+	)
+}
+func newReportsSentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsSentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsSentTable, ReportsSentColumn),
+	)
+}
+func newReportsReceivedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsReceivedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsReceivedTable, ReportsReceivedColumn),
+	)
+}
 func newPhotosStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -465,6 +687,13 @@ func newInvitationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
 	)
 }
+func newOrganizationMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrganizationMembershipsTable, OrganizationMembershipsColumn),
+	)
+}
 func newFcmPushSubscriptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -479,6 +708,13 @@ func newPwaPushSubscriptionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PwaPushSubscriptionsTable, PwaPushSubscriptionsColumn),
 	)
 }
+func newPushDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PushDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PushDeliveriesTable, PushDeliveriesColumn),
+	)
+}
 func newNotificationPermissionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
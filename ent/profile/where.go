@@ -638,6 +638,144 @@ func HasFriendsWith(preds ...predicate.Profile) predicate.Profile {
 	})
 }
 
+// HasSentFriendRequests applies the HasEdge predicate on the "sent_friend_requests" edge.
+func HasSentFriendRequests() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SentFriendRequestsTable, SentFriendRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSentFriendRequestsWith applies the HasEdge predicate on the "sent_friend_requests" edge with a given conditions (other predicates).
+func HasSentFriendRequestsWith(preds ...predicate.FriendRequest) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newSentFriendRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceivedFriendRequests applies the HasEdge predicate on the "received_friend_requests" edge.
+func HasReceivedFriendRequests() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivedFriendRequestsTable, ReceivedFriendRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivedFriendRequestsWith applies the HasEdge predicate on the "received_friend_requests" edge with a given conditions (other predicates).
+func HasReceivedFriendRequestsWith(preds ...predicate.FriendRequest) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newReceivedFriendRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.Profile) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocked applies the HasEdge predicate on the "blocked" edge.
+func HasBlocked() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlockedTable, BlockedPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedWith applies the HasEdge predicate on the "blocked" edge with a given conditions (other predicates).
+func HasBlockedWith(preds ...predicate.Profile) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newBlockedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReportsSent applies the HasEdge predicate on the "reports_sent" edge.
+func HasReportsSent() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsSentTable, ReportsSentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsSentWith applies the HasEdge predicate on the "reports_sent" edge with a given conditions (other predicates).
+func HasReportsSentWith(preds ...predicate.Report) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newReportsSentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReportsReceived applies the HasEdge predicate on the "reports_received" edge.
+func HasReportsReceived() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsReceivedTable, ReportsReceivedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsReceivedWith applies the HasEdge predicate on the "reports_received" edge with a given conditions (other predicates).
+func HasReportsReceivedWith(preds ...predicate.Report) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newReportsReceivedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPhotos applies the HasEdge predicate on the "photos" edge.
 func HasPhotos() predicate.Profile {
 	return predicate.Profile(func(s *sql.Selector) {
@@ -730,6 +868,29 @@ func HasInvitationsWith(preds ...predicate.Invitation) predicate.Profile {
 	})
 }
 
+// HasOrganizationMemberships applies the HasEdge predicate on the "organization_memberships" edge.
+func HasOrganizationMemberships() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrganizationMembershipsTable, OrganizationMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationMembershipsWith applies the HasEdge predicate on the "organization_memberships" edge with a given conditions (other predicates).
+func HasOrganizationMembershipsWith(preds ...predicate.OrganizationMembership) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newOrganizationMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasFcmPushSubscriptions applies the HasEdge predicate on the "fcm_push_subscriptions" edge.
 func HasFcmPushSubscriptions() predicate.Profile {
 	return predicate.Profile(func(s *sql.Selector) {
@@ -776,6 +937,29 @@ func HasPwaPushSubscriptionsWith(preds ...predicate.PwaPushSubscription) predica
 	})
 }
 
+// HasPushDeliveries applies the HasEdge predicate on the "push_deliveries" edge.
+func HasPushDeliveries() predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PushDeliveriesTable, PushDeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPushDeliveriesWith applies the HasEdge predicate on the "push_deliveries" edge with a given conditions (other predicates).
+func HasPushDeliveriesWith(preds ...predicate.PushDelivery) predicate.Profile {
+	return predicate.Profile(func(s *sql.Selector) {
+		step := newPushDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasNotificationPermissions applies the HasEdge predicate on the "notification_permissions" edge.
 func HasNotificationPermissions() predicate.Profile {
 	return predicate.Profile(func(s *sql.Selector) {
@@ -12,15 +12,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/invitation"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
+	"github.com/mikestefanello/pagoda/ent/report"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
 	"github.com/mikestefanello/pagoda/ent/user"
 )
@@ -188,6 +192,96 @@ func (pc *ProfileCreate) AddFriends(p ...*Profile) *ProfileCreate {
 	return pc.AddFriendIDs(ids...)
 }
 
+// AddSentFriendRequestIDs adds the "sent_friend_requests" edge to the FriendRequest entity by IDs.
+func (pc *ProfileCreate) AddSentFriendRequestIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddSentFriendRequestIDs(ids...)
+	return pc
+}
+
+// AddSentFriendRequests adds the "sent_friend_requests" edges to the FriendRequest entity.
+func (pc *ProfileCreate) AddSentFriendRequests(f ...*FriendRequest) *ProfileCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return pc.AddSentFriendRequestIDs(ids...)
+}
+
+// AddReceivedFriendRequestIDs adds the "received_friend_requests" edge to the FriendRequest entity by IDs.
+func (pc *ProfileCreate) AddReceivedFriendRequestIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddReceivedFriendRequestIDs(ids...)
+	return pc
+}
+
+// AddReceivedFriendRequests adds the "received_friend_requests" edges to the FriendRequest entity.
+func (pc *ProfileCreate) AddReceivedFriendRequests(f ...*FriendRequest) *ProfileCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return pc.AddReceivedFriendRequestIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Profile entity by IDs.
+func (pc *ProfileCreate) AddBlockedByIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddBlockedByIDs(ids...)
+	return pc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Profile entity.
+func (pc *ProfileCreate) AddBlockedBy(p ...*Profile) *ProfileCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddBlockedByIDs(ids...)
+}
+
+// AddBlockedIDs adds the "blocked" edge to the Profile entity by IDs.
+func (pc *ProfileCreate) AddBlockedIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddBlockedIDs(ids...)
+	return pc
+}
+
+// AddBlocked adds the "blocked" edges to the Profile entity.
+func (pc *ProfileCreate) AddBlocked(p ...*Profile) *ProfileCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddBlockedIDs(ids...)
+}
+
+// AddReportsSentIDs adds the "reports_sent" edge to the Report entity by IDs.
+func (pc *ProfileCreate) AddReportsSentIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddReportsSentIDs(ids...)
+	return pc
+}
+
+// AddReportsSent adds the "reports_sent" edges to the Report entity.
+func (pc *ProfileCreate) AddReportsSent(r ...*Report) *ProfileCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddReportsSentIDs(ids...)
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by IDs.
+func (pc *ProfileCreate) AddReportsReceivedIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddReportsReceivedIDs(ids...)
+	return pc
+}
+
+// AddReportsReceived adds the "reports_received" edges to the Report entity.
+func (pc *ProfileCreate) AddReportsReceived(r ...*Report) *ProfileCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddReportsReceivedIDs(ids...)
+}
+
 // AddPhotoIDs adds the "photos" edge to the Image entity by IDs.
 func (pc *ProfileCreate) AddPhotoIDs(ids ...int) *ProfileCreate {
 	pc.mutation.AddPhotoIDs(ids...)
@@ -252,6 +346,21 @@ func (pc *ProfileCreate) AddInvitations(i ...*Invitation) *ProfileCreate {
 	return pc.AddInvitationIDs(ids...)
 }
 
+// AddOrganizationMembershipIDs adds the "organization_memberships" edge to the OrganizationMembership entity by IDs.
+func (pc *ProfileCreate) AddOrganizationMembershipIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddOrganizationMembershipIDs(ids...)
+	return pc
+}
+
+// AddOrganizationMemberships adds the "organization_memberships" edges to the OrganizationMembership entity.
+func (pc *ProfileCreate) AddOrganizationMemberships(o ...*OrganizationMembership) *ProfileCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pc.AddOrganizationMembershipIDs(ids...)
+}
+
 // AddFcmPushSubscriptionIDs adds the "fcm_push_subscriptions" edge to the FCMSubscriptions entity by IDs.
 func (pc *ProfileCreate) AddFcmPushSubscriptionIDs(ids ...int) *ProfileCreate {
 	pc.mutation.AddFcmPushSubscriptionIDs(ids...)
@@ -282,6 +391,21 @@ func (pc *ProfileCreate) AddPwaPushSubscriptions(p ...*PwaPushSubscription) *Pro
 	return pc.AddPwaPushSubscriptionIDs(ids...)
 }
 
+// AddPushDeliveryIDs adds the "push_deliveries" edge to the PushDelivery entity by IDs.
+func (pc *ProfileCreate) AddPushDeliveryIDs(ids ...int) *ProfileCreate {
+	pc.mutation.AddPushDeliveryIDs(ids...)
+	return pc
+}
+
+// AddPushDeliveries adds the "push_deliveries" edges to the PushDelivery entity.
+func (pc *ProfileCreate) AddPushDeliveries(p ...*PushDelivery) *ProfileCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddPushDeliveryIDs(ids...)
+}
+
 // AddNotificationPermissionIDs adds the "notification_permissions" edge to the NotificationPermission entity by IDs.
 func (pc *ProfileCreate) AddNotificationPermissionIDs(ids ...int) *ProfileCreate {
 	pc.mutation.AddNotificationPermissionIDs(ids...)
@@ -514,6 +638,102 @@ func (pc *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.SentFriendRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ReceivedFriendRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.BlockedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ReportsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.PhotosIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -579,6 +799,22 @@ func (pc *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.OrganizationMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.FcmPushSubscriptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -611,6 +847,22 @@ func (pc *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.PushDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.NotificationPermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
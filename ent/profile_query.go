@@ -13,16 +13,20 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/invitation"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/predicate"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
+	"github.com/mikestefanello/pagoda/ent/report"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
 	"github.com/mikestefanello/pagoda/ent/user"
 )
@@ -35,12 +39,20 @@ type ProfileQuery struct {
 	inters                      []Interceptor
 	predicates                  []predicate.Profile
 	withFriends                 *ProfileQuery
+	withSentFriendRequests      *FriendRequestQuery
+	withReceivedFriendRequests  *FriendRequestQuery
+	withBlockedBy               *ProfileQuery
+	withBlocked                 *ProfileQuery
+	withReportsSent             *ReportQuery
+	withReportsReceived         *ReportQuery
 	withPhotos                  *ImageQuery
 	withProfileImage            *ImageQuery
 	withNotifications           *NotificationQuery
 	withInvitations             *InvitationQuery
+	withOrganizationMemberships *OrganizationMembershipQuery
 	withFcmPushSubscriptions    *FCMSubscriptionsQuery
 	withPwaPushSubscriptions    *PwaPushSubscriptionQuery
+	withPushDeliveries          *PushDeliveryQuery
 	withNotificationPermissions *NotificationPermissionQuery
 	withNotificationTimes       *NotificationTimeQuery
 	withPhoneVerificationCode   *PhoneVerificationCodeQuery
@@ -106,6 +118,138 @@ func (pq *ProfileQuery) QueryFriends() *ProfileQuery {
 	return query
 }
 
+// QuerySentFriendRequests chains the current query on the "sent_friend_requests" edge.
+func (pq *ProfileQuery) QuerySentFriendRequests() *FriendRequestQuery {
+	query := (&FriendRequestClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(friendrequest.Table, friendrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SentFriendRequestsTable, profile.SentFriendRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceivedFriendRequests chains the current query on the "received_friend_requests" edge.
+func (pq *ProfileQuery) QueryReceivedFriendRequests() *FriendRequestQuery {
+	query := (&FriendRequestClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(friendrequest.Table, friendrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReceivedFriendRequestsTable, profile.ReceivedFriendRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (pq *ProfileQuery) QueryBlockedBy() *ProfileQuery {
+	query := (&ProfileClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, profile.BlockedByTable, profile.BlockedByPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlocked chains the current query on the "blocked" edge.
+func (pq *ProfileQuery) QueryBlocked() *ProfileQuery {
+	query := (&ProfileClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.BlockedTable, profile.BlockedPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReportsSent chains the current query on the "reports_sent" edge.
+func (pq *ProfileQuery) QueryReportsSent() *ReportQuery {
+	query := (&ReportClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReportsSentTable, profile.ReportsSentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReportsReceived chains the current query on the "reports_received" edge.
+func (pq *ProfileQuery) QueryReportsReceived() *ReportQuery {
+	query := (&ReportClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReportsReceivedTable, profile.ReportsReceivedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPhotos chains the current query on the "photos" edge.
 func (pq *ProfileQuery) QueryPhotos() *ImageQuery {
 	query := (&ImageClient{config: pq.config}).Query()
@@ -194,6 +338,28 @@ func (pq *ProfileQuery) QueryInvitations() *InvitationQuery {
 	return query
 }
 
+// QueryOrganizationMemberships chains the current query on the "organization_memberships" edge.
+func (pq *ProfileQuery) QueryOrganizationMemberships() *OrganizationMembershipQuery {
+	query := (&OrganizationMembershipClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(organizationmembership.Table, organizationmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.OrganizationMembershipsTable, profile.OrganizationMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryFcmPushSubscriptions chains the current query on the "fcm_push_subscriptions" edge.
 func (pq *ProfileQuery) QueryFcmPushSubscriptions() *FCMSubscriptionsQuery {
 	query := (&FCMSubscriptionsClient{config: pq.config}).Query()
@@ -238,6 +404,28 @@ func (pq *ProfileQuery) QueryPwaPushSubscriptions() *PwaPushSubscriptionQuery {
 	return query
 }
 
+// QueryPushDeliveries chains the current query on the "push_deliveries" edge.
+func (pq *ProfileQuery) QueryPushDeliveries() *PushDeliveryQuery {
+	query := (&PushDeliveryClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(pushdelivery.Table, pushdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PushDeliveriesTable, profile.PushDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryNotificationPermissions chains the current query on the "notification_permissions" edge.
 func (pq *ProfileQuery) QueryNotificationPermissions() *NotificationPermissionQuery {
 	query := (&NotificationPermissionClient{config: pq.config}).Query()
@@ -563,12 +751,20 @@ func (pq *ProfileQuery) Clone() *ProfileQuery {
 		inters:                      append([]Interceptor{}, pq.inters...),
 		predicates:                  append([]predicate.Profile{}, pq.predicates...),
 		withFriends:                 pq.withFriends.Clone(),
+		withSentFriendRequests:      pq.withSentFriendRequests.Clone(),
+		withReceivedFriendRequests:  pq.withReceivedFriendRequests.Clone(),
+		withBlockedBy:               pq.withBlockedBy.Clone(),
+		withBlocked:                 pq.withBlocked.Clone(),
+		withReportsSent:             pq.withReportsSent.Clone(),
+		withReportsReceived:         pq.withReportsReceived.Clone(),
 		withPhotos:                  pq.withPhotos.Clone(),
 		withProfileImage:            pq.withProfileImage.Clone(),
 		withNotifications:           pq.withNotifications.Clone(),
 		withInvitations:             pq.withInvitations.Clone(),
+		withOrganizationMemberships: pq.withOrganizationMemberships.Clone(),
 		withFcmPushSubscriptions:    pq.withFcmPushSubscriptions.Clone(),
 		withPwaPushSubscriptions:    pq.withPwaPushSubscriptions.Clone(),
+		withPushDeliveries:          pq.withPushDeliveries.Clone(),
 		withNotificationPermissions: pq.withNotificationPermissions.Clone(),
 		withNotificationTimes:       pq.withNotificationTimes.Clone(),
 		withPhoneVerificationCode:   pq.withPhoneVerificationCode.Clone(),
@@ -592,6 +788,72 @@ func (pq *ProfileQuery) WithFriends(opts ...func(*ProfileQuery)) *ProfileQuery {
 	return pq
 }
 
+// WithSentFriendRequests tells the query-builder to eager-load the nodes that are connected to
+// the "sent_friend_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithSentFriendRequests(opts ...func(*FriendRequestQuery)) *ProfileQuery {
+	query := (&FriendRequestClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSentFriendRequests = query
+	return pq
+}
+
+// WithReceivedFriendRequests tells the query-builder to eager-load the nodes that are connected to
+// the "received_friend_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithReceivedFriendRequests(opts ...func(*FriendRequestQuery)) *ProfileQuery {
+	query := (&FriendRequestClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withReceivedFriendRequests = query
+	return pq
+}
+
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithBlockedBy(opts ...func(*ProfileQuery)) *ProfileQuery {
+	query := (&ProfileClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withBlockedBy = query
+	return pq
+}
+
+// WithBlocked tells the query-builder to eager-load the nodes that are connected to
+// the "blocked" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithBlocked(opts ...func(*ProfileQuery)) *ProfileQuery {
+	query := (&ProfileClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withBlocked = query
+	return pq
+}
+
+// WithReportsSent tells the query-builder to eager-load the nodes that are connected to
+// the "reports_sent" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithReportsSent(opts ...func(*ReportQuery)) *ProfileQuery {
+	query := (&ReportClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withReportsSent = query
+	return pq
+}
+
+// WithReportsReceived tells the query-builder to eager-load the nodes that are connected to
+// the "reports_received" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithReportsReceived(opts ...func(*ReportQuery)) *ProfileQuery {
+	query := (&ReportClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withReportsReceived = query
+	return pq
+}
+
 // WithPhotos tells the query-builder to eager-load the nodes that are connected to
 // the "photos" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *ProfileQuery) WithPhotos(opts ...func(*ImageQuery)) *ProfileQuery {
@@ -636,6 +898,17 @@ func (pq *ProfileQuery) WithInvitations(opts ...func(*InvitationQuery)) *Profile
 	return pq
 }
 
+// WithOrganizationMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "organization_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithOrganizationMemberships(opts ...func(*OrganizationMembershipQuery)) *ProfileQuery {
+	query := (&OrganizationMembershipClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOrganizationMemberships = query
+	return pq
+}
+
 // WithFcmPushSubscriptions tells the query-builder to eager-load the nodes that are connected to
 // the "fcm_push_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *ProfileQuery) WithFcmPushSubscriptions(opts ...func(*FCMSubscriptionsQuery)) *ProfileQuery {
@@ -658,6 +931,17 @@ func (pq *ProfileQuery) WithPwaPushSubscriptions(opts ...func(*PwaPushSubscripti
 	return pq
 }
 
+// WithPushDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "push_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProfileQuery) WithPushDeliveries(opts ...func(*PushDeliveryQuery)) *ProfileQuery {
+	query := (&PushDeliveryClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withPushDeliveries = query
+	return pq
+}
+
 // WithNotificationPermissions tells the query-builder to eager-load the nodes that are connected to
 // the "notification_permissions" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *ProfileQuery) WithNotificationPermissions(opts ...func(*NotificationPermissionQuery)) *ProfileQuery {
@@ -803,14 +1087,22 @@ func (pq *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 		nodes       = []*Profile{}
 		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [13]bool{
+		loadedTypes = [21]bool{
 			pq.withFriends != nil,
+			pq.withSentFriendRequests != nil,
+			pq.withReceivedFriendRequests != nil,
+			pq.withBlockedBy != nil,
+			pq.withBlocked != nil,
+			pq.withReportsSent != nil,
+			pq.withReportsReceived != nil,
 			pq.withPhotos != nil,
 			pq.withProfileImage != nil,
 			pq.withNotifications != nil,
 			pq.withInvitations != nil,
+			pq.withOrganizationMemberships != nil,
 			pq.withFcmPushSubscriptions != nil,
 			pq.withPwaPushSubscriptions != nil,
+			pq.withPushDeliveries != nil,
 			pq.withNotificationPermissions != nil,
 			pq.withNotificationTimes != nil,
 			pq.withPhoneVerificationCode != nil,
@@ -850,6 +1142,50 @@ func (pq *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 			return nil, err
 		}
 	}
+	if query := pq.withSentFriendRequests; query != nil {
+		if err := pq.loadSentFriendRequests(ctx, query, nodes,
+			func(n *Profile) { n.Edges.SentFriendRequests = []*FriendRequest{} },
+			func(n *Profile, e *FriendRequest) { n.Edges.SentFriendRequests = append(n.Edges.SentFriendRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withReceivedFriendRequests; query != nil {
+		if err := pq.loadReceivedFriendRequests(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ReceivedFriendRequests = []*FriendRequest{} },
+			func(n *Profile, e *FriendRequest) {
+				n.Edges.ReceivedFriendRequests = append(n.Edges.ReceivedFriendRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withBlockedBy; query != nil {
+		if err := pq.loadBlockedBy(ctx, query, nodes,
+			func(n *Profile) { n.Edges.BlockedBy = []*Profile{} },
+			func(n *Profile, e *Profile) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withBlocked; query != nil {
+		if err := pq.loadBlocked(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Blocked = []*Profile{} },
+			func(n *Profile, e *Profile) { n.Edges.Blocked = append(n.Edges.Blocked, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withReportsSent; query != nil {
+		if err := pq.loadReportsSent(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ReportsSent = []*Report{} },
+			func(n *Profile, e *Report) { n.Edges.ReportsSent = append(n.Edges.ReportsSent, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withReportsReceived; query != nil {
+		if err := pq.loadReportsReceived(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ReportsReceived = []*Report{} },
+			func(n *Profile, e *Report) { n.Edges.ReportsReceived = append(n.Edges.ReportsReceived, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withPhotos; query != nil {
 		if err := pq.loadPhotos(ctx, query, nodes,
 			func(n *Profile) { n.Edges.Photos = []*Image{} },
@@ -877,6 +1213,15 @@ func (pq *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 			return nil, err
 		}
 	}
+	if query := pq.withOrganizationMemberships; query != nil {
+		if err := pq.loadOrganizationMemberships(ctx, query, nodes,
+			func(n *Profile) { n.Edges.OrganizationMemberships = []*OrganizationMembership{} },
+			func(n *Profile, e *OrganizationMembership) {
+				n.Edges.OrganizationMemberships = append(n.Edges.OrganizationMemberships, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withFcmPushSubscriptions; query != nil {
 		if err := pq.loadFcmPushSubscriptions(ctx, query, nodes,
 			func(n *Profile) { n.Edges.FcmPushSubscriptions = []*FCMSubscriptions{} },
@@ -895,6 +1240,13 @@ func (pq *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 			return nil, err
 		}
 	}
+	if query := pq.withPushDeliveries; query != nil {
+		if err := pq.loadPushDeliveries(ctx, query, nodes,
+			func(n *Profile) { n.Edges.PushDeliveries = []*PushDelivery{} },
+			func(n *Profile, e *PushDelivery) { n.Edges.PushDeliveries = append(n.Edges.PushDeliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withNotificationPermissions; query != nil {
 		if err := pq.loadNotificationPermissions(ctx, query, nodes,
 			func(n *Profile) { n.Edges.NotificationPermissions = []*NotificationPermission{} },
@@ -1006,6 +1358,252 @@ func (pq *ProfileQuery) loadFriends(ctx context.Context, query *ProfileQuery, no
 	}
 	return nil
 }
+func (pq *ProfileQuery) loadSentFriendRequests(ctx context.Context, query *FriendRequestQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *FriendRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FriendRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.SentFriendRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_sent_friend_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_sent_friend_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_sent_friend_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadReceivedFriendRequests(ctx context.Context, query *FriendRequestQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *FriendRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FriendRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ReceivedFriendRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_received_friend_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_received_friend_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_received_friend_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadBlockedBy(ctx context.Context, query *ProfileQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Profile)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Profile)
+	nids := make(map[int]map[*Profile]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(profile.BlockedByTable)
+		s.Join(joinT).On(s.C(profile.FieldID), joinT.C(profile.BlockedByPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(profile.BlockedByPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(profile.BlockedByPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Profile]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Profile](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_by" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadBlocked(ctx context.Context, query *ProfileQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Profile)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Profile)
+	nids := make(map[int]map[*Profile]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(profile.BlockedTable)
+		s.Join(joinT).On(s.C(profile.FieldID), joinT.C(profile.BlockedPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(profile.BlockedPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(profile.BlockedPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Profile]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Profile](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadReportsSent(ctx context.Context, query *ReportQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Report)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ReportsSentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_reports_sent
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_reports_sent" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_reports_sent" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProfileQuery) loadReportsReceived(ctx context.Context, query *ReportQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Report)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ReportsReceivedColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.profile_reports_received
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_reports_received" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_reports_received" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (pq *ProfileQuery) loadPhotos(ctx context.Context, query *ImageQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Image)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Profile)
@@ -1131,6 +1729,36 @@ func (pq *ProfileQuery) loadInvitations(ctx context.Context, query *InvitationQu
 	}
 	return nil
 }
+func (pq *ProfileQuery) loadOrganizationMemberships(ctx context.Context, query *OrganizationMembershipQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *OrganizationMembership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(organizationmembership.FieldProfileID)
+	}
+	query.Where(predicate.OrganizationMembership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.OrganizationMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (pq *ProfileQuery) loadFcmPushSubscriptions(ctx context.Context, query *FCMSubscriptionsQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *FCMSubscriptions)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Profile)
@@ -1191,6 +1819,36 @@ func (pq *ProfileQuery) loadPwaPushSubscriptions(ctx context.Context, query *Pwa
 	}
 	return nil
 }
+func (pq *ProfileQuery) loadPushDeliveries(ctx context.Context, query *PushDeliveryQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *PushDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pushdelivery.FieldProfileID)
+	}
+	query.Where(predicate.PushDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.PushDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (pq *ProfileQuery) loadNotificationPermissions(ctx context.Context, query *NotificationPermissionQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *NotificationPermission)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Profile)
@@ -1273,9 +1931,12 @@ func (pq *ProfileQuery) loadPhoneVerificationCode(ctx context.Context, query *Ph
 	}
 	for _, n := range neighbors {
 		fk := n.ProfileID
-		node, ok := nodeids[fk]
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "profile_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
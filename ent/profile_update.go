@@ -12,16 +12,20 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/invitation"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/predicate"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
+	"github.com/mikestefanello/pagoda/ent/report"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
 	"github.com/mikestefanello/pagoda/ent/user"
 )
@@ -221,6 +225,96 @@ func (pu *ProfileUpdate) AddFriends(p ...*Profile) *ProfileUpdate {
 	return pu.AddFriendIDs(ids...)
 }
 
+// AddSentFriendRequestIDs adds the "sent_friend_requests" edge to the FriendRequest entity by IDs.
+func (pu *ProfileUpdate) AddSentFriendRequestIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddSentFriendRequestIDs(ids...)
+	return pu
+}
+
+// AddSentFriendRequests adds the "sent_friend_requests" edges to the FriendRequest entity.
+func (pu *ProfileUpdate) AddSentFriendRequests(f ...*FriendRequest) *ProfileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return pu.AddSentFriendRequestIDs(ids...)
+}
+
+// AddReceivedFriendRequestIDs adds the "received_friend_requests" edge to the FriendRequest entity by IDs.
+func (pu *ProfileUpdate) AddReceivedFriendRequestIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddReceivedFriendRequestIDs(ids...)
+	return pu
+}
+
+// AddReceivedFriendRequests adds the "received_friend_requests" edges to the FriendRequest entity.
+func (pu *ProfileUpdate) AddReceivedFriendRequests(f ...*FriendRequest) *ProfileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return pu.AddReceivedFriendRequestIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Profile entity by IDs.
+func (pu *ProfileUpdate) AddBlockedByIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddBlockedByIDs(ids...)
+	return pu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Profile entity.
+func (pu *ProfileUpdate) AddBlockedBy(p ...*Profile) *ProfileUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddBlockedByIDs(ids...)
+}
+
+// AddBlockedIDs adds the "blocked" edge to the Profile entity by IDs.
+func (pu *ProfileUpdate) AddBlockedIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddBlockedIDs(ids...)
+	return pu
+}
+
+// AddBlocked adds the "blocked" edges to the Profile entity.
+func (pu *ProfileUpdate) AddBlocked(p ...*Profile) *ProfileUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddBlockedIDs(ids...)
+}
+
+// AddReportsSentIDs adds the "reports_sent" edge to the Report entity by IDs.
+func (pu *ProfileUpdate) AddReportsSentIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddReportsSentIDs(ids...)
+	return pu
+}
+
+// AddReportsSent adds the "reports_sent" edges to the Report entity.
+func (pu *ProfileUpdate) AddReportsSent(r ...*Report) *ProfileUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddReportsSentIDs(ids...)
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by IDs.
+func (pu *ProfileUpdate) AddReportsReceivedIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddReportsReceivedIDs(ids...)
+	return pu
+}
+
+// AddReportsReceived adds the "reports_received" edges to the Report entity.
+func (pu *ProfileUpdate) AddReportsReceived(r ...*Report) *ProfileUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddReportsReceivedIDs(ids...)
+}
+
 // AddPhotoIDs adds the "photos" edge to the Image entity by IDs.
 func (pu *ProfileUpdate) AddPhotoIDs(ids ...int) *ProfileUpdate {
 	pu.mutation.AddPhotoIDs(ids...)
@@ -285,6 +379,21 @@ func (pu *ProfileUpdate) AddInvitations(i ...*Invitation) *ProfileUpdate {
 	return pu.AddInvitationIDs(ids...)
 }
 
+// AddOrganizationMembershipIDs adds the "organization_memberships" edge to the OrganizationMembership entity by IDs.
+func (pu *ProfileUpdate) AddOrganizationMembershipIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddOrganizationMembershipIDs(ids...)
+	return pu
+}
+
+// AddOrganizationMemberships adds the "organization_memberships" edges to the OrganizationMembership entity.
+func (pu *ProfileUpdate) AddOrganizationMemberships(o ...*OrganizationMembership) *ProfileUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.AddOrganizationMembershipIDs(ids...)
+}
+
 // AddFcmPushSubscriptionIDs adds the "fcm_push_subscriptions" edge to the FCMSubscriptions entity by IDs.
 func (pu *ProfileUpdate) AddFcmPushSubscriptionIDs(ids ...int) *ProfileUpdate {
 	pu.mutation.AddFcmPushSubscriptionIDs(ids...)
@@ -315,6 +424,21 @@ func (pu *ProfileUpdate) AddPwaPushSubscriptions(p ...*PwaPushSubscription) *Pro
 	return pu.AddPwaPushSubscriptionIDs(ids...)
 }
 
+// AddPushDeliveryIDs adds the "push_deliveries" edge to the PushDelivery entity by IDs.
+func (pu *ProfileUpdate) AddPushDeliveryIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.AddPushDeliveryIDs(ids...)
+	return pu
+}
+
+// AddPushDeliveries adds the "push_deliveries" edges to the PushDelivery entity.
+func (pu *ProfileUpdate) AddPushDeliveries(p ...*PushDelivery) *ProfileUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddPushDeliveryIDs(ids...)
+}
+
 // AddNotificationPermissionIDs adds the "notification_permissions" edge to the NotificationPermission entity by IDs.
 func (pu *ProfileUpdate) AddNotificationPermissionIDs(ids ...int) *ProfileUpdate {
 	pu.mutation.AddNotificationPermissionIDs(ids...)
@@ -427,6 +551,132 @@ func (pu *ProfileUpdate) RemoveFriends(p ...*Profile) *ProfileUpdate {
 	return pu.RemoveFriendIDs(ids...)
 }
 
+// ClearSentFriendRequests clears all "sent_friend_requests" edges to the FriendRequest entity.
+func (pu *ProfileUpdate) ClearSentFriendRequests() *ProfileUpdate {
+	pu.mutation.ClearSentFriendRequests()
+	return pu
+}
+
+// RemoveSentFriendRequestIDs removes the "sent_friend_requests" edge to FriendRequest entities by IDs.
+func (pu *ProfileUpdate) RemoveSentFriendRequestIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveSentFriendRequestIDs(ids...)
+	return pu
+}
+
+// RemoveSentFriendRequests removes "sent_friend_requests" edges to FriendRequest entities.
+func (pu *ProfileUpdate) RemoveSentFriendRequests(f ...*FriendRequest) *ProfileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return pu.RemoveSentFriendRequestIDs(ids...)
+}
+
+// ClearReceivedFriendRequests clears all "received_friend_requests" edges to the FriendRequest entity.
+func (pu *ProfileUpdate) ClearReceivedFriendRequests() *ProfileUpdate {
+	pu.mutation.ClearReceivedFriendRequests()
+	return pu
+}
+
+// RemoveReceivedFriendRequestIDs removes the "received_friend_requests" edge to FriendRequest entities by IDs.
+func (pu *ProfileUpdate) RemoveReceivedFriendRequestIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveReceivedFriendRequestIDs(ids...)
+	return pu
+}
+
+// RemoveReceivedFriendRequests removes "received_friend_requests" edges to FriendRequest entities.
+func (pu *ProfileUpdate) RemoveReceivedFriendRequests(f ...*FriendRequest) *ProfileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return pu.RemoveReceivedFriendRequestIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Profile entity.
+func (pu *ProfileUpdate) ClearBlockedBy() *ProfileUpdate {
+	pu.mutation.ClearBlockedBy()
+	return pu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Profile entities by IDs.
+func (pu *ProfileUpdate) RemoveBlockedByIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveBlockedByIDs(ids...)
+	return pu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Profile entities.
+func (pu *ProfileUpdate) RemoveBlockedBy(p ...*Profile) *ProfileUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearBlocked clears all "blocked" edges to the Profile entity.
+func (pu *ProfileUpdate) ClearBlocked() *ProfileUpdate {
+	pu.mutation.ClearBlocked()
+	return pu
+}
+
+// RemoveBlockedIDs removes the "blocked" edge to Profile entities by IDs.
+func (pu *ProfileUpdate) RemoveBlockedIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveBlockedIDs(ids...)
+	return pu
+}
+
+// RemoveBlocked removes "blocked" edges to Profile entities.
+func (pu *ProfileUpdate) RemoveBlocked(p ...*Profile) *ProfileUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveBlockedIDs(ids...)
+}
+
+// ClearReportsSent clears all "reports_sent" edges to the Report entity.
+func (pu *ProfileUpdate) ClearReportsSent() *ProfileUpdate {
+	pu.mutation.ClearReportsSent()
+	return pu
+}
+
+// RemoveReportsSentIDs removes the "reports_sent" edge to Report entities by IDs.
+func (pu *ProfileUpdate) RemoveReportsSentIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveReportsSentIDs(ids...)
+	return pu
+}
+
+// RemoveReportsSent removes "reports_sent" edges to Report entities.
+func (pu *ProfileUpdate) RemoveReportsSent(r ...*Report) *ProfileUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveReportsSentIDs(ids...)
+}
+
+// ClearReportsReceived clears all "reports_received" edges to the Report entity.
+func (pu *ProfileUpdate) ClearReportsReceived() *ProfileUpdate {
+	pu.mutation.ClearReportsReceived()
+	return pu
+}
+
+// RemoveReportsReceivedIDs removes the "reports_received" edge to Report entities by IDs.
+func (pu *ProfileUpdate) RemoveReportsReceivedIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveReportsReceivedIDs(ids...)
+	return pu
+}
+
+// RemoveReportsReceived removes "reports_received" edges to Report entities.
+func (pu *ProfileUpdate) RemoveReportsReceived(r ...*Report) *ProfileUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveReportsReceivedIDs(ids...)
+}
+
 // ClearPhotos clears all "photos" edges to the Image entity.
 func (pu *ProfileUpdate) ClearPhotos() *ProfileUpdate {
 	pu.mutation.ClearPhotos()
@@ -496,6 +746,27 @@ func (pu *ProfileUpdate) RemoveInvitations(i ...*Invitation) *ProfileUpdate {
 	return pu.RemoveInvitationIDs(ids...)
 }
 
+// ClearOrganizationMemberships clears all "organization_memberships" edges to the OrganizationMembership entity.
+func (pu *ProfileUpdate) ClearOrganizationMemberships() *ProfileUpdate {
+	pu.mutation.ClearOrganizationMemberships()
+	return pu
+}
+
+// RemoveOrganizationMembershipIDs removes the "organization_memberships" edge to OrganizationMembership entities by IDs.
+func (pu *ProfileUpdate) RemoveOrganizationMembershipIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemoveOrganizationMembershipIDs(ids...)
+	return pu
+}
+
+// RemoveOrganizationMemberships removes "organization_memberships" edges to OrganizationMembership entities.
+func (pu *ProfileUpdate) RemoveOrganizationMemberships(o ...*OrganizationMembership) *ProfileUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.RemoveOrganizationMembershipIDs(ids...)
+}
+
 // ClearFcmPushSubscriptions clears all "fcm_push_subscriptions" edges to the FCMSubscriptions entity.
 func (pu *ProfileUpdate) ClearFcmPushSubscriptions() *ProfileUpdate {
 	pu.mutation.ClearFcmPushSubscriptions()
@@ -538,6 +809,27 @@ func (pu *ProfileUpdate) RemovePwaPushSubscriptions(p ...*PwaPushSubscription) *
 	return pu.RemovePwaPushSubscriptionIDs(ids...)
 }
 
+// ClearPushDeliveries clears all "push_deliveries" edges to the PushDelivery entity.
+func (pu *ProfileUpdate) ClearPushDeliveries() *ProfileUpdate {
+	pu.mutation.ClearPushDeliveries()
+	return pu
+}
+
+// RemovePushDeliveryIDs removes the "push_deliveries" edge to PushDelivery entities by IDs.
+func (pu *ProfileUpdate) RemovePushDeliveryIDs(ids ...int) *ProfileUpdate {
+	pu.mutation.RemovePushDeliveryIDs(ids...)
+	return pu
+}
+
+// RemovePushDeliveries removes "push_deliveries" edges to PushDelivery entities.
+func (pu *ProfileUpdate) RemovePushDeliveries(p ...*PushDelivery) *ProfileUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePushDeliveryIDs(ids...)
+}
+
 // ClearNotificationPermissions clears all "notification_permissions" edges to the NotificationPermission entity.
 func (pu *ProfileUpdate) ClearNotificationPermissions() *ProfileUpdate {
 	pu.mutation.ClearNotificationPermissions()
@@ -801,28 +1093,28 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.PhotosCleared() {
+	if pu.mutation.SentFriendRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.PhotosTable,
-			Columns: []string{profile.PhotosColumn},
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedPhotosIDs(); len(nodes) > 0 && !pu.mutation.PhotosCleared() {
+	if nodes := pu.mutation.RemovedSentFriendRequestsIDs(); len(nodes) > 0 && !pu.mutation.SentFriendRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.PhotosTable,
-			Columns: []string{profile.PhotosColumn},
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -830,15 +1122,15 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.PhotosIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.SentFriendRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.PhotosTable,
-			Columns: []string{profile.PhotosColumn},
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -846,57 +1138,73 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.ProfileImageCleared() {
+	if pu.mutation.ReceivedFriendRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.ProfileImageTable,
-			Columns: []string{profile.ProfileImageColumn},
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.ProfileImageIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.RemovedReceivedFriendRequestsIDs(); len(nodes) > 0 && !pu.mutation.ReceivedFriendRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.ProfileImageTable,
-			Columns: []string{profile.ProfileImageColumn},
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if pu.mutation.NotificationsCleared() {
+	if nodes := pu.mutation.ReceivedFriendRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.NotificationsTable,
-			Columns: []string{profile.NotificationsColumn},
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !pu.mutation.NotificationsCleared() {
+	if nodes := pu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !pu.mutation.BlockedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   profile.NotificationsTable,
-			Columns: []string{profile.NotificationsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -904,15 +1212,15 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.NotificationsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.BlockedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   profile.NotificationsTable,
-			Columns: []string{profile.NotificationsColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -920,28 +1228,28 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.InvitationsCleared() {
+	if pu.mutation.BlockedCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   profile.InvitationsTable,
-			Columns: []string{profile.InvitationsColumn},
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !pu.mutation.InvitationsCleared() {
+	if nodes := pu.mutation.RemovedBlockedIDs(); len(nodes) > 0 && !pu.mutation.BlockedCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   profile.InvitationsTable,
-			Columns: []string{profile.InvitationsColumn},
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -949,15 +1257,15 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.InvitationsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.BlockedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   profile.InvitationsTable,
-			Columns: []string{profile.InvitationsColumn},
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -965,28 +1273,28 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.FcmPushSubscriptionsCleared() {
+	if pu.mutation.ReportsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.FcmPushSubscriptionsTable,
-			Columns: []string{profile.FcmPushSubscriptionsColumn},
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(fcmsubscriptions.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedFcmPushSubscriptionsIDs(); len(nodes) > 0 && !pu.mutation.FcmPushSubscriptionsCleared() {
+	if nodes := pu.mutation.RemovedReportsSentIDs(); len(nodes) > 0 && !pu.mutation.ReportsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.FcmPushSubscriptionsTable,
-			Columns: []string{profile.FcmPushSubscriptionsColumn},
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(fcmsubscriptions.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -994,15 +1302,15 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.FcmPushSubscriptionsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.ReportsSentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.FcmPushSubscriptionsTable,
-			Columns: []string{profile.FcmPushSubscriptionsColumn},
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(fcmsubscriptions.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1010,28 +1318,28 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.PwaPushSubscriptionsCleared() {
+	if pu.mutation.ReportsReceivedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.PwaPushSubscriptionsTable,
-			Columns: []string{profile.PwaPushSubscriptionsColumn},
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pwapushsubscription.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedPwaPushSubscriptionsIDs(); len(nodes) > 0 && !pu.mutation.PwaPushSubscriptionsCleared() {
+	if nodes := pu.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !pu.mutation.ReportsReceivedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.PwaPushSubscriptionsTable,
-			Columns: []string{profile.PwaPushSubscriptionsColumn},
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pwapushsubscription.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1039,15 +1347,359 @@ func (pu *ProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.PwaPushSubscriptionsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   profile.PwaPushSubscriptionsTable,
-			Columns: []string{profile.PwaPushSubscriptionsColumn},
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pwapushsubscription.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PhotosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PhotosTable,
+			Columns: []string{profile.PhotosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPhotosIDs(); len(nodes) > 0 && !pu.mutation.PhotosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PhotosTable,
+			Columns: []string{profile.PhotosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PhotosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PhotosTable,
+			Columns: []string{profile.PhotosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ProfileImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   profile.ProfileImageTable,
+			Columns: []string{profile.ProfileImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProfileImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   profile.ProfileImageTable,
+			Columns: []string{profile.ProfileImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.NotificationsTable,
+			Columns: []string{profile.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !pu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.NotificationsTable,
+			Columns: []string{profile.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.NotificationsTable,
+			Columns: []string{profile.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.InvitationsTable,
+			Columns: []string{profile.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !pu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.InvitationsTable,
+			Columns: []string{profile.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.InvitationsTable,
+			Columns: []string{profile.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.OrganizationMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedOrganizationMembershipsIDs(); len(nodes) > 0 && !pu.mutation.OrganizationMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OrganizationMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.FcmPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.FcmPushSubscriptionsTable,
+			Columns: []string{profile.FcmPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fcmsubscriptions.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedFcmPushSubscriptionsIDs(); len(nodes) > 0 && !pu.mutation.FcmPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.FcmPushSubscriptionsTable,
+			Columns: []string{profile.FcmPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fcmsubscriptions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.FcmPushSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.FcmPushSubscriptionsTable,
+			Columns: []string{profile.FcmPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fcmsubscriptions.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PwaPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PwaPushSubscriptionsTable,
+			Columns: []string{profile.PwaPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pwapushsubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPwaPushSubscriptionsIDs(); len(nodes) > 0 && !pu.mutation.PwaPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PwaPushSubscriptionsTable,
+			Columns: []string{profile.PwaPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pwapushsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PwaPushSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PwaPushSubscriptionsTable,
+			Columns: []string{profile.PwaPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pwapushsubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PushDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPushDeliveriesIDs(); len(nodes) > 0 && !pu.mutation.PushDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PushDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1511,6 +2163,96 @@ func (puo *ProfileUpdateOne) AddFriends(p ...*Profile) *ProfileUpdateOne {
 	return puo.AddFriendIDs(ids...)
 }
 
+// AddSentFriendRequestIDs adds the "sent_friend_requests" edge to the FriendRequest entity by IDs.
+func (puo *ProfileUpdateOne) AddSentFriendRequestIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddSentFriendRequestIDs(ids...)
+	return puo
+}
+
+// AddSentFriendRequests adds the "sent_friend_requests" edges to the FriendRequest entity.
+func (puo *ProfileUpdateOne) AddSentFriendRequests(f ...*FriendRequest) *ProfileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return puo.AddSentFriendRequestIDs(ids...)
+}
+
+// AddReceivedFriendRequestIDs adds the "received_friend_requests" edge to the FriendRequest entity by IDs.
+func (puo *ProfileUpdateOne) AddReceivedFriendRequestIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddReceivedFriendRequestIDs(ids...)
+	return puo
+}
+
+// AddReceivedFriendRequests adds the "received_friend_requests" edges to the FriendRequest entity.
+func (puo *ProfileUpdateOne) AddReceivedFriendRequests(f ...*FriendRequest) *ProfileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return puo.AddReceivedFriendRequestIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Profile entity by IDs.
+func (puo *ProfileUpdateOne) AddBlockedByIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddBlockedByIDs(ids...)
+	return puo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Profile entity.
+func (puo *ProfileUpdateOne) AddBlockedBy(p ...*Profile) *ProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddBlockedByIDs(ids...)
+}
+
+// AddBlockedIDs adds the "blocked" edge to the Profile entity by IDs.
+func (puo *ProfileUpdateOne) AddBlockedIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddBlockedIDs(ids...)
+	return puo
+}
+
+// AddBlocked adds the "blocked" edges to the Profile entity.
+func (puo *ProfileUpdateOne) AddBlocked(p ...*Profile) *ProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddBlockedIDs(ids...)
+}
+
+// AddReportsSentIDs adds the "reports_sent" edge to the Report entity by IDs.
+func (puo *ProfileUpdateOne) AddReportsSentIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddReportsSentIDs(ids...)
+	return puo
+}
+
+// AddReportsSent adds the "reports_sent" edges to the Report entity.
+func (puo *ProfileUpdateOne) AddReportsSent(r ...*Report) *ProfileUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddReportsSentIDs(ids...)
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by IDs.
+func (puo *ProfileUpdateOne) AddReportsReceivedIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddReportsReceivedIDs(ids...)
+	return puo
+}
+
+// AddReportsReceived adds the "reports_received" edges to the Report entity.
+func (puo *ProfileUpdateOne) AddReportsReceived(r ...*Report) *ProfileUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddReportsReceivedIDs(ids...)
+}
+
 // AddPhotoIDs adds the "photos" edge to the Image entity by IDs.
 func (puo *ProfileUpdateOne) AddPhotoIDs(ids ...int) *ProfileUpdateOne {
 	puo.mutation.AddPhotoIDs(ids...)
@@ -1575,6 +2317,21 @@ func (puo *ProfileUpdateOne) AddInvitations(i ...*Invitation) *ProfileUpdateOne
 	return puo.AddInvitationIDs(ids...)
 }
 
+// AddOrganizationMembershipIDs adds the "organization_memberships" edge to the OrganizationMembership entity by IDs.
+func (puo *ProfileUpdateOne) AddOrganizationMembershipIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddOrganizationMembershipIDs(ids...)
+	return puo
+}
+
+// AddOrganizationMemberships adds the "organization_memberships" edges to the OrganizationMembership entity.
+func (puo *ProfileUpdateOne) AddOrganizationMemberships(o ...*OrganizationMembership) *ProfileUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.AddOrganizationMembershipIDs(ids...)
+}
+
 // AddFcmPushSubscriptionIDs adds the "fcm_push_subscriptions" edge to the FCMSubscriptions entity by IDs.
 func (puo *ProfileUpdateOne) AddFcmPushSubscriptionIDs(ids ...int) *ProfileUpdateOne {
 	puo.mutation.AddFcmPushSubscriptionIDs(ids...)
@@ -1605,6 +2362,21 @@ func (puo *ProfileUpdateOne) AddPwaPushSubscriptions(p ...*PwaPushSubscription)
 	return puo.AddPwaPushSubscriptionIDs(ids...)
 }
 
+// AddPushDeliveryIDs adds the "push_deliveries" edge to the PushDelivery entity by IDs.
+func (puo *ProfileUpdateOne) AddPushDeliveryIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.AddPushDeliveryIDs(ids...)
+	return puo
+}
+
+// AddPushDeliveries adds the "push_deliveries" edges to the PushDelivery entity.
+func (puo *ProfileUpdateOne) AddPushDeliveries(p ...*PushDelivery) *ProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddPushDeliveryIDs(ids...)
+}
+
 // AddNotificationPermissionIDs adds the "notification_permissions" edge to the NotificationPermission entity by IDs.
 func (puo *ProfileUpdateOne) AddNotificationPermissionIDs(ids ...int) *ProfileUpdateOne {
 	puo.mutation.AddNotificationPermissionIDs(ids...)
@@ -1717,6 +2489,132 @@ func (puo *ProfileUpdateOne) RemoveFriends(p ...*Profile) *ProfileUpdateOne {
 	return puo.RemoveFriendIDs(ids...)
 }
 
+// ClearSentFriendRequests clears all "sent_friend_requests" edges to the FriendRequest entity.
+func (puo *ProfileUpdateOne) ClearSentFriendRequests() *ProfileUpdateOne {
+	puo.mutation.ClearSentFriendRequests()
+	return puo
+}
+
+// RemoveSentFriendRequestIDs removes the "sent_friend_requests" edge to FriendRequest entities by IDs.
+func (puo *ProfileUpdateOne) RemoveSentFriendRequestIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveSentFriendRequestIDs(ids...)
+	return puo
+}
+
+// RemoveSentFriendRequests removes "sent_friend_requests" edges to FriendRequest entities.
+func (puo *ProfileUpdateOne) RemoveSentFriendRequests(f ...*FriendRequest) *ProfileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return puo.RemoveSentFriendRequestIDs(ids...)
+}
+
+// ClearReceivedFriendRequests clears all "received_friend_requests" edges to the FriendRequest entity.
+func (puo *ProfileUpdateOne) ClearReceivedFriendRequests() *ProfileUpdateOne {
+	puo.mutation.ClearReceivedFriendRequests()
+	return puo
+}
+
+// RemoveReceivedFriendRequestIDs removes the "received_friend_requests" edge to FriendRequest entities by IDs.
+func (puo *ProfileUpdateOne) RemoveReceivedFriendRequestIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveReceivedFriendRequestIDs(ids...)
+	return puo
+}
+
+// RemoveReceivedFriendRequests removes "received_friend_requests" edges to FriendRequest entities.
+func (puo *ProfileUpdateOne) RemoveReceivedFriendRequests(f ...*FriendRequest) *ProfileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return puo.RemoveReceivedFriendRequestIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Profile entity.
+func (puo *ProfileUpdateOne) ClearBlockedBy() *ProfileUpdateOne {
+	puo.mutation.ClearBlockedBy()
+	return puo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Profile entities by IDs.
+func (puo *ProfileUpdateOne) RemoveBlockedByIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveBlockedByIDs(ids...)
+	return puo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Profile entities.
+func (puo *ProfileUpdateOne) RemoveBlockedBy(p ...*Profile) *ProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearBlocked clears all "blocked" edges to the Profile entity.
+func (puo *ProfileUpdateOne) ClearBlocked() *ProfileUpdateOne {
+	puo.mutation.ClearBlocked()
+	return puo
+}
+
+// RemoveBlockedIDs removes the "blocked" edge to Profile entities by IDs.
+func (puo *ProfileUpdateOne) RemoveBlockedIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveBlockedIDs(ids...)
+	return puo
+}
+
+// RemoveBlocked removes "blocked" edges to Profile entities.
+func (puo *ProfileUpdateOne) RemoveBlocked(p ...*Profile) *ProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveBlockedIDs(ids...)
+}
+
+// ClearReportsSent clears all "reports_sent" edges to the Report entity.
+func (puo *ProfileUpdateOne) ClearReportsSent() *ProfileUpdateOne {
+	puo.mutation.ClearReportsSent()
+	return puo
+}
+
+// RemoveReportsSentIDs removes the "reports_sent" edge to Report entities by IDs.
+func (puo *ProfileUpdateOne) RemoveReportsSentIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveReportsSentIDs(ids...)
+	return puo
+}
+
+// RemoveReportsSent removes "reports_sent" edges to Report entities.
+func (puo *ProfileUpdateOne) RemoveReportsSent(r ...*Report) *ProfileUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveReportsSentIDs(ids...)
+}
+
+// ClearReportsReceived clears all "reports_received" edges to the Report entity.
+func (puo *ProfileUpdateOne) ClearReportsReceived() *ProfileUpdateOne {
+	puo.mutation.ClearReportsReceived()
+	return puo
+}
+
+// RemoveReportsReceivedIDs removes the "reports_received" edge to Report entities by IDs.
+func (puo *ProfileUpdateOne) RemoveReportsReceivedIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveReportsReceivedIDs(ids...)
+	return puo
+}
+
+// RemoveReportsReceived removes "reports_received" edges to Report entities.
+func (puo *ProfileUpdateOne) RemoveReportsReceived(r ...*Report) *ProfileUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveReportsReceivedIDs(ids...)
+}
+
 // ClearPhotos clears all "photos" edges to the Image entity.
 func (puo *ProfileUpdateOne) ClearPhotos() *ProfileUpdateOne {
 	puo.mutation.ClearPhotos()
@@ -1786,6 +2684,27 @@ func (puo *ProfileUpdateOne) RemoveInvitations(i ...*Invitation) *ProfileUpdateO
 	return puo.RemoveInvitationIDs(ids...)
 }
 
+// ClearOrganizationMemberships clears all "organization_memberships" edges to the OrganizationMembership entity.
+func (puo *ProfileUpdateOne) ClearOrganizationMemberships() *ProfileUpdateOne {
+	puo.mutation.ClearOrganizationMemberships()
+	return puo
+}
+
+// RemoveOrganizationMembershipIDs removes the "organization_memberships" edge to OrganizationMembership entities by IDs.
+func (puo *ProfileUpdateOne) RemoveOrganizationMembershipIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemoveOrganizationMembershipIDs(ids...)
+	return puo
+}
+
+// RemoveOrganizationMemberships removes "organization_memberships" edges to OrganizationMembership entities.
+func (puo *ProfileUpdateOne) RemoveOrganizationMemberships(o ...*OrganizationMembership) *ProfileUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.RemoveOrganizationMembershipIDs(ids...)
+}
+
 // ClearFcmPushSubscriptions clears all "fcm_push_subscriptions" edges to the FCMSubscriptions entity.
 func (puo *ProfileUpdateOne) ClearFcmPushSubscriptions() *ProfileUpdateOne {
 	puo.mutation.ClearFcmPushSubscriptions()
@@ -1828,6 +2747,27 @@ func (puo *ProfileUpdateOne) RemovePwaPushSubscriptions(p ...*PwaPushSubscriptio
 	return puo.RemovePwaPushSubscriptionIDs(ids...)
 }
 
+// ClearPushDeliveries clears all "push_deliveries" edges to the PushDelivery entity.
+func (puo *ProfileUpdateOne) ClearPushDeliveries() *ProfileUpdateOne {
+	puo.mutation.ClearPushDeliveries()
+	return puo
+}
+
+// RemovePushDeliveryIDs removes the "push_deliveries" edge to PushDelivery entities by IDs.
+func (puo *ProfileUpdateOne) RemovePushDeliveryIDs(ids ...int) *ProfileUpdateOne {
+	puo.mutation.RemovePushDeliveryIDs(ids...)
+	return puo
+}
+
+// RemovePushDeliveries removes "push_deliveries" edges to PushDelivery entities.
+func (puo *ProfileUpdateOne) RemovePushDeliveries(p ...*PushDelivery) *ProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePushDeliveryIDs(ids...)
+}
+
 // ClearNotificationPermissions clears all "notification_permissions" edges to the NotificationPermission entity.
 func (puo *ProfileUpdateOne) ClearNotificationPermissions() *ProfileUpdateOne {
 	puo.mutation.ClearNotificationPermissions()
@@ -2052,50 +2992,230 @@ func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err e
 	if value, ok := puo.mutation.FullyOnboarded(); ok {
 		_spec.SetField(profile.FieldFullyOnboarded, field.TypeBool, value)
 	}
-	if value, ok := puo.mutation.PhoneNumberE164(); ok {
-		_spec.SetField(profile.FieldPhoneNumberE164, field.TypeString, value)
+	if value, ok := puo.mutation.PhoneNumberE164(); ok {
+		_spec.SetField(profile.FieldPhoneNumberE164, field.TypeString, value)
+	}
+	if puo.mutation.PhoneNumberE164Cleared() {
+		_spec.ClearField(profile.FieldPhoneNumberE164, field.TypeString)
+	}
+	if value, ok := puo.mutation.CountryCode(); ok {
+		_spec.SetField(profile.FieldCountryCode, field.TypeString, value)
+	}
+	if puo.mutation.CountryCodeCleared() {
+		_spec.ClearField(profile.FieldCountryCode, field.TypeString)
+	}
+	if value, ok := puo.mutation.PhoneVerified(); ok {
+		_spec.SetField(profile.FieldPhoneVerified, field.TypeBool, value)
+	}
+	if puo.mutation.PhoneVerifiedCleared() {
+		_spec.ClearField(profile.FieldPhoneVerified, field.TypeBool)
+	}
+	if value, ok := puo.mutation.StripeID(); ok {
+		_spec.SetField(profile.FieldStripeID, field.TypeString, value)
+	}
+	if puo.mutation.StripeIDCleared() {
+		_spec.ClearField(profile.FieldStripeID, field.TypeString)
+	}
+	if puo.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.FriendsTable,
+			Columns: profile.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedFriendsIDs(); len(nodes) > 0 && !puo.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.FriendsTable,
+			Columns: profile.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.FriendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   profile.FriendsTable,
+			Columns: profile.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.SentFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSentFriendRequestsIDs(); len(nodes) > 0 && !puo.mutation.SentFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if puo.mutation.PhoneNumberE164Cleared() {
-		_spec.ClearField(profile.FieldPhoneNumberE164, field.TypeString)
+	if nodes := puo.mutation.SentFriendRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.SentFriendRequestsTable,
+			Columns: []string{profile.SentFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := puo.mutation.CountryCode(); ok {
-		_spec.SetField(profile.FieldCountryCode, field.TypeString, value)
+	if puo.mutation.ReceivedFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if puo.mutation.CountryCodeCleared() {
-		_spec.ClearField(profile.FieldCountryCode, field.TypeString)
+	if nodes := puo.mutation.RemovedReceivedFriendRequestsIDs(); len(nodes) > 0 && !puo.mutation.ReceivedFriendRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.PhoneVerified(); ok {
-		_spec.SetField(profile.FieldPhoneVerified, field.TypeBool, value)
+	if nodes := puo.mutation.ReceivedFriendRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReceivedFriendRequestsTable,
+			Columns: []string{profile.ReceivedFriendRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.PhoneVerifiedCleared() {
-		_spec.ClearField(profile.FieldPhoneVerified, field.TypeBool)
+	if puo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.StripeID(); ok {
-		_spec.SetField(profile.FieldStripeID, field.TypeString, value)
+	if nodes := puo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !puo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if puo.mutation.StripeIDCleared() {
-		_spec.ClearField(profile.FieldStripeID, field.TypeString)
+	if nodes := puo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   profile.BlockedByTable,
+			Columns: profile.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.FriendsCleared() {
+	if puo.mutation.BlockedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   profile.FriendsTable,
-			Columns: profile.FriendsPrimaryKey,
-			Bidi:    true,
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.RemovedFriendsIDs(); len(nodes) > 0 && !puo.mutation.FriendsCleared() {
+	if nodes := puo.mutation.RemovedBlockedIDs(); len(nodes) > 0 && !puo.mutation.BlockedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   profile.FriendsTable,
-			Columns: profile.FriendsPrimaryKey,
-			Bidi:    true,
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
@@ -2105,13 +3225,13 @@ func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.FriendsIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.BlockedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: false,
-			Table:   profile.FriendsTable,
-			Columns: profile.FriendsPrimaryKey,
-			Bidi:    true,
+			Table:   profile.BlockedTable,
+			Columns: profile.BlockedPrimaryKey,
+			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeInt),
 			},
@@ -2121,6 +3241,96 @@ func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.ReportsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReportsSentIDs(); len(nodes) > 0 && !puo.mutation.ReportsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReportsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsSentTable,
+			Columns: []string{profile.ReportsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !puo.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ReportsReceivedTable,
+			Columns: []string{profile.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.PhotosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2285,6 +3495,51 @@ func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.OrganizationMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedOrganizationMembershipsIDs(); len(nodes) > 0 && !puo.mutation.OrganizationMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrganizationMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.OrganizationMembershipsTable,
+			Columns: []string{profile.OrganizationMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.FcmPushSubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2375,6 +3630,51 @@ func (puo *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.PushDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPushDeliveriesIDs(); len(nodes) > 0 && !puo.mutation.PushDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PushDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.PushDeliveriesTable,
+			Columns: []string{profile.PushDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.NotificationPermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
+)
+
+// PushDeliveryDelete is the builder for deleting a PushDelivery entity.
+type PushDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *PushDeliveryMutation
+}
+
+// Where appends a list predicates to the PushDeliveryDelete builder.
+func (pdd *PushDeliveryDelete) Where(ps ...predicate.PushDelivery) *PushDeliveryDelete {
+	pdd.mutation.Where(ps...)
+	return pdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pdd *PushDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pdd.sqlExec, pdd.mutation, pdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdd *PushDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := pdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pdd *PushDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pushdelivery.Table, sqlgraph.NewFieldSpec(pushdelivery.FieldID, field.TypeInt))
+	if ps := pdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pdd.mutation.done = true
+	return affected, err
+}
+
+// PushDeliveryDeleteOne is the builder for deleting a single PushDelivery entity.
+type PushDeliveryDeleteOne struct {
+	pdd *PushDeliveryDelete
+}
+
+// Where appends a list predicates to the PushDeliveryDelete builder.
+func (pddo *PushDeliveryDeleteOne) Where(ps ...predicate.PushDelivery) *PushDeliveryDeleteOne {
+	pddo.pdd.mutation.Where(ps...)
+	return pddo
+}
+
+// Exec executes the deletion query.
+func (pddo *PushDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := pddo.pdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pushdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pddo *PushDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := pddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
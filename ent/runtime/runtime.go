@@ -5,32 +5,106 @@ package runtime
 import (
 	"time"
 
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/auditlog"
 	"github.com/mikestefanello/pagoda/ent/emailsubscription"
 	"github.com/mikestefanello/pagoda/ent/emailsubscriptiontype"
 	"github.com/mikestefanello/pagoda/ent/emojis"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
 	"github.com/mikestefanello/pagoda/ent/filestorage"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/ent/identity"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/imagesize"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
 	"github.com/mikestefanello/pagoda/ent/invitation"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
+	"github.com/mikestefanello/pagoda/ent/organization"
+	"github.com/mikestefanello/pagoda/ent/organizationinvitation"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/passkey"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/ent/role"
 	"github.com/mikestefanello/pagoda/ent/schema"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
+	"github.com/mikestefanello/pagoda/ent/smsmessage"
+	"github.com/mikestefanello/pagoda/ent/throttleattempt"
+	"github.com/mikestefanello/pagoda/ent/throttlelock"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/usersession"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apitokenMixin := schema.APIToken{}.Mixin()
+	apitokenMixinFields0 := apitokenMixin[0].Fields()
+	_ = apitokenMixinFields0
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenMixinFields0[0].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	// apitokenDescUpdatedAt is the schema descriptor for updated_at field.
+	apitokenDescUpdatedAt := apitokenMixinFields0[1].Descriptor()
+	// apitoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apitoken.DefaultUpdatedAt = apitokenDescUpdatedAt.Default.(func() time.Time)
+	// apitoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apitoken.UpdateDefaultUpdatedAt = apitokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apitokenDescName is the schema descriptor for name field.
+	apitokenDescName := apitokenFields[0].Descriptor()
+	// apitoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apitoken.NameValidator = apitokenDescName.Validators[0].(func(string) error)
+	// apitokenDescTokenHash is the schema descriptor for token_hash field.
+	apitokenDescTokenHash := apitokenFields[1].Descriptor()
+	// apitoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	apitoken.TokenHashValidator = apitokenDescTokenHash.Validators[0].(func(string) error)
+	// apitokenDescHint is the schema descriptor for hint field.
+	apitokenDescHint := apitokenFields[2].Descriptor()
+	// apitoken.DefaultHint holds the default value on creation for the hint field.
+	apitoken.DefaultHint = apitokenDescHint.Default.(string)
+	auditlogHooks := schema.AuditLog{}.Hooks()
+	auditlog.Hooks[0] = auditlogHooks[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[0].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[3].Descriptor()
+	// auditlog.DefaultTargetType holds the default value on creation for the target_type field.
+	auditlog.DefaultTargetType = auditlogDescTargetType.Default.(string)
+	// auditlogDescIPAddress is the schema descriptor for ip_address field.
+	auditlogDescIPAddress := auditlogFields[5].Descriptor()
+	// auditlog.DefaultIPAddress holds the default value on creation for the ip_address field.
+	auditlog.DefaultIPAddress = auditlogDescIPAddress.Default.(string)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[6].Descriptor()
+	// auditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditlog.DefaultUserAgent = auditlogDescUserAgent.Default.(string)
+	// auditlogDescRequestID is the schema descriptor for request_id field.
+	auditlogDescRequestID := auditlogFields[7].Descriptor()
+	// auditlog.DefaultRequestID holds the default value on creation for the request_id field.
+	auditlog.DefaultRequestID = auditlogDescRequestID.Default.(string)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[9].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
 	emailsubscriptionMixin := schema.EmailSubscription{}.Mixin()
 	emailsubscriptionMixinFields0 := emailsubscriptionMixin[0].Fields()
 	_ = emailsubscriptionMixinFields0
@@ -129,6 +203,44 @@ func init() {
 	filestorageDescObjectKey := filestorageFields[1].Descriptor()
 	// filestorage.ObjectKeyValidator is a validator for the "object_key" field. It is called by the builders before save.
 	filestorage.ObjectKeyValidator = filestorageDescObjectKey.Validators[0].(func(string) error)
+	friendrequestMixin := schema.FriendRequest{}.Mixin()
+	friendrequestMixinFields0 := friendrequestMixin[0].Fields()
+	_ = friendrequestMixinFields0
+	friendrequestFields := schema.FriendRequest{}.Fields()
+	_ = friendrequestFields
+	// friendrequestDescCreatedAt is the schema descriptor for created_at field.
+	friendrequestDescCreatedAt := friendrequestMixinFields0[0].Descriptor()
+	// friendrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	friendrequest.DefaultCreatedAt = friendrequestDescCreatedAt.Default.(func() time.Time)
+	// friendrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	friendrequestDescUpdatedAt := friendrequestMixinFields0[1].Descriptor()
+	// friendrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	friendrequest.DefaultUpdatedAt = friendrequestDescUpdatedAt.Default.(func() time.Time)
+	// friendrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	friendrequest.UpdateDefaultUpdatedAt = friendrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	identityMixin := schema.Identity{}.Mixin()
+	identityMixinFields0 := identityMixin[0].Fields()
+	_ = identityMixinFields0
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityMixinFields0[0].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescUpdatedAt is the schema descriptor for updated_at field.
+	identityDescUpdatedAt := identityMixinFields0[1].Descriptor()
+	// identity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	identity.DefaultUpdatedAt = identityDescUpdatedAt.Default.(func() time.Time)
+	// identity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	identity.UpdateDefaultUpdatedAt = identityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[0].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = identityDescProvider.Validators[0].(func(string) error)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[1].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
 	imageMixin := schema.Image{}.Mixin()
 	imageMixinFields0 := imageMixin[0].Fields()
 	_ = imageMixinFields0
@@ -167,6 +279,33 @@ func init() {
 	imagesizeDescHeight := imagesizeFields[2].Descriptor()
 	// imagesize.HeightValidator is a validator for the "height" field. It is called by the builders before save.
 	imagesize.HeightValidator = imagesizeDescHeight.Validators[0].(func(int) error)
+	impersonationMixin := schema.Impersonation{}.Mixin()
+	impersonationMixinFields0 := impersonationMixin[0].Fields()
+	_ = impersonationMixinFields0
+	impersonationFields := schema.Impersonation{}.Fields()
+	_ = impersonationFields
+	// impersonationDescCreatedAt is the schema descriptor for created_at field.
+	impersonationDescCreatedAt := impersonationMixinFields0[0].Descriptor()
+	// impersonation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	impersonation.DefaultCreatedAt = impersonationDescCreatedAt.Default.(func() time.Time)
+	// impersonationDescUpdatedAt is the schema descriptor for updated_at field.
+	impersonationDescUpdatedAt := impersonationMixinFields0[1].Descriptor()
+	// impersonation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	impersonation.DefaultUpdatedAt = impersonationDescUpdatedAt.Default.(func() time.Time)
+	// impersonation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	impersonation.UpdateDefaultUpdatedAt = impersonationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// impersonationDescReason is the schema descriptor for reason field.
+	impersonationDescReason := impersonationFields[0].Descriptor()
+	// impersonation.DefaultReason holds the default value on creation for the reason field.
+	impersonation.DefaultReason = impersonationDescReason.Default.(string)
+	// impersonationDescIPAddress is the schema descriptor for ip_address field.
+	impersonationDescIPAddress := impersonationFields[1].Descriptor()
+	// impersonation.DefaultIPAddress holds the default value on creation for the ip_address field.
+	impersonation.DefaultIPAddress = impersonationDescIPAddress.Default.(string)
+	// impersonationDescUserAgent is the schema descriptor for user_agent field.
+	impersonationDescUserAgent := impersonationFields[2].Descriptor()
+	// impersonation.DefaultUserAgent holds the default value on creation for the user_agent field.
+	impersonation.DefaultUserAgent = impersonationDescUserAgent.Default.(string)
 	invitationMixin := schema.Invitation{}.Mixin()
 	invitationMixinFields0 := invitationMixin[0].Fields()
 	_ = invitationMixinFields0
@@ -196,6 +335,25 @@ func init() {
 	lastseenonlineDescSeenAt := lastseenonlineFields[0].Descriptor()
 	// lastseenonline.DefaultSeenAt holds the default value on creation for the seen_at field.
 	lastseenonline.DefaultSeenAt = lastseenonlineDescSeenAt.Default.(func() time.Time)
+	magiclinktokenMixin := schema.MagicLinkToken{}.Mixin()
+	magiclinktokenMixinFields0 := magiclinktokenMixin[0].Fields()
+	_ = magiclinktokenMixinFields0
+	magiclinktokenFields := schema.MagicLinkToken{}.Fields()
+	_ = magiclinktokenFields
+	// magiclinktokenDescCreatedAt is the schema descriptor for created_at field.
+	magiclinktokenDescCreatedAt := magiclinktokenMixinFields0[0].Descriptor()
+	// magiclinktoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	magiclinktoken.DefaultCreatedAt = magiclinktokenDescCreatedAt.Default.(func() time.Time)
+	// magiclinktokenDescUpdatedAt is the schema descriptor for updated_at field.
+	magiclinktokenDescUpdatedAt := magiclinktokenMixinFields0[1].Descriptor()
+	// magiclinktoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	magiclinktoken.DefaultUpdatedAt = magiclinktokenDescUpdatedAt.Default.(func() time.Time)
+	// magiclinktoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	magiclinktoken.UpdateDefaultUpdatedAt = magiclinktokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// magiclinktokenDescTokenHash is the schema descriptor for token_hash field.
+	magiclinktokenDescTokenHash := magiclinktokenFields[0].Descriptor()
+	// magiclinktoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	magiclinktoken.TokenHashValidator = magiclinktokenDescTokenHash.Validators[0].(func(string) error)
 	monthlysubscriptionMixin := schema.MonthlySubscription{}.Mixin()
 	monthlysubscriptionMixinFields0 := monthlysubscriptionMixin[0].Fields()
 	_ = monthlysubscriptionMixinFields0
@@ -296,6 +454,116 @@ func init() {
 			return nil
 		}
 	}()
+	organizationMixin := schema.Organization{}.Mixin()
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	_ = organizationMixinFields0
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationMixinFields0[0].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationMixinFields0[1].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = func() func(string) error {
+		validators := organizationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	organizationinvitationMixin := schema.OrganizationInvitation{}.Mixin()
+	organizationinvitationMixinFields0 := organizationinvitationMixin[0].Fields()
+	_ = organizationinvitationMixinFields0
+	organizationinvitationFields := schema.OrganizationInvitation{}.Fields()
+	_ = organizationinvitationFields
+	// organizationinvitationDescCreatedAt is the schema descriptor for created_at field.
+	organizationinvitationDescCreatedAt := organizationinvitationMixinFields0[0].Descriptor()
+	// organizationinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationinvitation.DefaultCreatedAt = organizationinvitationDescCreatedAt.Default.(func() time.Time)
+	// organizationinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationinvitationDescUpdatedAt := organizationinvitationMixinFields0[1].Descriptor()
+	// organizationinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organizationinvitation.DefaultUpdatedAt = organizationinvitationDescUpdatedAt.Default.(func() time.Time)
+	// organizationinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organizationinvitation.UpdateDefaultUpdatedAt = organizationinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationinvitationDescEmail is the schema descriptor for email field.
+	organizationinvitationDescEmail := organizationinvitationFields[0].Descriptor()
+	// organizationinvitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	organizationinvitation.EmailValidator = organizationinvitationDescEmail.Validators[0].(func(string) error)
+	// organizationinvitationDescToken is the schema descriptor for token field.
+	organizationinvitationDescToken := organizationinvitationFields[2].Descriptor()
+	// organizationinvitation.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	organizationinvitation.TokenValidator = organizationinvitationDescToken.Validators[0].(func(string) error)
+	organizationmembershipMixin := schema.OrganizationMembership{}.Mixin()
+	organizationmembershipMixinFields0 := organizationmembershipMixin[0].Fields()
+	_ = organizationmembershipMixinFields0
+	organizationmembershipFields := schema.OrganizationMembership{}.Fields()
+	_ = organizationmembershipFields
+	// organizationmembershipDescCreatedAt is the schema descriptor for created_at field.
+	organizationmembershipDescCreatedAt := organizationmembershipMixinFields0[0].Descriptor()
+	// organizationmembership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationmembership.DefaultCreatedAt = organizationmembershipDescCreatedAt.Default.(func() time.Time)
+	// organizationmembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationmembershipDescUpdatedAt := organizationmembershipMixinFields0[1].Descriptor()
+	// organizationmembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organizationmembership.DefaultUpdatedAt = organizationmembershipDescUpdatedAt.Default.(func() time.Time)
+	// organizationmembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organizationmembership.UpdateDefaultUpdatedAt = organizationmembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	passkeyMixin := schema.Passkey{}.Mixin()
+	passkeyMixinFields0 := passkeyMixin[0].Fields()
+	_ = passkeyMixinFields0
+	passkeyFields := schema.Passkey{}.Fields()
+	_ = passkeyFields
+	// passkeyDescCreatedAt is the schema descriptor for created_at field.
+	passkeyDescCreatedAt := passkeyMixinFields0[0].Descriptor()
+	// passkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkey.DefaultCreatedAt = passkeyDescCreatedAt.Default.(func() time.Time)
+	// passkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeyDescUpdatedAt := passkeyMixinFields0[1].Descriptor()
+	// passkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
+	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeyDescName is the schema descriptor for name field.
+	passkeyDescName := passkeyFields[0].Descriptor()
+	// passkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	passkey.NameValidator = passkeyDescName.Validators[0].(func(string) error)
+	// passkeyDescCredentialID is the schema descriptor for credential_id field.
+	passkeyDescCredentialID := passkeyFields[1].Descriptor()
+	// passkey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkey.CredentialIDValidator = passkeyDescCredentialID.Validators[0].(func([]byte) error)
+	// passkeyDescPublicKey is the schema descriptor for public_key field.
+	passkeyDescPublicKey := passkeyFields[2].Descriptor()
+	// passkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	passkey.PublicKeyValidator = passkeyDescPublicKey.Validators[0].(func([]byte) error)
+	// passkeyDescSignCount is the schema descriptor for sign_count field.
+	passkeyDescSignCount := passkeyFields[6].Descriptor()
+	// passkey.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkey.DefaultSignCount = passkeyDescSignCount.Default.(uint32)
+	// passkeyDescBackupEligible is the schema descriptor for backup_eligible field.
+	passkeyDescBackupEligible := passkeyFields[7].Descriptor()
+	// passkey.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	passkey.DefaultBackupEligible = passkeyDescBackupEligible.Default.(bool)
+	// passkeyDescBackupState is the schema descriptor for backup_state field.
+	passkeyDescBackupState := passkeyFields[8].Descriptor()
+	// passkey.DefaultBackupState holds the default value on creation for the backup_state field.
+	passkey.DefaultBackupState = passkeyDescBackupState.Default.(bool)
 	passwordtokenFields := schema.PasswordToken{}.Fields()
 	_ = passwordtokenFields
 	// passwordtokenDescHash is the schema descriptor for hash field.
@@ -321,6 +589,10 @@ func init() {
 	phoneverificationcode.DefaultUpdatedAt = phoneverificationcodeDescUpdatedAt.Default.(func() time.Time)
 	// phoneverificationcode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	phoneverificationcode.UpdateDefaultUpdatedAt = phoneverificationcodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// phoneverificationcodeDescAttempts is the schema descriptor for attempts field.
+	phoneverificationcodeDescAttempts := phoneverificationcodeFields[4].Descriptor()
+	// phoneverificationcode.DefaultAttempts holds the default value on creation for the attempts field.
+	phoneverificationcode.DefaultAttempts = phoneverificationcodeDescAttempts.Default.(int)
 	profileMixin := schema.Profile{}.Mixin()
 	profileMixinFields0 := profileMixin[0].Fields()
 	_ = profileMixinFields0
@@ -340,6 +612,29 @@ func init() {
 	profileDescFullyOnboarded := profileFields[3].Descriptor()
 	// profile.DefaultFullyOnboarded holds the default value on creation for the fully_onboarded field.
 	profile.DefaultFullyOnboarded = profileDescFullyOnboarded.Default.(bool)
+	pushdeliveryMixin := schema.PushDelivery{}.Mixin()
+	pushdeliveryMixinFields0 := pushdeliveryMixin[0].Fields()
+	_ = pushdeliveryMixinFields0
+	pushdeliveryFields := schema.PushDelivery{}.Fields()
+	_ = pushdeliveryFields
+	// pushdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	pushdeliveryDescCreatedAt := pushdeliveryMixinFields0[0].Descriptor()
+	// pushdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pushdelivery.DefaultCreatedAt = pushdeliveryDescCreatedAt.Default.(func() time.Time)
+	// pushdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	pushdeliveryDescUpdatedAt := pushdeliveryMixinFields0[1].Descriptor()
+	// pushdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pushdelivery.DefaultUpdatedAt = pushdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// pushdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pushdelivery.UpdateDefaultUpdatedAt = pushdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pushdeliveryDescDevice is the schema descriptor for device field.
+	pushdeliveryDescDevice := pushdeliveryFields[1].Descriptor()
+	// pushdelivery.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	pushdelivery.DeviceValidator = pushdeliveryDescDevice.Validators[0].(func(string) error)
+	// pushdeliveryDescAttempts is the schema descriptor for attempts field.
+	pushdeliveryDescAttempts := pushdeliveryFields[3].Descriptor()
+	// pushdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	pushdelivery.DefaultAttempts = pushdeliveryDescAttempts.Default.(int)
 	pwapushsubscriptionMixin := schema.PwaPushSubscription{}.Mixin()
 	pwapushsubscriptionMixinFields0 := pwapushsubscriptionMixin[0].Fields()
 	_ = pwapushsubscriptionMixinFields0
@@ -367,6 +662,78 @@ func init() {
 	pwapushsubscriptionDescAuth := pwapushsubscriptionFields[2].Descriptor()
 	// pwapushsubscription.AuthValidator is a validator for the "auth" field. It is called by the builders before save.
 	pwapushsubscription.AuthValidator = pwapushsubscriptionDescAuth.Validators[0].(func(string) error)
+	recoverycodeMixin := schema.RecoveryCode{}.Mixin()
+	recoverycodeMixinFields0 := recoverycodeMixin[0].Fields()
+	_ = recoverycodeMixinFields0
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeMixinFields0[0].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	// recoverycodeDescUpdatedAt is the schema descriptor for updated_at field.
+	recoverycodeDescUpdatedAt := recoverycodeMixinFields0[1].Descriptor()
+	// recoverycode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recoverycode.DefaultUpdatedAt = recoverycodeDescUpdatedAt.Default.(func() time.Time)
+	// recoverycode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recoverycode.UpdateDefaultUpdatedAt = recoverycodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// recoverycodeDescHash is the schema descriptor for hash field.
+	recoverycodeDescHash := recoverycodeFields[0].Descriptor()
+	// recoverycode.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	recoverycode.HashValidator = recoverycodeDescHash.Validators[0].(func(string) error)
+	reportMixin := schema.Report{}.Mixin()
+	reportMixinFields0 := reportMixin[0].Fields()
+	_ = reportMixinFields0
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportMixinFields0[0].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	// reportDescUpdatedAt is the schema descriptor for updated_at field.
+	reportDescUpdatedAt := reportMixinFields0[1].Descriptor()
+	// report.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	report.DefaultUpdatedAt = reportDescUpdatedAt.Default.(func() time.Time)
+	// report.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	report.UpdateDefaultUpdatedAt = reportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reportDescDetails is the schema descriptor for details field.
+	reportDescDetails := reportFields[1].Descriptor()
+	// report.DetailsValidator is a validator for the "details" field. It is called by the builders before save.
+	report.DetailsValidator = reportDescDetails.Validators[0].(func(string) error)
+	roleMixin := schema.Role{}.Mixin()
+	roleMixinFields0 := roleMixin[0].Fields()
+	_ = roleMixinFields0
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleMixinFields0[0].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleMixinFields0[1].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	smsmessageMixin := schema.SMSMessage{}.Mixin()
+	smsmessageMixinFields0 := smsmessageMixin[0].Fields()
+	_ = smsmessageMixinFields0
+	smsmessageFields := schema.SMSMessage{}.Fields()
+	_ = smsmessageFields
+	// smsmessageDescCreatedAt is the schema descriptor for created_at field.
+	smsmessageDescCreatedAt := smsmessageMixinFields0[0].Descriptor()
+	// smsmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	smsmessage.DefaultCreatedAt = smsmessageDescCreatedAt.Default.(func() time.Time)
+	// smsmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	smsmessageDescUpdatedAt := smsmessageMixinFields0[1].Descriptor()
+	// smsmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	smsmessage.DefaultUpdatedAt = smsmessageDescUpdatedAt.Default.(func() time.Time)
+	// smsmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	smsmessage.UpdateDefaultUpdatedAt = smsmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
 	sentemailMixin := schema.SentEmail{}.Mixin()
 	sentemailMixinFields0 := sentemailMixin[0].Fields()
 	_ = sentemailMixinFields0
@@ -382,6 +749,49 @@ func init() {
 	sentemail.DefaultUpdatedAt = sentemailDescUpdatedAt.Default.(func() time.Time)
 	// sentemail.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	sentemail.UpdateDefaultUpdatedAt = sentemailDescUpdatedAt.UpdateDefault.(func() time.Time)
+	throttleattemptFields := schema.ThrottleAttempt{}.Fields()
+	_ = throttleattemptFields
+	// throttleattemptDescKey is the schema descriptor for key field.
+	throttleattemptDescKey := throttleattemptFields[0].Descriptor()
+	// throttleattempt.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	throttleattempt.KeyValidator = throttleattemptDescKey.Validators[0].(func(string) error)
+	// throttleattemptDescAttemptedAt is the schema descriptor for attempted_at field.
+	throttleattemptDescAttemptedAt := throttleattemptFields[1].Descriptor()
+	// throttleattempt.DefaultAttemptedAt holds the default value on creation for the attempted_at field.
+	throttleattempt.DefaultAttemptedAt = throttleattemptDescAttemptedAt.Default.(func() time.Time)
+	throttlelockFields := schema.ThrottleLock{}.Fields()
+	_ = throttlelockFields
+	// throttlelockDescKey is the schema descriptor for key field.
+	throttlelockDescKey := throttlelockFields[0].Descriptor()
+	// throttlelock.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	throttlelock.KeyValidator = throttlelockDescKey.Validators[0].(func(string) error)
+	totpsecretMixin := schema.TotpSecret{}.Mixin()
+	totpsecretMixinFields0 := totpsecretMixin[0].Fields()
+	_ = totpsecretMixinFields0
+	totpsecretFields := schema.TotpSecret{}.Fields()
+	_ = totpsecretFields
+	// totpsecretDescCreatedAt is the schema descriptor for created_at field.
+	totpsecretDescCreatedAt := totpsecretMixinFields0[0].Descriptor()
+	// totpsecret.DefaultCreatedAt holds the default value on creation for the created_at field.
+	totpsecret.DefaultCreatedAt = totpsecretDescCreatedAt.Default.(func() time.Time)
+	// totpsecretDescUpdatedAt is the schema descriptor for updated_at field.
+	totpsecretDescUpdatedAt := totpsecretMixinFields0[1].Descriptor()
+	// totpsecret.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	totpsecret.DefaultUpdatedAt = totpsecretDescUpdatedAt.Default.(func() time.Time)
+	// totpsecret.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	totpsecret.UpdateDefaultUpdatedAt = totpsecretDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// totpsecretDescEncryptedSecret is the schema descriptor for encrypted_secret field.
+	totpsecretDescEncryptedSecret := totpsecretFields[0].Descriptor()
+	// totpsecret.EncryptedSecretValidator is a validator for the "encrypted_secret" field. It is called by the builders before save.
+	totpsecret.EncryptedSecretValidator = totpsecretDescEncryptedSecret.Validators[0].(func(string) error)
+	// totpsecretDescEnabled is the schema descriptor for enabled field.
+	totpsecretDescEnabled := totpsecretFields[1].Descriptor()
+	// totpsecret.DefaultEnabled holds the default value on creation for the enabled field.
+	totpsecret.DefaultEnabled = totpsecretDescEnabled.Default.(bool)
+	// totpsecretDescLastUsedStep is the schema descriptor for last_used_step field.
+	totpsecretDescLastUsedStep := totpsecretFields[3].Descriptor()
+	// totpsecret.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	totpsecret.DefaultLastUsedStep = totpsecretDescLastUsedStep.Default.(int64)
 	userMixin := schema.User{}.Mixin()
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
@@ -415,6 +825,49 @@ func init() {
 	userDescVerified := userFields[3].Descriptor()
 	// user.DefaultVerified holds the default value on creation for the verified field.
 	user.DefaultVerified = userDescVerified.Default.(bool)
+	usersessionMixin := schema.UserSession{}.Mixin()
+	usersessionMixinFields0 := usersessionMixin[0].Fields()
+	_ = usersessionMixinFields0
+	usersessionFields := schema.UserSession{}.Fields()
+	_ = usersessionFields
+	// usersessionDescCreatedAt is the schema descriptor for created_at field.
+	usersessionDescCreatedAt := usersessionMixinFields0[0].Descriptor()
+	// usersession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usersession.DefaultCreatedAt = usersessionDescCreatedAt.Default.(func() time.Time)
+	// usersessionDescUpdatedAt is the schema descriptor for updated_at field.
+	usersessionDescUpdatedAt := usersessionMixinFields0[1].Descriptor()
+	// usersession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usersession.DefaultUpdatedAt = usersessionDescUpdatedAt.Default.(func() time.Time)
+	// usersession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usersession.UpdateDefaultUpdatedAt = usersessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usersessionDescTokenHash is the schema descriptor for token_hash field.
+	usersessionDescTokenHash := usersessionFields[0].Descriptor()
+	// usersession.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	usersession.TokenHashValidator = usersessionDescTokenHash.Validators[0].(func(string) error)
+	// usersessionDescUserAgent is the schema descriptor for user_agent field.
+	usersessionDescUserAgent := usersessionFields[1].Descriptor()
+	// usersession.DefaultUserAgent holds the default value on creation for the user_agent field.
+	usersession.DefaultUserAgent = usersessionDescUserAgent.Default.(string)
+	// usersessionDescBrowser is the schema descriptor for browser field.
+	usersessionDescBrowser := usersessionFields[2].Descriptor()
+	// usersession.DefaultBrowser holds the default value on creation for the browser field.
+	usersession.DefaultBrowser = usersessionDescBrowser.Default.(string)
+	// usersessionDescOs is the schema descriptor for os field.
+	usersessionDescOs := usersessionFields[3].Descriptor()
+	// usersession.DefaultOs holds the default value on creation for the os field.
+	usersession.DefaultOs = usersessionDescOs.Default.(string)
+	// usersessionDescDevice is the schema descriptor for device field.
+	usersessionDescDevice := usersessionFields[4].Descriptor()
+	// usersession.DefaultDevice holds the default value on creation for the device field.
+	usersession.DefaultDevice = usersessionDescDevice.Default.(string)
+	// usersessionDescIPAddress is the schema descriptor for ip_address field.
+	usersessionDescIPAddress := usersessionFields[5].Descriptor()
+	// usersession.DefaultIPAddress holds the default value on creation for the ip_address field.
+	usersession.DefaultIPAddress = usersessionDescIPAddress.Default.(string)
+	// usersessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	usersessionDescLastSeenAt := usersessionFields[6].Descriptor()
+	// usersession.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	usersession.DefaultLastSeenAt = usersessionDescLastSeenAt.Default.(func() time.Time)
 }
 
 const (
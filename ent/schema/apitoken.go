@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIToken holds the schema definition for the APIToken entity.
+type APIToken struct {
+	ent.Schema
+}
+
+func (APIToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the APIToken.
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("token_hash").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Comment("SHA-256 hash of the token, which is only shown to the user once"),
+		field.String("hint").
+			Default("").
+			Comment("Start of the token, to help the user tell their tokens apart"),
+		field.Strings("scopes").
+			Optional(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("When the token stops working, it never expires if empty"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the APIToken.
+func (APIToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the APIToken.
+func (APIToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
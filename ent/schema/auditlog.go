@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/mikestefanello/pagoda/ent/hook"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity. It is an append-only record of a
+// security-relevant event. Users are referenced by ID rather than edges so records outlive them.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").
+			NotEmpty().
+			Immutable().
+			Comment("What happened, e.g. auth.login"),
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("User who did it, empty for visitors and the system"),
+		field.Int("impersonator_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Admin who did it while acting as the actor"),
+		field.String("target_type").
+			Default("").
+			Immutable().
+			Comment("Kind of what was acted upon, e.g. user or profile"),
+		field.Int("target_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("ip_address").
+			Default("").
+			Immutable(),
+		field.String("user_agent").
+			Default("").
+			Immutable(),
+		field.String("request_id").
+			Default("").
+			Immutable(),
+		field.JSON("metadata", map[string]string{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("action"),
+		index.Fields("actor_id"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("created_at"),
+	}
+}
+
+// Hooks of the AuditLog.
+func (AuditLog) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Records are never changed, and only deleted in bulk once past their retention
+		hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDeleteOne),
+	}
+}
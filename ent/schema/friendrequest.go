@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendRequest holds the schema definition for the FriendRequest entity.
+type FriendRequest struct {
+	ent.Schema
+}
+
+func (FriendRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the FriendRequest.
+func (FriendRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "cancelled").
+			Default("pending"),
+		field.Time("responded_at").
+			Optional().
+			Nillable().
+			Comment("When the request was accepted, declined or cancelled"),
+	}
+}
+
+// Edges of the FriendRequest.
+func (FriendRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("requester", Profile.Type).
+			Ref("sent_friend_requests").
+			Unique().
+			Required(),
+		edge.From("recipient", Profile.Type).
+			Ref("received_friend_requests").
+			Unique().
+			Required(),
+	}
+}
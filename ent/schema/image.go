@@ -24,6 +24,10 @@ func (Image) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").
 			Values(domain.ImageCategories.Values()...),
+		field.Time("hidden_at").
+			Optional().
+			Nillable().
+			Comment("When a moderator hid the image, which is not shown anymore"),
 	}
 }
 
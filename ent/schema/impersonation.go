@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Impersonation holds the schema definition for the Impersonation entity. Each one records an admin
+// acting as another user, from when it started (created_at) to when it was stopped.
+type Impersonation struct {
+	ent.Schema
+}
+
+func (Impersonation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Impersonation.
+func (Impersonation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("reason").
+			Default("").
+			Comment("Why the admin needed to act as the user, e.g. a support ticket"),
+		field.String("ip_address").
+			Default(""),
+		field.String("user_agent").
+			Default(""),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			Comment("When the impersonation was stopped, it is still going on if empty"),
+		field.Int("admin_id").
+			Immutable(),
+		field.Int("user_id").
+			Immutable(),
+	}
+}
+
+// Edges of the Impersonation.
+func (Impersonation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("admin", User.Type).
+			Ref("impersonations_performed").
+			Field("admin_id").
+			Immutable().
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("impersonations").
+			Field("user_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Impersonation.
+func (Impersonation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_id"),
+		index.Fields("user_id"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -25,6 +26,15 @@ func (Invitation) Fields() []ent.Field {
 		field.String("confirmation_code").
 			NotEmpty().
 			Unique(),
+		field.Time("expires_at").
+			Comment("After that time, the invitation cannot be accepted anymore."),
+		field.Time("accepted_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("Set when the inviter revoked the invitation before it was accepted."),
 	}
 }
 
@@ -36,5 +46,9 @@ func (Invitation) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Comment("The profile who created the invitation."),
+		edge.To("invitee", Profile.Type).
+			Unique().
+			Comment("The profile who accepted the invitation.").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MagicLinkToken holds the schema definition for the MagicLinkToken entity.
+type MagicLinkToken struct {
+	ent.Schema
+}
+
+func (MagicLinkToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the MagicLinkToken.
+func (MagicLinkToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Comment("SHA-256 hash of the token ID (jti) embedded in the signed link"),
+		field.Time("expires_at"),
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("Set when the link is consumed, a link can only be used once"),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the MagicLinkToken.
+func (MagicLinkToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("magic_link_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MagicLinkToken.
+func (MagicLinkToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -48,7 +48,14 @@ func (MonthlySubscription) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Cancelling is effective after current period ends."),
-		field.Int("paying_profile_id"),
+		field.Int("paying_profile_id").
+			Optional().
+			Nillable().
+			Comment("Not set when the subscription is billed to an organization."),
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Comment("Set when the subscription is billed to an organization rather than a single payer."),
 	}
 }
 
@@ -62,7 +69,11 @@ func (MonthlySubscription) Edges() []ent.Edge {
 			Comment("Who is paying for this subscription").
 			Unique().
 			Field("paying_profile_id").
-			Required().
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+		edge.To("organization", Organization.Type).
+			Comment("Which organization is paying for this subscription").
+			Unique().
+			Field("organization_id").
 			Annotations(entsql.OnDelete(entsql.NoAction)),
 	}
 }
@@ -72,5 +83,6 @@ func (MonthlySubscription) Indexes() []ent.Index {
 	return []ent.Index{
 		// Someone can only have a single active subscription at a time.
 		index.Fields("paying_profile_id", "is_active").Unique(),
+		index.Fields("organization_id", "is_active").Unique(),
 	}
 }
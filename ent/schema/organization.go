@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Organization holds the schema definition for the Organization entity.
+type Organization struct {
+	ent.Schema
+}
+
+func (Organization) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.String("stripe_id").
+			Unique().
+			Optional().
+			Comment("ID used by stripe payment system when the organization is billed"),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", OrganizationMembership.Type).
+			Comment("Profiles who are part of the organization, along with their role.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitations", OrganizationInvitation.Type).
+			Comment("Invitations sent by email to join the organization.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("subscriptions", MonthlySubscription.Type).
+			Ref("organization").
+			Comment("Subscriptions billed to the organization rather than to a single payer."),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+// OrganizationInvitation holds the schema definition for the OrganizationInvitation entity.
+type OrganizationInvitation struct {
+	ent.Schema
+}
+
+func (OrganizationInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the OrganizationInvitation.
+func (OrganizationInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Comment("Only the user with this email address can accept the invitation."),
+		field.Enum("role").
+			Values(domain.OrganizationRoles.Values()...).
+			Default(domain.OrganizationRoleMember.Value).
+			Comment("The role the invitee gets when joining."),
+		field.String("token").
+			NotEmpty().
+			Unique().
+			Sensitive(),
+		field.Time("expires_at").
+			Comment("After that time, the invitation cannot be accepted anymore."),
+		field.Time("accepted_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the OrganizationInvitation.
+func (OrganizationInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("invitations").
+			Unique().
+			Required(),
+		edge.To("inviter", Profile.Type).
+			Unique().
+			Comment("The profile who sent the invitation.").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
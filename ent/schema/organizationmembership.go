@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+// OrganizationMembership holds the schema definition for the OrganizationMembership entity.
+type OrganizationMembership struct {
+	ent.Schema
+}
+
+func (OrganizationMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the OrganizationMembership.
+func (OrganizationMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Values(domain.OrganizationRoles.Values()...).
+			Default(domain.OrganizationRoleMember.Value),
+		field.Int("organization_id"),
+		field.Int("profile_id"),
+	}
+}
+
+// Edges of the OrganizationMembership.
+func (OrganizationMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("memberships").
+			Field("organization_id").
+			Unique().
+			Required(),
+		edge.From("profile", Profile.Type).
+			Ref("organization_memberships").
+			Field("profile_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OrganizationMembership.
+func (OrganizationMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// A profile is part of an organization at most once.
+		index.Fields("organization_id", "profile_id").Unique(),
+	}
+}
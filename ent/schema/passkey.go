@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Passkey holds the schema definition for the Passkey entity.
+type Passkey struct {
+	ent.Schema
+}
+
+func (Passkey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Passkey.
+func (Passkey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("A label chosen by the user to recognize the device holding the passkey"),
+		field.Bytes("credential_id").
+			NotEmpty().
+			Unique(),
+		field.Bytes("public_key").
+			Sensitive().
+			NotEmpty(),
+		field.String("attestation_type").
+			Optional(),
+		field.Strings("transports").
+			Optional(),
+		field.Bytes("aaguid").
+			Optional(),
+		field.Uint32("sign_count").
+			Default(0).
+			Comment("The signature counter reported by the authenticator, used to detect cloned credentials"),
+		field.Bool("backup_eligible").
+			Default(false),
+		field.Bool("backup_state").
+			Default(false),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the Passkey.
+func (Passkey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("passkeys").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Passkey.
+func (Passkey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
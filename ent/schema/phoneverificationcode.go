@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/mikestefanello/pagoda/pkg/domain"
 )
 
 // PhoneVerificationCode holds the schema definition for the PhoneVerificationCode entity.
@@ -22,8 +23,25 @@ func (PhoneVerificationCode) Mixin() []ent.Mixin {
 func (PhoneVerificationCode) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("code").
+			Sensitive().
 			Comment("The verification code"),
-		field.Int("profile_id"),
+		field.Int("profile_id").
+			Optional().
+			Nillable().
+			Comment("The profile verifying its phone number, unset for codes signing in or registering with one"),
+		field.String("phone_number_e164").
+			Optional().
+			Comment("Phone number the code was sent to, in E164 format"),
+		field.Enum("purpose").
+			Values(domain.PhoneCodePurposes.Values()...).
+			Default(domain.PhoneCodePurposeVerify.Value),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of times the code was checked"),
+		field.Time("invalidated_at").
+			Optional().
+			Nillable().
+			Comment("When the code was used, replaced by a newer one or ran out of attempts"),
 	}
 }
 
@@ -33,15 +51,14 @@ func (PhoneVerificationCode) Edges() []ent.Edge {
 		edge.From("profile", Profile.Type).
 			Ref("phone_verification_code").
 			Field("profile_id").
-			Unique().
-			Required(),
+			Unique(),
 	}
 }
 
-// Indexes of the Profile.
+// Indexes of the PhoneVerificationCode.
 func (PhoneVerificationCode) Indexes() []ent.Index {
 	return []ent.Index{
-		// Someone can only have a single active subscription at a time.
-		index.Fields("code", "profile_id").Unique(),
+		// Codes are looked up by the number they were sent to, for the resend cooldown and daily cap
+		index.Fields("phone_number_e164", "created_at"),
 	}
 }
@@ -52,6 +52,22 @@ func (Profile) Edges() []ent.Edge {
 		edge.To("friends", Profile.Type).
 			Comment("Who the profile is friends/connected to.").
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sent_friend_requests", FriendRequest.Type).
+			Comment("Friend requests the profile sent.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("received_friend_requests", FriendRequest.Type).
+			Comment("Friend requests the profile received.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("blocked", Profile.Type).
+			From("blocked_by").
+			Comment("Profiles this profile blocked, which cannot request its friendship nor see it.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reports_sent", Report.Type).
+			Comment("Reports the profile filed about others.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reports_received", Report.Type).
+			Comment("Reports filed about the profile or its photos.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("photos", Image.Type).
 			Comment("Photos associated to that profile, not including the profile picture.").
 			Annotations(entsql.OnDelete(entsql.Cascade)),
@@ -62,6 +78,9 @@ func (Profile) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("invitations", Invitation.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("organization_memberships", OrganizationMembership.Type).
+			Comment("Organizations the profile is part of, along with its role in them.").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 
 		edge.To("fcm_push_subscriptions", FCMSubscriptions.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)).
@@ -69,6 +88,9 @@ func (Profile) Edges() []ent.Edge {
 		edge.To("pwa_push_subscriptions", PwaPushSubscription.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)).
 			Comment("Track PWA push notification subscriptions, used for all device types but iOS"),
+		edge.To("push_deliveries", PushDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Push notifications sent to the devices of this profile, one per device"),
 		edge.To("notification_permissions", NotificationPermission.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("notification_times", NotificationTime.Type).
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+// PushDelivery holds the schema definition for the PushDelivery entity, the result of sending a push
+// notification to a single device.
+type PushDelivery struct {
+	ent.Schema
+}
+
+func (PushDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PushDelivery.
+func (PushDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("platform").
+			Values(domain.NotificationPlatformPush.Value, domain.NotificationPlatformFCMPush.Value),
+		field.String("device").
+			NotEmpty().
+			Comment("Endpoint of the PWA subscription or FCM token the notification was sent to"),
+		field.Enum("status").
+			Values(domain.PushDeliveryStatuses.Values()...).
+			Default(domain.PushDeliveryStatusQueued.Value),
+		field.Int("attempts").
+			Default(0),
+		field.Int("status_code").
+			Optional().
+			Comment("HTTP status the push service answered the last attempt with"),
+		field.String("error").
+			Optional().
+			Comment("Why the last attempt failed"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Int("profile_id"),
+	}
+}
+
+// Edges of the PushDelivery.
+func (PushDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("profile", Profile.Type).
+			Ref("push_deliveries").
+			Field("profile_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the PushDelivery.
+func (PushDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "created_at"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Report holds the schema definition for the Report entity.
+type Report struct {
+	ent.Schema
+}
+
+func (Report) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("reason").
+			Values("spam", "harassment", "inappropriate_content", "impersonation", "other"),
+		field.String("details").
+			Optional().
+			MaxLen(1000).
+			Comment("What the reporter wrote about the issue"),
+		field.Enum("target_type").
+			Values("profile", "photo"),
+		field.Int("target_id").
+			Comment("ID of the reported profile or image"),
+		field.Enum("status").
+			Values("pending", "dismissed", "photo_hidden", "user_suspended").
+			Default("pending").
+			Comment("Pending until a moderator acts on the report, then the outcome"),
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("reporter", Profile.Type).
+			Ref("reports_sent").
+			Unique().
+			Required(),
+		edge.From("reported", Profile.Type).
+			Ref("reports_received").
+			Comment("The profile the reported content belongs to").
+			Unique().
+			Required(),
+		edge.From("resolved_by", User.Type).
+			Ref("reports_resolved").
+			Comment("The moderator who acted on the report").
+			Unique(),
+	}
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("target_type", "target_id"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+// SMSMessage holds the schema definition for the SMSMessage entity. Message bodies are not kept since they
+// carry one-time codes.
+type SMSMessage struct {
+	ent.Schema
+}
+
+func (SMSMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the SMSMessage.
+func (SMSMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("phone_number_e164").
+			Comment("Phone number the message was sent to, in E164 format"),
+		field.String("provider").
+			Comment("Name of the provider which sent the message"),
+		field.String("provider_message_id").
+			Optional().
+			Comment("ID the provider gave the message, unset if it was never accepted"),
+		field.Enum("status").
+			Values(domain.SMSStatuses.Values()...).
+			Default(domain.SMSStatusSent.Value),
+		field.String("error_code").
+			Optional().
+			Comment("Why the message was not delivered, as reported by the provider"),
+	}
+}
+
+// Indexes of the SMSMessage.
+func (SMSMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "provider_message_id"),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ThrottleAttempt holds the schema definition for the ThrottleAttempt entity.
+// It is only used when throttling is not backed by Redis, e.g. in embedded mode.
+type ThrottleAttempt struct {
+	ent.Schema
+}
+
+// Fields of the ThrottleAttempt.
+func (ThrottleAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Comment("What is being throttled, e.g. email:jane@example.com or ip:127.0.0.1"),
+		field.Time("attempted_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the ThrottleAttempt.
+func (ThrottleAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "attempted_at"),
+	}
+}
@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ThrottleLock holds the schema definition for the ThrottleLock entity.
+// It is only used when throttling is not backed by Redis, e.g. in embedded mode.
+type ThrottleLock struct {
+	ent.Schema
+}
+
+// Fields of the ThrottleLock.
+func (ThrottleLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique(),
+		field.Time("locked_until"),
+	}
+}
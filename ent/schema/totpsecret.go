@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TotpSecret holds the schema definition for the TotpSecret entity.
+type TotpSecret struct {
+	ent.Schema
+}
+
+func (TotpSecret) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TotpSecret.
+func (TotpSecret) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("encrypted_secret").
+			Sensitive().
+			NotEmpty().
+			Comment("The TOTP shared secret, encrypted with the app encryption key"),
+		field.Bool("enabled").
+			Default(false).
+			Comment("A secret is only enabled once the user confirmed enrollment with a valid code"),
+		field.Time("enabled_at").
+			Optional().
+			Nillable(),
+		field.Int64("last_used_step").
+			Default(0).
+			Comment("The last TOTP time step that was accepted, used to prevent code replay"),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the TotpSecret.
+func (TotpSecret) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("totp_secret").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
@@ -39,6 +39,14 @@ func (User) Fields() []ent.Field {
 			Default(false),
 		field.Time("last_online").
 			Optional(),
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable().
+			Comment("When the account gets deleted. It is locked until then, unless the deletion is cancelled."),
+		field.Time("suspended_at").
+			Optional().
+			Nillable().
+			Comment("When a moderator suspended the account, which is locked while suspended."),
 	}
 }
 
@@ -53,6 +61,39 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("last_seen_at", LastSeenOnline.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("totp_secret", TotpSecret.Type).
+			Unique().
+			Comment("TOTP secret used for two-factor authentication, if the user enrolled").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recovery_codes", RecoveryCode.Type).
+			Comment("One-time codes to log in when the TOTP device is lost").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("passkeys", Passkey.Type).
+			Comment("WebAuthn credentials the user can sign in with instead of a password").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("identities", Identity.Type).
+			Comment("External accounts, from social login providers, linked to the user").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("magic_link_tokens", MagicLinkToken.Type).
+			Comment("Single-use sign-in links sent by email").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sessions", UserSession.Type).
+			Comment("Server-side sessions, one per device the user is logged in on").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("api_tokens", APIToken.Type).
+			Comment("Personal access tokens to authenticate API requests with").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("roles", Role.Type).
+			Comment("Roles granting the user permissions, such as admin"),
+		edge.To("impersonations_performed", Impersonation.Type).
+			Comment("Times this admin acted as another user").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("impersonations", Impersonation.Type).
+			Comment("Times an admin acted as this user").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reports_resolved", Report.Type).
+			Comment("Reports this moderator acted on").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
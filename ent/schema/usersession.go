@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserSession holds the schema definition for the UserSession entity.
+type UserSession struct {
+	ent.Schema
+}
+
+func (UserSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the UserSession.
+func (UserSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Comment("SHA-256 hash of the session token stored in the session cookie"),
+		field.String("user_agent").
+			Default(""),
+		field.String("browser").
+			Default("").
+			Comment("Browser name parsed from the user agent"),
+		field.String("os").
+			Default("").
+			Comment("Operating system parsed from the user agent"),
+		field.String("device").
+			Default("").
+			Comment("Device name parsed from the user agent, if any"),
+		field.String("ip_address").
+			Default(""),
+		field.Time("last_seen_at").
+			Default(time.Now),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the UserSession.
+func (UserSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the UserSession.
+func (UserSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
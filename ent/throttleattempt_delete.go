@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/throttleattempt"
+)
+
+// ThrottleAttemptDelete is the builder for deleting a ThrottleAttempt entity.
+type ThrottleAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *ThrottleAttemptMutation
+}
+
+// Where appends a list predicates to the ThrottleAttemptDelete builder.
+func (tad *ThrottleAttemptDelete) Where(ps ...predicate.ThrottleAttempt) *ThrottleAttemptDelete {
+	tad.mutation.Where(ps...)
+	return tad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tad *ThrottleAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tad.sqlExec, tad.mutation, tad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tad *ThrottleAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := tad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tad *ThrottleAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(throttleattempt.Table, sqlgraph.NewFieldSpec(throttleattempt.FieldID, field.TypeInt))
+	if ps := tad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tad.mutation.done = true
+	return affected, err
+}
+
+// ThrottleAttemptDeleteOne is the builder for deleting a single ThrottleAttempt entity.
+type ThrottleAttemptDeleteOne struct {
+	tad *ThrottleAttemptDelete
+}
+
+// Where appends a list predicates to the ThrottleAttemptDelete builder.
+func (tado *ThrottleAttemptDeleteOne) Where(ps ...predicate.ThrottleAttempt) *ThrottleAttemptDeleteOne {
+	tado.tad.mutation.Where(ps...)
+	return tado
+}
+
+// Exec executes the deletion query.
+func (tado *ThrottleAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := tado.tad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{throttleattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tado *ThrottleAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := tado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
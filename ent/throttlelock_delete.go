@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/throttlelock"
+)
+
+// ThrottleLockDelete is the builder for deleting a ThrottleLock entity.
+type ThrottleLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *ThrottleLockMutation
+}
+
+// Where appends a list predicates to the ThrottleLockDelete builder.
+func (tld *ThrottleLockDelete) Where(ps ...predicate.ThrottleLock) *ThrottleLockDelete {
+	tld.mutation.Where(ps...)
+	return tld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tld *ThrottleLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tld.sqlExec, tld.mutation, tld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tld *ThrottleLockDelete) ExecX(ctx context.Context) int {
+	n, err := tld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tld *ThrottleLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(throttlelock.Table, sqlgraph.NewFieldSpec(throttlelock.FieldID, field.TypeInt))
+	if ps := tld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tld.mutation.done = true
+	return affected, err
+}
+
+// ThrottleLockDeleteOne is the builder for deleting a single ThrottleLock entity.
+type ThrottleLockDeleteOne struct {
+	tld *ThrottleLockDelete
+}
+
+// Where appends a list predicates to the ThrottleLockDelete builder.
+func (tldo *ThrottleLockDeleteOne) Where(ps ...predicate.ThrottleLock) *ThrottleLockDeleteOne {
+	tldo.tld.mutation.Where(ps...)
+	return tldo
+}
+
+// Exec executes the deletion query.
+func (tldo *ThrottleLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := tldo.tld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{throttlelock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tldo *ThrottleLockDeleteOne) ExecX(ctx context.Context) {
+	if err := tldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
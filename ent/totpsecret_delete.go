@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
+)
+
+// TotpSecretDelete is the builder for deleting a TotpSecret entity.
+type TotpSecretDelete struct {
+	config
+	hooks    []Hook
+	mutation *TotpSecretMutation
+}
+
+// Where appends a list predicates to the TotpSecretDelete builder.
+func (tsd *TotpSecretDelete) Where(ps ...predicate.TotpSecret) *TotpSecretDelete {
+	tsd.mutation.Where(ps...)
+	return tsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsd *TotpSecretDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsd.sqlExec, tsd.mutation, tsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsd *TotpSecretDelete) ExecX(ctx context.Context) int {
+	n, err := tsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsd *TotpSecretDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(totpsecret.Table, sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt))
+	if ps := tsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsd.mutation.done = true
+	return affected, err
+}
+
+// TotpSecretDeleteOne is the builder for deleting a single TotpSecret entity.
+type TotpSecretDeleteOne struct {
+	tsd *TotpSecretDelete
+}
+
+// Where appends a list predicates to the TotpSecretDelete builder.
+func (tsdo *TotpSecretDeleteOne) Where(ps ...predicate.TotpSecret) *TotpSecretDeleteOne {
+	tsdo.tsd.mutation.Where(ps...)
+	return tsdo
+}
+
+// Exec executes the deletion query.
+func (tsdo *TotpSecretDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsdo.tsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{totpsecret.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsdo *TotpSecretDeleteOne) ExecX(ctx context.Context) {
+	if err := tsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -14,6 +14,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// EmailSubscription is the client for interacting with the EmailSubscription builders.
 	EmailSubscription *EmailSubscriptionClient
 	// EmailSubscriptionType is the client for interacting with the EmailSubscriptionType builders.
@@ -24,14 +28,22 @@ type Tx struct {
 	FCMSubscriptions *FCMSubscriptionsClient
 	// FileStorage is the client for interacting with the FileStorage builders.
 	FileStorage *FileStorageClient
+	// FriendRequest is the client for interacting with the FriendRequest builders.
+	FriendRequest *FriendRequestClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
 	// ImageSize is the client for interacting with the ImageSize builders.
 	ImageSize *ImageSizeClient
+	// Impersonation is the client for interacting with the Impersonation builders.
+	Impersonation *ImpersonationClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
 	// LastSeenOnline is the client for interacting with the LastSeenOnline builders.
 	LastSeenOnline *LastSeenOnlineClient
+	// MagicLinkToken is the client for interacting with the MagicLinkToken builders.
+	MagicLinkToken *MagicLinkTokenClient
 	// MonthlySubscription is the client for interacting with the MonthlySubscription builders.
 	MonthlySubscription *MonthlySubscriptionClient
 	// Notification is the client for interacting with the Notification builders.
@@ -40,18 +52,44 @@ type Tx struct {
 	NotificationPermission *NotificationPermissionClient
 	// NotificationTime is the client for interacting with the NotificationTime builders.
 	NotificationTime *NotificationTimeClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// OrganizationInvitation is the client for interacting with the OrganizationInvitation builders.
+	OrganizationInvitation *OrganizationInvitationClient
+	// OrganizationMembership is the client for interacting with the OrganizationMembership builders.
+	OrganizationMembership *OrganizationMembershipClient
+	// Passkey is the client for interacting with the Passkey builders.
+	Passkey *PasskeyClient
 	// PasswordToken is the client for interacting with the PasswordToken builders.
 	PasswordToken *PasswordTokenClient
 	// PhoneVerificationCode is the client for interacting with the PhoneVerificationCode builders.
 	PhoneVerificationCode *PhoneVerificationCodeClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// PushDelivery is the client for interacting with the PushDelivery builders.
+	PushDelivery *PushDeliveryClient
 	// PwaPushSubscription is the client for interacting with the PwaPushSubscription builders.
 	PwaPushSubscription *PwaPushSubscriptionClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// SMSMessage is the client for interacting with the SMSMessage builders.
+	SMSMessage *SMSMessageClient
 	// SentEmail is the client for interacting with the SentEmail builders.
 	SentEmail *SentEmailClient
+	// ThrottleAttempt is the client for interacting with the ThrottleAttempt builders.
+	ThrottleAttempt *ThrottleAttemptClient
+	// ThrottleLock is the client for interacting with the ThrottleLock builders.
+	ThrottleLock *ThrottleLockClient
+	// TotpSecret is the client for interacting with the TotpSecret builders.
+	TotpSecret *TotpSecretClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
 
 	// lazily loaded.
 	client     *Client
@@ -183,25 +221,44 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIToken = NewAPITokenClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.EmailSubscription = NewEmailSubscriptionClient(tx.config)
 	tx.EmailSubscriptionType = NewEmailSubscriptionTypeClient(tx.config)
 	tx.Emojis = NewEmojisClient(tx.config)
 	tx.FCMSubscriptions = NewFCMSubscriptionsClient(tx.config)
 	tx.FileStorage = NewFileStorageClient(tx.config)
+	tx.FriendRequest = NewFriendRequestClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
 	tx.Image = NewImageClient(tx.config)
 	tx.ImageSize = NewImageSizeClient(tx.config)
+	tx.Impersonation = NewImpersonationClient(tx.config)
 	tx.Invitation = NewInvitationClient(tx.config)
 	tx.LastSeenOnline = NewLastSeenOnlineClient(tx.config)
+	tx.MagicLinkToken = NewMagicLinkTokenClient(tx.config)
 	tx.MonthlySubscription = NewMonthlySubscriptionClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.NotificationPermission = NewNotificationPermissionClient(tx.config)
 	tx.NotificationTime = NewNotificationTimeClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
+	tx.OrganizationInvitation = NewOrganizationInvitationClient(tx.config)
+	tx.OrganizationMembership = NewOrganizationMembershipClient(tx.config)
+	tx.Passkey = NewPasskeyClient(tx.config)
 	tx.PasswordToken = NewPasswordTokenClient(tx.config)
 	tx.PhoneVerificationCode = NewPhoneVerificationCodeClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
+	tx.PushDelivery = NewPushDeliveryClient(tx.config)
 	tx.PwaPushSubscription = NewPwaPushSubscriptionClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
+	tx.SMSMessage = NewSMSMessageClient(tx.config)
 	tx.SentEmail = NewSentEmailClient(tx.config)
+	tx.ThrottleAttempt = NewThrottleAttemptClient(tx.config)
+	tx.ThrottleLock = NewThrottleLockClient(tx.config)
+	tx.TotpSecret = NewTotpSecretClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserSession = NewUserSessionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -211,7 +268,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: EmailSubscription.QueryXXX(), the query will be executed
+// applies a query, for example: APIToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
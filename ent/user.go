@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
 	"github.com/mikestefanello/pagoda/ent/user"
 )
 
@@ -32,6 +33,10 @@ type User struct {
 	Verified bool `json:"verified,omitempty"`
 	// LastOnline holds the value of the "last_online" field.
 	LastOnline time.Time `json:"last_online,omitempty"`
+	// When the account gets deleted. It is locked until then, unless the deletion is cancelled.
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// When a moderator suspended the account, which is locked while suspended.
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -46,9 +51,31 @@ type UserEdges struct {
 	Profile *Profile `json:"profile,omitempty"`
 	// LastSeenAt holds the value of the last_seen_at edge.
 	LastSeenAt []*LastSeenOnline `json:"last_seen_at,omitempty"`
+	// TOTP secret used for two-factor authentication, if the user enrolled
+	TotpSecret *TotpSecret `json:"totp_secret,omitempty"`
+	// One-time codes to log in when the TOTP device is lost
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// WebAuthn credentials the user can sign in with instead of a password
+	Passkeys []*Passkey `json:"passkeys,omitempty"`
+	// External accounts, from social login providers, linked to the user
+	Identities []*Identity `json:"identities,omitempty"`
+	// Single-use sign-in links sent by email
+	MagicLinkTokens []*MagicLinkToken `json:"magic_link_tokens,omitempty"`
+	// Server-side sessions, one per device the user is logged in on
+	Sessions []*UserSession `json:"sessions,omitempty"`
+	// Personal access tokens to authenticate API requests with
+	APITokens []*APIToken `json:"api_tokens,omitempty"`
+	// Roles granting the user permissions, such as admin
+	Roles []*Role `json:"roles,omitempty"`
+	// Times this admin acted as another user
+	ImpersonationsPerformed []*Impersonation `json:"impersonations_performed,omitempty"`
+	// Times an admin acted as this user
+	Impersonations []*Impersonation `json:"impersonations,omitempty"`
+	// Reports this moderator acted on
+	ReportsResolved []*Report `json:"reports_resolved,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [14]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -80,6 +107,107 @@ func (e UserEdges) LastSeenAtOrErr() ([]*LastSeenOnline, error) {
 	return nil, &NotLoadedError{edge: "last_seen_at"}
 }
 
+// TotpSecretOrErr returns the TotpSecret value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) TotpSecretOrErr() (*TotpSecret, error) {
+	if e.TotpSecret != nil {
+		return e.TotpSecret, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: totpsecret.Label}
+	}
+	return nil, &NotLoadedError{edge: "totp_secret"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[4] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// PasskeysOrErr returns the Passkeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasskeysOrErr() ([]*Passkey, error) {
+	if e.loadedTypes[5] {
+		return e.Passkeys, nil
+	}
+	return nil, &NotLoadedError{edge: "passkeys"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[6] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// MagicLinkTokensOrErr returns the MagicLinkTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MagicLinkTokensOrErr() ([]*MagicLinkToken, error) {
+	if e.loadedTypes[7] {
+		return e.MagicLinkTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "magic_link_tokens"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*UserSession, error) {
+	if e.loadedTypes[8] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// APITokensOrErr returns the APITokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APITokensOrErr() ([]*APIToken, error) {
+	if e.loadedTypes[9] {
+		return e.APITokens, nil
+	}
+	return nil, &NotLoadedError{edge: "api_tokens"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[10] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// ImpersonationsPerformedOrErr returns the ImpersonationsPerformed value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ImpersonationsPerformedOrErr() ([]*Impersonation, error) {
+	if e.loadedTypes[11] {
+		return e.ImpersonationsPerformed, nil
+	}
+	return nil, &NotLoadedError{edge: "impersonations_performed"}
+}
+
+// ImpersonationsOrErr returns the Impersonations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ImpersonationsOrErr() ([]*Impersonation, error) {
+	if e.loadedTypes[12] {
+		return e.Impersonations, nil
+	}
+	return nil, &NotLoadedError{edge: "impersonations"}
+}
+
+// ReportsResolvedOrErr returns the ReportsResolved value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsResolvedOrErr() ([]*Report, error) {
+	if e.loadedTypes[13] {
+		return e.ReportsResolved, nil
+	}
+	return nil, &NotLoadedError{edge: "reports_resolved"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -91,7 +219,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case user.FieldName, user.FieldEmail, user.FieldPassword:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastOnline:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastOnline, user.FieldDeletionScheduledAt, user.FieldSuspendedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -156,6 +284,20 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.LastOnline = value.Time
 			}
+		case user.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				u.DeletionScheduledAt = new(time.Time)
+				*u.DeletionScheduledAt = value.Time
+			}
+		case user.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				u.SuspendedAt = new(time.Time)
+				*u.SuspendedAt = value.Time
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -184,6 +326,61 @@ func (u *User) QueryLastSeenAt() *LastSeenOnlineQuery {
 	return NewUserClient(u.config).QueryLastSeenAt(u)
 }
 
+// QueryTotpSecret queries the "totp_secret" edge of the User entity.
+func (u *User) QueryTotpSecret() *TotpSecretQuery {
+	return NewUserClient(u.config).QueryTotpSecret(u)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (u *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(u.config).QueryRecoveryCodes(u)
+}
+
+// QueryPasskeys queries the "passkeys" edge of the User entity.
+func (u *User) QueryPasskeys() *PasskeyQuery {
+	return NewUserClient(u.config).QueryPasskeys(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
+// QueryMagicLinkTokens queries the "magic_link_tokens" edge of the User entity.
+func (u *User) QueryMagicLinkTokens() *MagicLinkTokenQuery {
+	return NewUserClient(u.config).QueryMagicLinkTokens(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *UserSessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryAPITokens queries the "api_tokens" edge of the User entity.
+func (u *User) QueryAPITokens() *APITokenQuery {
+	return NewUserClient(u.config).QueryAPITokens(u)
+}
+
+// QueryRoles queries the "roles" edge of the User entity.
+func (u *User) QueryRoles() *RoleQuery {
+	return NewUserClient(u.config).QueryRoles(u)
+}
+
+// QueryImpersonationsPerformed queries the "impersonations_performed" edge of the User entity.
+func (u *User) QueryImpersonationsPerformed() *ImpersonationQuery {
+	return NewUserClient(u.config).QueryImpersonationsPerformed(u)
+}
+
+// QueryImpersonations queries the "impersonations" edge of the User entity.
+func (u *User) QueryImpersonations() *ImpersonationQuery {
+	return NewUserClient(u.config).QueryImpersonations(u)
+}
+
+// QueryReportsResolved queries the "reports_resolved" edge of the User entity.
+func (u *User) QueryReportsResolved() *ReportQuery {
+	return NewUserClient(u.config).QueryReportsResolved(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -226,6 +423,16 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_online=")
 	builder.WriteString(u.LastOnline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := u.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
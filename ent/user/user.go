@@ -29,12 +29,38 @@ const (
 	FieldVerified = "verified"
 	// FieldLastOnline holds the string denoting the last_online field in the database.
 	FieldLastOnline = "last_online"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
+	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
+	FieldSuspendedAt = "suspended_at"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeProfile holds the string denoting the profile edge name in mutations.
 	EdgeProfile = "profile"
 	// EdgeLastSeenAt holds the string denoting the last_seen_at edge name in mutations.
 	EdgeLastSeenAt = "last_seen_at"
+	// EdgeTotpSecret holds the string denoting the totp_secret edge name in mutations.
+	EdgeTotpSecret = "totp_secret"
+	// EdgeRecoveryCodes holds the string denoting the recovery_codes edge name in mutations.
+	EdgeRecoveryCodes = "recovery_codes"
+	// EdgePasskeys holds the string denoting the passkeys edge name in mutations.
+	EdgePasskeys = "passkeys"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeMagicLinkTokens holds the string denoting the magic_link_tokens edge name in mutations.
+	EdgeMagicLinkTokens = "magic_link_tokens"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeAPITokens holds the string denoting the api_tokens edge name in mutations.
+	EdgeAPITokens = "api_tokens"
+	// EdgeRoles holds the string denoting the roles edge name in mutations.
+	EdgeRoles = "roles"
+	// EdgeImpersonationsPerformed holds the string denoting the impersonations_performed edge name in mutations.
+	EdgeImpersonationsPerformed = "impersonations_performed"
+	// EdgeImpersonations holds the string denoting the impersonations edge name in mutations.
+	EdgeImpersonations = "impersonations"
+	// EdgeReportsResolved holds the string denoting the reports_resolved edge name in mutations.
+	EdgeReportsResolved = "reports_resolved"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -58,6 +84,81 @@ const (
 	LastSeenAtInverseTable = "last_seen_onlines"
 	// LastSeenAtColumn is the table column denoting the last_seen_at relation/edge.
 	LastSeenAtColumn = "user_last_seen_at"
+	// TotpSecretTable is the table that holds the totp_secret relation/edge.
+	TotpSecretTable = "totp_secrets"
+	// TotpSecretInverseTable is the table name for the TotpSecret entity.
+	// It exists in this package in order to avoid circular dependency with the "totpsecret" package.
+	TotpSecretInverseTable = "totp_secrets"
+	// TotpSecretColumn is the table column denoting the totp_secret relation/edge.
+	TotpSecretColumn = "user_id"
+	// RecoveryCodesTable is the table that holds the recovery_codes relation/edge.
+	RecoveryCodesTable = "recovery_codes"
+	// RecoveryCodesInverseTable is the table name for the RecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "recoverycode" package.
+	RecoveryCodesInverseTable = "recovery_codes"
+	// RecoveryCodesColumn is the table column denoting the recovery_codes relation/edge.
+	RecoveryCodesColumn = "user_id"
+	// PasskeysTable is the table that holds the passkeys relation/edge.
+	PasskeysTable = "passkeys"
+	// PasskeysInverseTable is the table name for the Passkey entity.
+	// It exists in this package in order to avoid circular dependency with the "passkey" package.
+	PasskeysInverseTable = "passkeys"
+	// PasskeysColumn is the table column denoting the passkeys relation/edge.
+	PasskeysColumn = "user_id"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "identities"
+	// IdentitiesInverseTable is the table name for the Identity entity.
+	// It exists in this package in order to avoid circular dependency with the "identity" package.
+	IdentitiesInverseTable = "identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_id"
+	// MagicLinkTokensTable is the table that holds the magic_link_tokens relation/edge.
+	MagicLinkTokensTable = "magic_link_tokens"
+	// MagicLinkTokensInverseTable is the table name for the MagicLinkToken entity.
+	// It exists in this package in order to avoid circular dependency with the "magiclinktoken" package.
+	MagicLinkTokensInverseTable = "magic_link_tokens"
+	// MagicLinkTokensColumn is the table column denoting the magic_link_tokens relation/edge.
+	MagicLinkTokensColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "user_sessions"
+	// SessionsInverseTable is the table name for the UserSession entity.
+	// It exists in this package in order to avoid circular dependency with the "usersession" package.
+	SessionsInverseTable = "user_sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
+	// APITokensTable is the table that holds the api_tokens relation/edge.
+	APITokensTable = "api_tokens"
+	// APITokensInverseTable is the table name for the APIToken entity.
+	// It exists in this package in order to avoid circular dependency with the "apitoken" package.
+	APITokensInverseTable = "api_tokens"
+	// APITokensColumn is the table column denoting the api_tokens relation/edge.
+	APITokensColumn = "user_id"
+	// RolesTable is the table that holds the roles relation/edge. The primary key declared below.
+	RolesTable = "user_roles"
+	// RolesInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RolesInverseTable = "roles"
+	// ImpersonationsPerformedTable is the table that holds the impersonations_performed relation/edge.
+	ImpersonationsPerformedTable = "impersonations"
+	// ImpersonationsPerformedInverseTable is the table name for the Impersonation entity.
+	// It exists in this package in order to avoid circular dependency with the "impersonation" package.
+	ImpersonationsPerformedInverseTable = "impersonations"
+	// ImpersonationsPerformedColumn is the table column denoting the impersonations_performed relation/edge.
+	ImpersonationsPerformedColumn = "admin_id"
+	// ImpersonationsTable is the table that holds the impersonations relation/edge.
+	ImpersonationsTable = "impersonations"
+	// ImpersonationsInverseTable is the table name for the Impersonation entity.
+	// It exists in this package in order to avoid circular dependency with the "impersonation" package.
+	ImpersonationsInverseTable = "impersonations"
+	// ImpersonationsColumn is the table column denoting the impersonations relation/edge.
+	ImpersonationsColumn = "user_id"
+	// ReportsResolvedTable is the table that holds the reports_resolved relation/edge.
+	ReportsResolvedTable = "reports"
+	// ReportsResolvedInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsResolvedInverseTable = "reports"
+	// ReportsResolvedColumn is the table column denoting the reports_resolved relation/edge.
+	ReportsResolvedColumn = "user_reports_resolved"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -70,8 +171,16 @@ var Columns = []string{
 	FieldPassword,
 	FieldVerified,
 	FieldLastOnline,
+	FieldDeletionScheduledAt,
+	FieldSuspendedAt,
 }
 
+var (
+	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
+	// primary key for the roles relation (M2M).
+	RolesPrimaryKey = []string{"user_id", "role_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -148,6 +257,16 @@ func ByLastOnline(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastOnline, opts...).ToFunc()
 }
 
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
+// BySuspendedAt orders the results by the suspended_at field.
+func BySuspendedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedAt, opts...).ToFunc()
+}
+
 // ByOwnerCount orders the results by owner count.
 func ByOwnerCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -182,6 +301,153 @@ func ByLastSeenAt(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLastSeenAtStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTotpSecretField orders the results by totp_secret field.
+func ByTotpSecretField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTotpSecretStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecoveryCodesCount orders the results by recovery_codes count.
+func ByRecoveryCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveryCodesStep(), opts...)
+	}
+}
+
+// ByRecoveryCodes orders the results by recovery_codes terms.
+func ByRecoveryCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveryCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPasskeysCount orders the results by passkeys count.
+func ByPasskeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPasskeysStep(), opts...)
+	}
+}
+
+// ByPasskeys orders the results by passkeys terms.
+func ByPasskeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPasskeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMagicLinkTokensCount orders the results by magic_link_tokens count.
+func ByMagicLinkTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMagicLinkTokensStep(), opts...)
+	}
+}
+
+// ByMagicLinkTokens orders the results by magic_link_tokens terms.
+func ByMagicLinkTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMagicLinkTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAPITokensCount orders the results by api_tokens count.
+func ByAPITokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPITokensStep(), opts...)
+	}
+}
+
+// ByAPITokens orders the results by api_tokens terms.
+func ByAPITokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPITokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRolesCount orders the results by roles count.
+func ByRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolesStep(), opts...)
+	}
+}
+
+// ByRoles orders the results by roles terms.
+func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImpersonationsPerformedCount orders the results by impersonations_performed count.
+func ByImpersonationsPerformedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImpersonationsPerformedStep(), opts...)
+	}
+}
+
+// ByImpersonationsPerformed orders the results by impersonations_performed terms.
+func ByImpersonationsPerformed(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImpersonationsPerformedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImpersonationsCount orders the results by impersonations count.
+func ByImpersonationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImpersonationsStep(), opts...)
+	}
+}
+
+// ByImpersonations orders the results by impersonations terms.
+func ByImpersonations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImpersonationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReportsResolvedCount orders the results by reports_resolved count.
+func ByReportsResolvedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsResolvedStep(), opts...)
+	}
+}
+
+// ByReportsResolved orders the results by reports_resolved terms.
+func ByReportsResolved(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsResolvedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -203,3 +469,80 @@ func newLastSeenAtStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LastSeenAtTable, LastSeenAtColumn),
 	)
 }
+func newTotpSecretStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TotpSecretInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, TotpSecretTable, TotpSecretColumn),
+	)
+}
+func newRecoveryCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveryCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+	)
+}
+func newPasskeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PasskeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PasskeysTable, PasskeysColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
+func newMagicLinkTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MagicLinkTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MagicLinkTokensTable, MagicLinkTokensColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newAPITokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APITokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
+	)
+}
+func newRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RolesTable, RolesPrimaryKey...),
+	)
+}
+func newImpersonationsPerformedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImpersonationsPerformedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImpersonationsPerformedTable, ImpersonationsPerformedColumn),
+	)
+}
+func newImpersonationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImpersonationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImpersonationsTable, ImpersonationsColumn),
+	)
+}
+func newReportsResolvedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsResolvedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsResolvedTable, ReportsResolvedColumn),
+	)
+}
@@ -11,10 +11,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/identity"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
+	"github.com/mikestefanello/pagoda/ent/passkey"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
 	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/ent/role"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/usersession"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -99,6 +109,34 @@ func (uc *UserCreate) SetNillableLastOnline(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uc *UserCreate) SetDeletionScheduledAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletionScheduledAt(t)
+	return uc
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletionScheduledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletionScheduledAt(*t)
+	}
+	return uc
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uc *UserCreate) SetSuspendedAt(t time.Time) *UserCreate {
+	uc.mutation.SetSuspendedAt(t)
+	return uc
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSuspendedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetSuspendedAt(*t)
+	}
+	return uc
+}
+
 // AddOwnerIDs adds the "owner" edge to the PasswordToken entity by IDs.
 func (uc *UserCreate) AddOwnerIDs(ids ...int) *UserCreate {
 	uc.mutation.AddOwnerIDs(ids...)
@@ -148,6 +186,175 @@ func (uc *UserCreate) AddLastSeenAt(l ...*LastSeenOnline) *UserCreate {
 	return uc.AddLastSeenAtIDs(ids...)
 }
 
+// SetTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID.
+func (uc *UserCreate) SetTotpSecretID(id int) *UserCreate {
+	uc.mutation.SetTotpSecretID(id)
+	return uc
+}
+
+// SetNillableTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecretID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetTotpSecretID(*id)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" edge to the TotpSecret entity.
+func (uc *UserCreate) SetTotpSecret(t *TotpSecret) *UserCreate {
+	return uc.SetTotpSecretID(t.ID)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uc *UserCreate) AddRecoveryCodeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRecoveryCodeIDs(ids...)
+	return uc
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uc *UserCreate) AddRecoveryCodes(r ...*RecoveryCode) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRecoveryCodeIDs(ids...)
+}
+
+// AddPasskeyIDs adds the "passkeys" edge to the Passkey entity by IDs.
+func (uc *UserCreate) AddPasskeyIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPasskeyIDs(ids...)
+	return uc
+}
+
+// AddPasskeys adds the "passkeys" edges to the Passkey entity.
+func (uc *UserCreate) AddPasskeys(p ...*Passkey) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasskeyIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uc *UserCreate) AddIdentities(i ...*Identity) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// AddMagicLinkTokenIDs adds the "magic_link_tokens" edge to the MagicLinkToken entity by IDs.
+func (uc *UserCreate) AddMagicLinkTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMagicLinkTokenIDs(ids...)
+	return uc
+}
+
+// AddMagicLinkTokens adds the "magic_link_tokens" edges to the MagicLinkToken entity.
+func (uc *UserCreate) AddMagicLinkTokens(m ...*MagicLinkToken) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMagicLinkTokenIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the UserSession entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the UserSession entity.
+func (uc *UserCreate) AddSessions(u ...*UserSession) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uc *UserCreate) AddAPITokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAPITokenIDs(ids...)
+	return uc
+}
+
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uc *UserCreate) AddAPITokens(a ...*APIToken) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAPITokenIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uc *UserCreate) AddRoleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRoleIDs(ids...)
+	return uc
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uc *UserCreate) AddRoles(r ...*Role) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRoleIDs(ids...)
+}
+
+// AddImpersonationsPerformedIDs adds the "impersonations_performed" edge to the Impersonation entity by IDs.
+func (uc *UserCreate) AddImpersonationsPerformedIDs(ids ...int) *UserCreate {
+	uc.mutation.AddImpersonationsPerformedIDs(ids...)
+	return uc
+}
+
+// AddImpersonationsPerformed adds the "impersonations_performed" edges to the Impersonation entity.
+func (uc *UserCreate) AddImpersonationsPerformed(i ...*Impersonation) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddImpersonationsPerformedIDs(ids...)
+}
+
+// AddImpersonationIDs adds the "impersonations" edge to the Impersonation entity by IDs.
+func (uc *UserCreate) AddImpersonationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddImpersonationIDs(ids...)
+	return uc
+}
+
+// AddImpersonations adds the "impersonations" edges to the Impersonation entity.
+func (uc *UserCreate) AddImpersonations(i ...*Impersonation) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddImpersonationIDs(ids...)
+}
+
+// AddReportsResolvedIDs adds the "reports_resolved" edge to the Report entity by IDs.
+func (uc *UserCreate) AddReportsResolvedIDs(ids ...int) *UserCreate {
+	uc.mutation.AddReportsResolvedIDs(ids...)
+	return uc
+}
+
+// AddReportsResolved adds the "reports_resolved" edges to the Report entity.
+func (uc *UserCreate) AddReportsResolved(r ...*Report) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddReportsResolvedIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -296,6 +503,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastOnline, field.TypeTime, value)
 		_node.LastOnline = value
 	}
+	if value, ok := uc.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
+	if value, ok := uc.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
 	if nodes := uc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -344,6 +559,182 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.TotpSecretIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.TotpSecretTable,
+			Columns: []string{user.TotpSecretColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasskeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeysTable,
+			Columns: []string{user.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MagicLinkTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MagicLinkTokensTable,
+			Columns: []string{user.MagicLinkTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(magiclinktoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ImpersonationsPerformedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonationsPerformedTable,
+			Columns: []string{user.ImpersonationsPerformedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ImpersonationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonationsTable,
+			Columns: []string{user.ImpersonationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ReportsResolvedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -474,6 +865,42 @@ func (u *UserUpsert) ClearLastOnline() *UserUpsert {
 	return u
 }
 
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *UserUpsert) SetDeletionScheduledAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldDeletionScheduledAt, v)
+	return u
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDeletionScheduledAt() *UserUpsert {
+	u.SetExcluded(user.FieldDeletionScheduledAt)
+	return u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *UserUpsert) ClearDeletionScheduledAt() *UserUpsert {
+	u.SetNull(user.FieldDeletionScheduledAt)
+	return u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsert) SetSuspendedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldSuspendedAt, v)
+	return u
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSuspendedAt() *UserUpsert {
+	u.SetExcluded(user.FieldSuspendedAt)
+	return u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsert) ClearSuspendedAt() *UserUpsert {
+	u.SetNull(user.FieldSuspendedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -610,6 +1037,48 @@ func (u *UserUpsertOne) ClearLastOnline() *UserUpsertOne {
 	})
 }
 
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *UserUpsertOne) SetDeletionScheduledAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletionScheduledAt(v)
+	})
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDeletionScheduledAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletionScheduledAt()
+	})
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *UserUpsertOne) ClearDeletionScheduledAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletionScheduledAt()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsertOne) SetSuspendedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSuspendedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsertOne) ClearSuspendedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -912,6 +1381,48 @@ func (u *UserUpsertBulk) ClearLastOnline() *UserUpsertBulk {
 	})
 }
 
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *UserUpsertBulk) SetDeletionScheduledAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletionScheduledAt(v)
+	})
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDeletionScheduledAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletionScheduledAt()
+	})
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *UserUpsertBulk) ClearDeletionScheduledAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletionScheduledAt()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *UserUpsertBulk) SetSuspendedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSuspendedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *UserUpsertBulk) ClearSuspendedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
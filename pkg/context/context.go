@@ -22,6 +22,22 @@ const (
 	PasswordTokenKey = "password_token"
 
 	IsFromIOSApp = "is_from_ios_app"
+
+	// APITokenKey is the key value used to store the personal access token a request was authenticated with
+	APITokenKey = "api_token"
+
+	// ImpersonationKey is the key value used to store the impersonation an admin is acting as the authenticated user with
+	ImpersonationKey = "impersonation"
+
+	// OrganizationKey is the key value used to store the organization the authenticated user is working in
+	OrganizationKey = "org"
+
+	// OrganizationMembershipKey is the key value used to store the membership of the authenticated user in the
+	// organization they are working in
+	OrganizationMembershipKey = "org_membership"
+
+	// OrganizationMembershipsKey is the key value used to store all the memberships of the authenticated user
+	OrganizationMembershipsKey = "org_memberships"
 )
 
 // IsCanceledError determines if an error is due to a context cancelation
@@ -13,6 +13,7 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/mikestefanello/pagoda/pkg/htmx"
 	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/templates"
 )
 
@@ -88,9 +89,27 @@ type Page struct {
 
 	AuthUserProfilePicURL string
 
+	// Roles stores the names of the roles granted to the authenticated user
+	Roles []string
+
+	// IsAdmin indicates whether the authenticated user has the admin role
+	IsAdmin bool
+
+	// IsImpersonating indicates whether an admin is acting as the authenticated user
+	IsImpersonating bool
+
+	// Impersonator stores the admin acting as the authenticated user, if any
+	Impersonator *ent.User
+
 	// AuthProfile stores the authenticated profile
 	AuthProfile *ent.Profile
 
+	// Organization stores the organization the authenticated user is working in, if they are part of any
+	Organization *ent.Organization
+
+	// OrganizationMemberships stores the memberships of the authenticated user, along with their organization
+	OrganizationMemberships []*ent.OrganizationMembership
+
 	// ActiveProduct stores the active product for the profile (limited to 1 for now)
 	ActiveProduct domain.ProductType
 
@@ -114,6 +133,9 @@ type Page struct {
 	// If this is populated, all forms must include this value otherwise the requests will be rejected.
 	CSRF string
 
+	// BotChallenge stores the challenge forms protected against bots on the page embed, if any
+	BotChallenge *services.BotChallenge
+
 	// Headers stores a list of HTTP headers and values to be set on the response
 	Headers map[string]string
 
@@ -169,6 +191,10 @@ func NewPage(ctx echo.Context) Page {
 	if u := ctx.Get(context.AuthenticatedUserKey); u != nil {
 		p.IsAuth = true
 		p.AuthUser = u.(*ent.User)
+		for _, r := range p.AuthUser.Edges.Roles {
+			p.Roles = append(p.Roles, r.Name)
+		}
+		p.IsAdmin = services.UserHasRole(p.AuthUser, services.RoleAdmin)
 		if p.AuthUser.Edges.Profile != nil {
 			p.AuthProfile = p.AuthUser.Edges.Profile
 			if fullyOnboarded := ctx.Get(context.ProfileFullyOnboarded); fullyOnboarded != nil {
@@ -178,6 +204,16 @@ func NewPage(ctx echo.Context) Page {
 			}
 		}
 	}
+	if imp, ok := ctx.Get(context.ImpersonationKey).(*ent.Impersonation); ok {
+		p.IsImpersonating = true
+		p.Impersonator = imp.Edges.Admin
+	}
+	if org, ok := ctx.Get(context.OrganizationKey).(*ent.Organization); ok {
+		p.Organization = org
+	}
+	if memberships, ok := ctx.Get(context.OrganizationMembershipsKey).([]*ent.OrganizationMembership); ok {
+		p.OrganizationMemberships = memberships
+	}
 	if u := ctx.Get(context.AuthenticatedUserProfilePicURL); u != nil {
 		p.AuthUserProfilePicURL = u.(string)
 	}
@@ -202,3 +238,13 @@ func (p Page) GetMessages(typ msg.Type) []template.HTML {
 	}
 	return ret
 }
+
+// HasRole returns true if the authenticated user was granted any of the given roles
+func (p Page) HasRole(roles ...string) bool {
+	return services.UserHasRole(p.AuthUser, roles...)
+}
+
+// HasPermission returns true if a role of the authenticated user grants the given permission
+func (p Page) HasPermission(permission string) bool {
+	return services.UserHasPermission(p.AuthUser, permission)
+}
@@ -16,6 +16,13 @@ var (
 	NotificationTypePlatformUpdate                = NotificationType{"platform_update"}
 	NotificationTypePaymentFailed                 = NotificationType{"payment_failed"}
 	NotificationTypeDailyConversationReminder     = NotificationType{"daily_conversation_reminder"}
+	NotificationTypeInvitationAccepted            = NotificationType{"invitation_accepted"}
+	NotificationTypeFriendRequestReceived         = NotificationType{"friend_request_received"}
+	NotificationTypeFriendRequestAccepted         = NotificationType{"friend_request_accepted"}
+	NotificationTypeFriendRequestDeclined         = NotificationType{"friend_request_declined"}
+	NotificationTypeFriendRequestCancelled        = NotificationType{"friend_request_cancelled"}
+	NotificationTypeReportResolved                = NotificationType{"report_resolved"}
+	NotificationTypeModerationAction              = NotificationType{"moderation_action"}
 
 	NotificationTypes = enum.New(
 		NotificationTypeNewPrivateMessage,
@@ -26,6 +33,13 @@ var (
 		NotificationTypePlatformUpdate,
 		NotificationTypePaymentFailed,
 		NotificationTypeDailyConversationReminder,
+		NotificationTypeInvitationAccepted,
+		NotificationTypeFriendRequestReceived,
+		NotificationTypeFriendRequestAccepted,
+		NotificationTypeFriendRequestDeclined,
+		NotificationTypeFriendRequestCancelled,
+		NotificationTypeReportResolved,
+		NotificationTypeModerationAction,
 	)
 )
 
@@ -59,6 +73,91 @@ var (
 	)
 )
 
+// FriendshipStatus is how a profile relates to another one, as seen from the former
+type FriendshipStatus enum.Member[string]
+
+var (
+	FriendshipStatusNone            = FriendshipStatus{"none"}
+	FriendshipStatusRequestSent     = FriendshipStatus{"request_sent"}
+	FriendshipStatusRequestReceived = FriendshipStatus{"request_received"}
+	FriendshipStatusFriends         = FriendshipStatus{"friends"}
+	FriendshipStatusBlocked         = FriendshipStatus{"blocked"}
+	FriendshipStatusBlockedBy       = FriendshipStatus{"blocked_by"}
+
+	FriendshipStatuses = enum.New(
+		FriendshipStatusNone,
+		FriendshipStatusRequestSent,
+		FriendshipStatusRequestReceived,
+		FriendshipStatusFriends,
+		FriendshipStatusBlocked,
+		FriendshipStatusBlockedBy,
+	)
+)
+
+// OrganizationRole is what a profile is allowed to do in an organization it is part of
+type OrganizationRole enum.Member[string]
+
+var (
+	OrganizationRoleOwner  = OrganizationRole{"owner"}
+	OrganizationRoleAdmin  = OrganizationRole{"admin"}
+	OrganizationRoleMember = OrganizationRole{"member"}
+
+	OrganizationRoles = enum.New(
+		OrganizationRoleOwner,
+		OrganizationRoleAdmin,
+		OrganizationRoleMember,
+	)
+)
+
+// PhoneCodePurpose is what a one-time code sent by SMS is for
+type PhoneCodePurpose enum.Member[string]
+
+var (
+	PhoneCodePurposeVerify = PhoneCodePurpose{"verify"}
+	PhoneCodePurposeLogin  = PhoneCodePurpose{"login"}
+
+	PhoneCodePurposes = enum.New(
+		PhoneCodePurposeVerify,
+		PhoneCodePurposeLogin,
+	)
+)
+
+// SMSStatus is how far a text message got towards its recipient, as reported by the provider sending it
+type SMSStatus enum.Member[string]
+
+var (
+	SMSStatusSent        = SMSStatus{"sent"}
+	SMSStatusDelivered   = SMSStatus{"delivered"}
+	SMSStatusUndelivered = SMSStatus{"undelivered"}
+	SMSStatusFailed      = SMSStatus{"failed"}
+
+	SMSStatuses = enum.New(
+		SMSStatusSent,
+		SMSStatusDelivered,
+		SMSStatusUndelivered,
+		SMSStatusFailed,
+	)
+)
+
+// PushDeliveryStatus is how far a push notification got towards a single device
+type PushDeliveryStatus enum.Member[string]
+
+var (
+	PushDeliveryStatusQueued   = PushDeliveryStatus{"queued"}
+	PushDeliveryStatusSent     = PushDeliveryStatus{"sent"}
+	PushDeliveryStatusRetrying = PushDeliveryStatus{"retrying"}
+	PushDeliveryStatusFailed   = PushDeliveryStatus{"failed"}
+	PushDeliveryStatusPruned   = PushDeliveryStatus{"pruned"}
+
+	PushDeliveryStatuses = enum.New(
+		PushDeliveryStatusQueued,
+		PushDeliveryStatusSent,
+		PushDeliveryStatusRetrying,
+		PushDeliveryStatusFailed,
+		PushDeliveryStatusPruned,
+	)
+)
+
 type ImageSize enum.Member[string]
 
 var (
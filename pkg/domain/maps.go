@@ -16,6 +16,9 @@ var NotificationPermissionMap = map[NotificationPermissionType]NotificationPermi
 
 var NotificationCenterButtonText = map[NotificationType]string{
 	NotificationTypeConnectionEngagedWithQuestion: "Answer",
+	NotificationTypeInvitationAccepted:            "See profile",
+	NotificationTypeFriendRequestReceived:         "Respond",
+	NotificationTypeFriendRequestAccepted:         "See profile",
 }
 
 // DeleteOnceReadNotificationTypesMap is a map of notification types th;oiSJDfiujladijrgoizdikrjgat can be deleted once seen.
@@ -23,3 +26,12 @@ var NotificationCenterButtonText = map[NotificationType]string{
 var DeleteOnceReadNotificationTypesMap = map[NotificationType]bool{
 	NotificationTypeDailyConversationReminder: true,
 }
+
+// NotificationTypePermissionMap maps the notifications users can opt out of to the permission they need to be
+// sent beyond the app. Notifications missing from it cannot be opted out of, such as a failed payment or a
+// friend request, and are always sent to the devices subscribed to push notifications, but never by email or SMS.
+var NotificationTypePermissionMap = map[NotificationType]NotificationPermissionType{
+	NotificationTypeDailyConversationReminder:     NotificationPermissionDailyReminder,
+	NotificationTypeConnectionEngagedWithQuestion: NotificationPermissionNewFriendActivity,
+	NotificationTypeNewPrivateMessage:             NotificationPermissionNewFriendActivity,
+}
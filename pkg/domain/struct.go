@@ -152,6 +152,22 @@ type Invitation struct {
 	CreatedAt        time.Time `json:"created_at"`
 	InviteeName      string    `json:"invitee_name"`
 	ConfirmationCode string    `json:"confirmation_code"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+// FriendRequest is a pending friend request, along with the profile on the other end of it
+type FriendRequest struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Profile   Profile   `json:"profile"`
+}
+
+// Friendship is how a profile relates to another one
+type Friendship struct {
+	ProfileID int              `json:"profile_id"`
+	Status    FriendshipStatus `json:"status"`
+	// RequestID is the pending friend request between both profiles, if any
+	RequestID int `json:"request_id,omitempty"`
 }
 
 // AggregatedEmojiReaction aggregates all the emoji reaction for a specific emoji root,
@@ -19,6 +19,7 @@ const (
 	HeaderPush               = "HX-Push"
 	HeaderRedirect           = "HX-Redirect"
 	HeaderRefresh            = "HX-Refresh"
+	HeaderReswap             = "HX-Reswap"
 )
 
 type (
@@ -40,6 +41,7 @@ type (
 		Trigger            string
 		TriggerAfterSwap   string
 		TriggerAfterSettle string
+		Reswap             string
 		NoContent          bool
 	}
 )
@@ -76,6 +78,9 @@ func (r Response) Apply(ctx echo.Context) {
 	if r.TriggerAfterSettle != "" {
 		ctx.Response().Header().Set(HeaderTriggerAfterSettle, r.TriggerAfterSettle)
 	}
+	if r.Reswap != "" {
+		ctx.Response().Header().Set(HeaderReswap, r.Reswap)
+	}
 	if r.NoContent {
 		ctx.Response().Status = http.StatusNoContent
 	}
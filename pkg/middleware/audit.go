@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+// SetAuditRequest stores who made the request, from where and under which request ID in the request
+// context, so audit log records made while handling it carry them.
+// This requires that the request ID and authenticated user middleware first execute.
+func SetAuditRequest() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := services.AuditRequest{
+				IPAddress: c.RealIP(),
+				UserAgent: c.Request().UserAgent(),
+				RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+			}
+			if u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User); ok {
+				r.ActorID = &u.ID
+			}
+			if imp, ok := c.Get(context.ImpersonationKey).(*ent.Impersonation); ok {
+				r.ImpersonatorID = &imp.AdminID
+			}
+
+			c.SetRequest(c.Request().WithContext(services.WithAuditRequest(c.Request().Context(), r)))
+			return next(c)
+		}
+	}
+}
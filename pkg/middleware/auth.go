@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
@@ -29,24 +30,24 @@ func LoadAuthenticatedUser(
 				c.Logger().Warn("auth user not found")
 			case services.NotAuthenticatedError:
 			case nil:
-				c.Set(context.AuthenticatedUserKey, u)
-				if u.Edges.Profile != nil {
-					c.Set(context.ProfileFullyOnboarded, profilerepo.IsProfileFullyOnboarded(u.Edges.Profile))
-
-					// if subscriptionsRepo != nil {
-					// 	activeProduct, _, err := subscriptionsRepo.GetCurrentlyActiveProduct(c.Request().Context(), u.Edges.Profile.ID)
-					// 	if err != nil {
-					// 		log.Error().Err(err).Int("userID", u.ID).Int("profileID", u.Edges.Profile.ID).Msg("failed to get active product in middleware for user")
-					// 	}
-					// 	c.Set(context.ActiveProductPlan, activeProduct)
-
-					// }
+				if err := loadImpersonation(c, authClient); err != nil {
+					return echo.NewHTTPError(
+						http.StatusInternalServerError,
+						fmt.Sprintf("error querying for impersonation: %v", err),
+					)
 				}
-				if profileRepo != nil {
-					// TODO: cache profile photo URL somewhere in the stack
-					c.Set(context.AuthenticatedUserProfilePicURL, profileRepo.GetProfilePhotoThumbnailURL(u.ID))
+				// Suspended users are logged out, though admins can still act as them
+				if u.SuspendedAt != nil && c.Get(context.ImpersonationKey) == nil {
+					if err := authClient.Logout(c); err != nil {
+						return echo.NewHTTPError(
+							http.StatusInternalServerError,
+							fmt.Sprintf("error logging out suspended user: %v", err),
+						)
+					}
+					msg.Danger(c, "Your account was suspended for breaking our community guidelines.")
+					return next(c)
 				}
-				c.Logger().Infof("auth user loaded in to context: %d", u.ID)
+				setAuthenticatedUser(c, u, profileRepo)
 			default:
 				return echo.NewHTTPError(
 					http.StatusInternalServerError,
@@ -59,6 +60,117 @@ func LoadAuthenticatedUser(
 	}
 }
 
+// LoadAPITokenUser authenticates requests carrying a personal access token in a bearer Authorization
+// header, storing its owner in context like LoadAuthenticatedUser does. Requests without one are left as is.
+// Tokens need the write scope for anything other than reading.
+func LoadAPITokenUser(authClient *services.AuthClient, profileRepo *profilerepo.ProfileRepo) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok {
+				return next(c)
+			}
+
+			u, t, err := authClient.AuthenticateAPIToken(c.Request().Context(), strings.TrimSpace(token))
+			switch err.(type) {
+			case nil:
+			case services.InvalidAPITokenError:
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+			default:
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					fmt.Sprintf("error querying for API token: %v", err),
+				)
+			}
+
+			scope := services.APITokenScopeWrite
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				scope = services.APITokenScopeRead
+			}
+			if !services.APITokenHasScope(t, scope) {
+				return apiTokenScopeError(c, scope)
+			}
+
+			c.Set(context.APITokenKey, t)
+			setAuthenticatedUser(c, u, profileRepo)
+
+			return next(c)
+		}
+	}
+}
+
+// RequireAPITokenScope requires that requests authenticated with a personal access token were granted a scope.
+// Requests authenticated otherwise are not affected.
+func RequireAPITokenScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if t, ok := c.Get(context.APITokenKey).(*ent.APIToken); ok && !services.APITokenHasScope(t, scope) {
+				return apiTokenScopeError(c, scope)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireAPIAuthentication requires that the user be authenticated, responding with a JSON error rather
+// than redirecting to the login page
+func RequireAPIAuthentication() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Get(context.AuthenticatedUserKey) == nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "authentication required"})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func apiTokenScopeError(c echo.Context, scope string) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, scope))
+	return c.JSON(http.StatusForbidden, map[string]string{"error": "token is missing the " + scope + " scope"})
+}
+
+// setAuthenticatedUser stores the authenticated user, and what is derived from them, in context
+func setAuthenticatedUser(c echo.Context, u *ent.User, profileRepo *profilerepo.ProfileRepo) {
+	c.Set(context.AuthenticatedUserKey, u)
+	if u.Edges.Profile != nil {
+		c.Set(context.ProfileFullyOnboarded, profilerepo.IsProfileFullyOnboarded(u.Edges.Profile))
+
+		// if subscriptionsRepo != nil {
+		// 	activeProduct, _, err := subscriptionsRepo.GetCurrentlyActiveProduct(c.Request().Context(), u.Edges.Profile.ID)
+		// 	if err != nil {
+		// 		log.Error().Err(err).Int("userID", u.ID).Int("profileID", u.Edges.Profile.ID).Msg("failed to get active product in middleware for user")
+		// 	}
+		// 	c.Set(context.ActiveProductPlan, activeProduct)
+
+		// }
+	}
+	if profileRepo != nil {
+		// TODO: cache profile photo URL somewhere in the stack
+		c.Set(context.AuthenticatedUserProfilePicURL, profileRepo.GetProfilePhotoThumbnailURL(u.ID))
+	}
+	c.Logger().Infof("auth user loaded in to context: %d", u.ID)
+}
+
+// loadImpersonation stores in context the impersonation the admin of the session is acting as the
+// authenticated user with, if any
+func loadImpersonation(c echo.Context, authClient *services.AuthClient) error {
+	imp, err := authClient.GetImpersonation(c)
+	switch err.(type) {
+	case nil:
+		c.Set(context.ImpersonationKey, imp)
+	case services.NotImpersonatingError:
+	default:
+		return err
+	}
+	return nil
+}
+
 // LoadValidPasswordToken loads a valid password token entity that matches the user and token
 // provided in path parameters
 // If the token is invalid, the user will be redirected to the forgot password route
@@ -133,6 +245,52 @@ func RequireAuthentication() echo.MiddlewareFunc {
 	}
 }
 
+// RequireRole requires that the authenticated user was granted any of the given roles in order to proceed.
+// This must come after RequireAuthentication.
+func RequireRole(roles ...string) echo.MiddlewareFunc {
+	return requireAuthorization(func(u *ent.User) bool {
+		return services.UserHasRole(u, roles...)
+	})
+}
+
+// RequirePermission requires that a role of the authenticated user grants the given permission in order to proceed.
+// This must come after RequireAuthentication.
+func RequirePermission(permission string) echo.MiddlewareFunc {
+	return requireAuthorization(func(u *ent.User) bool {
+		return services.UserHasPermission(u, permission)
+	})
+}
+
+func requireAuthorization(allowed func(u *ent.User) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User)
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+			if !allowed(u) {
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireNotImpersonating blocks actions an admin must not take on behalf of the user they are acting as,
+// such as payments or deleting the account
+func RequireNotImpersonating() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Get(context.ImpersonationKey) != nil {
+				return echo.NewHTTPError(http.StatusForbidden, "not allowed while impersonating a user")
+			}
+
+			return next(c)
+		}
+	}
+}
+
 // RequireNoAuthentication requires that the user not be authenticated in order to proceed
 func RequireNoAuthentication() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
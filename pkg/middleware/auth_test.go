@@ -1,12 +1,16 @@
 package middleware
 
 import (
+	stdcontext "context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/tests"
 
 	"github.com/stretchr/testify/require"
@@ -35,6 +39,30 @@ func TestLoadAuthenticatedUser(t *testing.T) {
 	assert.Equal(t, usr.ID, ctxUsr.ID)
 }
 
+func TestLoadAuthenticatedUserSuspended(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	mw := LoadAuthenticatedUser(c.Auth, nil, nil)
+
+	suspended, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	err = c.Auth.Login(ctx, suspended.ID)
+	require.NoError(t, err)
+
+	err = c.ORM.User.UpdateOneID(suspended.ID).SetSuspendedAt(time.Now()).Exec(stdcontext.Background())
+	require.NoError(t, err)
+
+	// Suspended users are logged out
+	_ = tests.ExecuteMiddleware(ctx, mw)
+	assert.Nil(t, ctx.Get(context.AuthenticatedUserKey))
+	_, err = c.Auth.GetAuthenticatedUserID(ctx)
+	assert.Error(t, err)
+
+	// And cannot log back in
+	err = c.Auth.Login(ctx, suspended.ID)
+	assert.Equal(t, services.AccountSuspendedError{}, err)
+}
+
 func TestRequireAuthentication(t *testing.T) {
 	ctx, _ := tests.NewContext(c.Web, "/")
 	tests.InitSession(ctx)
@@ -111,3 +139,102 @@ func TestLoadValidPasswordToken(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, pt.ID, ctxPt.ID)
 }
+
+func TestRequireRole(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	// Not logged in
+	err := tests.ExecuteMiddleware(ctx, RequireRole(services.RoleAdmin))
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Logged in without the role
+	u := &ent.User{ID: usr.ID}
+	ctx.Set(context.AuthenticatedUserKey, u)
+	err = tests.ExecuteMiddleware(ctx, RequireRole(services.RoleAdmin))
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+
+	// Logged in with the role
+	u.Edges.Roles = []*ent.Role{{Name: services.RoleAdmin}}
+	err = tests.ExecuteMiddleware(ctx, RequireRole(services.RoleAdmin))
+	assert.Nil(t, err)
+}
+
+func TestRequirePermission(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	u := &ent.User{ID: usr.ID}
+	ctx.Set(context.AuthenticatedUserKey, u)
+
+	err := tests.ExecuteMiddleware(ctx, RequirePermission(services.PermissionAdminAccess))
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+
+	u.Edges.Roles = []*ent.Role{{Name: services.RoleAdmin, Permissions: []string{services.PermissionAll}}}
+	err = tests.ExecuteMiddleware(ctx, RequirePermission(services.PermissionAdminAccess))
+	assert.Nil(t, err)
+}
+
+func TestLoadAPITokenUser(t *testing.T) {
+	mw := LoadAPITokenUser(c.Auth, nil)
+
+	// No token
+	ctx, _ := tests.NewContext(c.Web, "/")
+	err := tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Get(context.AuthenticatedUserKey))
+
+	// Invalid token
+	ctx, rec := tests.NewContext(c.Web, "/")
+	ctx.Request().Header.Set(echo.HeaderAuthorization, "Bearer pat_invalid")
+	err = tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Nil(t, ctx.Get(context.AuthenticatedUserKey))
+
+	// Valid token
+	token, _, err := c.Auth.CreateAPIToken(ctx.Request().Context(), usr.ID, "test", []string{services.APITokenScopeRead}, nil)
+	require.NoError(t, err)
+	ctx, _ = tests.NewContext(c.Web, "/")
+	ctx.Request().Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	err = tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+	ctxUsr, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	require.True(t, ok)
+	assert.Equal(t, usr.ID, ctxUsr.ID)
+	assert.NotNil(t, ctx.Get(context.APITokenKey))
+
+	// Read-only tokens cannot change anything
+	ctx, rec = tests.NewContext(c.Web, "/")
+	ctx.Request().Method = http.MethodPost
+	ctx.Request().Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	err = tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Nil(t, ctx.Get(context.AuthenticatedUserKey))
+}
+
+func TestRequireNotImpersonating(t *testing.T) {
+	admin, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	require.NoError(t, c.Auth.GrantRole(stdcontext.Background(), admin.ID, services.RoleAdmin))
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	require.NoError(t, c.Auth.Login(ctx, admin.ID))
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth, nil, nil))
+
+	// The admin themselves is not restricted
+	err = tests.ExecuteMiddleware(ctx, RequireNotImpersonating())
+	assert.Nil(t, err)
+
+	// Acting as a user is
+	_, err = c.Auth.StartImpersonation(ctx, ctx.Get(context.AuthenticatedUserKey).(*ent.User), usr.ID, "test")
+	require.NoError(t, err)
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth, nil, nil))
+
+	ctxUsr, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	require.True(t, ok)
+	assert.Equal(t, usr.ID, ctxUsr.ID)
+	require.NotNil(t, ctx.Get(context.ImpersonationKey))
+
+	err = tests.ExecuteMiddleware(ctx, RequireNotImpersonating())
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/repos/organizations"
+)
+
+// organizationSessionKey is the session value storing the organization the user last switched to
+const organizationSessionKey = "organization_id"
+
+// LoadOrganization loads the organization the authenticated user is working in, if they are part of any, and
+// stores it in context along with their memberships. It is the one they last switched to, or else the first one
+// they joined. This requires that the authenticated user is loaded in to context.
+func LoadOrganization(organizationsRepo *organizations.OrganizationsRepo) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User)
+			if !ok || u.Edges.Profile == nil {
+				return next(c)
+			}
+
+			memberships, err := organizationsRepo.GetMemberships(c.Request().Context(), u.Edges.Profile.ID)
+			if err != nil {
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					fmt.Sprintf("error querying for organizations: %v", err),
+				)
+			}
+			if len(memberships) == 0 {
+				return next(c)
+			}
+
+			active := memberships[0]
+			if sess, err := session.Get("session", c); err == nil {
+				if organizationID, ok := sess.Values[organizationSessionKey].(int); ok {
+					for _, m := range memberships {
+						if m.OrganizationID == organizationID {
+							active = m
+						}
+					}
+				}
+			}
+
+			c.Set(context.OrganizationMembershipsKey, memberships)
+			c.Set(context.OrganizationMembershipKey, active)
+			c.Set(context.OrganizationKey, active.Edges.Organization)
+
+			return next(c)
+		}
+	}
+}
+
+// SetActiveOrganization remembers in session the organization the user switched to, which LoadOrganization
+// loads on the following requests
+func SetActiveOrganization(c echo.Context, organizationID int) error {
+	sess, err := session.Get("session", c)
+	if err != nil {
+		return err
+	}
+	sess.Values[organizationSessionKey] = organizationID
+	return sess.Save(c.Request(), c.Response())
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+	HeaderRetryAfter         = "Retry-After"
+)
+
+// RateLimit limits requests with the policy of a route group, along with the policies listing the name of
+// the requested route. The most restrictive result is reported with RateLimit-* headers, and requests
+// over the limit of any policy fail with a 429 status and a Retry-After header, without counting against
+// the other policies.
+// This must come after LoadAuthenticatedUser in order to limit per user.
+func RateLimit(limiter *services.RateLimiter, groupPolicy string) echo.MiddlewareFunc {
+	var (
+		once       sync.Once
+		routeNames map[string]string
+	)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !limiter.Enabled() {
+				return next(c)
+			}
+
+			once.Do(func() {
+				routeNames = make(map[string]string)
+				for _, r := range c.Echo().Routes() {
+					routeNames[r.Method+" "+r.Path] = r.Name
+				}
+			})
+			routeName := routeNames[c.Request().Method+" "+c.Path()]
+
+			policies := append([]string{groupPolicy}, limiter.RoutePolicies(routeName)...)
+
+			var (
+				tightest *services.RateLimitResult
+				taken    []rateLimitBucket
+			)
+			for _, name := range policies {
+				policy, ok := limiter.Policy(name)
+				if !ok {
+					continue
+				}
+
+				bucket := rateLimitBucket{policy: name, key: rateLimitKey(c, policy.By, routeName)}
+				res, err := limiter.Allow(c.Request().Context(), bucket.policy, bucket.key)
+				if err != nil {
+					// Don't take the site down if the rate limiter backend is unavailable
+					c.Logger().Errorf("unable to rate limit request: %v", err)
+					continue
+				}
+
+				if tightest == nil || !res.Allowed || (tightest.Allowed && res.Remaining < tightest.Remaining) {
+					tightest = &res
+				}
+				if !res.Allowed {
+					break
+				}
+				taken = append(taken, bucket)
+			}
+
+			if tightest == nil {
+				return next(c)
+			}
+
+			h := c.Response().Header()
+			h.Set(HeaderRateLimitLimit, strconv.Itoa(tightest.Limit))
+			h.Set(HeaderRateLimitRemaining, strconv.Itoa(tightest.Remaining))
+			h.Set(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(tightest.Reset.Seconds())))
+			h.Set(HeaderRateLimitPolicy, fmt.Sprintf("%d;w=%d", tightest.Limit, ceilSeconds(tightest.Period.Seconds())))
+
+			if !tightest.Allowed {
+				// The tokens taken under the policies which allowed the request are given back
+				for _, bucket := range taken {
+					if err := limiter.Refund(c.Request().Context(), bucket.policy, bucket.key); err != nil {
+						c.Logger().Errorf("unable to refund rate limit token: %v", err)
+					}
+				}
+
+				h.Set(HeaderRetryAfter, strconv.Itoa(ceilSeconds(tightest.RetryAfter.Seconds())))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "too many requests")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitBucket identifies the token bucket of a request under a policy
+type rateLimitBucket struct {
+	policy string
+	key    string
+}
+
+// rateLimitKey builds the key of the bucket a request is counted against
+func rateLimitKey(c echo.Context, by []string, routeName string) string {
+	parts := make([]string, 0, len(by))
+	for _, b := range by {
+		switch b {
+		case "ip":
+			parts = append(parts, "ip:"+c.RealIP())
+		case "user":
+			// Visitors are limited per IP
+			if u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User); ok {
+				parts = append(parts, fmt.Sprintf("user:%d", u.ID))
+			} else {
+				parts = append(parts, "ip:"+c.RealIP())
+			}
+		case "route":
+			if routeName == "" {
+				routeName = c.Path()
+			}
+			parts = append(parts, "route:"+routeName)
+		}
+	}
+	if len(parts) == 0 {
+		return "global"
+	}
+	return strings.Join(parts, "|")
+}
+
+func ceilSeconds(seconds float64) int {
+	return int(math.Ceil(seconds))
+}
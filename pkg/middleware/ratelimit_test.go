@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	limiter := services.NewRateLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Policies: map[string]config.RateLimitPolicy{
+			"web": {
+				Requests: 2,
+				Period:   time.Hour,
+				By:       []string{"user"},
+			},
+		},
+	}, services.NewMemoryRateLimitStore())
+	mw := RateLimit(limiter, "web")
+
+	// Requests within the limit go through with the headers set
+	for i := 1; i >= 0; i-- {
+		ctx, rec := tests.NewContext(c.Web, "/")
+		err := tests.ExecuteMiddleware(ctx, mw)
+		require.NoError(t, err)
+		assert.Equal(t, "2", rec.Header().Get(HeaderRateLimitLimit))
+		assert.Equal(t, strconv.Itoa(i), rec.Header().Get(HeaderRateLimitRemaining))
+		assert.Equal(t, "2;w=3600", rec.Header().Get(HeaderRateLimitPolicy))
+		assert.Empty(t, rec.Header().Get(HeaderRetryAfter))
+	}
+
+	// The next request is rejected
+	ctx, rec := tests.NewContext(c.Web, "/")
+	err := tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusTooManyRequests)
+	assert.Equal(t, "1800", rec.Header().Get(HeaderRetryAfter))
+
+	// Authenticated users have their own bucket
+	ctx, _ = tests.NewContext(c.Web, "/")
+	ctx.Set(context.AuthenticatedUserKey, usr)
+	err = tests.ExecuteMiddleware(ctx, mw)
+	require.NoError(t, err)
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	limiter := services.NewRateLimiter(config.RateLimitConfig{}, services.NewMemoryRateLimitStore())
+	ctx, rec := tests.NewContext(c.Web, "/")
+	err := tests.ExecuteMiddleware(ctx, RateLimit(limiter, "web"))
+	require.NoError(t, err)
+	assert.Empty(t, rec.Header().Get(HeaderRateLimitLimit))
+}
+
+func TestRateLimit_RefundsWhenDenied(t *testing.T) {
+	limiter := services.NewRateLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Policies: map[string]config.RateLimitPolicy{
+			"web": {
+				Requests: 10,
+				Period:   time.Hour,
+				By:       []string{"ip"},
+			},
+			"login": {
+				Requests: 1,
+				Period:   time.Hour,
+				By:       []string{"ip"},
+				Routes:   []string{"login.submit"},
+			},
+		},
+	}, services.NewMemoryRateLimitStore())
+	mw := RateLimit(limiter, "web")
+
+	e := echo.New()
+	e.POST("/login", func(echo.Context) error { return nil }).Name = "login.submit"
+	submitLogin := func() (*httptest.ResponseRecorder, error) {
+		ctx, rec := tests.NewContext(e, "/login")
+		ctx.Request().Method = http.MethodPost
+		ctx.SetPath("/login")
+		return rec, tests.ExecuteMiddleware(ctx, mw)
+	}
+
+	rec, err := submitLogin()
+	require.NoError(t, err)
+	assert.Equal(t, "0", rec.Header().Get(HeaderRateLimitRemaining))
+
+	// Requests turned down by the login policy don't use up the budget of the web policy
+	for i := 0; i < 3; i++ {
+		_, err = submitLogin()
+		tests.AssertHTTPErrorCode(t, err, http.StatusTooManyRequests)
+	}
+
+	ctx, rec := tests.NewContext(e, "/")
+	require.NoError(t, tests.ExecuteMiddleware(ctx, mw))
+	assert.Equal(t, "8", rec.Header().Get(HeaderRateLimitRemaining))
+}
+
+func TestRateLimitKey_SpoofedIP(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	key := rateLimitKey(ctx, []string{"ip"}, "")
+
+	// Clients cannot pick the bucket they are counted against by sending forwarding headers
+	ctx, _ = tests.NewContext(c.Web, "/")
+	ctx.Request().Header.Set(echo.HeaderXForwardedFor, "203.0.113.7")
+	ctx.Request().Header.Set(echo.HeaderXRealIP, "203.0.113.8")
+	assert.Equal(t, key, rateLimitKey(ctx, []string{"ip"}, ""))
+	assert.Equal(t, "ip:192.0.2.1", key)
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/securecookie"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+// ResignSessions re-signs the session cookies signed with a key that was rotated out of the keyring,
+// so that they keep working once that key is dropped.
+// This requires that the session middleware first executes.
+func ResignSessions(keyring *services.Keyring) echo.MiddlewareFunc {
+	codecs := keyring.CookieCodecs()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			for _, cookie := range c.Request().Cookies() {
+				values := make(map[any]any)
+				if securecookie.DecodeMulti(cookie.Name, cookie.Value, &values, codecs[0]) == nil {
+					continue
+				}
+				// Cookies no key can verify are not sessions, or were tampered with
+				if securecookie.DecodeMulti(cookie.Name, cookie.Value, &values, codecs[1:]...) != nil {
+					continue
+				}
+
+				sess, err := session.Get(cookie.Name, c)
+				if err == nil {
+					err = sess.Save(c.Request(), c.Response())
+				}
+				if err != nil {
+					return echo.NewHTTPError(
+						http.StatusInternalServerError,
+						fmt.Sprintf("error re-signing session: %v", err),
+					)
+				}
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/securecookie"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResignSessions(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Keyring.ActiveKey = "k1"
+	cfg.Keyring.Keys = map[string]string{"k1": "secret-1"}
+	old, err := services.NewKeyring(cfg)
+	require.NoError(t, err)
+
+	cfg.Keyring.ActiveKey = "k2"
+	cfg.Keyring.Keys = map[string]string{"k1": "secret-1", "k2": "secret-2"}
+	rotated, err := services.NewKeyring(cfg)
+	require.NoError(t, err)
+
+	value, err := securecookie.EncodeMulti("ua", map[any]any{"user_id": 1}, old.CookieCodecs()...)
+	require.NoError(t, err)
+
+	ctx, rec := tests.NewContext(c.Web, "/")
+	ctx.Request().AddCookie(&http.Cookie{Name: "ua", Value: value})
+	ctx.Request().AddCookie(&http.Cookie{Name: "other", Value: "value"})
+	require.NoError(t, tests.ExecuteMiddleware(ctx, session.Middleware(rotated.SessionStore())))
+	require.NoError(t, tests.ExecuteMiddleware(ctx, ResignSessions(rotated)))
+
+	// Only the session signed with the old key is signed again, with the active key
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "ua", cookies[0].Name)
+	values := make(map[any]any)
+	require.NoError(t, rotated.CookieCodecs()[0].Decode("ua", cookies[0].Value, &values))
+	assert.Equal(t, 1, values["user_id"])
+}
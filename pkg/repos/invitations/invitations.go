@@ -0,0 +1,220 @@
+package invitations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/invitation"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+)
+
+// invitationCodeBytes is the number of random bytes of an invitation code, which is base64 encoded in links
+const invitationCodeBytes = 18
+
+var (
+	// ErrInvitationNotFound is returned for invitations which do not exist, were revoked or were already accepted
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrInvitationExpired  = errors.New("invitation expired")
+	ErrOwnInvitation      = errors.New("profiles cannot accept their own invitations")
+)
+
+type InvitationsRepo struct {
+	orm         *ent.Client
+	profileRepo *profilerepo.ProfileRepo
+	expiration  time.Duration
+}
+
+func NewInvitationsRepo(orm *ent.Client, profileRepo *profilerepo.ProfileRepo, expirationInDays int) *InvitationsRepo {
+	return &InvitationsRepo{
+		orm:         orm,
+		profileRepo: profileRepo,
+		expiration:  time.Duration(expirationInDays) * 24 * time.Hour,
+	}
+}
+
+// CreateInvitation creates an invitation which a single person can accept to sign up and become friends
+// with the inviter, until it expires.
+func (r *InvitationsRepo) CreateInvitation(
+	ctx context.Context, inviterProfileID int, inviteeName string,
+) (*domain.Invitation, error) {
+	code, err := generateInvitationCode()
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := r.orm.Invitation.
+		Create().
+		SetInviterID(inviterProfileID).
+		SetInviteeName(inviteeName).
+		SetConfirmationCode(code).
+		SetExpiresAt(time.Now().Add(r.expiration)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainInvitation(inv), nil
+}
+
+// GetPendingInvitations returns the invitations of a profile which can still be accepted, most recent first
+func (r *InvitationsRepo) GetPendingInvitations(ctx context.Context, inviterProfileID int) ([]domain.Invitation, error) {
+	invs, err := r.orm.Invitation.
+		Query().
+		Where(
+			invitation.HasInviterWith(profile.ID(inviterProfileID)),
+			pendingInvitation(),
+		).
+		Order(ent.Desc(invitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]domain.Invitation, len(invs))
+	for i, inv := range invs {
+		invitations[i] = *toDomainInvitation(inv)
+	}
+	return invitations, nil
+}
+
+// GetInvitation returns an invitation which can still be accepted, along with its inviter and their user
+func (r *InvitationsRepo) GetInvitation(ctx context.Context, code string) (*ent.Invitation, error) {
+	inv, err := r.orm.Invitation.
+		Query().
+		Where(
+			invitation.ConfirmationCode(code),
+			invitation.AcceptedAtIsNil(),
+			invitation.RevokedAtIsNil(),
+		).
+		WithInviter(func(pq *ent.ProfileQuery) {
+			pq.WithUser()
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if inv.ExpiresAt.Before(time.Now()) {
+		return nil, ErrInvitationExpired
+	}
+	return inv, nil
+}
+
+// AcceptInvitation accepts an invitation on behalf of a profile, which becomes friends with the inviter.
+// The returned invitation comes with its inviter and their user.
+func (r *InvitationsRepo) AcceptInvitation(
+	ctx context.Context, code string, inviteeProfileID int,
+) (*ent.Invitation, error) {
+	inv, err := r.GetInvitation(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	inviterProfileID := inv.Edges.Inviter.ID
+	if inviterProfileID == inviteeProfileID {
+		return nil, ErrOwnInvitation
+	}
+
+	// Invitations cannot get around blocks
+	blocked, err := r.profileRepo.IsBlockedBetween(ctx, inviterProfileID, inviteeProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, ErrInvitationNotFound
+	}
+
+	// Only a single profile can claim an invitation, even when accepted concurrently
+	now := time.Now()
+	claimed, err := r.orm.Invitation.
+		Update().
+		Where(
+			invitation.ID(inv.ID),
+			pendingInvitation(),
+		).
+		SetAcceptedAt(now).
+		SetInviteeID(inviteeProfileID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return nil, ErrInvitationNotFound
+	}
+
+	alreadyFriends, err := r.profileRepo.AreProfilesFriends(ctx, inviterProfileID, inviteeProfileID)
+	if err == nil && !alreadyFriends {
+		err = r.profileRepo.LinkProfilesAsFriends(ctx, inviterProfileID, inviteeProfileID)
+	}
+	if err != nil {
+		// Release the invitation so that it can be accepted again
+		if releaseErr := r.orm.Invitation.
+			UpdateOneID(inv.ID).
+			ClearAcceptedAt().
+			ClearInvitee().
+			Exec(ctx); releaseErr != nil {
+			return nil, errors.Join(err, releaseErr)
+		}
+		return nil, err
+	}
+
+	inv.AcceptedAt = &now
+	return inv, nil
+}
+
+// RevokeInvitation revokes an invitation of a profile which was not accepted yet
+func (r *InvitationsRepo) RevokeInvitation(ctx context.Context, inviterProfileID, invitationID int) error {
+	revoked, err := r.orm.Invitation.
+		Update().
+		Where(
+			invitation.ID(invitationID),
+			invitation.HasInviterWith(profile.ID(inviterProfileID)),
+			invitation.AcceptedAtIsNil(),
+			invitation.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if revoked == 0 {
+		return ErrInvitationNotFound
+	}
+	return nil
+}
+
+// pendingInvitation matches the invitations which can still be accepted
+func pendingInvitation() predicate.Invitation {
+	return invitation.And(
+		invitation.AcceptedAtIsNil(),
+		invitation.RevokedAtIsNil(),
+		invitation.ExpiresAtGT(time.Now()),
+	)
+}
+
+func toDomainInvitation(inv *ent.Invitation) *domain.Invitation {
+	return &domain.Invitation{
+		ID:               inv.ID,
+		CreatedAt:        inv.CreatedAt,
+		InviteeName:      inv.InviteeName,
+		ConfirmationCode: inv.ConfirmationCode,
+		ExpiresAt:        inv.ExpiresAt,
+	}
+}
+
+// generateInvitationCode generates a random code which is safe to use in URLs
+func generateInvitationCode() (string, error) {
+	b := make([]byte, invitationCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
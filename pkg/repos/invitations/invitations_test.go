@@ -0,0 +1,115 @@
+package invitations_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/stdlib"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/invitations"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func init() {
+	// Register "pgx" as "postgres" explicitly for database/sql
+	sql.Register("postgres", stdlib.GetDefaultDriver())
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	inviter, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	invitee, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	latecomer, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+
+	invitationsRepo := invitations.NewInvitationsRepo(client, profileRepo, 15)
+	inv, err := invitationsRepo.CreateInvitation(ctx, inviter.ID, "Joanne")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, inv.ConfirmationCode)
+
+	pending, err := invitationsRepo.GetPendingInvitations(ctx, inviter.ID)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 1)
+
+	// Inviters cannot accept their own invitations
+	_, err = invitationsRepo.AcceptInvitation(ctx, inv.ConfirmationCode, inviter.ID)
+	assert.Equal(t, invitations.ErrOwnInvitation, err)
+
+	accepted, err := invitationsRepo.AcceptInvitation(ctx, inv.ConfirmationCode, invitee.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Jo Bandi", accepted.Edges.Inviter.Edges.User.Name)
+
+	areFriends, err := profileRepo.AreProfilesFriends(ctx, inviter.ID, invitee.ID)
+	assert.Nil(t, err)
+	assert.True(t, areFriends)
+
+	// Invitations can only be accepted once
+	_, err = invitationsRepo.AcceptInvitation(ctx, inv.ConfirmationCode, latecomer.ID)
+	assert.Equal(t, invitations.ErrInvitationNotFound, err)
+
+	pending, err = invitationsRepo.GetPendingInvitations(ctx, inviter.ID)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 0)
+
+	// Invitations do not get around blocks
+	inv, err = invitationsRepo.CreateInvitation(ctx, latecomer.ID, "Jo")
+	assert.Nil(t, err)
+	assert.Nil(t, profileRepo.BlockProfile(ctx, inviter.ID, latecomer.ID))
+	_, err = invitationsRepo.AcceptInvitation(ctx, inv.ConfirmationCode, inviter.ID)
+	assert.Equal(t, invitations.ErrInvitationNotFound, err)
+}
+
+func TestRevokeAndExpireInvitation(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	inviter, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	invitee, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+
+	invitationsRepo := invitations.NewInvitationsRepo(client, profileRepo, 15)
+
+	revoked, err := invitationsRepo.CreateInvitation(ctx, inviter.ID, "Joanne")
+	assert.Nil(t, err)
+
+	// Only the inviter can revoke their invitations
+	err = invitationsRepo.RevokeInvitation(ctx, invitee.ID, revoked.ID)
+	assert.Equal(t, invitations.ErrInvitationNotFound, err)
+	err = invitationsRepo.RevokeInvitation(ctx, inviter.ID, revoked.ID)
+	assert.Nil(t, err)
+
+	_, err = invitationsRepo.AcceptInvitation(ctx, revoked.ConfirmationCode, invitee.ID)
+	assert.Equal(t, invitations.ErrInvitationNotFound, err)
+
+	expired, err := invitationsRepo.CreateInvitation(ctx, inviter.ID, "Joanne")
+	assert.Nil(t, err)
+	err = client.Invitation.
+		UpdateOneID(expired.ID).
+		SetExpiresAt(time.Now().Add(-time.Hour)).
+		Exec(ctx)
+	assert.Nil(t, err)
+
+	_, err = invitationsRepo.AcceptInvitation(ctx, expired.ConfirmationCode, invitee.ID)
+	assert.Equal(t, invitations.ErrInvitationExpired, err)
+
+	areFriends, err := profileRepo.AreProfilesFriends(ctx, inviter.ID, invitee.ID)
+	assert.Nil(t, err)
+	assert.False(t, areFriends)
+}
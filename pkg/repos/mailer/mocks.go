@@ -0,0 +1,18 @@
+package mailer
+
+import "github.com/stretchr/testify/mock"
+
+// MockMailClient is a mock implementation of the MailClientInterface.
+type MockMailClient struct {
+	mock.Mock
+}
+
+// NewMockMailClient creates a new instance of MockMailClient.
+func NewMockMailClient() *MockMailClient {
+	return &MockMailClient{}
+}
+
+func (m *MockMailClient) Send(email *mail) error {
+	args := m.Called(email.to, email.subject)
+	return args.Error(0)
+}
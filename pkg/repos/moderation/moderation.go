@@ -0,0 +1,305 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/image"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+var (
+	// ErrReportTargetNotFound is returned when the reported profile or photo does not exist
+	ErrReportTargetNotFound = errors.New("reported content not found")
+	ErrOwnContent           = errors.New("profiles cannot report their own content")
+	ErrAlreadyReported      = errors.New("content already reported")
+	// ErrReportNotFound is returned for reports which do not exist or were already acted on
+	ErrReportNotFound = errors.New("report not found")
+	// ErrActionNotApplicable is returned for actions which do not apply to what was reported, such as
+	// hiding a profile
+	ErrActionNotApplicable = errors.New("action does not apply to the report")
+)
+
+// ReportInput is what a profile reports
+type ReportInput struct {
+	TargetType report.TargetType
+	TargetID   int
+	Reason     report.Reason
+	Details    string
+}
+
+type ModerationRepo struct {
+	orm *ent.Client
+}
+
+func NewModerationRepo(orm *ent.Client) *ModerationRepo {
+	return &ModerationRepo{
+		orm: orm,
+	}
+}
+
+// CreateReport files a report about a profile or a photo, which waits in the moderation queue until a
+// moderator acts on it. A profile can only have a single pending report about the same content. The report
+// is returned with the reported profile.
+func (r *ModerationRepo) CreateReport(ctx context.Context, reporterProfileID int, in ReportInput) (*ent.Report, error) {
+	reportedProfileID, err := r.contentOwner(ctx, in.TargetType, in.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if reportedProfileID == reporterProfileID {
+		return nil, ErrOwnContent
+	}
+
+	exists, err := r.orm.Report.
+		Query().
+		Where(
+			report.HasReporterWith(profile.ID(reporterProfileID)),
+			report.TargetTypeEQ(in.TargetType),
+			report.TargetID(in.TargetID),
+			report.StatusEQ(report.StatusPending),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrAlreadyReported
+	}
+
+	rep, err := r.orm.Report.
+		Create().
+		SetReporterID(reporterProfileID).
+		SetReportedID(reportedProfileID).
+		SetTargetType(in.TargetType).
+		SetTargetID(in.TargetID).
+		SetReason(in.Reason).
+		SetDetails(in.Details).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.orm.Report.
+		Query().
+		Where(report.ID(rep.ID)).
+		WithReported().
+		Only(ctx)
+}
+
+// GetPendingReports returns the reports waiting for a moderator, oldest first, along with the users of the
+// reporter and of the reported profile
+func (r *ModerationRepo) GetPendingReports(ctx context.Context, limit int) ([]*ent.Report, error) {
+	return r.orm.Report.
+		Query().
+		Where(report.StatusEQ(report.StatusPending)).
+		WithReporter(func(pq *ent.ProfileQuery) {
+			pq.WithUser()
+		}).
+		WithReported(func(pq *ent.ProfileQuery) {
+			pq.WithUser()
+		}).
+		Order(ent.Asc(report.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// GetImages returns images along with their sizes and files, including hidden ones
+func (r *ModerationRepo) GetImages(ctx context.Context, imageIDs []int) ([]*ent.Image, error) {
+	return r.orm.Image.
+		Query().
+		Where(image.IDIn(imageIDs...)).
+		WithSizes(func(isq *ent.ImageSizeQuery) {
+			isq.WithFile()
+		}).
+		All(ctx)
+}
+
+// HidePhoto hides the photo of a report. Every pending report about that photo is resolved along, and
+// returned with its reporter and reported profile.
+func (r *ModerationRepo) HidePhoto(ctx context.Context, moderatorID, reportID int) ([]*ent.Report, error) {
+	return r.resolve(ctx, moderatorID, reportID, report.StatusPhotoHidden, func(tx *ent.Tx, rep *ent.Report) (predicate.Report, error) {
+		if rep.TargetType != report.TargetTypePhoto {
+			return nil, ErrActionNotApplicable
+		}
+
+		err := tx.Image.
+			Update().
+			Where(
+				image.ID(rep.TargetID),
+				image.HiddenAtIsNil(),
+			).
+			SetHiddenAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return report.And(
+			report.TargetTypeEQ(report.TargetTypePhoto),
+			report.TargetID(rep.TargetID),
+		), nil
+	})
+}
+
+// SuspendUser suspends the user whose content was reported. Every pending report about them is resolved
+// along, and returned with its reporter and reported profile.
+func (r *ModerationRepo) SuspendUser(ctx context.Context, moderatorID, reportID int) ([]*ent.Report, error) {
+	return r.resolve(ctx, moderatorID, reportID, report.StatusUserSuspended, func(tx *ent.Tx, rep *ent.Report) (predicate.Report, error) {
+		reportedProfileID := rep.Edges.Reported.ID
+
+		err := tx.User.
+			Update().
+			Where(
+				user.HasProfileWith(profile.ID(reportedProfileID)),
+				user.SuspendedAtIsNil(),
+			).
+			SetSuspendedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return report.HasReportedWith(profile.ID(reportedProfileID)), nil
+	})
+}
+
+// Dismiss dismisses a report without acting on the reported content. The report is returned with its
+// reporter and reported profile.
+func (r *ModerationRepo) Dismiss(ctx context.Context, moderatorID, reportID int) (*ent.Report, error) {
+	reports, err := r.resolve(ctx, moderatorID, reportID, report.StatusDismissed, func(tx *ent.Tx, rep *ent.Report) (predicate.Report, error) {
+		return report.ID(rep.ID), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reports[0], nil
+}
+
+// UnsuspendUser lifts the suspension of a user
+func (r *ModerationRepo) UnsuspendUser(ctx context.Context, userID int) error {
+	return r.orm.User.
+		UpdateOneID(userID).
+		ClearSuspendedAt().
+		Exec(ctx)
+}
+
+// resolve applies the action of a moderator to a pending report, then closes with the given status the
+// pending reports matched by the predicate the action returns.
+func (r *ModerationRepo) resolve(
+	ctx context.Context,
+	moderatorID, reportID int,
+	status report.Status,
+	action func(tx *ent.Tx, rep *ent.Report) (predicate.Report, error),
+) ([]*ent.Report, error) {
+	tx, err := r.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	reports, err := resolveInTx(ctx, tx, moderatorID, reportID, status, action)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func resolveInTx(
+	ctx context.Context,
+	tx *ent.Tx,
+	moderatorID, reportID int,
+	status report.Status,
+	action func(tx *ent.Tx, rep *ent.Report) (predicate.Report, error),
+) ([]*ent.Report, error) {
+	rep, err := tx.Report.
+		Query().
+		Where(
+			report.ID(reportID),
+			report.StatusEQ(report.StatusPending),
+		).
+		WithReported().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrReportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := action(tx, rep)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := report.And(report.StatusEQ(report.StatusPending), resolved)
+	ids, err := tx.Report.Query().Where(pending).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reports resolved concurrently are left out, so that their outcome is only announced once
+	updated, err := tx.Report.
+		Update().
+		Where(report.IDIn(ids...), report.StatusEQ(report.StatusPending)).
+		SetStatus(status).
+		SetResolvedAt(time.Now()).
+		SetResolvedByID(moderatorID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated != len(ids) {
+		return nil, ErrReportNotFound
+	}
+
+	return tx.Report.
+		Query().
+		Where(report.IDIn(ids...)).
+		WithReporter().
+		WithReported().
+		Order(ent.Asc(report.FieldCreatedAt)).
+		All(ctx)
+}
+
+// contentOwner returns the ID of the profile reported content belongs to
+func (r *ModerationRepo) contentOwner(ctx context.Context, targetType report.TargetType, targetID int) (int, error) {
+	var owner *ent.Profile
+	var err error
+	switch targetType {
+	case report.TargetTypeProfile:
+		owner, err = r.orm.Profile.Get(ctx, targetID)
+	case report.TargetTypePhoto:
+		owner, err = r.orm.Profile.
+			Query().
+			Where(
+				profile.Or(
+					profile.HasPhotosWith(image.ID(targetID), image.HiddenAtIsNil()),
+					profile.HasProfileImageWith(image.ID(targetID), image.HiddenAtIsNil()),
+				),
+			).
+			Only(ctx)
+	default:
+		return 0, ErrReportTargetNotFound
+	}
+	if ent.IsNotFound(err) {
+		return 0, ErrReportTargetNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return owner.ID, nil
+}
@@ -0,0 +1,166 @@
+package moderation_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/image"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/moderation"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func init() {
+	// Register "pgx" as "postgres" explicitly for database/sql
+	sql.Register("postgres", stdlib.GetDefaultDriver())
+}
+
+func TestReportAndHidePhoto(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+	admin := tests.CreateUser(ctx, client, "Admin", "admin@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	reporter1, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	reporter2, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	reported, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+
+	photo, err := client.Image.
+		Create().
+		SetType(image.Type(domain.ImageCategoryProfileGallery.Value)).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.Profile.UpdateOneID(reported.ID).AddPhotos(photo).Exec(ctx))
+
+	moderationRepo := moderation.NewModerationRepo(client)
+	photoReport := moderation.ReportInput{
+		TargetType: report.TargetTypePhoto,
+		TargetID:   photo.ID,
+		Reason:     report.ReasonInappropriateContent,
+	}
+
+	_, err = moderationRepo.CreateReport(ctx, reported.ID, photoReport)
+	assert.Equal(t, moderation.ErrOwnContent, err)
+
+	_, err = moderationRepo.CreateReport(ctx, reporter1.ID, moderation.ReportInput{
+		TargetType: report.TargetTypePhoto,
+		TargetID:   photo.ID + 1000,
+		Reason:     report.ReasonSpam,
+	})
+	assert.Equal(t, moderation.ErrReportTargetNotFound, err)
+
+	rep1, err := moderationRepo.CreateReport(ctx, reporter1.ID, photoReport)
+	require.NoError(t, err)
+	assert.Equal(t, reported.ID, rep1.Edges.Reported.ID)
+
+	_, err = moderationRepo.CreateReport(ctx, reporter1.ID, photoReport)
+	assert.Equal(t, moderation.ErrAlreadyReported, err)
+
+	_, err = moderationRepo.CreateReport(ctx, reporter2.ID, photoReport)
+	require.NoError(t, err)
+	profileReport, err := moderationRepo.CreateReport(ctx, reporter2.ID, moderation.ReportInput{
+		TargetType: report.TargetTypeProfile,
+		TargetID:   reported.ID,
+		Reason:     report.ReasonOther,
+		Details:    "Keeps posting the same photo",
+	})
+	require.NoError(t, err)
+
+	pending, err := moderationRepo.GetPendingReports(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	assert.Equal(t, "Jo Bandi", pending[0].Edges.Reporter.Edges.User.Name)
+	assert.Equal(t, "James Bond", pending[0].Edges.Reported.Edges.User.Name)
+
+	// Profiles cannot be hidden
+	_, err = moderationRepo.HidePhoto(ctx, admin.ID, profileReport.ID)
+	assert.Equal(t, moderation.ErrActionNotApplicable, err)
+
+	// Hiding the photo resolves every report about it
+	resolved, err := moderationRepo.HidePhoto(ctx, admin.ID, rep1.ID)
+	require.NoError(t, err)
+	require.Len(t, resolved, 2)
+	for _, rep := range resolved {
+		assert.Equal(t, report.StatusPhotoHidden, rep.Status)
+		assert.NotNil(t, rep.Edges.Reporter)
+	}
+
+	hidden, err := client.Image.Get(ctx, photo.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, hidden.HiddenAt)
+
+	visible, err := client.Profile.
+		QueryPhotos(reported).
+		Where(image.HiddenAtIsNil()).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, visible)
+
+	_, err = moderationRepo.Dismiss(ctx, admin.ID, rep1.ID)
+	assert.Equal(t, moderation.ErrReportNotFound, err)
+
+	dismissed, err := moderationRepo.Dismiss(ctx, admin.ID, profileReport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, report.StatusDismissed, dismissed.Status)
+
+	pending, err = moderationRepo.GetPendingReports(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestSuspendUser(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+	admin := tests.CreateUser(ctx, client, "Admin", "admin@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	reporter1, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	reporter2, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	reported, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+
+	moderationRepo := moderation.NewModerationRepo(client)
+	profileReport := moderation.ReportInput{
+		TargetType: report.TargetTypeProfile,
+		TargetID:   reported.ID,
+		Reason:     report.ReasonHarassment,
+	}
+	rep, err := moderationRepo.CreateReport(ctx, reporter1.ID, profileReport)
+	require.NoError(t, err)
+	_, err = moderationRepo.CreateReport(ctx, reporter2.ID, profileReport)
+	require.NoError(t, err)
+
+	// Suspending the user resolves every report about them
+	resolved, err := moderationRepo.SuspendUser(ctx, admin.ID, rep.ID)
+	require.NoError(t, err)
+	assert.Len(t, resolved, 2)
+
+	suspended, err := client.User.Get(ctx, user3.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, suspended.SuspendedAt)
+
+	require.NoError(t, moderationRepo.UnsuspendUser(ctx, user3.ID))
+	suspended, err = client.User.Get(ctx, user3.ID)
+	require.NoError(t, err)
+	assert.Nil(t, suspended.SuspendedAt)
+}
@@ -0,0 +1,107 @@
+package notifierrepo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
+)
+
+// EmailNotificationSender sends notifications by email to the address of the user of a profile
+type EmailNotificationSender struct {
+	orm    *ent.Client
+	mail   *mailer.MailClient
+	domain string
+}
+
+// NewEmailNotificationSender creates a new EmailNotificationSender. Domain makes the links of notifications
+// absolute.
+func NewEmailNotificationSender(orm *ent.Client, mail *mailer.MailClient, domain string) *EmailNotificationSender {
+	return &EmailNotificationSender{
+		orm:    orm,
+		mail:   mail,
+		domain: domain,
+	}
+}
+
+// SendNotification implements NotificationSender.
+func (s *EmailNotificationSender) SendNotification(
+	ctx context.Context, profileID int, notification domain.Notification, _ int,
+) error {
+	u, err := s.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrNoNotificationRecipient
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.mail.
+		Compose().
+		To(u.Email).
+		Subject(notification.Title).
+		Body(notificationBody(notification, s.domain, "\n\n")).
+		Send(ctx)
+}
+
+// SMSNotificationSender sends notifications by SMS to the verified phone number of a profile
+type SMSNotificationSender struct {
+	orm    *ent.Client
+	sms    *SMSSender
+	domain string
+}
+
+// NewSMSNotificationSender creates a new SMSNotificationSender. Domain makes the links of notifications
+// absolute.
+func NewSMSNotificationSender(orm *ent.Client, sms *SMSSender, domain string) *SMSNotificationSender {
+	return &SMSNotificationSender{
+		orm:    orm,
+		sms:    sms,
+		domain: domain,
+	}
+}
+
+// SendNotification implements NotificationSender.
+func (s *SMSNotificationSender) SendNotification(
+	ctx context.Context, profileID int, notification domain.Notification, _ int,
+) error {
+	p, err := s.orm.Profile.Get(ctx, profileID)
+	if ent.IsNotFound(err) {
+		return ErrNoNotificationRecipient
+	}
+	if err != nil {
+		return err
+	}
+	if !p.PhoneVerified || p.PhoneNumberE164 == "" {
+		return ErrNoNotificationRecipient
+	}
+
+	_, err = s.sms.SendSms(ctx, p.PhoneNumberE164, notificationBody(notification, s.domain, " "))
+	return err
+}
+
+// notificationBody writes a notification as text, with its title, its text and its link separated by sep
+func notificationBody(notification domain.Notification, domain, sep string) string {
+	var parts []string
+	for _, part := range []string{notification.Title, notification.Text} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	if link := notification.Link; link != "" {
+		if strings.HasPrefix(link, "/") {
+			link = domain + link
+		}
+		parts = append(parts, link)
+	}
+
+	return strings.Join(parts, sep)
+}
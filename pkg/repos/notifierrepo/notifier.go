@@ -2,6 +2,7 @@ package notifierrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,35 +16,38 @@ import (
 NotifierRepo manages the full lifecycle of notifications. That includes:
 - Storage in DB.
 - Publishing to event stream (pubsub).
-- Create push notifications (TODO) for mobile apps.
+- Sending them on the platforms their recipients allowed, such as push notifications, email and SMS.
 */
 type NotifierRepo struct {
 	pubSubClient             pubsub.PubSubClient
 	notificationStorageRepo  NotificationStorage
-	pwaPushNotificationsRepo *PwaPushNotificationsRepo
+	router                   *NotificationRouter
 	fcmPushNotificationsRepo *FcmPushNotificationsRepo
 	getNumNotifsCount        func(context.Context, int) (int, error)
 }
 
+// NewNotifierRepo creates a notifier. Without a pubsub client, notifications are stored and sent on the
+// other platforms but not published live to the app.
 func NewNotifierRepo(
 	pubSubClient pubsub.PubSubClient,
 	notificationStorageRepo NotificationStorage,
-	pwaPushNotificationsRepo *PwaPushNotificationsRepo,
+	router *NotificationRouter,
 	fcmPushNotificationsRepo *FcmPushNotificationsRepo,
 	getNumNotifsCount func(context.Context, int) (int, error),
 ) *NotifierRepo {
 	return &NotifierRepo{
 		pubSubClient:             pubSubClient,
 		notificationStorageRepo:  notificationStorageRepo,
-		pwaPushNotificationsRepo: pwaPushNotificationsRepo,
+		router:                   router,
 		fcmPushNotificationsRepo: fcmPushNotificationsRepo,
 		getNumNotifsCount:        getNumNotifsCount,
 	}
 }
 
-// CreateNotification creates and stores a notification, then publishes it
+// PublishNotification stores a notification and publishes it to the app. When dispatch is set, it is also
+// sent on every platform its recipient allowed for its type, see NotificationRouter.
 func (s *NotifierRepo) PublishNotification(
-	ctx context.Context, notification domain.Notification, storeInDB bool, sendPushNotif bool,
+	ctx context.Context, notification domain.Notification, storeInDB bool, dispatch bool,
 ) error {
 
 	// TODO: we may NOT want to store the entire notif in the DB, especially if it can be derived live. We may want custom marshaller to store
@@ -67,7 +71,7 @@ func (s *NotifierRepo) PublishNotification(
 	// TODO: if we re-use the messaging notifications, we'll need to defined the Type of this notif
 	// accordingly. For example we should use NotificationTypeIncrementNumUnseenMessages and NotificationTypeDecrementNumUnseenMessages
 	// for private messages, but NotificationTypeUpdateNumNotifications for general notifications.
-	err := s.pubSubClient.Publish(ctx, fmt.Sprint(notification.ProfileID), pubsub.SSEEvent{
+	err := s.publish(ctx, notification.ProfileID, pubsub.SSEEvent{
 		Type: domain.NotificationTypeUpdateNumNotifications.Value,
 		Data: "n/a",
 	})
@@ -75,44 +79,24 @@ func (s *NotifierRepo) PublishNotification(
 		return err
 	}
 
-	// Send push notification
-	if sendPushNotif {
+	// Send the notification beyond the app. It is still published to the app if that fails on some platforms.
+	var dispatchErr error
+	if dispatch && s.router != nil {
 		numNotifs, err := s.getNumNotifsCount(ctx, notification.ProfileID)
 		if err != nil {
 			log.Error().Err(err).Int("profileID", notification.ProfileID).Msg("failed to get number of notifications for profile")
 			return err
 		}
 
-		if s.pwaPushNotificationsRepo != nil {
-			err = s.pwaPushNotificationsRepo.SendPushNotifications(ctx, notification.ProfileID, notification.Title, notification.Text, numNotifs)
-			if err != nil {
-				return err
-			}
-			log.Debug().
-				Int("profileID", notification.ProfileID).
-				Int("profileIDWhoCausedNotif", notification.ProfileIDWhoCausedNotif).
-				Str("notificationType", notification.Type.Value).
-				Msg("sent pwa push notifications")
-		}
-		if s.fcmPushNotificationsRepo != nil {
-			err = s.fcmPushNotificationsRepo.SendPushNotifications(ctx, notification.ProfileID, notification.Title, notification.Text, numNotifs, true)
-			if err != nil {
-				return err
-			}
-			log.Debug().
-				Int("profileID", notification.ProfileID).
-				Int("profileIDWhoCausedNotif", notification.ProfileIDWhoCausedNotif).
-				Str("notificationType", notification.Type.Value).
-				Msg("sent fcm push notifications")
-		}
-
+		dispatchErr = s.router.Dispatch(ctx, notification, numNotifs)
 	}
 
 	// Publish the notification to the user-specific topic
-	return s.pubSubClient.Publish(ctx, fmt.Sprint(notification.ProfileID), pubsub.SSEEvent{
+	err = s.publish(ctx, notification.ProfileID, pubsub.SSEEvent{
 		Type: notification.Type.Value,
 		Data: notification.Text,
 	})
+	return errors.Join(dispatchErr, err)
 }
 
 // SendSSEUpdate sends an SSE HTML blob update to a profile
@@ -120,12 +104,20 @@ func (s *NotifierRepo) SendSSEUpdate(
 	ctx context.Context, notification domain.Notification,
 ) error {
 	// Publish the notification to the user-specific topic
-	return s.pubSubClient.Publish(ctx, fmt.Sprint(notification.ProfileID), pubsub.SSEEvent{
+	return s.publish(ctx, notification.ProfileID, pubsub.SSEEvent{
 		Type: notification.Type.Value,
 		Data: notification.Text,
 	})
 }
 
+// publish publishes an event to the topic of a profile, unless there is no pubsub client to do so
+func (s *NotifierRepo) publish(ctx context.Context, profileID int, event pubsub.SSEEvent) error {
+	if s.pubSubClient == nil {
+		return nil
+	}
+	return s.pubSubClient.Publish(ctx, fmt.Sprint(profileID), event)
+}
+
 func (s *NotifierRepo) HasNotificationForResourceAndPerson(
 	ctx context.Context, notifType domain.NotificationType, profileIDWhoCausedNotif, resourceID *int, maxAge time.Duration,
 ) (exists bool, err error) {
@@ -235,5 +227,8 @@ func (s *NotifierRepo) DeleteNotification(ctx context.Context, notificationID in
 func (s *NotifierRepo) SSESubscribe(
 	ctx context.Context, topic string,
 ) (<-chan pubsub.SSEEvent, error) {
+	if s.pubSubClient == nil {
+		return nil, errors.New("live notifications are unavailable without a pubsub client")
+	}
 	return s.pubSubClient.SSESubscribe(ctx, topic)
 }
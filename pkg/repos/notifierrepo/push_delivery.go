@@ -0,0 +1,248 @@
+package notifierrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/rs/zerolog/log"
+)
+
+// PushMessage is what a push notification shows on a device
+type PushMessage struct {
+	Title       string `json:"title"`
+	Body        string `json:"body"`
+	UnreadCount int    `json:"unread_count"`
+	Sound       bool   `json:"sound"`
+}
+
+// PushDelivery is a push notification to send to a single device, recorded by the PushDelivery entity of
+// the same ID
+type PushDelivery struct {
+	ID        int         `json:"id"`
+	Platform  string      `json:"platform"`
+	ProfileID int         `json:"profile_id"`
+	Device    string      `json:"device"`
+	Message   PushMessage `json:"message"`
+}
+
+// PushDeliveryQueue queues push notifications so they are delivered outside of the request sending them
+type PushDeliveryQueue interface {
+	EnqueuePushDelivery(ctx context.Context, delivery PushDelivery) error
+}
+
+// PushTransport delivers push notifications to the devices of a platform, one at a time
+type PushTransport interface {
+	DeliverPush(ctx context.Context, profileID int, device string, msg PushMessage) error
+	DeletePushDevice(ctx context.Context, profileID int, device string) error
+}
+
+// PushDeliveryError is a push service refusing a notification
+type PushDeliveryError struct {
+	// StatusCode is the HTTP status the push service answered with, 0 if unknown
+	StatusCode int
+	// RetryAfter is how long the push service asked to wait before trying again
+	RetryAfter time.Duration
+	// Gone is set when the device is no longer subscribed, and will never accept notifications again
+	Gone bool
+	Err  error
+}
+
+func (e *PushDeliveryError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("push service answered %d: %v", e.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("push service failed: %v", e.Err)
+}
+
+func (e *PushDeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable tells whether the push service could accept the notification later
+func (e *PushDeliveryError) Retryable() bool {
+	if e.Gone {
+		return false
+	}
+	return e.StatusCode == 0 || e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// newPushDeliveryError builds the error of a push service answering with a failed HTTP response
+func newPushDeliveryError(resp *http.Response, gone bool, err error) *PushDeliveryError {
+	return &PushDeliveryError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Gone:       gone,
+		Err:        err,
+	}
+}
+
+// parseRetryAfter reads a Retry-After header, either a number of seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return 0
+}
+
+// PushRetryDelay is how long to wait before retrying a failed delivery for the nth time: as long as the push
+// service asked to, or otherwise twice as long as the previous time, from base up to maxDelay.
+func PushRetryDelay(n int, err error, base, maxDelay time.Duration) time.Duration {
+	var pushErr *PushDeliveryError
+	if errors.As(err, &pushErr) && pushErr.RetryAfter > 0 {
+		return pushErr.RetryAfter
+	}
+	delay := base
+	for i := 0; i < n && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+type PushDeliveryRepo struct {
+	orm   *ent.Client
+	queue PushDeliveryQueue
+}
+
+// NewPushDeliveryRepo creates a repo sending push notifications device by device. Without a queue, they are
+// delivered right away and never retried.
+func NewPushDeliveryRepo(orm *ent.Client, queue PushDeliveryQueue) *PushDeliveryRepo {
+	return &PushDeliveryRepo{
+		orm:   orm,
+		queue: queue,
+	}
+}
+
+// Send records a delivery of msg for each device and queues them
+func (d *PushDeliveryRepo) Send(
+	ctx context.Context,
+	transport PushTransport,
+	platform domain.NotificationPlatform,
+	profileID int,
+	devices []string,
+	msg PushMessage,
+) error {
+	if len(devices) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.PushDeliveryCreate, len(devices))
+	for i, device := range devices {
+		builders[i] = d.orm.PushDelivery.
+			Create().
+			SetProfileID(profileID).
+			SetPlatform(pushdelivery.Platform(platform.Value)).
+			SetDevice(device)
+	}
+	records, err := d.orm.PushDelivery.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, record := range records {
+		delivery := PushDelivery{
+			ID:        record.ID,
+			Platform:  platform.Value,
+			ProfileID: profileID,
+			Device:    record.Device,
+			Message:   msg,
+		}
+
+		if d.queue == nil {
+			errs = append(errs, d.Deliver(ctx, transport, delivery, true))
+			continue
+		}
+		if err := d.queue.EnqueuePushDelivery(ctx, delivery); err != nil {
+			errs = append(errs, err, d.save(ctx, d.orm.PushDelivery.
+				UpdateOneID(record.ID).
+				SetStatus(pushdelivery.StatusFailed).
+				SetError(err.Error())))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Deliver sends a push notification to its device and records how it went. Devices which are no longer
+// subscribed get unsubscribed. An error is returned when delivery should be tried again, which is never
+// the case on the last attempt.
+func (d *PushDeliveryRepo) Deliver(
+	ctx context.Context, transport PushTransport, delivery PushDelivery, lastAttempt bool,
+) error {
+	sendErr := transport.DeliverPush(ctx, delivery.ProfileID, delivery.Device, delivery.Message)
+
+	update := d.orm.PushDelivery.
+		UpdateOneID(delivery.ID).
+		AddAttempts(1)
+	if sendErr == nil {
+		return d.save(ctx, update.
+			SetStatus(pushdelivery.StatusSent).
+			SetDeliveredAt(time.Now()).
+			ClearError())
+	}
+	update.SetError(sendErr.Error())
+
+	retryable := true
+	var pushErr *PushDeliveryError
+	if errors.As(sendErr, &pushErr) {
+		if pushErr.StatusCode != 0 {
+			update.SetStatusCode(pushErr.StatusCode)
+		}
+		if pushErr.Gone {
+			if err := transport.DeletePushDevice(ctx, delivery.ProfileID, delivery.Device); err != nil {
+				return err
+			}
+			log.Info().
+				Int("profileID", delivery.ProfileID).
+				Str("platform", delivery.Platform).
+				Str("device", delivery.Device).
+				Msg("Pruned push subscription which is no longer valid")
+			return d.save(ctx, update.SetStatus(pushdelivery.StatusPruned))
+		}
+		retryable = pushErr.Retryable()
+	}
+
+	if retryable && !lastAttempt {
+		if err := d.save(ctx, update.SetStatus(pushdelivery.StatusRetrying)); err != nil {
+			return err
+		}
+		return sendErr
+	}
+
+	log.Error().Err(sendErr).
+		Int("profileID", delivery.ProfileID).
+		Str("platform", delivery.Platform).
+		Str("device", delivery.Device).
+		Msg("failed to deliver push notification")
+	return d.save(ctx, update.SetStatus(pushdelivery.StatusFailed))
+}
+
+// GetDeliveries returns the most recent push notifications sent to the devices of a profile
+func (d *PushDeliveryRepo) GetDeliveries(ctx context.Context, profileID, limit int) ([]*ent.PushDelivery, error) {
+	return d.orm.PushDelivery.
+		Query().
+		Where(pushdelivery.ProfileIDEQ(profileID)).
+		Order(ent.Desc(pushdelivery.FieldCreatedAt), ent.Desc(pushdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// save records the outcome of a delivery, unless it was deleted along with its profile
+func (d *PushDeliveryRepo) save(ctx context.Context, update *ent.PushDeliveryUpdateOne) error {
+	if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
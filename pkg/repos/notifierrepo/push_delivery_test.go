@@ -0,0 +1,151 @@
+package notifierrepo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePushTransport struct {
+	errs      map[string]error
+	delivered []string
+	deleted   []string
+}
+
+func (f *fakePushTransport) DeliverPush(_ context.Context, _ int, device string, _ notifierrepo.PushMessage) error {
+	if err := f.errs[device]; err != nil {
+		return err
+	}
+	f.delivered = append(f.delivered, device)
+	return nil
+}
+
+func (f *fakePushTransport) DeletePushDevice(_ context.Context, _ int, device string) error {
+	f.deleted = append(f.deleted, device)
+	return nil
+}
+
+type fakePushQueue struct {
+	queued []notifierrepo.PushDelivery
+}
+
+func (f *fakePushQueue) EnqueuePushDelivery(_ context.Context, delivery notifierrepo.PushDelivery) error {
+	f.queued = append(f.queued, delivery)
+	return nil
+}
+
+func TestPushDeliveries(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user := tests.CreateUser(ctx, client, "User", "user1@example.com", "password", true)
+	subscriptionsRepo := subscriptions.NewSubscriptionsRepo(client, 10, 10)
+	profileRepo := profilerepo.NewProfileRepo(client, storagerepo.NewMockStorageClient(), subscriptionsRepo)
+	profile, err := profileRepo.CreateProfile(ctx, user, "bio", time.Now().AddDate(-25, 0, 0), nil, nil)
+	require.NoError(t, err)
+
+	transport := &fakePushTransport{errs: map[string]error{
+		"busy": &notifierrepo.PushDeliveryError{StatusCode: http.StatusServiceUnavailable, RetryAfter: time.Minute},
+		"gone": &notifierrepo.PushDeliveryError{StatusCode: http.StatusGone, Gone: true},
+		"bad":  &notifierrepo.PushDeliveryError{StatusCode: http.StatusForbidden},
+	}}
+	queue := &fakePushQueue{}
+	deliveries := notifierrepo.NewPushDeliveryRepo(client, queue)
+
+	// A delivery is recorded and queued for each device
+	msg := notifierrepo.PushMessage{Title: "Title", Body: "Body", UnreadCount: 2, Sound: true}
+	err = deliveries.Send(ctx, transport, domain.NotificationPlatformPush, profile.ID,
+		[]string{"ok", "busy", "gone", "bad"}, msg)
+	require.NoError(t, err)
+	require.Len(t, queue.queued, 4)
+	assert.Empty(t, transport.delivered)
+	assert.Equal(t, 4, client.PushDelivery.Query().
+		Where(pushdelivery.StatusEQ(pushdelivery.StatusQueued)).
+		CountX(ctx))
+
+	queued := make(map[string]notifierrepo.PushDelivery)
+	for _, delivery := range queue.queued {
+		assert.Equal(t, msg, delivery.Message)
+		assert.Equal(t, domain.NotificationPlatformPush.Value, delivery.Platform)
+		queued[delivery.Device] = delivery
+	}
+
+	// Delivered
+	require.NoError(t, deliveries.Deliver(ctx, transport, queued["ok"], false))
+	record := client.PushDelivery.GetX(ctx, queued["ok"].ID)
+	assert.Equal(t, pushdelivery.StatusSent, record.Status)
+	assert.Equal(t, 1, record.Attempts)
+	assert.NotNil(t, record.DeliveredAt)
+
+	// The push service is unavailable, so the delivery is retried until the last attempt
+	err = deliveries.Deliver(ctx, transport, queued["busy"], false)
+	assert.Error(t, err)
+	assert.Equal(t, time.Minute, notifierrepo.PushRetryDelay(0, err, 10*time.Second, time.Hour))
+	record = client.PushDelivery.GetX(ctx, queued["busy"].ID)
+	assert.Equal(t, pushdelivery.StatusRetrying, record.Status)
+	assert.Equal(t, http.StatusServiceUnavailable, record.StatusCode)
+
+	require.NoError(t, deliveries.Deliver(ctx, transport, queued["busy"], true))
+	record = client.PushDelivery.GetX(ctx, queued["busy"].ID)
+	assert.Equal(t, pushdelivery.StatusFailed, record.Status)
+	assert.Equal(t, 2, record.Attempts)
+
+	// The device unsubscribed, so it is pruned and never retried
+	require.NoError(t, deliveries.Deliver(ctx, transport, queued["gone"], false))
+	record = client.PushDelivery.GetX(ctx, queued["gone"].ID)
+	assert.Equal(t, pushdelivery.StatusPruned, record.Status)
+	assert.Equal(t, []string{"gone"}, transport.deleted)
+
+	// Refused for good, so not retried either
+	require.NoError(t, deliveries.Deliver(ctx, transport, queued["bad"], false))
+	record = client.PushDelivery.GetX(ctx, queued["bad"].ID)
+	assert.Equal(t, pushdelivery.StatusFailed, record.Status)
+	assert.Equal(t, http.StatusForbidden, record.StatusCode)
+
+	// Without a queue, devices are delivered to right away
+	inline := notifierrepo.NewPushDeliveryRepo(client, nil)
+	err = inline.Send(ctx, transport, domain.NotificationPlatformFCMPush, profile.ID, []string{"ok", "busy"}, msg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ok", "ok"}, transport.delivered)
+
+	results, err := inline.GetDeliveries(ctx, profile.ID, 2)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.Equal(t, pushdelivery.PlatformFcmPush, result.Platform)
+		assert.NotEqual(t, pushdelivery.StatusQueued, result.Status)
+	}
+}
+
+func TestPushRetryDelay(t *testing.T) {
+	base, maxDelay := 10*time.Second, time.Minute
+	err := errors.New("connection reset")
+
+	// Backs off exponentially up to the max delay
+	assert.Equal(t, 10*time.Second, notifierrepo.PushRetryDelay(0, err, base, maxDelay))
+	assert.Equal(t, 20*time.Second, notifierrepo.PushRetryDelay(1, err, base, maxDelay))
+	assert.Equal(t, 40*time.Second, notifierrepo.PushRetryDelay(2, err, base, maxDelay))
+	assert.Equal(t, time.Minute, notifierrepo.PushRetryDelay(3, err, base, maxDelay))
+	assert.Equal(t, time.Minute, notifierrepo.PushRetryDelay(100, err, base, maxDelay))
+
+	// Waits as long as the push service asked, even past the max delay
+	rateLimited := &notifierrepo.PushDeliveryError{StatusCode: http.StatusTooManyRequests, RetryAfter: 2 * time.Hour}
+	assert.True(t, rateLimited.Retryable())
+	assert.Equal(t, 2*time.Hour, notifierrepo.PushRetryDelay(0, rateLimited, base, maxDelay))
+
+	assert.True(t, (&notifierrepo.PushDeliveryError{StatusCode: http.StatusBadGateway}).Retryable())
+	assert.False(t, (&notifierrepo.PushDeliveryError{StatusCode: http.StatusForbidden}).Retryable())
+	assert.False(t, (&notifierrepo.PushDeliveryError{Gone: true}).Retryable())
+}
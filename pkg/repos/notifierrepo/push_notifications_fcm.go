@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	firebase "firebase.google.com/go/v4"
+	"firebase.google.com/go/v4/errorutils"
 	"firebase.google.com/go/v4/messaging"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
@@ -21,8 +22,9 @@ type FcmSubscription struct {
 }
 
 type FcmPushNotificationsRepo struct {
-	orm       *ent.Client
-	fcmClient *messaging.Client
+	orm        *ent.Client
+	fcmClient  *messaging.Client
+	deliveries *PushDeliveryRepo
 }
 
 func NewFcmPushNotificationsRepo(
@@ -31,7 +33,8 @@ func NewFcmPushNotificationsRepo(
 
 	var fcmClient *messaging.Client
 
-	if firebaseJSONAccessKeys != nil {
+	// Without access keys, as in development, no messages are actually sent
+	if firebaseJSONAccessKeys != nil && len(*firebaseJSONAccessKeys) > 0 {
 		opt := option.WithCredentialsJSON(*firebaseJSONAccessKeys)
 		app, err := firebase.NewApp(context.Background(), nil, opt)
 		if err != nil {
@@ -49,8 +52,9 @@ func NewFcmPushNotificationsRepo(
 	}
 
 	return &FcmPushNotificationsRepo{
-		orm:       orm,
-		fcmClient: fcmClient,
+		orm:        orm,
+		fcmClient:  fcmClient,
+		deliveries: NewPushDeliveryRepo(orm, nil),
 	}, nil
 }
 
@@ -63,73 +67,86 @@ func (p *FcmPushNotificationsRepo) AddPushSubscription(ctx context.Context, prof
 	return err
 }
 
+// SendPushNotifications sends a push notification to every device the profile registered a token for
 func (p *FcmPushNotificationsRepo) SendPushNotifications(ctx context.Context, profileID int, title, message string, numUnreadNotifs int, sendSound bool) error {
 	if p.fcmClient == nil {
 		log.Warn().Msg("No FCM client is set, not actually sending any real messages")
 		return nil
 	}
 
-	subs, err := p.orm.Profile.
+	tokens, err := p.orm.FCMSubscriptions.
 		Query().
-		Where(profile.IDEQ(profileID)).
-		QueryFcmPushSubscriptions().
-		All(ctx)
+		Where(fcmsubscriptions.ProfileIDEQ(profileID)).
+		Select(fcmsubscriptions.FieldToken).
+		Strings(ctx)
 	if err != nil {
 		return err
 	}
+	return p.deliveries.Send(ctx, p, domain.NotificationPlatformFCMPush, profileID, tokens, PushMessage{
+		Title:       title,
+		Body:        message,
+		UnreadCount: numUnreadNotifs,
+		Sound:       sendSound,
+	})
+}
 
-	invalidSubscriptions := make([]*ent.FCMSubscriptions, 0)
+// SetDeliveryQueue makes push notifications get delivered through queue rather than right away
+func (p *FcmPushNotificationsRepo) SetDeliveryQueue(queue PushDeliveryQueue) {
+	p.deliveries.queue = queue
+}
+
+// DeliverPush implements PushTransport.
+func (p *FcmPushNotificationsRepo) DeliverPush(ctx context.Context, _ int, token string, msg PushMessage) error {
+	if p.fcmClient == nil {
+		log.Warn().Msg("No FCM client is set, not actually sending any real messages")
+		return nil
+	}
 
 	var sound string
-	if sendSound {
+	if msg.Sound {
 		sound = "default"
 	}
-	for _, sub := range subs {
-		log.Debug().Str("token", sub.Token).Msg("Sending FCM push notification")
-		msg := &messaging.Message{
-			APNS: &messaging.APNSConfig{
-				Payload: &messaging.APNSPayload{
-					Aps: &messaging.Aps{
-						Alert: &messaging.ApsAlert{
-							Title: title,
-							Body:  message,
-						},
-						Badge: &numUnreadNotifs,
-						Sound: sound,
+	log.Debug().Str("token", token).Msg("Sending FCM push notification")
+	resp, err := p.fcmClient.Send(ctx, &messaging.Message{
+		APNS: &messaging.APNSConfig{
+			Payload: &messaging.APNSPayload{
+				Aps: &messaging.Aps{
+					Alert: &messaging.ApsAlert{
+						Title: msg.Title,
+						Body:  msg.Body,
 					},
+					Badge: &msg.UnreadCount,
+					Sound: sound,
 				},
 			},
+		},
 
-			Token: sub.Token,
-			// Data:  payload,
-		}
-
-		resp, err := p.fcmClient.Send(ctx, msg)
-		if err != nil {
-			// Handle invalid tokens and log error
-			if messaging.IsUnregistered(err) || messaging.IsInvalidArgument(err) {
-				log.Warn().Err(err).Str("token", sub.Token).Msg("Invalid FCM token, marking for cleanup")
-				invalidSubscriptions = append(invalidSubscriptions, sub)
-			} else {
-				log.Error().Err(err).
-					Str("token", sub.Token).
-					Msg("failed to send FCM push notification for this token")
-			}
+		Token: token,
+		// Data:  payload,
+	})
+	if err != nil {
+		gone := messaging.IsUnregistered(err) || messaging.IsInvalidArgument(err)
+		if httpResp := errorutils.HTTPResponse(err); httpResp != nil {
+			return newPushDeliveryError(httpResp, gone, err)
 		}
-		log.Debug().Str("token", sub.Token).
-			Str("response", resp).
-			Msg("Sent FCM push notification to token")
+		return &PushDeliveryError{Gone: gone, Err: err}
 	}
+	log.Debug().Str("token", token).
+		Str("response", resp).
+		Msg("Sent FCM push notification to token")
+	return nil
+}
 
-	// Cleanup invalid subscriptions
-	for _, sub := range invalidSubscriptions {
-		if err := p.DeletePushSubscriptionByToken(ctx, sub.ProfileID, sub.Token); err != nil {
-			log.Error().Err(err).Str("token", sub.Token).Msg("Failed to delete invalid FCM subscription")
-			return err // Handle or log failure to delete subscription
-		}
-	}
+// DeletePushDevice implements PushTransport.
+func (p *FcmPushNotificationsRepo) DeletePushDevice(ctx context.Context, profileID int, token string) error {
+	return p.DeletePushSubscriptionByToken(ctx, profileID, token)
+}
 
-	return nil
+// SendNotification implements NotificationSender.
+func (p *FcmPushNotificationsRepo) SendNotification(
+	ctx context.Context, profileID int, notification domain.Notification, numUnseen int,
+) error {
+	return p.SendPushNotifications(ctx, profileID, notification.Title, notification.Text, numUnseen, true)
 }
 
 func (p *FcmPushNotificationsRepo) DeletePushSubscriptionByToken(ctx context.Context, profileID int, token string) error {
@@ -3,8 +3,11 @@ package notifierrepo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 
@@ -32,6 +35,7 @@ type PwaPushNotificationsRepo struct {
 	subscriberEmail                string
 	orm                            *ent.Client
 	notificationSendPermissionRepo *NotificationSendPermissionRepo
+	deliveries                     *PushDeliveryRepo
 }
 
 func NewPwaPushNotificationsRepo(
@@ -47,6 +51,7 @@ func NewPwaPushNotificationsRepo(
 		subscriberEmail:                subscriberEmail,
 		orm:                            orm,
 		notificationSendPermissionRepo: notificationSendPermissionRepo,
+		deliveries:                     NewPushDeliveryRepo(orm, nil),
 	}
 }
 
@@ -61,70 +66,88 @@ func (p *PwaPushNotificationsRepo) AddPushSubscription(ctx context.Context, prof
 	return err
 }
 
+// SendPushNotifications sends a push notification to every device the profile subscribed with
 func (p *PwaPushNotificationsRepo) SendPushNotifications(ctx context.Context, profileID int, title, message string, numUnreadNotifs int) error {
-	subs, err := p.orm.Profile.
+	endpoints, err := p.GetPushSubscriptionEndpoints(ctx, profileID)
+	if err != nil {
+		return err
+	}
+	return p.deliveries.Send(ctx, p, domain.NotificationPlatformPush, profileID, endpoints, PushMessage{
+		Title:       title,
+		Body:        message,
+		UnreadCount: numUnreadNotifs,
+	})
+}
+
+// SetDeliveryQueue makes push notifications get delivered through queue rather than right away
+func (p *PwaPushNotificationsRepo) SetDeliveryQueue(queue PushDeliveryQueue) {
+	p.deliveries.queue = queue
+}
+
+// DeliverPush implements PushTransport.
+func (p *PwaPushNotificationsRepo) DeliverPush(ctx context.Context, profileID int, endpoint string, msg PushMessage) error {
+	sub, err := p.orm.PwaPushSubscription.
 		Query().
-		Where(profile.IDEQ(profileID)).
-		QueryPwaPushSubscriptions().
-		All(ctx)
+		Where(
+			pwapushsubscription.ProfileIDEQ(profileID),
+			pwapushsubscription.EndpointEQ(endpoint),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return &PushDeliveryError{Gone: true, Err: errors.New("subscription was deleted")}
+	}
 	if err != nil {
 		return err
 	}
-	var invalidSubscriptions []*ent.PwaPushSubscription
 
 	payload := map[string]string{
-		"title":       title,
-		"body":        message,
-		"unreadCount": fmt.Sprintf("%d", numUnreadNotifs),
+		"title":       msg.Title,
+		"body":        msg.Body,
+		"unreadCount": fmt.Sprintf("%d", msg.UnreadCount),
 		// "url":   url,
 	}
 	payloadBytes, _ := json.Marshal(payload)
 
-	for _, sub := range subs {
-		pushSub := webpush.Subscription{
-			Endpoint: sub.Endpoint,
-			Keys: webpush.Keys{
-				P256dh: sub.P256dh,
-				Auth:   sub.Auth,
-			},
-		}
-
-		// !!!!!!TODO: this should be a task and not run in the main coroutine!
-		resp, err := webpush.SendNotification(payloadBytes, &pushSub, &webpush.Options{
-			Subscriber:      p.subscriberEmail,
-			VAPIDPublicKey:  p.vapidDetails.PublicKey,
-			VAPIDPrivateKey: p.vapidDetails.PrivateKey,
-		})
-		if err != nil {
-			if resp != nil {
-				if resp.StatusCode == http.StatusGone ||
-					resp.StatusCode == http.StatusNotFound ||
-					resp.StatusCode == http.StatusBadRequest {
-					// These status codes suggest the subscription is no longer valid
-					invalidSubscriptions = append(invalidSubscriptions, sub)
-				}
-				resp.Body.Close() // Always close the response body
-			}
-			log.Error().Err(err).
-				Str("endpoint", sub.Endpoint).
-				Msg("failed to send push notification for this endpoint")
-			continue // Skip to the next subscription
-		}
-		log.Debug().Err(err).
-			Str("endpoint", sub.Endpoint).
-			Msg("Sent push notification to endpoint")
-		defer resp.Body.Close()
+	resp, err := webpush.SendNotificationWithContext(ctx, payloadBytes, &webpush.Subscription{
+		Endpoint: sub.Endpoint,
+		Keys: webpush.Keys{
+			P256dh: sub.P256dh,
+			Auth:   sub.Auth,
+		},
+	}, &webpush.Options{
+		Subscriber:      p.subscriberEmail,
+		VAPIDPublicKey:  p.vapidDetails.PublicKey,
+		VAPIDPrivateKey: p.vapidDetails.PrivateKey,
+	})
+	if err != nil {
+		return err
 	}
+	defer resp.Body.Close()
 
-	// Cleanup invalid subscriptions
-	if len(invalidSubscriptions) > 0 {
-		for _, sub := range invalidSubscriptions {
-			if err := p.DeletePushSubscriptionByEndpoint(ctx, sub.ProfileID, sub.Endpoint); err != nil {
-				return err // Handle or log failure to delete subscription
-			}
-		}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		log.Debug().
+			Str("endpoint", endpoint).
+			Msg("Sent push notification to endpoint")
+		return nil
 	}
-	return nil
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	// These status codes suggest the subscription is no longer valid
+	gone := resp.StatusCode == http.StatusGone ||
+		resp.StatusCode == http.StatusNotFound ||
+		resp.StatusCode == http.StatusBadRequest
+	return newPushDeliveryError(resp, gone, errors.New(strings.TrimSpace(string(body))))
+}
+
+// DeletePushDevice implements PushTransport.
+func (p *PwaPushNotificationsRepo) DeletePushDevice(ctx context.Context, profileID int, endpoint string) error {
+	return p.DeletePushSubscriptionByEndpoint(ctx, profileID, endpoint)
+}
+
+// SendNotification implements NotificationSender.
+func (p *PwaPushNotificationsRepo) SendNotification(
+	ctx context.Context, profileID int, notification domain.Notification, numUnseen int,
+) error {
+	return p.SendPushNotifications(ctx, profileID, notification.Title, notification.Text, numUnseen)
 }
 
 func (p *PwaPushNotificationsRepo) GetPushSubscriptionEndpoints(ctx context.Context, profileID int) ([]string, error) {
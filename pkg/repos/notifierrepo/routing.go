@@ -0,0 +1,152 @@
+package notifierrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrNoNotificationRecipient is returned by senders when a profile has nowhere to be sent notifications on
+// their platform, such as no verified phone number for SMS
+var ErrNoNotificationRecipient = errors.New("profile has no recipient on this platform")
+
+// NotificationSender sends notifications to a profile on one platform
+type NotificationSender interface {
+	SendNotification(ctx context.Context, profileID int, notification domain.Notification, numUnseen int) error
+}
+
+// NotificationPermissionGetter returns the notifications a profile allowed, by platform. It is implemented by
+// NotificationSendPermissionRepo.
+type NotificationPermissionGetter interface {
+	GetPermissions(
+		ctx context.Context, profileID int,
+	) (map[domain.NotificationPermissionType]domain.NotificationPermission, error)
+}
+
+// NotificationDispatch is the decision to send a notification on a platform or not
+type NotificationDispatch struct {
+	Platform domain.NotificationPlatform
+	Send     bool
+	Reason   string
+}
+
+// NotificationRouter decides which platforms notifications are sent on, from the permissions their
+// recipients granted, and sends them there
+type NotificationRouter struct {
+	permissions NotificationPermissionGetter
+	senders     map[domain.NotificationPlatform]NotificationSender
+}
+
+// NewNotificationRouter creates a new NotificationRouter sending notifications with the senders of each
+// platform. Notifications are not sent on platforms without a sender.
+func NewNotificationRouter(
+	permissions NotificationPermissionGetter, senders map[domain.NotificationPlatform]NotificationSender,
+) *NotificationRouter {
+	return &NotificationRouter{
+		permissions: permissions,
+		senders:     senders,
+	}
+}
+
+// Route decides which platforms a notification is sent on, logging every decision
+func (r *NotificationRouter) Route(
+	ctx context.Context, notification domain.Notification,
+) ([]NotificationDispatch, error) {
+	permission, needsPermission := domain.NotificationTypePermissionMap[notification.Type]
+
+	var granted map[string]bool
+	if needsPermission {
+		permissions, err := r.permissions.GetPermissions(ctx, notification.ProfileID)
+		if err != nil {
+			return nil, err
+		}
+		granted = make(map[string]bool)
+		for _, p := range permissions[permission].PlatformsList {
+			granted[p.Platform] = p.Granted
+		}
+	}
+
+	dispatches := make([]NotificationDispatch, 0, len(domain.NotificationPlatforms.Members()))
+	for _, platform := range domain.NotificationPlatforms.Members() {
+		d := NotificationDispatch{Platform: platform}
+
+		switch {
+		case r.senders[platform] == nil:
+			d.Reason = "no sender for platform"
+		case !needsPermission && isPushPlatform(platform):
+			d.Send = true
+			d.Reason = "notification needs no permission"
+		case !needsPermission:
+			d.Reason = "only sent by push without a permission"
+		case granted[platform.Value]:
+			d.Send = true
+			d.Reason = "permission granted"
+		default:
+			d.Reason = "permission not granted"
+		}
+
+		event := log.Info().
+			Int("profileID", notification.ProfileID).
+			Str("notificationType", notification.Type.Value).
+			Str("platform", platform.Value).
+			Bool("send", d.Send).
+			Str("reason", d.Reason)
+		if needsPermission {
+			event = event.Str("permission", permission.Value)
+		}
+		event.Msg("notification dispatch decision")
+
+		dispatches = append(dispatches, d)
+	}
+
+	return dispatches, nil
+}
+
+// Dispatch sends a notification on every platform its recipient allowed. Failing to send on a platform does
+// not stop it from being sent on the others, and the errors of all platforms are returned.
+func (r *NotificationRouter) Dispatch(ctx context.Context, notification domain.Notification, numUnseen int) error {
+	dispatches, err := r.Route(ctx, notification)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, d := range dispatches {
+		if !d.Send {
+			continue
+		}
+
+		err := r.senders[d.Platform].SendNotification(ctx, notification.ProfileID, notification, numUnseen)
+		switch {
+		case err == nil:
+			log.Debug().
+				Int("profileID", notification.ProfileID).
+				Str("notificationType", notification.Type.Value).
+				Str("platform", d.Platform.Value).
+				Msg("sent notification")
+		case errors.Is(err, ErrNoNotificationRecipient):
+			log.Info().
+				Int("profileID", notification.ProfileID).
+				Str("notificationType", notification.Type.Value).
+				Str("platform", d.Platform.Value).
+				Msg("notification not sent, profile has no recipient on platform")
+		default:
+			log.Error().Err(err).
+				Int("profileID", notification.ProfileID).
+				Str("notificationType", notification.Type.Value).
+				Str("platform", d.Platform.Value).
+				Msg("failed to send notification")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// isPushPlatform tells if a platform sends to devices which subscribed to notifications, which is consent
+// to receive them
+func isPushPlatform(platform domain.NotificationPlatform) bool {
+	return platform == domain.NotificationPlatformPush || platform == domain.NotificationPlatformFCMPush
+}
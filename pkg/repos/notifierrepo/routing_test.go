@@ -0,0 +1,137 @@
+package notifierrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePermissions map[domain.NotificationPermissionType][]domain.NotificationPlatform
+
+func (f fakePermissions) GetPermissions(
+	_ context.Context, _ int,
+) (map[domain.NotificationPermissionType]domain.NotificationPermission, error) {
+	perms := make(map[domain.NotificationPermissionType]domain.NotificationPermission)
+	for _, perm := range domain.NotificationPermissions.Members() {
+		p := domain.NotificationPermission{Permission: perm.Value}
+		for _, platform := range domain.NotificationPlatforms.Members() {
+			granted := false
+			for _, g := range f[perm] {
+				granted = granted || g == platform
+			}
+			p.PlatformsList = append(p.PlatformsList, domain.NotificationPermissionPlatform{
+				Platform: platform.Value,
+				Granted:  granted,
+			})
+		}
+		perms[perm] = p
+	}
+	return perms, nil
+}
+
+type fakeSender struct {
+	sent []domain.Notification
+	err  error
+}
+
+func (f *fakeSender) SendNotification(_ context.Context, _ int, notification domain.Notification, _ int) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, notification)
+	return nil
+}
+
+func TestNotificationRouter(t *testing.T) {
+	ctx := context.Background()
+	senders := map[domain.NotificationPlatform]*fakeSender{
+		domain.NotificationPlatformPush:    {},
+		domain.NotificationPlatformFCMPush: {},
+		domain.NotificationPlatformEmail:   {},
+		domain.NotificationPlatformSMS:     {},
+	}
+	reset := func() {
+		for _, s := range senders {
+			s.sent = nil
+		}
+	}
+	sent := func(platform domain.NotificationPlatform) int {
+		return len(senders[platform].sent)
+	}
+
+	router := notifierrepo.NewNotificationRouter(
+		fakePermissions{
+			domain.NotificationPermissionDailyReminder: {domain.NotificationPlatformFCMPush, domain.NotificationPlatformEmail},
+		},
+		map[domain.NotificationPlatform]notifierrepo.NotificationSender{
+			domain.NotificationPlatformPush:    senders[domain.NotificationPlatformPush],
+			domain.NotificationPlatformFCMPush: senders[domain.NotificationPlatformFCMPush],
+			domain.NotificationPlatformEmail:   senders[domain.NotificationPlatformEmail],
+			domain.NotificationPlatformSMS:     senders[domain.NotificationPlatformSMS],
+		},
+	)
+
+	// Only sent on the platforms the permission was granted for
+	err := router.Dispatch(ctx, domain.Notification{ProfileID: 1, Type: domain.NotificationTypeDailyConversationReminder}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent(domain.NotificationPlatformPush))
+	assert.Equal(t, 1, sent(domain.NotificationPlatformFCMPush))
+	assert.Equal(t, 1, sent(domain.NotificationPlatformEmail))
+	assert.Equal(t, 0, sent(domain.NotificationPlatformSMS))
+
+	// Not sent anywhere when the permission was not granted
+	reset()
+	err = router.Dispatch(ctx, domain.Notification{ProfileID: 1, Type: domain.NotificationTypeNewPrivateMessage}, 0)
+	require.NoError(t, err)
+	for platform := range senders {
+		assert.Equal(t, 0, sent(platform), platform.Value)
+	}
+
+	// Notifications which need no permission are only pushed
+	reset()
+	err = router.Dispatch(ctx, domain.Notification{ProfileID: 1, Type: domain.NotificationTypePaymentFailed}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent(domain.NotificationPlatformPush))
+	assert.Equal(t, 1, sent(domain.NotificationPlatformFCMPush))
+	assert.Equal(t, 0, sent(domain.NotificationPlatformEmail))
+	assert.Equal(t, 0, sent(domain.NotificationPlatformSMS))
+
+	// Failing on a platform does not stop the others, and profiles without a recipient are skipped
+	reset()
+	senders[domain.NotificationPlatformFCMPush].err = errors.New("fcm is down")
+	senders[domain.NotificationPlatformEmail].err = notifierrepo.ErrNoNotificationRecipient
+	err = router.Dispatch(ctx, domain.Notification{ProfileID: 1, Type: domain.NotificationTypeDailyConversationReminder}, 0)
+	assert.ErrorContains(t, err, "fcm is down")
+	assert.NotErrorIs(t, err, notifierrepo.ErrNoNotificationRecipient)
+}
+
+func TestNotificationRouter_Route(t *testing.T) {
+	router := notifierrepo.NewNotificationRouter(
+		fakePermissions{domain.NotificationPermissionNewFriendActivity: {domain.NotificationPlatformSMS}},
+		map[domain.NotificationPlatform]notifierrepo.NotificationSender{
+			domain.NotificationPlatformPush: &fakeSender{},
+			domain.NotificationPlatformSMS:  &fakeSender{},
+		},
+	)
+
+	dispatches, err := router.Route(context.Background(), domain.Notification{
+		ProfileID: 1,
+		Type:      domain.NotificationTypeConnectionEngagedWithQuestion,
+	})
+	require.NoError(t, err)
+
+	decisions := make(map[domain.NotificationPlatform]notifierrepo.NotificationDispatch)
+	for _, d := range dispatches {
+		decisions[d.Platform] = d
+	}
+	require.Len(t, decisions, len(domain.NotificationPlatforms.Members()))
+	assert.Equal(t, "permission not granted", decisions[domain.NotificationPlatformPush].Reason)
+	assert.Equal(t, "no sender for platform", decisions[domain.NotificationPlatformFCMPush].Reason)
+	assert.Equal(t, "no sender for platform", decisions[domain.NotificationPlatformEmail].Reason)
+	assert.True(t, decisions[domain.NotificationPlatformSMS].Send)
+}
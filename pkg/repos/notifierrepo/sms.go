@@ -2,146 +2,366 @@ package notifierrepo
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
 	"errors"
 	"fmt"
-	"math/rand"
+	"math/big"
+	"net/http"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/sns"
-	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/mikestefanello/pagoda/config"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/smsmessage"
+	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	// ErrInvalidCode is returned when a code is wrong, expired or was already used
+	ErrInvalidCode = errors.New("invalid code")
+
+	// ErrTooManyCodeAttempts is returned when a code was guessed wrong too many times, a new one has to be requested
+	ErrTooManyCodeAttempts = errors.New("too many attempts for this code")
+
+	// ErrDailyCodeCapReached is returned when a number was sent as many codes as it can be in a day
+	ErrDailyCodeCapReached = errors.New("too many codes sent to this number today")
+)
+
+// CodeCooldownError is returned when a code was sent to a number too recently to send another one
+type CodeCooldownError struct {
+	RetryIn time.Duration
+}
+
+// Error implements the error interface.
+func (e CodeCooldownError) Error() string {
+	return fmt.Sprintf("a code was sent recently, retry in %s", e.RetryIn)
+}
+
+// SMSSender sends text messages through an SMS provider, keeping track of their delivery, and the one-time
+// codes proving the ownership of phone numbers
 type SMSSender struct {
-	orm                             *ent.Client
-	snsClient                       *sns.Client
-	senderID                        string
-	validationTextExpirationMinutes int
+	orm      *ent.Client
+	provider SMSProvider
+	appName  string
+
+	// statusCallbackURL is where providers report the delivery of messages, see SetStatusCallbackURL
+	statusCallbackURL string
+
+	codeExpiration  time.Duration
+	codeLength      int
+	maxCodeAttempts int
+	resendCooldown  time.Duration
+	dailyCodeCap    int
 }
 
-// NewSMSSender initializes a new SMSSender with the AWS SNS client
-func NewSMSSender(orm *ent.Client, region, senderID string, validationTextExpirationMinutes int) (*SMSSender, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO(), config.WithRegion(region))
-	if err != nil {
-		return nil, fmt.Errorf("configuration error: %w", err)
+// NewSMSSender initializes a new SMSSender sending with the given provider
+func NewSMSSender(orm *ent.Client, provider SMSProvider, cfg *config.Config) *SMSSender {
+	return &SMSSender{
+		orm:             orm,
+		provider:        provider,
+		appName:         string(cfg.App.Name),
+		codeExpiration:  time.Duration(cfg.Phone.ValidationCodeExpirationMinutes) * time.Minute,
+		codeLength:      cfg.Phone.CodeLength,
+		maxCodeAttempts: cfg.Phone.MaxCodeAttempts,
+		resendCooldown:  cfg.Phone.CodeResendCooldown,
+		dailyCodeCap:    cfg.Phone.DailyCodeCap,
 	}
+}
 
-	client := sns.NewFromConfig(cfg)
-	return &SMSSender{
-		orm:       orm,
-		snsClient: client,
-		senderID:  senderID,
-	}, nil
+// Provider returns the provider sending the messages
+func (s *SMSSender) Provider() SMSProvider {
+	return s.provider
 }
 
+// SetStatusCallbackURL sets the absolute URL providers supporting it report the delivery of messages to.
+// It is set once the route receiving them is registered.
+func (s *SMSSender) SetStatusCallbackURL(url string) {
+	s.statusCallbackURL = url
+}
+
+// CreateConfirmationCode sends a code to a phone number which a profile confirms owning by entering it. The
+// message is written in the given language when it has a translation.
 func (s *SMSSender) CreateConfirmationCode(
-	ctx context.Context, profileID int, phoneNumber string,
+	ctx context.Context, profileID int, phoneNumber, locale string,
 ) (string, error) {
+	return s.createCode(ctx, domain.PhoneCodePurposeVerify, &profileID, phoneNumber,
+		SMSTemplatePhoneVerification, locale)
+}
 
-	_, err := s.orm.PhoneVerificationCode.
-		Delete().
+// VerifyConfirmationCode checks the code a profile entered to confirm its phone number, using it up if correct.
+// Only a code sent to that same number confirms it.
+func (s *SMSSender) VerifyConfirmationCode(
+	ctx context.Context, profileID int, phoneNumber, code string,
+) (bool, error) {
+	err := s.verifyCode(ctx, domain.PhoneCodePurposeVerify, code,
+		phoneverificationcode.ProfileIDEQ(profileID),
+		phoneverificationcode.PhoneNumberE164(phoneNumber),
+	)
+	return err == nil, err
+}
+
+// CancelConfirmationCodes invalidates the codes a profile was sent to confirm its phone number, so none of them
+// can confirm a number it changed to
+func (s *SMSSender) CancelConfirmationCodes(ctx context.Context, profileID int) error {
+	return s.orm.PhoneVerificationCode.
+		Update().
 		Where(
+			phoneverificationcode.PurposeEQ(phoneverificationcode.Purpose(domain.PhoneCodePurposeVerify.Value)),
 			phoneverificationcode.ProfileIDEQ(profileID),
+			phoneverificationcode.InvalidatedAtIsNil(),
 		).
+		SetInvalidatedAt(time.Now()).
 		Exec(ctx)
+}
 
+// CreateLoginCode sends a code to a phone number to sign in with it, or register if no account has it yet. The
+// message is written in the given language when it has a translation.
+func (s *SMSSender) CreateLoginCode(ctx context.Context, phoneNumber, locale string) (string, error) {
+	return s.createCode(ctx, domain.PhoneCodePurposeLogin, nil, phoneNumber, SMSTemplateLoginCode, locale)
+}
+
+// VerifyLoginCode checks the code entered to sign in with a phone number, using it up if correct
+func (s *SMSSender) VerifyLoginCode(ctx context.Context, phoneNumber, code string) error {
+	return s.verifyCode(ctx, domain.PhoneCodePurposeLogin, code,
+		phoneverificationcode.PhoneNumberE164(phoneNumber),
+	)
+}
+
+// createCode sends a new code to a phone number, replacing the ones sent to it for the same purpose. Numbers
+// are only sent a code once per cooldown and up to a daily cap, since each text costs money and can be used
+// to harass their owner.
+func (s *SMSSender) createCode(
+	ctx context.Context, purpose domain.PhoneCodePurpose, profileID *int, phoneNumber string,
+	tmpl SMSTemplate, locale string,
+) (string, error) {
+	now := time.Now()
+
+	last, err := s.orm.PhoneVerificationCode.
+		Query().
+		Where(phoneverificationcode.PhoneNumberE164(phoneNumber)).
+		Order(ent.Desc(phoneverificationcode.FieldCreatedAt)).
+		First(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return "", err
 	}
+	if last != nil {
+		if wait := last.CreatedAt.Add(s.resendCooldown).Sub(now); wait > 0 {
+			return "", CodeCooldownError{RetryIn: wait}
+		}
+	}
 
-	code := generateRandomIntWithNDigits(4)
+	sentToday, err := s.orm.PhoneVerificationCode.
+		Query().
+		Where(
+			phoneverificationcode.PhoneNumberE164(phoneNumber),
+			phoneverificationcode.CreatedAtGT(now.Add(-24*time.Hour)),
+		).
+		Count(ctx)
+	if err != nil {
+		return "", err
+	}
+	if sentToday >= s.dailyCodeCap {
+		return "", ErrDailyCodeCapReached
+	}
+
+	// Codes older than a day no longer count towards the cap, so the table does not grow forever
+	_, err = s.orm.PhoneVerificationCode.
+		Delete().
+		Where(phoneverificationcode.CreatedAtLTE(now.Add(-24 * time.Hour))).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
 
+	// Only the latest code sent works
+	replaced := []predicate.PhoneVerificationCode{
+		phoneverificationcode.PurposeEQ(phoneverificationcode.Purpose(purpose.Value)),
+		phoneverificationcode.InvalidatedAtIsNil(),
+	}
+	if profileID != nil {
+		replaced = append(replaced, phoneverificationcode.ProfileIDEQ(*profileID))
+	} else {
+		replaced = append(replaced, phoneverificationcode.PhoneNumberE164(phoneNumber))
+	}
 	err = s.orm.PhoneVerificationCode.
-		Create().
-		SetCode(fmt.Sprintf("%d", code)).
-		SetProfileID(profileID).
+		Update().
+		Where(replaced...).
+		SetInvalidatedAt(now).
 		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	code, err := generateCode(s.codeLength)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := RenderSMS(locale, tmpl, SMSTemplateData{
+		AppName:             s.appName,
+		Code:                code,
+		ExpirationInMinutes: int(s.codeExpiration.Minutes()),
+	})
+	if err != nil {
+		return "", err
+	}
 
-	_, err = s.SendSms(ctx, phoneNumber,
-		fmt.Sprintf("Please confirm your phone number for Goship, your code is %d", code))
+	created, err := s.orm.PhoneVerificationCode.
+		Create().
+		SetCode(code).
+		SetNillableProfileID(profileID).
+		SetPhoneNumberE164(phoneNumber).
+		SetPurpose(phoneverificationcode.Purpose(purpose.Value)).
+		Save(ctx)
 	if err != nil {
-		log.Error().Err(err)
 		return "", err
 	}
 
-	return fmt.Sprintf("%d", code), nil
+	_, err = s.SendSms(ctx, phoneNumber, body)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to send code by SMS")
+
+		// The code never reached the number, so it should not count towards its cooldown and cap
+		if err := s.orm.PhoneVerificationCode.DeleteOne(created).Exec(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to delete unsent code")
+		}
+		return "", err
+	}
+
+	return code, nil
 }
 
-func (s *SMSSender) VerifyConfirmationCode(ctx context.Context, profileID int, code string) (bool, error) {
-	phoneCodeInDB, err := s.orm.PhoneVerificationCode.
+// verifyCode checks a code against the latest one sent for a purpose. Every check counts as an attempt, and
+// the code stops working once it is used or guessed wrong too many times.
+func (s *SMSSender) verifyCode(
+	ctx context.Context, purpose domain.PhoneCodePurpose, code string, where ...predicate.PhoneVerificationCode,
+) error {
+	pending, err := s.orm.PhoneVerificationCode.
 		Query().
 		Where(
-			phoneverificationcode.ProfileIDEQ(profileID),
-			phoneverificationcode.CreatedAtGTE(time.Now().Add(-time.Minute*time.Duration(s.validationTextExpirationMinutes))),
+			phoneverificationcode.PurposeEQ(phoneverificationcode.Purpose(purpose.Value)),
+			phoneverificationcode.InvalidatedAtIsNil(),
+			phoneverificationcode.CreatedAtGTE(time.Now().Add(-s.codeExpiration)),
 		).
-		Only(ctx)
+		Where(where...).
+		Order(ent.Desc(phoneverificationcode.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return ErrInvalidCode
+	}
+	if err != nil {
+		return err
+	}
 
+	// Counting the attempt atomically keeps concurrent guesses within the limit
+	counted, err := s.orm.PhoneVerificationCode.
+		Update().
+		Where(
+			phoneverificationcode.ID(pending.ID),
+			phoneverificationcode.InvalidatedAtIsNil(),
+			phoneverificationcode.AttemptsLT(s.maxCodeAttempts),
+		).
+		AddAttempts(1).
+		Save(ctx)
 	if err != nil {
-		return false, err
+		return err
+	}
+	if counted == 0 {
+		return ErrTooManyCodeAttempts
 	}
 
-	if code != phoneCodeInDB.Code {
-		return false, errors.New("incorrect code")
+	matches := subtle.ConstantTimeCompare([]byte(code), []byte(pending.Code)) == 1
+	if !matches && pending.Attempts+1 < s.maxCodeAttempts {
+		return ErrInvalidCode
 	}
 
-	err = s.orm.PhoneVerificationCode.
-		DeleteOneID(phoneCodeInDB.ID).
-		Exec(ctx)
+	invalidated, err := s.orm.PhoneVerificationCode.
+		Update().
+		Where(
+			phoneverificationcode.ID(pending.ID),
+			phoneverificationcode.InvalidatedAtIsNil(),
+		).
+		SetInvalidatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
 
-	return true, err
+	switch {
+	case !matches:
+		return ErrTooManyCodeAttempts
+	case invalidated == 0:
+		// Another request used the code first
+		return ErrInvalidCode
+	}
+	return nil
 }
 
-// SendSms sends an SMS message to the specified phone number with the given message
-func (s *SMSSender) SendSms(ctx context.Context, phoneNumber, message string) (*sns.PublishOutput, error) {
-	params := &sns.PublishInput{
-		Message:     aws.String(message),
-		PhoneNumber: aws.String(phoneNumber), // In international string format
-		MessageAttributes: map[string]types.MessageAttributeValue{
-			"AWS.SNS.SMS.SenderID": {
-				DataType:    aws.String("String"),
-				StringValue: aws.String(s.senderID),
-			},
-			"AWS.SNS.SMS.SMSType": {
-				DataType:    aws.String("String"),
-				StringValue: aws.String("Transactional"),
-			},
-		},
-	}
+// SendSms sends an SMS message to the specified phone number with the given body, and records it to follow
+// its delivery
+func (s *SMSSender) SendSms(ctx context.Context, phoneNumber, body string) (*ent.SMSMessage, error) {
+	id, sendErr := s.provider.Send(ctx, SMS{
+		To:                phoneNumber,
+		Body:              body,
+		StatusCallbackURL: s.statusCallbackURL,
+	})
 
-	resp, err := s.snsClient.Publish(ctx, params)
+	create := s.orm.SMSMessage.
+		Create().
+		SetPhoneNumberE164(phoneNumber).
+		SetProvider(s.provider.Name()).
+		SetProviderMessageID(id)
+	if sendErr != nil {
+		create.SetStatus(smsmessage.Status(domain.SMSStatusFailed.Value))
+	}
+	msg, err := create.Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send SMS: %w", err)
+		log.Error().Err(err).Msg("failed to record sent SMS")
 	}
 
-	return resp, nil
+	if sendErr != nil {
+		return nil, sendErr
+	}
+	return msg, err
 }
 
-// generateRandomIntWithNDigits generates a random integer with n digits.
-func generateRandomIntWithNDigits(n int) int {
-	if n <= 0 {
-		return 0
+// HandleStatusCallback updates the status of a sent message from a callback made by its provider. Messages
+// which reached a final status are not updated, since callbacks can arrive out of order.
+func (s *SMSSender) HandleStatusCallback(r *http.Request) error {
+	parser, ok := s.provider.(SMSStatusCallbackParser)
+	if !ok {
+		return ErrStatusCallbacksUnsupported
 	}
 
-	min := int(pow(10, n-1))
-	max := int(pow(10, n)) - 1
+	status, err := parser.ParseStatusCallback(r, s.statusCallbackURL)
+	if err != nil {
+		return err
+	}
 
-	rand.Seed(time.Now().UnixNano())
-	return rand.Intn(max-min+1) + min
+	return s.orm.SMSMessage.
+		Update().
+		Where(
+			smsmessage.Provider(s.provider.Name()),
+			smsmessage.ProviderMessageID(status.ProviderMessageID),
+			smsmessage.StatusEQ(smsmessage.Status(domain.SMSStatusSent.Value)),
+		).
+		SetStatus(smsmessage.Status(status.Status.Value)).
+		SetErrorCode(status.ErrorCode).
+		Exec(r.Context())
 }
 
-// pow is a simple power function
-func pow(base, exp int) int {
-	result := 1
-	for exp != 0 {
-		if exp%2 != 0 {
-			result *= base
+// generateCode generates a code of n random digits, which may start with zeros
+func generateCode(n int) (string, error) {
+	code := make([]byte, n)
+	for i := range code {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
 		}
-		exp /= 2
-		base *= base
+		code[i] = byte('0' + d.Int64())
 	}
-	return result
+	return string(code), nil
 }
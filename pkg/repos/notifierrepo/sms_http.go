@@ -0,0 +1,146 @@
+package notifierrepo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+// twilioSignatureHeader holds the signature of the requests made by Twilio-compatible APIs
+const twilioSignatureHeader = "X-Twilio-Signature"
+
+// HTTPSMSProvider sends text messages through a Twilio-compatible HTTP API
+type HTTPSMSProvider struct {
+	client     *http.Client
+	baseURL    string
+	accountSID string
+	authToken  string
+	from       string
+}
+
+// NewHTTPSMSProvider initializes a new HTTPSMSProvider
+func NewHTTPSMSProvider(cfg config.PhoneHTTPConfig) *HTTPSMSProvider {
+	return &HTTPSMSProvider{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		accountSID: cfg.AccountSID,
+		authToken:  cfg.AuthToken,
+		from:       cfg.From,
+	}
+}
+
+// Name implements SMSProvider.
+func (p *HTTPSMSProvider) Name() string {
+	return SMSProviderHTTP
+}
+
+// Send implements SMSProvider.
+func (p *HTTPSMSProvider) Send(ctx context.Context, sms SMS) (string, error) {
+	form := url.Values{}
+	form.Set("To", sms.To)
+	form.Set("From", p.from)
+	form.Set("Body", sms.Body)
+	if sms.StatusCallbackURL != "" {
+		form.Set("StatusCallback", sms.StatusCallbackURL)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", p.baseURL, url.PathEscape(p.accountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(p.accountSID, p.authToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send SMS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		SID     string `json:"sid"`
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to send SMS: unexpected response with status %d: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("failed to send SMS: status %d, error %d: %s", resp.StatusCode, body.Code, body.Message)
+	}
+
+	return body.SID, nil
+}
+
+// ParseStatusCallback implements SMSStatusCallbackParser. Callbacks are signed with the auth token over the
+// URL they were sent to followed by their sorted form parameters.
+func (p *HTTPSMSProvider) ParseStatusCallback(r *http.Request, callbackURL string) (*SMSDeliveryStatus, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, ErrInvalidStatusCallback
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(r.Header.Get(twilioSignatureHeader))
+	if err != nil || !hmac.Equal(signature, p.sign(callbackURL, r.PostForm)) {
+		return nil, ErrInvalidStatusCallback
+	}
+
+	id := r.PostForm.Get("MessageSid")
+	if id == "" {
+		return nil, ErrInvalidStatusCallback
+	}
+
+	var status domain.SMSStatus
+	switch r.PostForm.Get("MessageStatus") {
+	case "sent":
+		status = domain.SMSStatusSent
+	case "delivered", "read":
+		status = domain.SMSStatusDelivered
+	case "undelivered":
+		status = domain.SMSStatusUndelivered
+	case "failed", "canceled":
+		status = domain.SMSStatusFailed
+	default:
+		// Messages still queued or sending have nothing new to report
+		status = domain.SMSStatusSent
+	}
+
+	return &SMSDeliveryStatus{
+		ProviderMessageID: id,
+		Status:            status,
+		ErrorCode:         r.PostForm.Get("ErrorCode"),
+	}, nil
+}
+
+// sign computes the signature of a request made to a URL with the given form parameters
+func (p *HTTPSMSProvider) sign(callbackURL string, params url.Values) []byte {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(callbackURL)
+	for _, k := range keys {
+		for _, v := range params[k] {
+			b.WriteString(k)
+			b.WriteString(v)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(p.authToken))
+	mac.Write([]byte(b.String()))
+	return mac.Sum(nil)
+}
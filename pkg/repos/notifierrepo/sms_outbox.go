@@ -0,0 +1,113 @@
+package notifierrepo
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// OutboxSMS is a text message written to the outbox
+type OutboxSMS struct {
+	ID     string    `json:"id"`
+	To     string    `json:"to"`
+	Body   string    `json:"body"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+// OutboxSMSProvider writes text messages to a local file, one JSON object per line, instead of sending them.
+// It is meant for development, where the messages can be read from a debug page.
+type OutboxSMSProvider struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewOutboxSMSProvider initializes a new OutboxSMSProvider writing to the file at the given path
+func NewOutboxSMSProvider(path string) *OutboxSMSProvider {
+	return &OutboxSMSProvider{path: path}
+}
+
+// Name implements SMSProvider.
+func (p *OutboxSMSProvider) Name() string {
+	return SMSProviderOutbox
+}
+
+// Send implements SMSProvider.
+func (p *OutboxSMSProvider) Send(ctx context.Context, sms SMS) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := "outbox-" + hex.EncodeToString(b)
+
+	line, err := json.Marshal(OutboxSMS{
+		ID:     id,
+		To:     sms.To,
+		Body:   sms.Body,
+		SentAt: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(p.path), 0o755); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(p.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// Messages returns up to limit of the latest messages written to the outbox, newest first
+func (p *OutboxSMSProvider) Messages(limit int) ([]OutboxSMS, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f, err := os.Open(p.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var messages []OutboxSMS
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var msg OutboxSMS
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Newest first
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
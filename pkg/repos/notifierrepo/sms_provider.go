@@ -0,0 +1,80 @@
+package notifierrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+const (
+	SMSProviderSNS    = "sns"
+	SMSProviderHTTP   = "http"
+	SMSProviderOutbox = "outbox"
+)
+
+var (
+	// ErrStatusCallbacksUnsupported is returned when the provider sending texts does not report their delivery
+	ErrStatusCallbacksUnsupported = errors.New("sms provider does not support status callbacks")
+
+	// ErrInvalidStatusCallback is returned when a status callback is malformed or was not signed by the provider
+	ErrInvalidStatusCallback = errors.New("invalid sms status callback")
+)
+
+// SMS is a text message to send
+type SMS struct {
+	To   string
+	Body string
+
+	// StatusCallbackURL is where providers supporting it report the delivery of the message, if set
+	StatusCallbackURL string
+}
+
+// SMSProvider sends text messages
+type SMSProvider interface {
+	// Name identifies the provider in the messages it sent
+	Name() string
+
+	// Send sends a message and returns the ID the provider gave it
+	Send(ctx context.Context, sms SMS) (string, error)
+}
+
+// SMSDeliveryStatus is the status of a sent message as reported by its provider
+type SMSDeliveryStatus struct {
+	ProviderMessageID string
+	Status            domain.SMSStatus
+	ErrorCode         string
+}
+
+// SMSStatusCallbackParser is implemented by providers which report the delivery of messages with callbacks
+type SMSStatusCallbackParser interface {
+	// ParseStatusCallback authenticates a callback request received at the given URL and returns the status it reports
+	ParseStatusCallback(r *http.Request, callbackURL string) (*SMSDeliveryStatus, error)
+}
+
+// NewSMSProvider initializes the SMS provider chosen by the config, which defaults to SNS in production and
+// to the outbox elsewhere so that no texts are sent while developing
+func NewSMSProvider(cfg *config.Config) (SMSProvider, error) {
+	provider := cfg.Phone.Provider
+	if provider == "" {
+		if cfg.App.Environment == config.EnvProduction {
+			provider = SMSProviderSNS
+		} else {
+			provider = SMSProviderOutbox
+		}
+	}
+
+	switch provider {
+	case SMSProviderSNS:
+		return NewSNSSMSProvider(cfg.Phone.Region, cfg.Phone.SenderID)
+	case SMSProviderHTTP:
+		return NewHTTPSMSProvider(cfg.Phone.HTTP), nil
+	case SMSProviderOutbox:
+		return NewOutboxSMSProvider(cfg.Phone.OutboxPath), nil
+	default:
+		return nil, fmt.Errorf("unknown sms provider: %s", provider)
+	}
+}
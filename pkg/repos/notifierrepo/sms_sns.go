@@ -0,0 +1,61 @@
+package notifierrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// SNSSMSProvider sends text messages with AWS SNS
+type SNSSMSProvider struct {
+	client   *sns.Client
+	senderID string
+}
+
+// NewSNSSMSProvider initializes a new SNSSMSProvider with the AWS SNS client
+func NewSNSSMSProvider(region, senderID string) (*SNSSMSProvider, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(context.TODO(), awsconfig.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("configuration error: %w", err)
+	}
+
+	return &SNSSMSProvider{
+		client:   sns.NewFromConfig(awsCfg),
+		senderID: senderID,
+	}, nil
+}
+
+// Name implements SMSProvider.
+func (p *SNSSMSProvider) Name() string {
+	return SMSProviderSNS
+}
+
+// Send implements SMSProvider. SNS reports deliveries to CloudWatch rather than with callbacks, so the
+// status callback URL is ignored.
+func (p *SNSSMSProvider) Send(ctx context.Context, sms SMS) (string, error) {
+	params := &sns.PublishInput{
+		Message:     aws.String(sms.Body),
+		PhoneNumber: aws.String(sms.To), // In international string format
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"AWS.SNS.SMS.SenderID": {
+				DataType:    aws.String("String"),
+				StringValue: aws.String(p.senderID),
+			},
+			"AWS.SNS.SMS.SMSType": {
+				DataType:    aws.String("String"),
+				StringValue: aws.String("Transactional"),
+			},
+		},
+	}
+
+	resp, err := p.client.Publish(ctx, params)
+	if err != nil {
+		return "", fmt.Errorf("failed to send SMS: %w", err)
+	}
+
+	return aws.ToString(resp.MessageId), nil
+}
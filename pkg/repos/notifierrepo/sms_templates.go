@@ -0,0 +1,68 @@
+package notifierrepo
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// SMSTemplate identifies the body of a text message, which is rendered in the recipient's language
+type SMSTemplate string
+
+const (
+	SMSTemplatePhoneVerification SMSTemplate = "phone_verification"
+	SMSTemplateLoginCode         SMSTemplate = "login_code"
+)
+
+// defaultSMSLocale is the language texts fall back to when the recipient's one has no translation
+const defaultSMSLocale = "en"
+
+// SMSTemplateData is what the bodies of text messages can use
+type SMSTemplateData struct {
+	AppName             string
+	Code                string
+	ExpirationInMinutes int
+}
+
+// smsTemplates holds the body of every text message by language. Every template needs a default language
+// body, and languages are added by translating the templates here.
+var smsTemplates = parseSMSTemplates(map[string]map[SMSTemplate]string{
+	"en": {
+		SMSTemplatePhoneVerification: "{{.Code}} is your {{.AppName}} code to confirm your phone number. It expires in {{.ExpirationInMinutes}} minutes.",
+		SMSTemplateLoginCode:         "{{.Code}} is your {{.AppName}} sign-in code. Never share it with anyone.",
+	},
+	"fr": {
+		SMSTemplatePhoneVerification: "{{.Code}} est votre code {{.AppName}} pour confirmer votre numéro de téléphone. Il expire dans {{.ExpirationInMinutes}} minutes.",
+		SMSTemplateLoginCode:         "{{.Code}} est votre code de connexion {{.AppName}}. Ne le partagez avec personne.",
+	},
+})
+
+func parseSMSTemplates(bodies map[string]map[SMSTemplate]string) map[string]map[SMSTemplate]*template.Template {
+	parsed := make(map[string]map[SMSTemplate]*template.Template, len(bodies))
+	for locale, templates := range bodies {
+		parsed[locale] = make(map[SMSTemplate]*template.Template, len(templates))
+		for name, body := range templates {
+			parsed[locale][name] = template.Must(template.New(locale + "." + string(name)).Parse(body))
+		}
+	}
+	return parsed
+}
+
+// RenderSMS renders the body of a text message in the given language, such as "fr-CA". Languages without a
+// translation for the region fall back to the base language, then to English.
+func RenderSMS(locale string, name SMSTemplate, data SMSTemplateData) (string, error) {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	base, _, _ := strings.Cut(locale, "-")
+
+	for _, l := range []string{locale, base, defaultSMSLocale} {
+		if tmpl, ok := smsTemplates[l][name]; ok {
+			var b strings.Builder
+			if err := tmpl.Execute(&b, data); err != nil {
+				return "", err
+			}
+			return b.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown sms template: %s", name)
+}
@@ -0,0 +1,213 @@
+package notifierrepo_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent/smsmessage"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func smsTestConfig() *config.Config {
+	return &config.Config{
+		App: config.AppConfig{Name: "GoShip"},
+		Phone: config.PhoneConfig{
+			ValidationCodeExpirationMinutes: 15,
+			CodeLength:                      6,
+			MaxCodeAttempts:                 3,
+			CodeResendCooldown:              time.Minute,
+			DailyCodeCap:                    5,
+		},
+	}
+}
+
+func TestSMSLoginCodes(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	outbox := notifierrepo.NewOutboxSMSProvider(filepath.Join(t.TempDir(), "outbox.jsonl"))
+	sender := notifierrepo.NewSMSSender(client, outbox, smsTestConfig())
+
+	code, err := sender.CreateLoginCode(ctx, "+15145550101", "fr-CA")
+	require.NoError(t, err)
+	assert.Len(t, code, 6)
+
+	// The code was written to the outbox in the recipient's language
+	messages, err := outbox.Messages(10)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "+15145550101", messages[0].To)
+	assert.Equal(t, code+" est votre code de connexion GoShip. Ne le partagez avec personne.", messages[0].Body)
+
+	// The message was recorded to follow its delivery
+	recorded := client.SMSMessage.Query().OnlyX(ctx)
+	assert.Equal(t, notifierrepo.SMSProviderOutbox, recorded.Provider)
+	assert.Equal(t, messages[0].ID, recorded.ProviderMessageID)
+	assert.Equal(t, smsmessage.Status(domain.SMSStatusSent.Value), recorded.Status)
+
+	// Another code cannot be sent right away
+	_, err = sender.CreateLoginCode(ctx, "+15145550101", "en")
+	assert.IsType(t, notifierrepo.CodeCooldownError{}, err)
+
+	// Wrong guesses use up attempts, and the code stops working after the last one
+	assert.Equal(t, notifierrepo.ErrInvalidCode, sender.VerifyLoginCode(ctx, "+15145550101", "wrong"))
+	assert.Equal(t, notifierrepo.ErrInvalidCode, sender.VerifyLoginCode(ctx, "+15145550101", "wrong"))
+	assert.Equal(t, notifierrepo.ErrTooManyCodeAttempts, sender.VerifyLoginCode(ctx, "+15145550101", "wrong"))
+	assert.Equal(t, notifierrepo.ErrInvalidCode, sender.VerifyLoginCode(ctx, "+15145550101", code))
+
+	// A code works once
+	code, err = sender.CreateLoginCode(ctx, "+15145550102", "en")
+	require.NoError(t, err)
+	assert.NoError(t, sender.VerifyLoginCode(ctx, "+15145550102", code))
+	assert.Equal(t, notifierrepo.ErrInvalidCode, sender.VerifyLoginCode(ctx, "+15145550102", code))
+}
+
+func TestSMSConfirmationCodeBoundToNumber(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	usr := tests.CreateUser(ctx, client, "User", "user@example.com", "password", true)
+	subscriptionsRepo := subscriptions.NewSubscriptionsRepo(client, 10, 10)
+	profileRepo := profilerepo.NewProfileRepo(client, storagerepo.NewMockStorageClient(), subscriptionsRepo)
+	prof, err := profileRepo.CreateProfile(ctx, usr, "bio", time.Now().AddDate(-25, 0, 0), nil, nil)
+	require.NoError(t, err)
+
+	outbox := notifierrepo.NewOutboxSMSProvider(filepath.Join(t.TempDir(), "outbox.jsonl"))
+	sender := notifierrepo.NewSMSSender(client, outbox, smsTestConfig())
+
+	code, err := sender.CreateConfirmationCode(ctx, prof.ID, "+15145550101", "en")
+	require.NoError(t, err)
+
+	// The profile changed its number, the code sent to the previous one does not confirm the new one
+	require.NoError(t, sender.CancelConfirmationCodes(ctx, prof.ID))
+	valid, err := sender.VerifyConfirmationCode(ctx, prof.ID, "+15145550199", code)
+	assert.Equal(t, notifierrepo.ErrInvalidCode, err)
+	assert.False(t, valid)
+
+	// Nor does it still confirm the previous number once cancelled
+	valid, err = sender.VerifyConfirmationCode(ctx, prof.ID, "+15145550101", code)
+	assert.Equal(t, notifierrepo.ErrInvalidCode, err)
+	assert.False(t, valid)
+
+	// Even a pending code only confirms the number it was sent to
+	code, err = sender.CreateConfirmationCode(ctx, prof.ID, "+15145550102", "en")
+	require.NoError(t, err)
+	valid, err = sender.VerifyConfirmationCode(ctx, prof.ID, "+15145550199", code)
+	assert.Equal(t, notifierrepo.ErrInvalidCode, err)
+	assert.False(t, valid)
+	valid, err = sender.VerifyConfirmationCode(ctx, prof.ID, "+15145550102", code)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestSMSDailyCodeCap(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	cfg := smsTestConfig()
+	cfg.Phone.CodeResendCooldown = 0
+	cfg.Phone.DailyCodeCap = 2
+	outbox := notifierrepo.NewOutboxSMSProvider(filepath.Join(t.TempDir(), "outbox.jsonl"))
+	sender := notifierrepo.NewSMSSender(client, outbox, cfg)
+
+	_, err := sender.CreateLoginCode(ctx, "+15145550101", "en")
+	require.NoError(t, err)
+	_, err = sender.CreateLoginCode(ctx, "+15145550101", "en")
+	require.NoError(t, err)
+	_, err = sender.CreateLoginCode(ctx, "+15145550101", "en")
+	assert.Equal(t, notifierrepo.ErrDailyCodeCapReached, err)
+
+	messages, err := outbox.Messages(10)
+	require.NoError(t, err)
+	assert.Len(t, messages, 2)
+}
+
+func TestRenderSMS(t *testing.T) {
+	data := notifierrepo.SMSTemplateData{AppName: "GoShip", Code: "123456", ExpirationInMinutes: 15}
+
+	body, err := notifierrepo.RenderSMS("en-US", notifierrepo.SMSTemplatePhoneVerification, data)
+	require.NoError(t, err)
+	assert.Equal(t, "123456 is your GoShip code to confirm your phone number. It expires in 15 minutes.", body)
+
+	// Languages without a translation fall back to English
+	fallback, err := notifierrepo.RenderSMS("de", notifierrepo.SMSTemplatePhoneVerification, data)
+	require.NoError(t, err)
+	assert.Equal(t, body, fallback)
+
+	_, err = notifierrepo.RenderSMS("en", "unknown", data)
+	assert.Error(t, err)
+}
+
+func TestHTTPSMSProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "+15145550101", r.PostForm.Get("To"))
+		assert.Equal(t, "+15145550199", r.PostForm.Get("From"))
+		assert.Equal(t, "hello", r.PostForm.Get("Body"))
+		assert.Equal(t, "https://goship.run/sms/status", r.PostForm.Get("StatusCallback"))
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]string{"sid": "SM123", "status": "queued"})
+	}))
+	defer server.Close()
+
+	provider := notifierrepo.NewHTTPSMSProvider(config.PhoneHTTPConfig{
+		BaseURL:    server.URL,
+		AccountSID: "AC123",
+		AuthToken:  "secret",
+		From:       "+15145550199",
+	})
+
+	id, err := provider.Send(context.Background(), notifierrepo.SMS{
+		To:                "+15145550101",
+		Body:              "hello",
+		StatusCallbackURL: "https://goship.run/sms/status",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SM123", id)
+
+	// Status callbacks must be signed with the auth token
+	callback := func(signature string) *http.Request {
+		form := url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"undelivered"}, "ErrorCode": {"30003"}}
+		r := httptest.NewRequest(http.MethodPost, "/sms/status", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set("X-Twilio-Signature", signature)
+		return r
+	}
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write([]byte("https://goship.run/sms/statusErrorCode30003MessageSidSM123MessageStatusundelivered"))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	status, err := provider.ParseStatusCallback(callback(signature), "https://goship.run/sms/status")
+	require.NoError(t, err)
+	assert.Equal(t, "SM123", status.ProviderMessageID)
+	assert.Equal(t, domain.SMSStatusUndelivered, status.Status)
+	assert.Equal(t, "30003", status.ErrorCode)
+
+	_, err = provider.ParseStatusCallback(callback("forged"), "https://goship.run/sms/status")
+	assert.Equal(t, notifierrepo.ErrInvalidStatusCallback, err)
+}
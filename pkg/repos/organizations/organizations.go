@@ -0,0 +1,484 @@
+package organizations
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organization"
+	"github.com/mikestefanello/pagoda/ent/organizationinvitation"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+// invitationTokenBytes is the number of random bytes of an invitation token, which is base64 encoded in links
+const invitationTokenBytes = 24
+
+var (
+	ErrNotMember      = errors.New("profile is not a member of the organization")
+	ErrNotAllowed     = errors.New("role does not allow this action in the organization")
+	ErrLastOwner      = errors.New("organizations need at least one owner")
+	ErrAlreadyMember  = errors.New("already a member of the organization")
+	ErrAlreadyInvited = errors.New("an invitation was already sent to this email address")
+	// ErrInvitationNotFound is returned for invitations which do not exist, were revoked or were already accepted
+	ErrInvitationNotFound = errors.New("organization invitation not found")
+	ErrInvitationExpired  = errors.New("organization invitation expired")
+	// ErrInvitationEmailMismatch is returned when an invitation is accepted by a user it was not sent to
+	ErrInvitationEmailMismatch = errors.New("organization invitation was sent to another email address")
+)
+
+type OrganizationsRepo struct {
+	orm        *ent.Client
+	expiration time.Duration
+}
+
+func NewOrganizationsRepo(orm *ent.Client, invitationExpirationInDays int) *OrganizationsRepo {
+	return &OrganizationsRepo{
+		orm:        orm,
+		expiration: time.Duration(invitationExpirationInDays) * 24 * time.Hour,
+	}
+}
+
+// CanManageMembers determines whether a role allows inviting, removing and changing the role of members
+func CanManageMembers(role domain.OrganizationRole) bool {
+	return role == domain.OrganizationRoleOwner || role == domain.OrganizationRoleAdmin
+}
+
+// CanManageBilling determines whether a role allows managing the subscription of the organization
+func CanManageBilling(role domain.OrganizationRole) bool {
+	return role == domain.OrganizationRoleOwner
+}
+
+// MembershipRole returns the role a membership grants
+func MembershipRole(m *ent.OrganizationMembership) domain.OrganizationRole {
+	return *domain.OrganizationRoles.Parse(m.Role.String())
+}
+
+// CreateOrganization creates an organization, of which the profile creating it is the owner
+func (r *OrganizationsRepo) CreateOrganization(
+	ctx context.Context, ownerProfileID int, name string,
+) (*ent.Organization, error) {
+	tx, err := r.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	org, err := tx.Organization.
+		Create().
+		SetName(name).
+		Save(ctx)
+	if err == nil {
+		err = tx.OrganizationMembership.
+			Create().
+			SetOrganizationID(org.ID).
+			SetProfileID(ownerProfileID).
+			SetRole(organizationmembership.Role(domain.OrganizationRoleOwner.Value)).
+			Exec(ctx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// GetMemberships returns the memberships of a profile along with their organization, in the order it joined them
+func (r *OrganizationsRepo) GetMemberships(ctx context.Context, profileID int) ([]*ent.OrganizationMembership, error) {
+	return r.orm.OrganizationMembership.
+		Query().
+		Where(organizationmembership.ProfileID(profileID)).
+		WithOrganization().
+		Order(ent.Asc(organizationmembership.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetMembership returns the membership of a profile in an organization, along with the organization
+func (r *OrganizationsRepo) GetMembership(
+	ctx context.Context, organizationID, profileID int,
+) (*ent.OrganizationMembership, error) {
+	m, err := r.orm.OrganizationMembership.
+		Query().
+		Where(
+			organizationmembership.OrganizationID(organizationID),
+			organizationmembership.ProfileID(profileID),
+		).
+		WithOrganization().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotMember
+	}
+	return m, err
+}
+
+// GetMembers returns the memberships of an organization along with their profile and its user, in the order
+// they joined it
+func (r *OrganizationsRepo) GetMembers(ctx context.Context, organizationID int) ([]*ent.OrganizationMembership, error) {
+	return r.orm.OrganizationMembership.
+		Query().
+		Where(organizationmembership.OrganizationID(organizationID)).
+		WithProfile(func(pq *ent.ProfileQuery) {
+			pq.WithUser()
+		}).
+		Order(ent.Asc(organizationmembership.FieldCreatedAt)).
+		All(ctx)
+}
+
+// UpdateMemberRole changes the role of a member on behalf of another one. Admins manage admins and members,
+// while only owners can make or unmake owners. The last owner of an organization cannot be demoted.
+func (r *OrganizationsRepo) UpdateMemberRole(
+	ctx context.Context, actor *ent.OrganizationMembership, memberProfileID int, role domain.OrganizationRole,
+) error {
+	member, err := r.GetMembership(ctx, actor.OrganizationID, memberProfileID)
+	if err != nil {
+		return err
+	}
+	if err := checkCanManage(actor, member, role); err != nil {
+		return err
+	}
+	if MembershipRole(member) == role {
+		return nil
+	}
+	if MembershipRole(member) == domain.OrganizationRoleOwner {
+		if err := r.checkNotLastOwner(ctx, actor.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	return r.orm.OrganizationMembership.
+		UpdateOne(member).
+		SetRole(organizationmembership.Role(role.Value)).
+		Exec(ctx)
+}
+
+// RemoveMember removes a member from an organization on behalf of another one, following the same rules as
+// UpdateMemberRole. Anyone can leave an organization, unless they are its last owner.
+func (r *OrganizationsRepo) RemoveMember(
+	ctx context.Context, actor *ent.OrganizationMembership, memberProfileID int,
+) error {
+	member, err := r.GetMembership(ctx, actor.OrganizationID, memberProfileID)
+	if err != nil {
+		return err
+	}
+	if member.ID != actor.ID {
+		if err := checkCanManage(actor, member, MembershipRole(member)); err != nil {
+			return err
+		}
+	}
+	if MembershipRole(member) == domain.OrganizationRoleOwner {
+		if err := r.checkNotLastOwner(ctx, actor.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	tx, err := r.orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := removeInTx(ctx, tx, member); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateInvitation creates an invitation to join an organization with a role, which only the user with the
+// given email address can accept, until it expires
+func (r *OrganizationsRepo) CreateInvitation(
+	ctx context.Context, actor *ent.OrganizationMembership, email string, role domain.OrganizationRole,
+) (*ent.OrganizationInvitation, error) {
+	if !CanManageMembers(MembershipRole(actor)) {
+		return nil, ErrNotAllowed
+	}
+	if role == domain.OrganizationRoleOwner && MembershipRole(actor) != domain.OrganizationRoleOwner {
+		return nil, ErrNotAllowed
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	isMember, err := r.orm.OrganizationMembership.
+		Query().
+		Where(
+			organizationmembership.OrganizationID(actor.OrganizationID),
+			organizationmembership.HasProfileWith(
+				profile.HasUserWith(user.EmailEqualFold(email)),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, ErrAlreadyMember
+	}
+
+	isInvited, err := r.orm.OrganizationInvitation.
+		Query().
+		Where(
+			organizationinvitation.HasOrganizationWith(organization.ID(actor.OrganizationID)),
+			organizationinvitation.EmailEqualFold(email),
+			pendingInvitation(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isInvited {
+		return nil, ErrAlreadyInvited
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.orm.OrganizationInvitation.
+		Create().
+		SetOrganizationID(actor.OrganizationID).
+		SetInviterID(actor.ProfileID).
+		SetEmail(email).
+		SetRole(organizationinvitation.Role(role.Value)).
+		SetToken(token).
+		SetExpiresAt(time.Now().Add(r.expiration)).
+		Save(ctx)
+}
+
+// GetPendingInvitations returns the invitations of an organization which can still be accepted, most recent first
+func (r *OrganizationsRepo) GetPendingInvitations(
+	ctx context.Context, organizationID int,
+) ([]*ent.OrganizationInvitation, error) {
+	return r.orm.OrganizationInvitation.
+		Query().
+		Where(
+			organizationinvitation.HasOrganizationWith(organization.ID(organizationID)),
+			pendingInvitation(),
+		).
+		Order(ent.Desc(organizationinvitation.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetInvitation returns an invitation which can still be accepted, along with its organization
+func (r *OrganizationsRepo) GetInvitation(ctx context.Context, token string) (*ent.OrganizationInvitation, error) {
+	inv, err := r.orm.OrganizationInvitation.
+		Query().
+		Where(
+			organizationinvitation.Token(token),
+			organizationinvitation.AcceptedAtIsNil(),
+			organizationinvitation.RevokedAtIsNil(),
+		).
+		WithOrganization().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if inv.ExpiresAt.Before(time.Now()) {
+		return nil, ErrInvitationExpired
+	}
+	return inv, nil
+}
+
+// AcceptInvitation makes a profile join an organization with the role it was invited with. The invitation
+// must have been sent to the email address of the user of the profile.
+func (r *OrganizationsRepo) AcceptInvitation(
+	ctx context.Context, token string, profileID int, email string,
+) (*ent.OrganizationInvitation, error) {
+	inv, err := r.GetInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(inv.Email, email) {
+		return nil, ErrInvitationEmailMismatch
+	}
+
+	tx, err := r.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := acceptInTx(ctx, tx, inv, profileID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// RevokeInvitation revokes an invitation of an organization which was not accepted yet
+func (r *OrganizationsRepo) RevokeInvitation(
+	ctx context.Context, actor *ent.OrganizationMembership, invitationID int,
+) error {
+	if !CanManageMembers(MembershipRole(actor)) {
+		return ErrNotAllowed
+	}
+
+	revoked, err := r.orm.OrganizationInvitation.
+		Update().
+		Where(
+			organizationinvitation.ID(invitationID),
+			organizationinvitation.HasOrganizationWith(organization.ID(actor.OrganizationID)),
+			organizationinvitation.AcceptedAtIsNil(),
+			organizationinvitation.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if revoked == 0 {
+		return ErrInvitationNotFound
+	}
+	return nil
+}
+
+// acceptInTx claims an invitation and creates the membership it grants within a transaction
+func acceptInTx(ctx context.Context, tx *ent.Tx, inv *ent.OrganizationInvitation, profileID int) error {
+	// Only a single profile can claim an invitation, even when accepted concurrently
+	claimed, err := tx.OrganizationInvitation.
+		Update().
+		Where(
+			organizationinvitation.ID(inv.ID),
+			pendingInvitation(),
+		).
+		SetAcceptedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if claimed == 0 {
+		return ErrInvitationNotFound
+	}
+
+	isMember, err := tx.OrganizationMembership.
+		Query().
+		Where(
+			organizationmembership.OrganizationID(inv.Edges.Organization.ID),
+			organizationmembership.ProfileID(profileID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return ErrAlreadyMember
+	}
+
+	err = tx.OrganizationMembership.
+		Create().
+		SetOrganizationID(inv.Edges.Organization.ID).
+		SetProfileID(profileID).
+		SetRole(organizationmembership.Role(inv.Role.String())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	// New members benefit from the subscription the organization pays for, if any
+	return tx.MonthlySubscription.
+		Update().
+		Where(
+			monthlysubscription.OrganizationID(inv.Edges.Organization.ID),
+			monthlysubscription.IsActive(true),
+		).
+		AddBenefactorIDs(profileID).
+		Exec(ctx)
+}
+
+// removeInTx deletes a membership within a transaction, along with the benefits the member got from the
+// subscriptions of the organization
+func removeInTx(ctx context.Context, tx *ent.Tx, member *ent.OrganizationMembership) error {
+	err := tx.OrganizationMembership.
+		DeleteOne(member).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.MonthlySubscription.
+		Update().
+		Where(monthlysubscription.OrganizationID(member.OrganizationID)).
+		RemoveBenefactorIDs(member.ProfileID).
+		Exec(ctx)
+}
+
+// checkCanManage checks that the role of a member can be managed by another one, including granting it a role
+func checkCanManage(actor, member *ent.OrganizationMembership, role domain.OrganizationRole) error {
+	if !CanManageMembers(MembershipRole(actor)) {
+		return ErrNotAllowed
+	}
+	if MembershipRole(actor) != domain.OrganizationRoleOwner &&
+		(MembershipRole(member) == domain.OrganizationRoleOwner || role == domain.OrganizationRoleOwner) {
+		return ErrNotAllowed
+	}
+	return nil
+}
+
+// checkNotLastOwner checks that an organization has another owner than the one about to be demoted or removed
+func (r *OrganizationsRepo) checkNotLastOwner(ctx context.Context, organizationID int) error {
+	owners, err := r.orm.OrganizationMembership.
+		Query().
+		Where(
+			organizationmembership.OrganizationID(organizationID),
+			organizationmembership.RoleEQ(organizationmembership.Role(domain.OrganizationRoleOwner.Value)),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// pendingInvitation matches the invitations which can still be accepted
+func pendingInvitation() predicate.OrganizationInvitation {
+	return organizationinvitation.And(
+		organizationinvitation.AcceptedAtIsNil(),
+		organizationinvitation.RevokedAtIsNil(),
+		organizationinvitation.ExpiresAtGT(time.Now()),
+	)
+}
+
+// generateInvitationToken generates a random token which is safe to use in URLs
+func generateInvitationToken() (string, error) {
+	b := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,162 @@
+package organizations_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/organizations"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func init() {
+	// Register "pgx" as "postgres" explicitly for database/sql
+	sql.Register("postgres", stdlib.GetDefaultDriver())
+}
+
+func TestMemberRoles(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	owner, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	member, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+
+	organizationsRepo := organizations.NewOrganizationsRepo(client, 7)
+	org, err := organizationsRepo.CreateOrganization(ctx, owner.ID, "Bandi & Co")
+	require.NoError(t, err)
+
+	ownerMembership, err := organizationsRepo.GetMembership(ctx, org.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrganizationRoleOwner, organizations.MembershipRole(ownerMembership))
+
+	_, err = organizationsRepo.GetMembership(ctx, org.ID, member.ID)
+	assert.Equal(t, organizations.ErrNotMember, err)
+
+	// The last owner can neither be demoted nor leave
+	err = organizationsRepo.UpdateMemberRole(ctx, ownerMembership, owner.ID, domain.OrganizationRoleAdmin)
+	assert.Equal(t, organizations.ErrLastOwner, err)
+	err = organizationsRepo.RemoveMember(ctx, ownerMembership, owner.ID)
+	assert.Equal(t, organizations.ErrLastOwner, err)
+
+	_, err = client.OrganizationMembership.
+		Create().
+		SetOrganizationID(org.ID).
+		SetProfileID(member.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	memberMembership, err := organizationsRepo.GetMembership(ctx, org.ID, member.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrganizationRoleMember, organizations.MembershipRole(memberMembership))
+
+	// Members cannot manage anyone
+	err = organizationsRepo.UpdateMemberRole(ctx, memberMembership, owner.ID, domain.OrganizationRoleMember)
+	assert.Equal(t, organizations.ErrNotAllowed, err)
+	err = organizationsRepo.RemoveMember(ctx, memberMembership, owner.ID)
+	assert.Equal(t, organizations.ErrNotAllowed, err)
+
+	// Owners can make other owners, after which they can step down
+	err = organizationsRepo.UpdateMemberRole(ctx, ownerMembership, member.ID, domain.OrganizationRoleOwner)
+	require.NoError(t, err)
+	err = organizationsRepo.UpdateMemberRole(ctx, ownerMembership, owner.ID, domain.OrganizationRoleMember)
+	require.NoError(t, err)
+
+	members, err := organizationsRepo.GetMembers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, owner.ID, members[0].ProfileID)
+	assert.Equal(t, domain.OrganizationRoleMember, organizations.MembershipRole(members[0]))
+	assert.Equal(t, domain.OrganizationRoleOwner, organizations.MembershipRole(members[1]))
+
+	// Anyone but the last owner can leave
+	ownerMembership, err = organizationsRepo.GetMembership(ctx, org.ID, owner.ID)
+	require.NoError(t, err)
+	err = organizationsRepo.RemoveMember(ctx, ownerMembership, owner.ID)
+	require.NoError(t, err)
+
+	memberships, err := organizationsRepo.GetMemberships(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Empty(t, memberships)
+}
+
+func TestInvitations(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	owner, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	invitee, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	other, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+
+	organizationsRepo := organizations.NewOrganizationsRepo(client, 7)
+	org, err := organizationsRepo.CreateOrganization(ctx, owner.ID, "Bandi & Co")
+	require.NoError(t, err)
+	ownerMembership, err := organizationsRepo.GetMembership(ctx, org.ID, owner.ID)
+	require.NoError(t, err)
+
+	_, err = organizationsRepo.CreateInvitation(ctx, ownerMembership, user1.Email, domain.OrganizationRoleMember)
+	assert.Equal(t, organizations.ErrAlreadyMember, err)
+
+	inv, err := organizationsRepo.CreateInvitation(ctx, ownerMembership, " Joane@gmail.com ", domain.OrganizationRoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, "joane@gmail.com", inv.Email)
+	assert.NotEmpty(t, inv.Token)
+
+	_, err = organizationsRepo.CreateInvitation(ctx, ownerMembership, user2.Email, domain.OrganizationRoleMember)
+	assert.Equal(t, organizations.ErrAlreadyInvited, err)
+
+	pending, err := organizationsRepo.GetPendingInvitations(ctx, org.ID)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	// Only the invited email address can accept it
+	_, err = organizationsRepo.AcceptInvitation(ctx, inv.Token, other.ID, user3.Email)
+	assert.Equal(t, organizations.ErrInvitationEmailMismatch, err)
+
+	_, err = organizationsRepo.AcceptInvitation(ctx, inv.Token, invitee.ID, user2.Email)
+	require.NoError(t, err)
+	adminMembership, err := organizationsRepo.GetMembership(ctx, org.ID, invitee.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrganizationRoleAdmin, organizations.MembershipRole(adminMembership))
+
+	_, err = organizationsRepo.AcceptInvitation(ctx, inv.Token, invitee.ID, user2.Email)
+	assert.Equal(t, organizations.ErrInvitationNotFound, err)
+
+	// Admins can invite, but not owners
+	_, err = organizationsRepo.CreateInvitation(ctx, adminMembership, user3.Email, domain.OrganizationRoleOwner)
+	assert.Equal(t, organizations.ErrNotAllowed, err)
+	inv, err = organizationsRepo.CreateInvitation(ctx, adminMembership, user3.Email, domain.OrganizationRoleMember)
+	require.NoError(t, err)
+
+	err = organizationsRepo.RevokeInvitation(ctx, adminMembership, inv.ID)
+	require.NoError(t, err)
+	err = organizationsRepo.RevokeInvitation(ctx, adminMembership, inv.ID)
+	assert.Equal(t, organizations.ErrInvitationNotFound, err)
+	_, err = organizationsRepo.AcceptInvitation(ctx, inv.Token, other.ID, user3.Email)
+	assert.Equal(t, organizations.ErrInvitationNotFound, err)
+
+	// Expired invitations cannot be accepted
+	inv, err = organizationsRepo.CreateInvitation(ctx, ownerMembership, user3.Email, domain.OrganizationRoleMember)
+	require.NoError(t, err)
+	require.NoError(t, client.OrganizationInvitation.UpdateOne(inv).SetExpiresAt(time.Now().Add(-time.Hour)).Exec(ctx))
+	_, err = organizationsRepo.AcceptInvitation(ctx, inv.Token, other.ID, user3.Email)
+	assert.Equal(t, organizations.ErrInvitationExpired, err)
+}
@@ -0,0 +1,401 @@
+package profilerepo
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/user"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+)
+
+// DataExportManifestName is the name of the manifest describing the content of a personal data export
+const DataExportManifestName = "manifest.json"
+
+type (
+	// DataExportManifest describes the content of a personal data export
+	DataExportManifest struct {
+		GeneratedAt time.Time        `json:"generated_at"`
+		UserID      int              `json:"user_id"`
+		ProfileID   int              `json:"profile_id,omitempty"`
+		Files       []DataExportFile `json:"files"`
+	}
+
+	// DataExportFile is a file of a personal data export
+	DataExportFile struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Records     *int   `json:"records,omitempty"`
+	}
+
+	// dataExportFriend is a friend of a user, as seen in their personal data export. Only what the user can
+	// see of their friends in the app is exported.
+	dataExportFriend struct {
+		ProfileID int    `json:"profile_id"`
+		Name      string `json:"name"`
+	}
+
+	// dataExportFriendRequest is a friend request a user sent or received
+	dataExportFriendRequest struct {
+		ID                 int        `json:"id"`
+		Status             string     `json:"status"`
+		RequesterProfileID int        `json:"requester_profile_id"`
+		RecipientProfileID int        `json:"recipient_profile_id"`
+		CreatedAt          time.Time  `json:"created_at"`
+		RespondedAt        *time.Time `json:"responded_at,omitempty"`
+	}
+
+	// dataExportOrganization is an organization a user is part of, along with their role in it
+	dataExportOrganization struct {
+		ID       int       `json:"id"`
+		Name     string    `json:"name"`
+		Role     string    `json:"role"`
+		JoinedAt time.Time `json:"joined_at"`
+	}
+
+	// dataExportWriter writes the files of a personal data export to a zip archive, keeping track of them
+	// for the manifest
+	dataExportWriter struct {
+		zw       *zip.Writer
+		manifest *DataExportManifest
+	}
+)
+
+// ExportUserData writes a zip archive to w holding all the personal data of a user: their account, profile,
+// friends, friend requests and blocks, notifications and notification permissions, subscriptions, sent emails, last-seen history and the
+// original of their photos, along with a manifest describing each file.
+func (p *ProfileRepo) ExportUserData(ctx context.Context, userID int, w io.Writer) (*DataExportManifest, error) {
+	usr, err := p.orm.User.
+		Query().
+		Where(user.ID(userID)).
+		WithLastSeenAt().
+		WithProfile().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ew := &dataExportWriter{
+		zw: zip.NewWriter(w),
+		manifest: &DataExportManifest{
+			GeneratedAt: time.Now().UTC(),
+			UserID:      usr.ID,
+			Files:       []DataExportFile{},
+		},
+	}
+
+	lastSeen := usr.Edges.LastSeenAt
+	prof := usr.Edges.Profile
+	usr.Edges = ent.UserEdges{}
+
+	if err := ew.writeJSON("account.json", "Your account", nil, usr); err != nil {
+		return nil, err
+	}
+	if err := ew.writeRecords("last_seen.json", "When you were last seen online", len(lastSeen), lastSeen); err != nil {
+		return nil, err
+	}
+
+	if prof != nil {
+		ew.manifest.ProfileID = prof.ID
+		prof.Edges = ent.ProfileEdges{}
+		if err := ew.writeJSON("profile.json", "Your profile", nil, prof); err != nil {
+			return nil, err
+		}
+		if err := p.exportProfileData(ctx, ew, prof.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	manifest, err := json.MarshalIndent(ew.manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	f, err := ew.zw.Create(DataExportManifestName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(manifest); err != nil {
+		return nil, err
+	}
+
+	if err := ew.zw.Close(); err != nil {
+		return nil, err
+	}
+	return ew.manifest, nil
+}
+
+// exportProfileData writes everything tied to the profile of a user to their personal data export
+func (p *ProfileRepo) exportProfileData(ctx context.Context, ew *dataExportWriter, profileID int) error {
+	friendProfiles, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryFriends().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	friends := toDataExportFriends(friendProfiles)
+	if err := ew.writeRecords("friends.json", "Your friends", len(friends), friends); err != nil {
+		return err
+	}
+
+	blockedProfiles, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryBlocked().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	blocked := toDataExportFriends(blockedProfiles)
+	if err := ew.writeRecords("blocked_profiles.json", "The profiles you blocked", len(blocked), blocked); err != nil {
+		return err
+	}
+
+	frs, err := p.orm.FriendRequest.
+		Query().
+		Where(
+			friendrequest.Or(
+				friendrequest.HasRequesterWith(profile.ID(profileID)),
+				friendrequest.HasRecipientWith(profile.ID(profileID)),
+			),
+		).
+		WithRequester().
+		WithRecipient().
+		Order(ent.Asc(friendrequest.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	friendRequests := make([]dataExportFriendRequest, 0, len(frs))
+	for _, fr := range frs {
+		friendRequests = append(friendRequests, dataExportFriendRequest{
+			ID:                 fr.ID,
+			Status:             fr.Status.String(),
+			RequesterProfileID: fr.Edges.Requester.ID,
+			RecipientProfileID: fr.Edges.Recipient.ID,
+			CreatedAt:          fr.CreatedAt,
+			RespondedAt:        fr.RespondedAt,
+		})
+	}
+	if err := ew.writeRecords(
+		"friend_requests.json", "The friend requests you sent and received", len(friendRequests), friendRequests,
+	); err != nil {
+		return err
+	}
+
+	memberships, err := p.orm.OrganizationMembership.
+		Query().
+		Where(organizationmembership.ProfileID(profileID)).
+		WithOrganization().
+		Order(ent.Asc(organizationmembership.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	organizations := make([]dataExportOrganization, 0, len(memberships))
+	for _, m := range memberships {
+		organizations = append(organizations, dataExportOrganization{
+			ID:       m.OrganizationID,
+			Name:     m.Edges.Organization.Name,
+			Role:     m.Role.String(),
+			JoinedAt: m.CreatedAt,
+		})
+	}
+	if err := ew.writeRecords(
+		"organizations.json", "The organizations you are part of", len(organizations), organizations,
+	); err != nil {
+		return err
+	}
+
+	notifications, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryNotifications().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ew.writeRecords("notifications.json", "Your notifications", len(notifications), notifications); err != nil {
+		return err
+	}
+
+	permissions, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryNotificationPermissions().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, perm := range permissions {
+		// Tokens authenticate the links turning notifications off, they are secrets rather than personal data
+		perm.Token = ""
+	}
+	if err := ew.writeRecords(
+		"notification_permissions.json", "The notifications you allowed", len(permissions), permissions,
+	); err != nil {
+		return err
+	}
+
+	subs, err := p.orm.MonthlySubscription.
+		Query().
+		Where(
+			monthlysubscription.Or(
+				monthlysubscription.HasBenefactorsWith(profile.ID(profileID)),
+				monthlysubscription.PayingProfileID(profileID),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ew.writeRecords("subscriptions.json", "Your subscriptions", len(subs), subs); err != nil {
+		return err
+	}
+
+	sentEmails, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QuerySentEmails().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ew.writeRecords("sent_emails.json", "The emails we sent you", len(sentEmails), sentEmails); err != nil {
+		return err
+	}
+
+	profileImages, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryProfileImage().
+		WithSizes(func(isq *ent.ImageSizeQuery) {
+			isq.WithFile()
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if err := p.exportImages(ew, "profile_image", "Your profile image", profileImages); err != nil {
+		return err
+	}
+
+	photos, err := p.orm.Profile.
+		Query().
+		Where(profile.ID(profileID)).
+		QueryPhotos().
+		WithSizes(func(isq *ent.ImageSizeQuery) {
+			isq.WithFile()
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	return p.exportImages(ew, "photos", "One of your photos", photos)
+}
+
+// toDataExportFriends keeps only what a user can see in the app of other profiles
+func toDataExportFriends(profiles []*ent.Profile) []dataExportFriend {
+	friends := make([]dataExportFriend, 0, len(profiles))
+	for _, f := range profiles {
+		friend := dataExportFriend{ProfileID: f.ID}
+		if f.Edges.User != nil {
+			friend.Name = f.Edges.User.Name
+		}
+		friends = append(friends, friend)
+	}
+	return friends
+}
+
+// exportImages copies the largest stored size of each image to the personal data export, since the
+// uploaded files are not kept as is
+func (p *ProfileRepo) exportImages(ew *dataExportWriter, dir, description string, images []*ent.Image) error {
+	for _, img := range images {
+		var largest *ent.ImageSize
+		for _, size := range img.Edges.Sizes {
+			if size.Edges.File == nil {
+				continue
+			}
+			if largest == nil || size.Width*size.Height > largest.Width*largest.Height {
+				largest = size
+			}
+		}
+		if largest == nil {
+			continue
+		}
+
+		objectKey := largest.Edges.File.ObjectKey
+		ext := filepath.Ext(objectKey)
+		if ext == "" {
+			ext = ".jpg"
+		}
+
+		err := p.exportFile(ew, fmt.Sprintf("%s/%d%s", dir, img.ID, ext), description, objectKey)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportFile copies a file from storage to the personal data export
+func (p *ProfileRepo) exportFile(ew *dataExportWriter, name, description, objectKey string) error {
+	r, err := p.storageRepo.GetFile(storagerepo.BucketMainApp, objectKey)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := ew.create(name, description, nil)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// writeRecords writes a list of records as a JSON file
+func (ew *dataExportWriter) writeRecords(name, description string, count int, records any) error {
+	return ew.writeJSON(name, description, &count, records)
+}
+
+// writeJSON writes a value as an indented JSON file
+func (ew *dataExportWriter) writeJSON(name, description string, records *int, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ew.create(name, description, records)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
+
+// create adds a file to the archive and to the manifest
+func (ew *dataExportWriter) create(name, description string, records *int) (io.Writer, error) {
+	f, err := ew.zw.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	ew.manifest.Files = append(ew.manifest.Files, DataExportFile{
+		Name:        name,
+		Description: description,
+		Records:     records,
+	})
+	return f, nil
+}
@@ -0,0 +1,434 @@
+package profilerepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/ent/image"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+var (
+	// ErrFriendRequestNotFound is returned for friend requests which do not exist, are not pending anymore or
+	// which the profile is not allowed to act upon
+	ErrFriendRequestNotFound = errors.New("friend request not found")
+	ErrOwnProfile            = errors.New("profiles cannot befriend nor block themselves")
+	ErrAlreadyFriends        = errors.New("profiles are already friends")
+	ErrProfileBlocked        = errors.New("profile blocked")
+)
+
+// SendFriendRequest sends a friend request to a profile. When that profile already requested the friendship
+// of the requester, their request is accepted instead, and returned with the accepted status.
+func (p *ProfileRepo) SendFriendRequest(
+	ctx context.Context, requesterProfileID, recipientProfileID int,
+) (*ent.FriendRequest, error) {
+	if requesterProfileID == recipientProfileID {
+		return nil, ErrOwnProfile
+	}
+
+	blocked, err := p.IsBlockedBetween(ctx, requesterProfileID, recipientProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, ErrProfileBlocked
+	}
+
+	friends, err := p.AreProfilesFriends(ctx, requesterProfileID, recipientProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if friends {
+		return nil, ErrAlreadyFriends
+	}
+
+	pending, err := p.orm.FriendRequest.
+		Query().
+		Where(pendingFriendRequestBetween(requesterProfileID, recipientProfileID)).
+		WithRequester().
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if pending != nil {
+		if pending.Edges.Requester.ID == requesterProfileID {
+			return nil, ErrContactRequestAlreadyExists
+		}
+		return p.AcceptFriendRequest(ctx, requesterProfileID, pending.ID)
+	}
+
+	fr, err := p.orm.FriendRequest.
+		Create().
+		SetRequesterID(requesterProfileID).
+		SetRecipientID(recipientProfileID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.orm.FriendRequest.
+		Query().
+		Where(friendrequest.ID(fr.ID)).
+		WithRequester().
+		WithRecipient().
+		Only(ctx)
+}
+
+// AcceptFriendRequest accepts a pending friend request received by a profile, which becomes friends with
+// the requester. The returned request comes with both its requester and recipient.
+func (p *ProfileRepo) AcceptFriendRequest(
+	ctx context.Context, recipientProfileID, requestID int,
+) (*ent.FriendRequest, error) {
+	tx, err := p.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	fr, err := respondToFriendRequest(
+		ctx, tx.Client(), requestID,
+		friendrequest.HasRecipientWith(profile.ID(recipientProfileID)),
+		friendrequest.StatusAccepted,
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	requesterProfileID := fr.Edges.Requester.ID
+	friends, err := tx.Profile.
+		Query().
+		Where(profile.ID(recipientProfileID)).
+		QueryFriends().
+		Where(profile.ID(requesterProfileID)).
+		Exist(ctx)
+	if err == nil && !friends {
+		err = tx.Profile.
+			UpdateOneID(recipientProfileID).
+			AddFriendIDs(requesterProfileID).
+			Exec(ctx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return fr, nil
+}
+
+// DeclineFriendRequest declines a pending friend request received by a profile. The returned request comes
+// with both its requester and recipient.
+func (p *ProfileRepo) DeclineFriendRequest(
+	ctx context.Context, recipientProfileID, requestID int,
+) (*ent.FriendRequest, error) {
+	return respondToFriendRequest(
+		ctx, p.orm, requestID,
+		friendrequest.HasRecipientWith(profile.ID(recipientProfileID)),
+		friendrequest.StatusDeclined,
+	)
+}
+
+// CancelFriendRequest cancels a pending friend request sent by a profile. The returned request comes with
+// both its requester and recipient.
+func (p *ProfileRepo) CancelFriendRequest(
+	ctx context.Context, requesterProfileID, requestID int,
+) (*ent.FriendRequest, error) {
+	return respondToFriendRequest(
+		ctx, p.orm, requestID,
+		friendrequest.HasRequesterWith(profile.ID(requesterProfileID)),
+		friendrequest.StatusCancelled,
+	)
+}
+
+// GetPendingFriendRequests returns the pending friend requests a profile received and sent, most recent first
+func (p *ProfileRepo) GetPendingFriendRequests(
+	ctx context.Context, profileID int,
+) (received []domain.FriendRequest, sent []domain.FriendRequest, err error) {
+	frs, err := p.orm.FriendRequest.
+		Query().
+		Where(
+			friendrequest.StatusEQ(friendrequest.StatusPending),
+			friendrequest.Or(
+				friendrequest.HasRecipientWith(profile.ID(profileID)),
+				friendrequest.HasRequesterWith(profile.ID(profileID)),
+			),
+		).
+		WithRequester(withFriendRequestProfile).
+		WithRecipient(withFriendRequestProfile).
+		Order(ent.Desc(friendrequest.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	received, sent = []domain.FriendRequest{}, []domain.FriendRequest{}
+	for _, fr := range frs {
+		other := fr.Edges.Requester
+		if other.ID == profileID {
+			other = fr.Edges.Recipient
+		}
+		otherProfile, err := p.EntProfileToDomainObject(other)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		request := domain.FriendRequest{
+			ID:        fr.ID,
+			CreatedAt: fr.CreatedAt,
+			Profile:   *otherProfile,
+		}
+		if fr.Edges.Requester.ID == profileID {
+			sent = append(sent, request)
+		} else {
+			received = append(received, request)
+		}
+	}
+	return received, sent, nil
+}
+
+// GetFriendship returns how a profile relates to another one
+func (p *ProfileRepo) GetFriendship(ctx context.Context, selfProfileID, otherProfileID int) (*domain.Friendship, error) {
+	friendship := &domain.Friendship{
+		ProfileID: otherProfileID,
+		Status:    domain.FriendshipStatusNone,
+	}
+
+	blocked, err := hasBlocked(ctx, p.orm, selfProfileID, otherProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		friendship.Status = domain.FriendshipStatusBlocked
+		return friendship, nil
+	}
+
+	blockedBy, err := hasBlocked(ctx, p.orm, otherProfileID, selfProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if blockedBy {
+		friendship.Status = domain.FriendshipStatusBlockedBy
+		return friendship, nil
+	}
+
+	friends, err := p.AreProfilesFriends(ctx, selfProfileID, otherProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if friends {
+		friendship.Status = domain.FriendshipStatusFriends
+		return friendship, nil
+	}
+
+	pending, err := p.orm.FriendRequest.
+		Query().
+		Where(pendingFriendRequestBetween(selfProfileID, otherProfileID)).
+		WithRequester().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return friendship, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	friendship.RequestID = pending.ID
+	if pending.Edges.Requester.ID == selfProfileID {
+		friendship.Status = domain.FriendshipStatusRequestSent
+	} else {
+		friendship.Status = domain.FriendshipStatusRequestReceived
+	}
+	return friendship, nil
+}
+
+// BlockProfile blocks a profile, which stops being friends with the blocker and cannot request their
+// friendship nor see their profile anymore. Pending friend requests between both profiles are closed.
+func (p *ProfileRepo) BlockProfile(ctx context.Context, blockerProfileID, blockedProfileID int) error {
+	if blockerProfileID == blockedProfileID {
+		return ErrOwnProfile
+	}
+
+	tx, err := p.orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := blockProfile(ctx, tx.Client(), blockerProfileID, blockedProfileID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// UnblockProfile unblocks a profile, which does not restore any former friendship
+func (p *ProfileRepo) UnblockProfile(ctx context.Context, blockerProfileID, blockedProfileID int) error {
+	return p.orm.Profile.
+		UpdateOneID(blockerProfileID).
+		RemoveBlockedIDs(blockedProfileID).
+		Exec(ctx)
+}
+
+// IsBlockedBetween checks if either profile blocked the other one
+func (p *ProfileRepo) IsBlockedBetween(ctx context.Context, profileID1, profileID2 int) (bool, error) {
+	return p.orm.Profile.
+		Query().
+		Where(
+			profile.ID(profileID1),
+			profile.Or(
+				profile.HasBlockedWith(profile.ID(profileID2)),
+				profile.HasBlockedByWith(profile.ID(profileID2)),
+			),
+		).
+		Exist(ctx)
+}
+
+func blockProfile(ctx context.Context, client *ent.Client, blockerProfileID, blockedProfileID int) error {
+	alreadyBlocked, err := hasBlocked(ctx, client, blockerProfileID, blockedProfileID)
+	if err != nil {
+		return err
+	}
+
+	update := client.Profile.
+		UpdateOneID(blockerProfileID).
+		RemoveFriendIDs(blockedProfileID)
+	if !alreadyBlocked {
+		update.AddBlockedIDs(blockedProfileID)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = client.FriendRequest.
+		Update().
+		Where(
+			friendrequest.StatusEQ(friendrequest.StatusPending),
+			friendrequest.HasRequesterWith(profile.ID(blockerProfileID)),
+			friendrequest.HasRecipientWith(profile.ID(blockedProfileID)),
+		).
+		SetStatus(friendrequest.StatusCancelled).
+		SetRespondedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.FriendRequest.
+		Update().
+		Where(
+			friendrequest.StatusEQ(friendrequest.StatusPending),
+			friendrequest.HasRequesterWith(profile.ID(blockedProfileID)),
+			friendrequest.HasRecipientWith(profile.ID(blockerProfileID)),
+		).
+		SetStatus(friendrequest.StatusDeclined).
+		SetRespondedAt(now).
+		Save(ctx)
+	return err
+}
+
+// respondToFriendRequest moves a pending friend request to a final status. Only a single response goes
+// through, even when concurrent.
+func respondToFriendRequest(
+	ctx context.Context, client *ent.Client, requestID int, allowed predicate.FriendRequest, status friendrequest.Status,
+) (*ent.FriendRequest, error) {
+	fr, err := client.FriendRequest.
+		Query().
+		Where(
+			friendrequest.ID(requestID),
+			friendrequest.StatusEQ(friendrequest.StatusPending),
+			allowed,
+		).
+		WithRequester().
+		WithRecipient().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrFriendRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	updated, err := client.FriendRequest.
+		Update().
+		Where(
+			friendrequest.ID(requestID),
+			friendrequest.StatusEQ(friendrequest.StatusPending),
+		).
+		SetStatus(status).
+		SetRespondedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrFriendRequestNotFound
+	}
+
+	fr.Status = status
+	fr.RespondedAt = &now
+	return fr, nil
+}
+
+func hasBlocked(ctx context.Context, client *ent.Client, blockerProfileID, blockedProfileID int) (bool, error) {
+	return client.Profile.
+		Query().
+		Where(profile.ID(blockerProfileID)).
+		QueryBlocked().
+		Where(profile.ID(blockedProfileID)).
+		Exist(ctx)
+}
+
+// pendingFriendRequestBetween matches the pending friend requests between two profiles, in either direction
+func pendingFriendRequestBetween(profileID1, profileID2 int) predicate.FriendRequest {
+	return friendrequest.And(
+		friendrequest.StatusEQ(friendrequest.StatusPending),
+		friendrequest.Or(
+			friendrequest.And(
+				friendrequest.HasRequesterWith(profile.ID(profileID1)),
+				friendrequest.HasRecipientWith(profile.ID(profileID2)),
+			),
+			friendrequest.And(
+				friendrequest.HasRequesterWith(profile.ID(profileID2)),
+				friendrequest.HasRecipientWith(profile.ID(profileID1)),
+			),
+		),
+	)
+}
+
+// notBlockedWith matches the profiles which neither blocked a profile nor were blocked by it
+func notBlockedWith(profileID int) predicate.Profile {
+	return profile.Not(
+		profile.Or(
+			profile.HasBlockedWith(profile.ID(profileID)),
+			profile.HasBlockedByWith(profile.ID(profileID)),
+		),
+	)
+}
+
+func withFriendRequestProfile(pq *ent.ProfileQuery) {
+	pq.WithUser().
+		WithProfileImage(func(iq *ent.ImageQuery) {
+			iq.Where(image.HiddenAtIsNil()).WithSizes(func(s *ent.ImageSizeQuery) {
+				s.WithFile()
+			})
+		})
+}
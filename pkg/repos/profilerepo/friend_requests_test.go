@@ -0,0 +1,171 @@
+package profilerepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFriendRequests(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	profile1, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	profile2, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	profile3, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+
+	_, err = profileRepo.SendFriendRequest(ctx, profile1.ID, profile1.ID)
+	assert.Equal(t, profilerepo.ErrOwnProfile, err)
+
+	// A request does not befriend anyone until accepted
+	request, err := profileRepo.SendFriendRequest(ctx, profile1.ID, profile2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, friendrequest.StatusPending, request.Status)
+
+	_, err = profileRepo.SendFriendRequest(ctx, profile1.ID, profile2.ID)
+	assert.Equal(t, profilerepo.ErrContactRequestAlreadyExists, err)
+
+	friends, err := profileRepo.AreProfilesFriends(ctx, profile1.ID, profile2.ID)
+	require.NoError(t, err)
+	assert.False(t, friends)
+
+	friendship, err := profileRepo.GetFriendship(ctx, profile1.ID, profile2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.FriendshipStatusRequestSent, friendship.Status)
+	assert.Equal(t, request.ID, friendship.RequestID)
+
+	friendship, err = profileRepo.GetFriendship(ctx, profile2.ID, profile1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.FriendshipStatusRequestReceived, friendship.Status)
+
+	received, sent, err := profileRepo.GetPendingFriendRequests(ctx, profile2.ID)
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	assert.Empty(t, sent)
+	assert.Equal(t, profile1.ID, received[0].Profile.ID)
+	assert.Equal(t, "Jo Bandi", received[0].Profile.Name)
+
+	// Only the recipient can accept
+	_, err = profileRepo.AcceptFriendRequest(ctx, profile1.ID, request.ID)
+	assert.Equal(t, profilerepo.ErrFriendRequestNotFound, err)
+
+	accepted, err := profileRepo.AcceptFriendRequest(ctx, profile2.ID, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, friendrequest.StatusAccepted, accepted.Status)
+	assert.Equal(t, profile1.ID, accepted.Edges.Requester.ID)
+	assert.NotNil(t, accepted.RespondedAt)
+
+	friends, err = profileRepo.AreProfilesFriends(ctx, profile1.ID, profile2.ID)
+	require.NoError(t, err)
+	assert.True(t, friends)
+
+	_, err = profileRepo.AcceptFriendRequest(ctx, profile2.ID, request.ID)
+	assert.Equal(t, profilerepo.ErrFriendRequestNotFound, err)
+
+	_, err = profileRepo.SendFriendRequest(ctx, profile2.ID, profile1.ID)
+	assert.Equal(t, profilerepo.ErrAlreadyFriends, err)
+
+	// Declined and cancelled requests can be sent again
+	request, err = profileRepo.SendFriendRequest(ctx, profile3.ID, profile1.ID)
+	require.NoError(t, err)
+	_, err = profileRepo.CancelFriendRequest(ctx, profile1.ID, request.ID)
+	assert.Equal(t, profilerepo.ErrFriendRequestNotFound, err)
+	cancelled, err := profileRepo.CancelFriendRequest(ctx, profile3.ID, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, friendrequest.StatusCancelled, cancelled.Status)
+
+	request, err = profileRepo.SendFriendRequest(ctx, profile3.ID, profile1.ID)
+	require.NoError(t, err)
+	declined, err := profileRepo.DeclineFriendRequest(ctx, profile1.ID, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, friendrequest.StatusDeclined, declined.Status)
+
+	// Requesting the friendship of whom already requested yours accepts their request
+	request, err = profileRepo.SendFriendRequest(ctx, profile3.ID, profile1.ID)
+	require.NoError(t, err)
+	mutual, err := profileRepo.SendFriendRequest(ctx, profile1.ID, profile3.ID)
+	require.NoError(t, err)
+	assert.Equal(t, request.ID, mutual.ID)
+	assert.Equal(t, friendrequest.StatusAccepted, mutual.Status)
+
+	friendProfiles, err := profileRepo.GetFriends(ctx, profile1.ID)
+	require.NoError(t, err)
+	assert.Len(t, friendProfiles, 2)
+}
+
+func TestBlockProfile(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	profile1, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	profile2, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+	profile3, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, profileRepo.LinkProfilesAsFriends(ctx, profile1.ID, profile2.ID))
+	pending, err := profileRepo.SendFriendRequest(ctx, profile3.ID, profile1.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, profilerepo.ErrOwnProfile, profileRepo.BlockProfile(ctx, profile1.ID, profile1.ID))
+
+	// Blocking ends friendships and closes pending requests
+	require.NoError(t, profileRepo.BlockProfile(ctx, profile1.ID, profile2.ID))
+	require.NoError(t, profileRepo.BlockProfile(ctx, profile1.ID, profile3.ID))
+	require.NoError(t, profileRepo.BlockProfile(ctx, profile1.ID, profile3.ID))
+
+	friends, err := profileRepo.AreProfilesFriends(ctx, profile2.ID, profile1.ID)
+	require.NoError(t, err)
+	assert.False(t, friends)
+
+	friendProfiles, err := profileRepo.GetFriends(ctx, profile2.ID)
+	require.NoError(t, err)
+	assert.Empty(t, friendProfiles)
+
+	_, err = profileRepo.AcceptFriendRequest(ctx, profile1.ID, pending.ID)
+	assert.Equal(t, profilerepo.ErrFriendRequestNotFound, err)
+
+	// Neither side can request the friendship of the other anymore
+	_, err = profileRepo.SendFriendRequest(ctx, profile2.ID, profile1.ID)
+	assert.Equal(t, profilerepo.ErrProfileBlocked, err)
+	_, err = profileRepo.SendFriendRequest(ctx, profile1.ID, profile3.ID)
+	assert.Equal(t, profilerepo.ErrProfileBlocked, err)
+
+	friendship, err := profileRepo.GetFriendship(ctx, profile1.ID, profile2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.FriendshipStatusBlocked, friendship.Status)
+
+	friendship, err = profileRepo.GetFriendship(ctx, profile2.ID, profile1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.FriendshipStatusBlockedBy, friendship.Status)
+
+	// Unblocking does not restore the friendship
+	require.NoError(t, profileRepo.UnblockProfile(ctx, profile1.ID, profile2.ID))
+
+	friendship, err = profileRepo.GetFriendship(ctx, profile2.ID, profile1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.FriendshipStatusNone, friendship.Status)
+
+	_, err = profileRepo.SendFriendRequest(ctx, profile2.ID, profile1.ID)
+	assert.NoError(t, err)
+}
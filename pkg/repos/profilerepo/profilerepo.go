@@ -128,14 +128,16 @@ func (p *ProfileRepo) UpdateProfile(
 	return nil
 }
 
-// GetFriends retrieves all friends
+// GetFriends retrieves all friends, leaving out the ones blocked either way
 func (s *ProfileRepo) GetFriends(
 	ctx context.Context, currentProfileID int,
 ) ([]domain.Profile, error) {
 	entProfiles, err := s.orm.Profile.Query().
 		Where(profile.IDEQ(currentProfileID)).
 		QueryFriends().
+		Where(notBlockedWith(currentProfileID)).
 		WithProfileImage(func(pi *ent.ImageQuery) {
+			pi.Where(image.HiddenAtIsNil())
 			pi.WithSizes(func(s *ent.ImageSizeQuery) {
 				s.WithFile()
 			})
@@ -159,14 +161,17 @@ func (s *ProfileRepo) GetFriends(
 	return profiles, nil
 }
 
-// AreProfilesFriends checks if two profiles are friends
+// AreProfilesFriends checks if two profiles are friends, which they are not while either one blocked the other
 func (p *ProfileRepo) AreProfilesFriends(ctx context.Context, profileID1, profileID2 int) (bool, error) {
 	// Check if there is a friendship link between the two profiles
 	exists, err := p.orm.Profile.
 		Query().
 		Where(profile.IDEQ(profileID1)).
 		QueryFriends().
-		Where(profile.IDEQ(profileID2)).
+		Where(
+			profile.IDEQ(profileID2),
+			notBlockedWith(profileID1),
+		).
 		Exist(ctx)
 
 	if err != nil {
@@ -181,7 +186,8 @@ func (p *ProfileRepo) AreProfilesFriends(ctx context.Context, profileID1, profil
 	return exists, nil
 }
 
-// LinkProfilesAsFriends links two profiles as friends.
+// LinkProfilesAsFriends links two profiles as friends, with no consent step. Friendships between users
+// normally go through SendFriendRequest and AcceptFriendRequest.
 func (p *ProfileRepo) LinkProfilesAsFriends(
 	ctx context.Context, inviterProfileID int, inviteeProfileID int,
 ) error {
@@ -216,11 +222,13 @@ func (p *ProfileRepo) GetProfileByID(
 		Where(profile.IDEQ(profileID)).
 		WithUser().
 		WithProfileImage(func(pi *ent.ImageQuery) {
+			pi.Where(image.HiddenAtIsNil())
 			pi.WithSizes(func(s *ent.ImageSizeQuery) {
 				s.WithFile()
 			})
 		}).
 		WithPhotos(func(pi *ent.ImageQuery) {
+			pi.Where(image.HiddenAtIsNil())
 			pi.WithSizes(func(s *ent.ImageSizeQuery) {
 				s.WithFile()
 			})
@@ -287,6 +295,7 @@ func (p *ProfileRepo) GetPhotosByProfileByID(
 		Query().
 		Where(profile.IDEQ(profileID)).
 		QueryPhotos().
+		Where(image.HiddenAtIsNil()).
 		Order(ent.Desc(filestorage.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
@@ -311,6 +320,7 @@ func (p *ProfileRepo) GetProfilePhotoThumbnailURL(userID int) string {
 		Query().
 		Where(profile.HasUserWith(user.IDEQ(userID))).
 		QueryProfileImage().
+		Where(image.HiddenAtIsNil()).
 		WithSizes(func(s *ent.ImageSizeQuery) {
 			s.WithFile()
 		}).
@@ -563,7 +573,7 @@ func (p *ProfileRepo) EntProfileToDomainObject(e *ent.Profile) (*domain.Profile,
 		name = e.Edges.User.Name
 	}
 	var profileImage *domain.Photo
-	if e.Edges.ProfileImage != nil {
+	if e.Edges.ProfileImage != nil && e.Edges.ProfileImage.HiddenAt == nil {
 		image, err := p.storageRepo.GetImageObjectFromFile(e.Edges.ProfileImage)
 		if err != nil {
 			return nil, err
@@ -628,20 +638,32 @@ func (p *ProfileRepo) DeleteUserData(ctx context.Context, profileID int) error {
 		}
 	}
 
-	sub, err := p.orm.MonthlySubscription.Query().Where(
+	// A profile can benefit from its own subscription as well as from the ones of its organizations
+	subs, err := p.orm.MonthlySubscription.Query().Where(
 		monthlysubscription.HasBenefactorsWith(profile.IDEQ(profileID)),
 	).
 		WithPayer().
 		WithBenefactors().
-		Only(ctx)
-	if err != nil && !ent.IsNotFound(err) {
+		All(ctx)
+	if err != nil {
 		return err
 	}
-	if !ent.IsNotFound(err) {
+	for _, sub := range subs {
+		if sub.OrganizationID != nil {
+			// The organization keeps paying for its other members
+			err = p.orm.MonthlySubscription.
+				UpdateOne(sub).
+				RemoveBenefactorIDs(profileID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 
 		delete := false
 
-		if sub.Edges.Payer.ID == profileID {
+		if sub.Edges.Payer != nil && sub.Edges.Payer.ID == profileID {
 			delete = true
 		}
 
@@ -1,16 +1,22 @@
 package profilerepo_test
 
 import (
+	"archive/zip"
 	"bytes"
 	"database/sql"
+	"fmt"
 	"image"
 	"image/jpeg"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/jackc/pgx/stdlib"
 	"github.com/mikestefanello/pagoda/ent"
+	entimage "github.com/mikestefanello/pagoda/ent/image"
+	"github.com/mikestefanello/pagoda/ent/imagesize"
 	"github.com/mikestefanello/pagoda/ent/profile"
 	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
@@ -468,3 +474,88 @@ func TestDeleteUserData(t *testing.T) {
 	assert.Equal(t, len(initialUsers), len(finalUsers2)+2)
 	assert.Equal(t, len(initialProfiles), len(finalProfiles2)+2)
 }
+
+func TestExportUserData(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+
+	mockStorage := storagerepo.NewMockStorageClient()
+	profileRepo := profilerepo.NewProfileRepo(client, mockStorage, nil)
+	profile1, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	profile2, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	tests.LinkFriends(ctx, client, profile1.ID, []int{profile2.ID})
+
+	// A photo stored in two sizes, of which only the largest one is exported
+	var sizes []*ent.ImageSize
+	for i, s := range []struct {
+		size          imagesize.Size
+		width, height int
+	}{
+		{imagesize.SizePreview, 100, 50},
+		{imagesize.SizeFull, 1000, 500},
+	} {
+		file, err := client.FileStorage.
+			Create().
+			SetBucketName("bucketName").
+			SetObjectKey(fmt.Sprintf("photo-%d.jpg", i)).
+			SetFileSize(1).
+			SetFileHash("a").
+			Save(ctx)
+		assert.Nil(t, err)
+		size, err := client.ImageSize.
+			Create().
+			SetSize(s.size).
+			SetWidth(s.width).
+			SetHeight(s.height).
+			SetFile(file).
+			Save(ctx)
+		assert.Nil(t, err)
+		sizes = append(sizes, size)
+	}
+	photo, err := client.Image.
+		Create().
+		SetType(entimage.TypeProfileGallery).
+		AddSizes(sizes...).
+		Save(ctx)
+	assert.Nil(t, err)
+	err = client.Profile.UpdateOne(profile1).AddPhotos(photo).Exec(ctx)
+	assert.Nil(t, err)
+
+	mockStorage.On("GetFile", storagerepo.BucketMainApp, "photo-1.jpg").
+		Return(io.NopCloser(strings.NewReader("original")), nil).Once()
+
+	buf := new(bytes.Buffer)
+	manifest, err := profileRepo.ExportUserData(ctx, user1.ID, buf)
+	assert.NoError(t, err)
+	mockStorage.AssertExpectations(t)
+	assert.Equal(t, user1.ID, manifest.UserID)
+	assert.Equal(t, profile1.ID, manifest.ProfileID)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		assert.NoError(t, err)
+		content, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		files[f.Name] = string(content)
+	}
+
+	// Every file is listed in the manifest
+	assert.Contains(t, files, profilerepo.DataExportManifestName)
+	assert.Len(t, manifest.Files, len(files)-1)
+	for _, f := range manifest.Files {
+		assert.Contains(t, files, f.Name)
+	}
+
+	assert.Equal(t, "original", files[fmt.Sprintf("photos/%d.jpg", photo.ID)])
+	assert.Contains(t, files["account.json"], "jo@gmail.com")
+	assert.NotContains(t, files["account.json"], "password")
+	assert.Contains(t, files["friends.json"], "James Bond")
+}
@@ -37,6 +37,11 @@ func (msc *MockStorageClient) DeleteFile(bucket Bucket, objectName string) error
 	return nil
 }
 
+func (msc *MockStorageClient) GetFile(bucket Bucket, objectName string) (io.ReadCloser, error) {
+	args := msc.Called(bucket, objectName)
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
 func (msc *MockStorageClient) GetPresignedURL(bucket Bucket, objectName string, expiry time.Duration) (string, error) {
 	// Implement mock logic.
 	// Return a mock URL and nil error.
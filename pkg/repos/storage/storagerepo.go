@@ -42,6 +42,7 @@ type StorageClientInterface interface {
 	CreateBucket(bucketName string, location string) error
 	UploadFile(bucket Bucket, objectName string, fileStream io.Reader) (*int, error)
 	DeleteFile(bucket Bucket, objectName string) error
+	GetFile(bucket Bucket, objectName string) (io.ReadCloser, error)
 	GetPresignedURL(bucket Bucket, objectName string, expiry time.Duration) (string, error)
 	GetImageObjectFromFile(file *ent.Image) (*domain.Photo, error)
 	GetImageObjectsFromFiles(files []*ent.Image) ([]domain.Photo, error)
@@ -163,6 +164,18 @@ func (sc *StorageClient) GetPresignedURL(bucket Bucket, objectName string, expir
 	return presignedURL.String(), nil
 }
 
+// GetFile returns the content of a file, which the caller has to close
+func (sc *StorageClient) GetFile(bucket Bucket, objectName string) (io.ReadCloser, error) {
+	ctx := context.Background()
+
+	bucketName, err := sc.getBucketName(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return sc.minioClient.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+}
+
 func (sc *StorageClient) DeleteFile(bucket Bucket, objectName string) error {
 	ctx := context.Background()
 	bucketName, err := sc.getBucketName(bucket)
@@ -7,6 +7,9 @@ import (
 
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
+	"github.com/mikestefanello/pagoda/ent/organization"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
+	"github.com/mikestefanello/pagoda/ent/predicate"
 	"github.com/mikestefanello/pagoda/ent/profile"
 	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/rs/zerolog/log"
@@ -75,58 +78,97 @@ func (s *SubscriptionsRepo) DeactivateExpiredSubscriptions(ctx context.Context)
 // UpdateToPaidPro idempotently updates a subscription to pro plan.
 func (s *SubscriptionsRepo) UpdateToPaidPro(
 	ctx context.Context, profileID int,
+) error {
+	return s.updateToPaidPro(ctx, paidByProfile(profileID), func(m *ent.MonthlySubscriptionMutation) {
+		m.SetPayerID(profileID)
+		m.AddBenefactorIDs(profileID)
+	})
+}
+
+// UpdateOrganizationToPaidPro idempotently updates the subscription billed to an organization to pro plan.
+// All of its current members benefit from it.
+func (s *SubscriptionsRepo) UpdateOrganizationToPaidPro(
+	ctx context.Context, organizationID int,
+) error {
+	memberIDs, err := s.orm.OrganizationMembership.
+		Query().
+		Where(organizationmembership.OrganizationID(organizationID)).
+		Select(organizationmembership.FieldProfileID).
+		Ints(ctx)
+	if err != nil {
+		return err
+	}
+	return s.updateToPaidPro(ctx, paidByOrganization(organizationID), func(m *ent.MonthlySubscriptionMutation) {
+		m.SetOrganizationID(organizationID)
+		m.AddBenefactorIDs(memberIDs...)
+	})
+}
+
+// updateToPaidPro idempotently updates the active subscription of a payer to pro plan, creating it if there
+// is none. The payer and benefactors are set on the subscription with attach.
+func (s *SubscriptionsRepo) updateToPaidPro(
+	ctx context.Context, paidBy predicate.MonthlySubscription, attach func(*ent.MonthlySubscriptionMutation),
 ) error {
 	count, err := s.orm.MonthlySubscription.
 		Query().
 		Where(
-			monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+			paidBy,
 			monthlysubscription.IsActive(true),
 		).Count(ctx)
 	if err != nil {
 		return err
 	}
 	if count == 1 {
-		return s.orm.MonthlySubscription.
+		update := s.orm.MonthlySubscription.
 			Update().
 			Where(
-				monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+				paidBy,
 				monthlysubscription.IsActive(true),
 			).
 			SetProduct(monthlysubscription.Product(domain.ProductTypePro.Value)).
-			SetPayerID(profileID).
-			AddBenefactorIDs(profileID).
 			SetIsTrial(false).
 			SetIsActive(true).
 			SetStartedAt(time.Now()).
 			ClearExpiredOn().
-			ClearCancelledAt().
-			Exec(ctx)
+			ClearCancelledAt()
+		attach(update.Mutation())
+		return update.Exec(ctx)
 	} else if count > 1 {
-		return errors.New("there should only ever be 1 active subscription for a profile")
+		return errors.New("there should only ever be 1 active subscription for a payer")
 	}
 
-	return s.orm.MonthlySubscription.
+	create := s.orm.MonthlySubscription.
 		Create().
 		SetProduct(monthlysubscription.Product(domain.ProductTypePro.Value)).
-		SetPayerID(profileID).
-		AddBenefactorIDs(profileID).
 		SetIsTrial(false).
 		SetIsActive(true).
-		SetStartedAt(time.Now()).
-		Exec(ctx)
+		SetStartedAt(time.Now())
+	attach(create.Mutation())
+	return create.Exec(ctx)
 }
 
 // TODO: refactor to return domain.ProductType and not *domain.ProductType
 func (s *SubscriptionsRepo) GetCurrentlyActiveProduct(
 	ctx context.Context, profileID int,
+) (*domain.ProductType, *time.Time, bool, error) {
+	return s.getCurrentlyActiveProduct(ctx, paidByProfile(profileID))
+}
+
+// GetOrganizationActiveProduct returns the product of the subscription billed to an organization, if any.
+func (s *SubscriptionsRepo) GetOrganizationActiveProduct(
+	ctx context.Context, organizationID int,
+) (*domain.ProductType, *time.Time, bool, error) {
+	return s.getCurrentlyActiveProduct(ctx, paidByOrganization(organizationID))
+}
+
+func (s *SubscriptionsRepo) getCurrentlyActiveProduct(
+	ctx context.Context, paidBy predicate.MonthlySubscription,
 ) (*domain.ProductType, *time.Time, bool, error) {
 	sub, err := s.orm.MonthlySubscription.
 		Query().
 		Where(
 			monthlysubscription.IsActive(true),
-			monthlysubscription.HasPayerWith(
-				profile.IDEQ(profileID),
-			),
+			paidBy,
 		).
 		Only(ctx)
 
@@ -167,15 +209,62 @@ func (s *SubscriptionsRepo) GetProfileIDFromStripeCustomerID(ctx context.Context
 		).
 		Select(profile.FieldID).
 		Only(ctx)
-	return profile.ID, err
+	if err != nil {
+		return 0, err
+	}
+	return profile.ID, nil
+}
+
+// StoreOrganizationStripeCustomerID stores the Stripe customer ID an organization is billed with
+func (s *SubscriptionsRepo) StoreOrganizationStripeCustomerID(
+	ctx context.Context, organizationID int, stripeCustomerID string,
+) error {
+	return s.orm.Organization.
+		UpdateOneID(organizationID).
+		SetStripeID(stripeCustomerID).
+		Exec(ctx)
+}
+
+// GetOrganizationIDFromStripeCustomerID returns the organization billed with a Stripe customer ID
+func (s *SubscriptionsRepo) GetOrganizationIDFromStripeCustomerID(
+	ctx context.Context, stripeCustomerID string,
+) (int, error) {
+	return s.orm.Organization.
+		Query().
+		Where(organization.StripeIDEQ(stripeCustomerID)).
+		OnlyID(ctx)
 }
 
 // CancelWithGracePeriod idempotently manages a subscription when a payment fails.
 func (s *SubscriptionsRepo) CancelWithGracePeriod(ctx context.Context, profileID int) error {
+	return s.cancelWithGracePeriod(ctx, paidByProfile(profileID))
+}
+
+// CancelOrganizationWithGracePeriod idempotently manages the subscription of an organization when a payment fails.
+func (s *SubscriptionsRepo) CancelOrganizationWithGracePeriod(ctx context.Context, organizationID int) error {
+	return s.cancelWithGracePeriod(ctx, paidByOrganization(organizationID))
+}
+
+// CancelOrRenew idempotently updates the subscription status to cancelled and sets the expiry date.
+// If the expiry date is nil, the subscription is renewed.
+func (s *SubscriptionsRepo) CancelOrRenew(
+	ctx context.Context, profileID int, cancelDate *time.Time,
+) error {
+	return s.cancelOrRenew(ctx, paidByProfile(profileID), cancelDate)
+}
+
+// CancelOrganizationOrRenew is CancelOrRenew for the subscription billed to an organization.
+func (s *SubscriptionsRepo) CancelOrganizationOrRenew(
+	ctx context.Context, organizationID int, cancelDate *time.Time,
+) error {
+	return s.cancelOrRenew(ctx, paidByOrganization(organizationID), cancelDate)
+}
+
+func (s *SubscriptionsRepo) cancelWithGracePeriod(ctx context.Context, paidBy predicate.MonthlySubscription) error {
 	count, err := s.orm.MonthlySubscription.
 		Query().
 		Where(
-			monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+			paidBy,
 			monthlysubscription.IsActive(true),
 		).
 		Count(ctx)
@@ -186,13 +275,13 @@ func (s *SubscriptionsRepo) CancelWithGracePeriod(ctx context.Context, profileID
 		// on free plan
 		return nil
 	} else if count > 1 {
-		return errors.New("there should only ever be 1 active subscription for a profile")
+		return errors.New("there should only ever be 1 active subscription for a payer")
 	}
 
 	m, err := s.orm.MonthlySubscription.
 		Query().
 		Where(
-			monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+			paidBy,
 			monthlysubscription.IsActive(true),
 		).
 		Select(monthlysubscription.FieldExpiredOn).
@@ -204,7 +293,7 @@ func (s *SubscriptionsRepo) CancelWithGracePeriod(ctx context.Context, profileID
 	if m.ExpiredOn == nil || m.ExpiredOn.After(time.Now().Add(s.paymentFailedGracePeriodInDays)) {
 		_, err := s.orm.MonthlySubscription.Update().
 			Where(
-				monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+				paidBy,
 				monthlysubscription.IsActive(true),
 			).
 			SetExpiredOn(time.Now().Add(s.paymentFailedGracePeriodInDays)).
@@ -214,15 +303,13 @@ func (s *SubscriptionsRepo) CancelWithGracePeriod(ctx context.Context, profileID
 	return nil
 }
 
-// CancelOrRenew idempotently updates the subscription status to cancelled and sets the expiry date.
-// If the expiry date is nil, the subscription is renewed.
-func (s *SubscriptionsRepo) CancelOrRenew(
-	ctx context.Context, profileID int, cancelDate *time.Time,
+func (s *SubscriptionsRepo) cancelOrRenew(
+	ctx context.Context, paidBy predicate.MonthlySubscription, cancelDate *time.Time,
 ) error {
 	if cancelDate == nil {
 		return s.orm.MonthlySubscription.Update().
 			Where(
-				monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+				paidBy,
 				monthlysubscription.IsActive(true),
 			).
 			ClearCancelledAt().
@@ -233,7 +320,7 @@ func (s *SubscriptionsRepo) CancelOrRenew(
 	count, err := s.orm.MonthlySubscription.
 		Query().
 		Where(
-			monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+			paidBy,
 			monthlysubscription.IsActive(true),
 		).
 		Count(ctx)
@@ -244,12 +331,12 @@ func (s *SubscriptionsRepo) CancelOrRenew(
 		// on free plan
 		return nil
 	} else if count > 1 {
-		return errors.New("there should only ever be 1 active subscription for a profile")
+		return errors.New("there should only ever be 1 active subscription for a payer")
 	}
 
 	_, err = s.orm.MonthlySubscription.Update().
 		Where(
-			monthlysubscription.HasPayerWith(profile.IDEQ(profileID)),
+			paidBy,
 			monthlysubscription.IsActive(true),
 		).
 		SetNillableExpiredOn(cancelDate).
@@ -287,3 +374,13 @@ func (s *SubscriptionsRepo) UpdateToFree(
 		SetIsActive(false).
 		Exec(ctx)
 }
+
+// paidByProfile matches the subscriptions a profile pays for
+func paidByProfile(profileID int) predicate.MonthlySubscription {
+	return monthlysubscription.HasPayerWith(profile.IDEQ(profileID))
+}
+
+// paidByOrganization matches the subscriptions billed to an organization
+func paidByOrganization(organizationID int) predicate.MonthlySubscription {
+	return monthlysubscription.OrganizationID(organizationID)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/profile"
 	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/organizations"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
 	"github.com/mikestefanello/pagoda/pkg/tests"
@@ -358,3 +359,92 @@ func TestCancelOrRenew(t *testing.T) {
 	assert.Nil(t, subProfile2.ExpiredOn)
 	assert.Nil(t, subProfile2.CancelledAt)
 }
+
+func TestOrganizationSubscription(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	user1 := tests.CreateUser(ctx, client, "Jo Bandi", "jo@gmail.com", "password", true)
+	user2 := tests.CreateUser(ctx, client, "Joanne Bandi", "joane@gmail.com", "password", true)
+
+	profileRepo := profilerepo.NewProfileRepo(client, nil, nil)
+	owner, err := profileRepo.CreateProfile(ctx, user1, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	member, err := profileRepo.CreateProfile(ctx, user2, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+
+	organizationsRepo := organizations.NewOrganizationsRepo(client, 7)
+	org, err := organizationsRepo.CreateOrganization(ctx, owner.ID, "Bandi & Co")
+	assert.Nil(t, err)
+	err = client.OrganizationMembership.
+		Create().
+		SetOrganizationID(org.ID).
+		SetProfileID(member.ID).
+		Exec(ctx)
+	assert.Nil(t, err)
+
+	subscriptionsRepo := subscriptions.NewSubscriptionsRepo(client, 15, 3)
+
+	stripeCustomerID := "cus_org_1234"
+	err = subscriptionsRepo.StoreOrganizationStripeCustomerID(ctx, org.ID, stripeCustomerID)
+	assert.Nil(t, err)
+	orgID, err := subscriptionsRepo.GetOrganizationIDFromStripeCustomerID(ctx, stripeCustomerID)
+	assert.Nil(t, err)
+	assert.Equal(t, org.ID, orgID)
+
+	for i := 0; i < 3; i++ {
+		// For idempotency
+		err = subscriptionsRepo.UpdateOrganizationToPaidPro(ctx, org.ID)
+		assert.Nil(t, err)
+	}
+
+	prodName, expiredOn, isTrial, err := subscriptionsRepo.GetOrganizationActiveProduct(ctx, org.ID)
+	assert.Nil(t, err)
+	assert.False(t, isTrial)
+	assert.Nil(t, expiredOn)
+	assert.Equal(t, domain.ProductTypePro, *prodName)
+
+	sub, err := client.MonthlySubscription.
+		Query().
+		Where(monthlysubscription.OrganizationID(org.ID)).
+		WithBenefactors().
+		Only(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, sub.PayingProfileID)
+	assert.Len(t, sub.Edges.Benefactors, 2)
+
+	// Members joining and leaving the organization gain and lose the benefits of its subscription
+	user3 := tests.CreateUser(ctx, client, "James Bond", "james007@gmail.com", "password", true)
+	newcomer, err := profileRepo.CreateProfile(ctx, user3, "bio", time.Time{}, nil, nil)
+	assert.Nil(t, err)
+	ownerMembership, err := organizationsRepo.GetMembership(ctx, org.ID, owner.ID)
+	assert.Nil(t, err)
+	inv, err := organizationsRepo.CreateInvitation(ctx, ownerMembership, user3.Email, domain.OrganizationRoleMember)
+	assert.Nil(t, err)
+	_, err = organizationsRepo.AcceptInvitation(ctx, inv.Token, newcomer.ID, user3.Email)
+	assert.Nil(t, err)
+
+	err = organizationsRepo.RemoveMember(ctx, ownerMembership, member.ID)
+	assert.Nil(t, err)
+
+	benefactorIDs, err := client.MonthlySubscription.
+		QueryBenefactors(sub).
+		IDs(ctx)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int{owner.ID, newcomer.ID}, benefactorIDs)
+
+	// Members deleting their account leave the subscription to the others
+	err = profileRepo.DeleteUserData(ctx, newcomer.ID)
+	assert.Nil(t, err)
+	benefactorIDs, err = client.MonthlySubscription.
+		QueryBenefactors(sub).
+		IDs(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{owner.ID}, benefactorIDs)
+
+	err = subscriptionsRepo.CancelOrganizationWithGracePeriod(ctx, org.ID)
+	assert.Nil(t, err)
+	_, expiredOn, _, err = subscriptionsRepo.GetOrganizationActiveProduct(ctx, org.ID)
+	assert.Nil(t, err)
+	assert.NotNil(t, expiredOn)
+}
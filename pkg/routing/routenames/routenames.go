@@ -5,9 +5,24 @@ const (
 	RouteNameForgotPasswordSubmit    = "forgot_password.submit"
 	RouteNameLogin                   = "login"
 	RouteNameLoginSubmit             = "login.submit"
+	RouteNameLoginTwoFactor          = "login.two_factor"
+	RouteNameLoginTwoFactorSubmit    = "login.two_factor.submit"
+	RouteNameMagicLink               = "login.magic_link"
+	RouteNameMagicLinkSubmit         = "login.magic_link.submit"
+	RouteNameMagicLinkConfirm        = "login.magic_link.confirm"
+	RouteNameMagicLinkConsume        = "login.magic_link.consume"
+	RouteNamePhoneLogin              = "login.phone"
+	RouteNamePhoneLoginSubmit        = "login.phone.submit"
+	RouteNamePhoneLoginCodeSubmit    = "login.phone.code.submit"
+	RouteNamePasskeyLoginBegin       = "login.passkey.begin"
+	RouteNamePasskeyLoginFinish      = "login.passkey.finish"
+	RouteNameOAuthLogin              = "oauth.login"
+	RouteNameOAuthCallback           = "oauth.callback"
 	RouteNameLogout                  = "logout"
 	RouteNameRegister                = "register"
 	RouteNameRegisterSubmit          = "register.submit"
+	RouteNamePhoneRegister           = "register.phone"
+	RouteNamePhoneRegisterSubmit     = "register.phone.submit"
 	RouteNameResetPassword           = "reset_password"
 	RouteNameResetPasswordSubmit     = "reset_password.submit"
 	RouteNameVerifyEmail             = "verify_email"
@@ -24,8 +39,70 @@ const (
 	RouteNameSubmitPhoneVerification = "phone.verification.submit"
 	RouteNameDeleteAccountPage       = "delete_account.page"
 	RouteNameDeleteAccountRequest    = "delete_account.request"
+	RouteNameCancelAccountDeletion   = "delete_account.cancel"
+	RouteNameCancelDeletionSubmit    = "delete_account.cancel.submit"
+	RouteNameDataExportRequest       = "data_export.request"
+	RouteNameInvitations             = "invitations"
+	RouteNameInvitationCreate        = "invitations.create"
+	RouteNameInvitationRevoke        = "invitations.revoke"
+	RouteNameInvitationAccept        = "invitations.accept"
+	RouteNameInvitationAcceptSubmit  = "invitations.accept.submit"
+	RouteNameFriendRequests          = "friend_requests"
+	RouteNameFriendRequestSend       = "friend_requests.send"
+	RouteNameFriendRequestAccept     = "friend_requests.accept"
+	RouteNameFriendRequestDecline    = "friend_requests.decline"
+	RouteNameFriendRequestCancel     = "friend_requests.cancel"
+	RouteNameProfileBlock            = "profile.block"
+	RouteNameProfileUnblock          = "profile.unblock"
+	RouteNameReport                  = "reports.new"
+	RouteNameReportCreate            = "reports.create"
 	RouteNamePrivacyPolicy           = "privacy_policy"
 
+	RouteNameOrganizations                = "organizations"
+	RouteNameOrganizationCreate           = "organizations.create"
+	RouteNameOrganization                 = "organizations.show"
+	RouteNameOrganizationSwitch           = "organizations.switch"
+	RouteNameOrganizationInvite           = "organizations.invite"
+	RouteNameOrganizationInvitationRevoke = "organizations.invitations.revoke"
+	RouteNameOrganizationInvitationAccept = "organizations.invitations.accept"
+	RouteNameOrganizationMemberRole       = "organizations.members.role"
+	RouteNameOrganizationMemberRemove     = "organizations.members.remove"
+
+	RouteNameSMSStatusCallback = "sms.status_callback"
+	RouteNameSMSOutbox         = "dev.sms_outbox"
+
+	RouteNameTwoFactorSettings      = "two_factor.settings"
+	RouteNameTwoFactorEnroll        = "two_factor.enroll"
+	RouteNameTwoFactorConfirm       = "two_factor.confirm"
+	RouteNameTwoFactorDisable       = "two_factor.disable"
+	RouteNameTwoFactorRecoveryCodes = "two_factor.recovery_codes"
+
+	RouteNamePasskeys              = "passkeys"
+	RouteNamePasskeyRegisterBegin  = "passkeys.register.begin"
+	RouteNamePasskeyRegisterFinish = "passkeys.register.finish"
+	RouteNamePasskeyDelete         = "passkeys.delete"
+
+	RouteNameDevices           = "devices"
+	RouteNameDeviceSignOut     = "devices.sign_out"
+	RouteNameDevicesSignOutAll = "devices.sign_out_all"
+
+	RouteNameChangeEmail        = "change_email"
+	RouteNameChangeEmailSubmit  = "change_email.submit"
+	RouteNameChangeEmailConfirm = "change_email.confirm"
+
+	RouteNameAPITokens      = "api_tokens"
+	RouteNameAPITokenCreate = "api_tokens.create"
+	RouteNameAPITokenRevoke = "api_tokens.revoke"
+	RouteNameAPIMe          = "api.me"
+
+	RouteNameAdmin              = "admin"
+	RouteNameImpersonationStart = "admin.impersonation.start"
+	RouteNameModerationQueue    = "admin.moderation"
+	RouteNameModerationDismiss  = "admin.moderation.dismiss"
+	RouteNameModerationHide     = "admin.moderation.hide_photo"
+	RouteNameModerationSuspend  = "admin.moderation.suspend"
+	RouteNameImpersonationStop  = "impersonation.stop"
+
 	RouteNameHomeFeed           = "home_feed"
 	RouteNameGetHomeFeedButtons = "home_feed.buttons"
 	RouteNameGetHomeFeedStats   = "home_feed.stats"
@@ -0,0 +1,153 @@
+package routes
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+// adminImpersonationsShown is how many of the most recent impersonations the admin area lists
+const adminImpersonationsShown = 20
+
+type (
+	admin struct {
+		ctr controller.Controller
+	}
+)
+
+func NewAdminRoute(ctr controller.Controller) admin {
+	return admin{
+		ctr: ctr,
+	}
+}
+
+// Get shows the admin area, with the roles and who they were granted to, and the recent impersonations.
+func (c *admin) Get(ctx echo.Context) error {
+	roles, err := c.ctr.Container.Auth.GetRoles(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load roles")
+	}
+
+	impersonations, err := c.ctr.Container.Auth.GetImpersonations(ctx, adminImpersonationsShown)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load impersonations")
+	}
+
+	data := &types.AdminData{
+		Roles:          make([]types.AdminRole, len(roles)),
+		Impersonations: make([]types.AdminImpersonation, len(impersonations)),
+	}
+	for i, r := range roles {
+		data.Roles[i] = types.AdminRole{
+			Name:        r.Name,
+			Permissions: r.Permissions,
+			Members:     make([]types.AdminRoleMember, len(r.Edges.Users)),
+		}
+		for j, u := range r.Edges.Users {
+			data.Roles[i].Members[j] = types.AdminRoleMember{
+				ID:    u.ID,
+				Name:  u.Name,
+				Email: u.Email,
+			}
+		}
+	}
+	for i, imp := range impersonations {
+		data.Impersonations[i] = types.AdminImpersonation{
+			AdminName:  imp.Edges.Admin.Name,
+			AdminEmail: imp.Edges.Admin.Email,
+			UserName:   imp.Edges.User.Name,
+			UserEmail:  imp.Edges.User.Email,
+			Reason:     imp.Reason,
+			IPAddress:  imp.IPAddress,
+			StartedAt:  imp.CreatedAt,
+			EndedAt:    imp.EndedAt,
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageAdmin
+	page.Title = "Admin"
+	page.Data = data
+	page.Form = &types.ImpersonateForm{}
+	page.Component = pages.Admin(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.ImpersonateForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// StartImpersonating lets the admin act as the user of the submitted email address, to see the app as they do.
+func (c *admin) StartImpersonating(ctx echo.Context) error {
+	admin := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.ImpersonateForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse impersonation form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	target, err := c.ctr.Container.ORM.User.
+		Query().
+		Where(user.Email(strings.ToLower(form.Email))).
+		Only(ctx.Request().Context())
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		form.Submission.SetFieldError("Email", "No user has this email address.")
+		return c.Get(ctx)
+	default:
+		return c.ctr.Fail(err, "unable to query user")
+	}
+
+	_, err = c.ctr.Container.Auth.StartImpersonation(ctx, admin, target.ID, form.Reason)
+	switch e := err.(type) {
+	case nil:
+	case services.ImpersonationNotAllowedError:
+		form.Submission.SetFieldError("Email", "This user cannot be impersonated: "+e.Reason+".")
+		return c.Get(ctx)
+	default:
+		return c.ctr.Fail(err, "unable to start impersonation")
+	}
+
+	msg.Info(ctx, "You are now acting as "+target.Name+". Payments and account changes are disabled until you stop.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
+}
+
+// StopImpersonating switches the admin back to themselves. It is reachable while acting as any user.
+func (c *admin) StopImpersonating(ctx echo.Context) error {
+	_, err := c.ctr.Container.Auth.StopImpersonation(ctx)
+	switch err.(type) {
+	case nil:
+		msg.Success(ctx, "You stopped impersonating the user.")
+	case services.NotImpersonatingError:
+		return c.ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
+	default:
+		return c.ctr.Fail(err, "unable to stop impersonation")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameAdmin)
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/types"
+)
+
+type (
+	api struct {
+		ctr controller.Controller
+	}
+)
+
+func NewAPIRoute(ctr controller.Controller) api {
+	return api{
+		ctr: ctr,
+	}
+}
+
+// Me returns the authenticated user, it lets API clients check their token works.
+func (c *api) Me(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	me := types.APIMe{
+		ID:    usr.ID,
+		Name:  usr.Name,
+		Email: usr.Email,
+		Roles: make([]string, len(usr.Edges.Roles)),
+	}
+	for i, r := range usr.Edges.Roles {
+		me.Roles[i] = r.Name
+	}
+
+	return c.ctr.RenderJSON(ctx, me)
+}
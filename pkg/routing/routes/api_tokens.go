@@ -0,0 +1,166 @@
+package routes
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+// apiTokenExpirations are the lifetimes a personal access token can be created with, an empty one never expires
+var apiTokenExpirations = []struct {
+	types.APITokenExpiration
+	duration time.Duration
+}{
+	{types.APITokenExpiration{Value: "30d", Label: "30 days"}, 30 * 24 * time.Hour},
+	{types.APITokenExpiration{Value: "90d", Label: "90 days"}, 90 * 24 * time.Hour},
+	{types.APITokenExpiration{Value: "1y", Label: "1 year"}, 365 * 24 * time.Hour},
+	{types.APITokenExpiration{Value: "never", Label: "Never"}, 0},
+}
+
+type (
+	apiTokens struct {
+		ctr controller.Controller
+	}
+)
+
+func NewAPITokensRoute(ctr controller.Controller) apiTokens {
+	return apiTokens{
+		ctr: ctr,
+	}
+}
+
+// Get lists the personal access tokens of the user, with a form to create one.
+func (c *apiTokens) Get(ctx echo.Context) error {
+	return c.render(ctx, "")
+}
+
+// Create creates a personal access token and shows it to the user, which is the only time it can be seen.
+func (c *apiTokens) Create(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.APITokenForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse API token form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	for _, scope := range form.Scopes {
+		if !slices.Contains(services.APITokenScopes, scope) {
+			form.Submission.SetFieldError("Scopes", "Unknown scope.")
+		}
+	}
+
+	var expiresAt *time.Time
+	validExpiration := false
+	for _, e := range apiTokenExpirations {
+		if e.Value == form.ExpiresIn {
+			validExpiration = true
+			if e.duration > 0 {
+				t := time.Now().Add(e.duration)
+				expiresAt = &t
+			}
+		}
+	}
+	if !validExpiration {
+		form.Submission.SetFieldError("ExpiresIn", "Pick when the token expires.")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	token, _, err := c.ctr.Container.Auth.CreateAPIToken(ctx.Request().Context(), usr.ID, form.Name, form.Scopes, expiresAt)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to create API token")
+	}
+
+	ctx.Set(context.FormKey, nil)
+	msg.Success(ctx, "Your token was created. Copy it now, you won't be able to see it again.")
+	return c.render(ctx, token)
+}
+
+// Revoke deletes a personal access token, after which requests made with it are rejected.
+func (c *apiTokens) Revoke(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	tokenID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid token ID")
+	}
+
+	err = c.ctr.Container.Auth.RevokeAPIToken(ctx.Request().Context(), usr.ID, tokenID)
+	switch err.(type) {
+	case nil:
+		msg.Success(ctx, "The token was revoked.")
+	case *ent.NotFoundError:
+		msg.Warning(ctx, "That token was already revoked.")
+	default:
+		return c.ctr.Fail(err, "unable to revoke API token")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameAPITokens)
+}
+
+func (c *apiTokens) render(ctx echo.Context, newToken string) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	tokens, err := c.ctr.Container.Auth.GetAPITokens(ctx.Request().Context(), usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load API tokens")
+	}
+
+	data := &types.APITokensData{
+		Tokens:      make([]types.APIToken, len(tokens)),
+		Scopes:      services.APITokenScopes,
+		Expirations: make([]types.APITokenExpiration, len(apiTokenExpirations)),
+		NewToken:    newToken,
+	}
+	for i, t := range tokens {
+		data.Tokens[i] = types.APIToken{
+			ID:         t.ID,
+			Name:       t.Name,
+			Hint:       t.Hint,
+			Scopes:     t.Scopes,
+			CreatedAt:  t.CreatedAt,
+			LastUsedAt: t.LastUsedAt,
+			ExpiresAt:  t.ExpiresAt,
+			Expired:    t.ExpiresAt != nil && t.ExpiresAt.Before(time.Now()),
+		}
+	}
+	for i, e := range apiTokenExpirations {
+		data.Expirations[i] = e.APITokenExpiration
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageAPITokens
+	page.Title = "API tokens"
+	page.Data = data
+	page.Form = &types.APITokenForm{ExpiresIn: apiTokenExpirations[0].Value}
+	page.Component = pages.APITokens(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.APITokenForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/rs/zerolog/log"
+)
+
+// Names of the forms protected against bots, which their challenges are bound to
+const (
+	botFormRegister       = "register"
+	botFormEmailSubscribe = "email_subscribe"
+)
+
+// addBotChallenge embeds a bot challenge for the named form in the page
+func addBotChallenge(ctr controller.Controller, page *controller.Page, form string) error {
+	challenge, err := ctr.Container.BotDefense.NewChallenge(form)
+	if err != nil {
+		return err
+	}
+	page.BotChallenge = challenge
+	return nil
+}
+
+// applyBotDefense checks a submission of the named form was made by a person, setting an error on the
+// "BotDefense" field of the submission if it does not look so. An error is only returned when the check
+// could not be made.
+func applyBotDefense(
+	ctx echo.Context, ctr controller.Controller, submission *controller.FormSubmission, form string,
+	fields types.BotDefenseFields,
+) error {
+	captchaResponse := fields.HCaptchaResponse
+	if captchaResponse == "" {
+		captchaResponse = fields.TurnstileResponse
+	}
+
+	err := ctr.Container.BotDefense.Verify(ctx.Request().Context(), services.BotSubmission{
+		Form:            form,
+		Honeypot:        fields.Website,
+		Token:           fields.Token,
+		Nonce:           fields.Nonce,
+		CaptchaResponse: captchaResponse,
+		RemoteIP:        ctx.RealIP(),
+	})
+
+	var botErr services.BotCheckError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &botErr):
+		log.Info().
+			Str("form", form).
+			Str("check", botErr.Check).
+			Str("ip", ctx.RealIP()).
+			Msg("form submission failed a bot check")
+		submission.SetFieldError("BotDefense", botErr.Message)
+		return nil
+	default:
+		return err
+	}
+}
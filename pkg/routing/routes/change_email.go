@@ -0,0 +1,193 @@
+package routes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/emails"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/mileusna/useragent"
+)
+
+type (
+	changeEmail struct {
+		ctr controller.Controller
+	}
+)
+
+func NewChangeEmailRoute(ctr controller.Controller) changeEmail {
+	return changeEmail{
+		ctr: ctr,
+	}
+}
+
+// Get renders the form to change the email address of the user.
+func (c *changeEmail) Get(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageChangeEmail
+	page.Title = "Change your email"
+	page.Data = &types.ChangeEmailData{CurrentEmail: usr.Email}
+	page.Form = &types.ChangeEmailForm{}
+	page.Component = pages.ChangeEmail(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.ChangeEmailForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Post sends a link to confirm the new address to it, and lets the current address know about the
+// request. Nothing changes until the link is followed.
+func (c *changeEmail) Post(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.ChangeEmailForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse change email form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	// The password is checked like a sign-in, so failures count towards the lockout of the account
+	keys := []services.ThrottleKey{
+		services.ThrottleKeyEmail(usr.Email),
+		services.ThrottleKeyIP(ctx.RealIP()),
+	}
+	if err := c.ctr.Container.Throttler.Check(ctx.Request().Context(), keys...); err != nil {
+		if !showThrottledMessage(ctx, err) {
+			return c.ctr.Fail(err, "unable to check change email throttle")
+		}
+		return c.Get(ctx)
+	}
+
+	if err := c.ctr.Container.Auth.CheckPassword(form.Password, usr.Password); err != nil {
+		if err := recordFailedAttempt(c.ctr, ctx, usr, services.AuditActionReauthenticationFailed, keys...); err != nil {
+			return c.ctr.Fail(err, "unable to record failed change email attempt")
+		}
+		form.Submission.SetFieldError("Password", "The password is incorrect.")
+		return c.Get(ctx)
+	}
+
+	newEmail := strings.ToLower(form.NewEmail)
+	if newEmail == usr.Email {
+		form.Submission.SetFieldError("NewEmail", "This is already your email address.")
+		return c.Get(ctx)
+	}
+
+	taken, err := c.ctr.Container.Auth.IsEmailTaken(ctx.Request().Context(), usr.ID, newEmail)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to check if email is taken")
+	}
+	if taken {
+		form.Submission.SetFieldError("NewEmail", "This email address is already used by another account.")
+		return c.Get(ctx)
+	}
+
+	token, err := c.ctr.Container.Auth.GenerateChangeEmailToken(usr.ID, usr.Email, newEmail)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to generate change email token")
+	}
+
+	if err := c.sendConfirmEmail(ctx, usr, newEmail, token); err != nil {
+		return c.ctr.Fail(err, "unable to send change email confirmation")
+	}
+
+	if err := c.sendNoticeEmail(ctx, usr, newEmail); err != nil {
+		ctx.Logger().Errorf("unable to send change email notice to the old address: %v", err)
+	}
+
+	ctx.Set(context.FormKey, nil)
+	msg.Info(ctx, fmt.Sprintf("We sent a link to %s. Follow it to confirm your new email address.", newEmail))
+	return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+}
+
+// Confirm applies the change of email address once the link sent to the new address is followed.
+func (c *changeEmail) Confirm(ctx echo.Context) error {
+	usr, _, err := c.ctr.Container.Auth.ChangeEmail(ctx.Request().Context(), ctx.Param("token"))
+	switch err.(type) {
+	case nil:
+		msg.Success(ctx, fmt.Sprintf("Your email address was changed to %s.", usr.Email))
+	case services.InvalidChangeEmailTokenError:
+		msg.Warning(ctx, "The link is either invalid or has expired.")
+	case services.EmailTakenError:
+		msg.Warning(ctx, "This email address is now used by another account.")
+	default:
+		return c.ctr.Fail(err, "unable to change email")
+	}
+
+	if ctx.Get(context.AuthenticatedUserKey) != nil {
+		return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+}
+
+func (c *changeEmail) sendConfirmEmail(ctx echo.Context, usr *ent.User, newEmail, token string) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Data = types.EmailChangeEmailConfirmData{
+		AppName:      string(c.ctr.Container.Config.App.Name),
+		SupportEmail: c.ctr.Container.Config.Mail.FromAddress,
+		Domain:       c.ctr.Container.Config.HTTP.Domain,
+		ProfileName:  usr.Name,
+		NewEmail:     newEmail,
+		ConfirmLink:  fmt.Sprintf("%s%s", c.ctr.Container.Config.HTTP.Domain, ctx.Echo().Reverse(routeNames.RouteNameChangeEmailConfirm, token)),
+		ExpiresIn:    formatWait(c.ctr.Container.Config.App.EmailVerificationTokenExpiration),
+	}
+
+	return c.ctr.Container.Mail.
+		Compose().
+		To(newEmail).
+		Subject("Confirm your new email address").
+		TemplateLayout(layouts.Email).
+		Component(emails.ChangeEmailConfirm(&page)).
+		Send(ctx.Request().Context())
+}
+
+func (c *changeEmail) sendNoticeEmail(ctx echo.Context, usr *ent.User, newEmail string) error {
+	ua := useragent.Parse(ctx.Request().UserAgent())
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Data = types.EmailChangeEmailNoticeData{
+		AppName:           string(c.ctr.Container.Config.App.Name),
+		SupportEmail:      c.ctr.Container.Config.Mail.FromAddress,
+		Domain:            c.ctr.Container.Config.HTTP.Domain,
+		ProfileName:       usr.Name,
+		NewEmail:          newEmail,
+		PasswordResetLink: fmt.Sprintf("%s%s", c.ctr.Container.Config.HTTP.Domain, ctx.Echo().Reverse(routeNames.RouteNameForgotPassword)),
+		IPAddress:         ctx.RealIP(),
+		OperatingSystem:   ua.OS,
+		BrowserName:       ua.Name,
+	}
+
+	return c.ctr.Container.Mail.
+		Compose().
+		To(usr.Email).
+		Subject("A change of email address was requested").
+		TemplateLayout(layouts.Email).
+		Component(emails.ChangeEmailNotice(&page)).
+		Send(ctx.Request().Context())
+}
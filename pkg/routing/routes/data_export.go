@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tasks"
+)
+
+type (
+	dataExport struct {
+		ctr        controller.Controller
+		taskRunner *services.TaskClient
+	}
+)
+
+func NewDataExportRoute(ctr controller.Controller, taskRunner *services.TaskClient) dataExport {
+	return dataExport{
+		ctr:        ctr,
+		taskRunner: taskRunner,
+	}
+}
+
+// Request queues the export of the personal data of the user, who gets emailed a link to download it
+// once it is ready.
+func (c *dataExport) Request(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if c.taskRunner == nil {
+		ctx.Logger().Warnf("no task client, unable to export the data of user %d", usr.ID)
+		msg.Danger(ctx, "Your data cannot be exported right now, please try again later.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+	}
+
+	err := c.taskRunner.
+		New(tasks.TypeExportUserData).
+		Payload(tasks.ExportUserDataPayload{UserID: usr.ID}).
+		Save()
+	if err != nil {
+		return c.ctr.Fail(err, "unable to queue data export")
+	}
+
+	msg.Success(ctx, "We are preparing a copy of your data. We will email you a link to download it once it is ready.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+}
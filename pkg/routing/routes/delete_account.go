@@ -1,6 +1,9 @@
 package routes
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/pkg/context"
@@ -8,11 +11,13 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/mikestefanello/pagoda/pkg/repos/msg"
 	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tasks"
 
-	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
 	"github.com/mikestefanello/pagoda/pkg/types"
 	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/emails"
 	"github.com/mikestefanello/pagoda/templates/layouts"
 	"github.com/mikestefanello/pagoda/templates/pages"
 )
@@ -20,21 +25,21 @@ import (
 type (
 	deleteAccount struct {
 		ctr               controller.Controller
-		profileRepo       *profilerepo.ProfileRepo
 		subscriptionsRepo *subscriptions.SubscriptionsRepo
+		taskRunner        *services.TaskClient
 	}
 )
 
 func NewDeleteAccountRoute(
 	ctr controller.Controller,
-	profileRepo *profilerepo.ProfileRepo,
 	subscriptionsRepo *subscriptions.SubscriptionsRepo,
+	taskRunner *services.TaskClient,
 ) deleteAccount {
 
 	return deleteAccount{
 		ctr:               ctr,
-		profileRepo:       profileRepo,
 		subscriptionsRepo: subscriptionsRepo,
+		taskRunner:        taskRunner,
 	}
 }
 
@@ -59,25 +64,106 @@ func (c *deleteAccount) DeleteAccountPage(ctx echo.Context) error {
 	page.Data = &types.DeleteAccountData{
 		IsPaymentsEnabled:          c.ctr.Container.Config.App.OperationalConstants.PaymentsEnabled,
 		HasUncancelledSubscription: uncancelledSubscription,
+		GracePeriodInDays:          c.ctr.Container.Config.App.OperationalConstants.AccountDeletionGracePeriodInDays,
 	}
 	page.HTMX.Request.Boosted = true
 
 	return c.ctr.RenderPage(ctx, page)
 }
 
+// DeleteAccountRequest locks the account and schedules its deletion once the grace period is over.
+// The user is emailed a link to cancel the deletion in the meantime.
 func (c *deleteAccount) DeleteAccountRequest(ctx echo.Context) error {
 	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
-	profileId := usr.QueryProfile().FirstX(ctx.Request().Context()).ID
 
-	err := c.profileRepo.DeleteUserData(ctx.Request().Context(), profileId)
+	gracePeriod := time.Duration(c.ctr.Container.Config.App.OperationalConstants.AccountDeletionGracePeriodInDays) * 24 * time.Hour
+	scheduledAt, token, err := c.ctr.Container.Auth.ScheduleAccountDeletion(ctx, usr.ID, gracePeriod)
 	if err != nil {
-		return err
+		return c.ctr.Fail(err, "unable to schedule account deletion")
 	}
 
-	if err := c.ctr.Container.Auth.Logout(ctx); err == nil {
-
+	if c.taskRunner != nil {
+		err = c.taskRunner.
+			New(tasks.TypeDeleteAccount).
+			Payload(tasks.DeleteAccountPayload{UserID: usr.ID}).
+			At(scheduledAt).
+			Save()
+		if err != nil {
+			return c.ctr.Fail(err, "unable to queue account deletion")
+		}
 	} else {
-		msg.Danger(ctx, "An error occurred. Please try again.")
+		ctx.Logger().Warnf("no task client, account %d is only deleted by the next deletion of due accounts", usr.ID)
+	}
+
+	if err := c.sendScheduledEmail(ctx, usr, scheduledAt, token); err != nil {
+		ctx.Logger().Errorf("unable to send account deletion email: %v", err)
+	}
+
+	if err := c.ctr.Container.Auth.Logout(ctx); err != nil {
+		return c.ctr.Fail(err, "unable to log out user")
 	}
+
+	msg.Info(ctx, fmt.Sprintf(
+		"Your account will be deleted on %s. We emailed you a link to cancel the deletion if you change your mind.",
+		scheduledAt.Format("January 2, 2006"),
+	))
 	return c.ctr.Redirect(ctx, routeNames.RouteNameLandingPage)
 }
+
+// CancelDeletionConfirm is where the emailed link points to. It does not cancel the deletion, it only renders
+// a button which does, since email clients and scanners commonly prefetch links.
+func (c *deleteAccount) CancelDeletionConfirm(ctx echo.Context) error {
+	// Links signed with a key which was rotated out since are submitted signed with the active key
+	token, err := c.ctr.Container.Keyring.ResignJWT(ctx.Param("token"))
+	if err != nil {
+		msg.Warning(ctx, "The link is either invalid or has expired.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PageCancelAccountDeletion
+	page.Title = "Cancel account deletion"
+	page.Data = &types.CancelAccountDeletionData{
+		Token: token,
+	}
+	page.Component = pages.CancelAccountDeletion(&page)
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// CancelDeletion unlocks an account scheduled for deletion, once its owner confirms it from the emailed link.
+func (c *deleteAccount) CancelDeletion(ctx echo.Context) error {
+	_, err := c.ctr.Container.Auth.CancelAccountDeletion(ctx.Request().Context(), ctx.Param("token"))
+	switch err.(type) {
+	case nil:
+		msg.Success(ctx, "Your account will not be deleted. You can sign in again.")
+	case services.InvalidCancelAccountDeletionTokenError:
+		msg.Warning(ctx, "The link is either invalid or has expired.")
+	default:
+		return c.ctr.Fail(err, "unable to cancel account deletion")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+}
+
+func (c *deleteAccount) sendScheduledEmail(ctx echo.Context, usr *ent.User, scheduledAt time.Time, token string) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Data = types.EmailAccountDeletionScheduledData{
+		AppName:      string(c.ctr.Container.Config.App.Name),
+		SupportEmail: c.ctr.Container.Config.Mail.FromAddress,
+		Domain:       c.ctr.Container.Config.HTTP.Domain,
+		ProfileName:  usr.Name,
+		DeletionDate: scheduledAt.Format("January 2, 2006"),
+		CancelLink:   fmt.Sprintf("%s%s", c.ctr.Container.Config.HTTP.Domain, ctx.Echo().Reverse(routeNames.RouteNameCancelAccountDeletion, token)),
+	}
+
+	return c.ctr.Container.Mail.
+		Compose().
+		To(usr.Email).
+		Subject("Your account will be deleted").
+		TemplateLayout(layouts.Email).
+		Component(emails.AccountDeletionScheduled(&page)).
+		Send(ctx.Request().Context())
+}
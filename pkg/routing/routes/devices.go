@@ -0,0 +1,119 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+type (
+	devices struct {
+		ctr controller.Controller
+	}
+)
+
+func NewDevicesRoute(ctr controller.Controller) devices {
+	return devices{
+		ctr: ctr,
+	}
+}
+
+// Get lists the devices the user is logged in on.
+func (c *devices) Get(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	sessions, err := c.ctr.Container.Auth.GetUserSessions(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load sessions")
+	}
+
+	currentID, err := c.ctr.Container.Auth.GetCurrentUserSessionID(ctx)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load current session")
+	}
+
+	data := &types.DevicesData{
+		Devices: make([]types.Device, len(sessions)),
+	}
+	for i, s := range sessions {
+		data.Devices[i] = types.Device{
+			ID:         s.ID,
+			Browser:    s.Browser,
+			OS:         s.Os,
+			Device:     s.Device,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			Current:    s.ID == currentID,
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageDevices
+	page.Title = "Your devices"
+	page.Data = data
+	page.Component = pages.Devices(&page)
+	page.HTMX.Request.Boosted = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// SignOut revokes the session of one device. Signing out the current device logs the user out.
+func (c *devices) SignOut(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	sessionID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid device ID")
+	}
+
+	currentID, err := c.ctr.Container.Auth.GetCurrentUserSessionID(ctx)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load current session")
+	}
+	if sessionID == currentID {
+		if err := c.ctr.Container.Auth.Logout(ctx); err != nil {
+			return c.ctr.Fail(err, "unable to log out")
+		}
+		msg.Success(ctx, "You were signed out of this device.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	}
+
+	err = c.ctr.Container.Auth.RevokeUserSession(ctx, usr.ID, sessionID)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return echo.NewHTTPError(http.StatusNotFound, "device not found")
+	default:
+		return c.ctr.Fail(err, "unable to revoke session")
+	}
+
+	msg.Success(ctx, "The device was signed out.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNameDevices)
+}
+
+// SignOutAll revokes every session of the user, including the current one.
+func (c *devices) SignOutAll(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := c.ctr.Container.Auth.RevokeAllUserSessions(ctx, usr.ID); err != nil {
+		return c.ctr.Fail(err, "unable to revoke sessions")
+	}
+	if err := c.ctr.Container.Auth.Logout(ctx); err != nil {
+		return c.ctr.Fail(err, "unable to log out")
+	}
+
+	msg.Success(ctx, "You were signed out of all your devices.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+}
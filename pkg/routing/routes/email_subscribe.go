@@ -40,7 +40,7 @@ func (c *emailSubscribe) Get(ctx echo.Context) error {
 	page.Name = templates.PageEmailSubscribe
 	page.Layout = layouts.Main
 	page.Component = pages.EmailSubscribe(&page)
-	page.Form = types.EmailSubscriptionForm{}
+	page.Form = &types.EmailSubscriptionForm{}
 	page.Data = types.EmailSubscriptionData{
 		Description: "Sign up to get our app release announcement.",
 		Placeholder: "Enter email",
@@ -52,6 +52,10 @@ func (c *emailSubscribe) Get(ctx echo.Context) error {
 	}
 	page.Cache.Enabled = false
 
+	if err := addBotChallenge(c.ctr, &page, botFormEmailSubscribe); err != nil {
+		return c.ctr.Fail(err, "unable to create bot challenge")
+	}
+
 	return c.ctr.RenderPage(ctx, page)
 }
 
@@ -68,6 +72,10 @@ func (c *emailSubscribe) Post(ctx echo.Context) error {
 		return c.ctr.Fail(err, "unable to process form submission")
 	}
 
+	if err := applyBotDefense(ctx, c.ctr, &form.Submission, botFormEmailSubscribe, form.BotDefense); err != nil {
+		return c.ctr.Fail(err, "unable to check for bots")
+	}
+
 	if form.Submission.HasErrors() {
 		return c.Get(ctx)
 	}
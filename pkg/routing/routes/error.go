@@ -1,11 +1,17 @@
 package routes
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/mikestefanello/pagoda/pkg/context"
 	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/htmx"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
 	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/components"
 	"github.com/mikestefanello/pagoda/templates/layouts"
 	"github.com/mikestefanello/pagoda/templates/pages"
 
@@ -48,11 +54,29 @@ func (e *errorHandler) Get(err error, ctx echo.Context) {
 
 	page.Component = pages.Error(&page)
 
+	// Rate limited HTMX requests keep the current page and only show a message,
+	// rather than replacing the whole body with the error page
+	if code == http.StatusTooManyRequests && htmx.GetRequest(ctx).Enabled {
+		page.HTMX.Request.Enabled = true
+		page.HTMX.Request.Boosted = false
+		page.HTMX.Response = &htmx.Response{Reswap: "none"}
+		page.Component = components.ToastMessage("warning", rateLimitedMessage(ctx))
+	}
+
 	if err = e.ctr.RenderPage(ctx, page); err != nil {
 		ctx.Logger().Error(err)
 	}
 }
 
+// rateLimitedMessage tells the user how long to wait, using the Retry-After header set by the rate limiter
+func rateLimitedMessage(ctx echo.Context) string {
+	seconds, err := strconv.Atoi(ctx.Response().Header().Get(middleware.HeaderRetryAfter))
+	if err != nil || seconds <= 0 {
+		return "You're going a little too fast. Please try again in a moment."
+	}
+	return fmt.Sprintf("You're going a little too fast. Please try again in %s.", formatWait(time.Duration(seconds)*time.Second))
+}
+
 func (e *errorHandler) GetHttp400BadRequest(ctx echo.Context) error {
 	e.Get(echo.NewHTTPError(http.StatusBadRequest, "Bad Request"), ctx)
 	return nil
@@ -0,0 +1,277 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/friendrequest"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/components"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
+)
+
+type (
+	friendRequestsRoute struct {
+		ctr         controller.Controller
+		profileRepo *profilerepo.ProfileRepo
+	}
+)
+
+func NewFriendRequestsRoute(ctr controller.Controller, profileRepo *profilerepo.ProfileRepo) friendRequestsRoute {
+	return friendRequestsRoute{
+		ctr:         ctr,
+		profileRepo: profileRepo,
+	}
+}
+
+// Get lists the pending friend requests the user received and sent.
+func (c *friendRequestsRoute) Get(ctx echo.Context) error {
+	selfProfileID, err := c.selfProfileID(ctx)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	received, sent, err := c.profileRepo.GetPendingFriendRequests(ctx.Request().Context(), selfProfileID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load friend requests")
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageFriendRequests
+	page.Title = "Friend requests"
+	page.Data = &types.FriendRequestsData{
+		Received: received,
+		Sent:     sent,
+	}
+	page.Component = pages.FriendRequests(&page)
+	page.HTMX.Request.Boosted = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Send requests the friendship of a profile. If that profile already requested the friendship of the
+// user, both become friends right away.
+func (c *friendRequestsRoute) Send(ctx echo.Context) error {
+	return c.act(ctx, func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error {
+		fr, err := c.profileRepo.SendFriendRequest(ctx.Request().Context(), selfProfileID, friendship.ProfileID)
+		switch err {
+		case nil:
+		case profilerepo.ErrContactRequestAlreadyExists, profilerepo.ErrAlreadyFriends, profilerepo.ErrProfileBlocked:
+			return nil
+		default:
+			return err
+		}
+
+		if fr.Status == friendrequest.StatusAccepted {
+			c.notifyAccepted(ctx, usr, selfProfileID, fr)
+			return nil
+		}
+		notifyProfile(ctx, c.ctr, domain.Notification{
+			Type:                    domain.NotificationTypeFriendRequestReceived,
+			ProfileID:               friendship.ProfileID,
+			Title:                   "New friend request",
+			Text:                    fmt.Sprintf("👋 %s wants to be your friend.", usr.Name),
+			Link:                    ctx.Echo().Reverse(routeNames.RouteNameFriendRequests),
+			ProfileIDWhoCausedNotif: selfProfileID,
+			ResourceIDTiedToNotif:   fr.ID,
+		})
+		return nil
+	})
+}
+
+// Accept accepts the pending friend request the user received from a profile.
+func (c *friendRequestsRoute) Accept(ctx echo.Context) error {
+	return c.act(ctx, func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error {
+		if friendship.Status != domain.FriendshipStatusRequestReceived {
+			return nil
+		}
+
+		fr, err := c.profileRepo.AcceptFriendRequest(ctx.Request().Context(), selfProfileID, friendship.RequestID)
+		switch err {
+		case nil:
+			c.notifyAccepted(ctx, usr, selfProfileID, fr)
+			return nil
+		case profilerepo.ErrFriendRequestNotFound:
+			return nil
+		default:
+			return err
+		}
+	})
+}
+
+// Decline declines the pending friend request the user received from a profile.
+func (c *friendRequestsRoute) Decline(ctx echo.Context) error {
+	return c.act(ctx, func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error {
+		if friendship.Status != domain.FriendshipStatusRequestReceived {
+			return nil
+		}
+
+		fr, err := c.profileRepo.DeclineFriendRequest(ctx.Request().Context(), selfProfileID, friendship.RequestID)
+		switch err {
+		case nil:
+		case profilerepo.ErrFriendRequestNotFound:
+			return nil
+		default:
+			return err
+		}
+
+		notifyProfile(ctx, c.ctr, domain.Notification{
+			Type:                    domain.NotificationTypeFriendRequestDeclined,
+			ProfileID:               fr.Edges.Requester.ID,
+			Title:                   "Friend request declined",
+			Text:                    fmt.Sprintf("%s declined your friend request.", usr.Name),
+			ProfileIDWhoCausedNotif: selfProfileID,
+			ResourceIDTiedToNotif:   fr.ID,
+		})
+		return nil
+	})
+}
+
+// Cancel withdraws the pending friend request the user sent to a profile.
+func (c *friendRequestsRoute) Cancel(ctx echo.Context) error {
+	return c.act(ctx, func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error {
+		if friendship.Status != domain.FriendshipStatusRequestSent {
+			return nil
+		}
+
+		fr, err := c.profileRepo.CancelFriendRequest(ctx.Request().Context(), selfProfileID, friendship.RequestID)
+		switch err {
+		case nil:
+		case profilerepo.ErrFriendRequestNotFound:
+			return nil
+		default:
+			return err
+		}
+
+		notifyProfile(ctx, c.ctr, domain.Notification{
+			Type:                    domain.NotificationTypeFriendRequestCancelled,
+			ProfileID:               fr.Edges.Recipient.ID,
+			Title:                   "Friend request withdrawn",
+			Text:                    fmt.Sprintf("%s withdrew their friend request.", usr.Name),
+			ProfileIDWhoCausedNotif: selfProfileID,
+			ResourceIDTiedToNotif:   fr.ID,
+		})
+		return nil
+	})
+}
+
+// Block blocks a profile, ending any friendship or pending request with it. The blocked profile is not
+// notified.
+func (c *friendRequestsRoute) Block(ctx echo.Context) error {
+	return c.act(ctx, func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error {
+		return c.profileRepo.BlockProfile(ctx.Request().Context(), selfProfileID, friendship.ProfileID)
+	})
+}
+
+// Unblock unblocks a profile, which can request the friendship of the user again.
+func (c *friendRequestsRoute) Unblock(ctx echo.Context) error {
+	return c.act(ctx, func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error {
+		if friendship.Status != domain.FriendshipStatusBlocked {
+			return nil
+		}
+		return c.profileRepo.UnblockProfile(ctx.Request().Context(), selfProfileID, friendship.ProfileID)
+	})
+}
+
+// act runs an action on the friendship between the user and the profile of the request, then shows where
+// the friendship now stands. HTMX requests get the updated friendship actions, the others are redirected
+// to the friend requests.
+func (c *friendRequestsRoute) act(
+	ctx echo.Context, action func(usr *ent.User, selfProfileID int, friendship *domain.Friendship) error,
+) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	otherProfileID, err := strconv.Atoi(ctx.Param(PROFILE_ID_QUERY_PARAM))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid profile ID")
+	}
+
+	selfProfileID, err := c.selfProfileID(ctx)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+	if selfProfileID == otherProfileID {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid profile ID")
+	}
+
+	friendship, err := c.profileRepo.GetFriendship(ctx.Request().Context(), selfProfileID, otherProfileID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load friendship")
+	}
+	// Whom blocked the user is not to know whether their profile exists
+	if friendship.Status == domain.FriendshipStatusBlockedBy {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	if err := action(usr, selfProfileID, friendship); err != nil {
+		return c.ctr.Fail(err, "unable to update friendship")
+	}
+
+	friendship, err = c.profileRepo.GetFriendship(ctx.Request().Context(), selfProfileID, otherProfileID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load friendship")
+	}
+
+	page := controller.NewPage(ctx)
+	if !page.HTMX.Request.Enabled {
+		msg.Success(ctx, "Your friends were updated.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameFriendRequests)
+	}
+
+	page.Name = templates.PageFriendRequests
+	page.Component = components.FriendshipActions(&page, *friendship)
+	return c.ctr.RenderPage(ctx, page)
+}
+
+func (c *friendRequestsRoute) notifyAccepted(ctx echo.Context, usr *ent.User, selfProfileID int, fr *ent.FriendRequest) {
+	notifyProfile(ctx, c.ctr, domain.Notification{
+		Type:                    domain.NotificationTypeFriendRequestAccepted,
+		ProfileID:               fr.Edges.Requester.ID,
+		Title:                   "Friend request accepted",
+		Text:                    fmt.Sprintf("🎉 %s accepted your friend request, you are now friends!", usr.Name),
+		Link:                    profileLink(ctx, selfProfileID),
+		ProfileIDWhoCausedNotif: selfProfileID,
+		ResourceIDTiedToNotif:   fr.ID,
+	})
+}
+
+func (c *friendRequestsRoute) selfProfileID(ctx echo.Context) (int, error) {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	return usr.QueryProfile().OnlyID(ctx.Request().Context())
+}
+
+// notifyProfile publishes a notification, logging rather than failing when it cannot be
+func notifyProfile(ctx echo.Context, ctr controller.Controller, notification domain.Notification) {
+	if ctr.Container.Notifier == nil {
+		ctx.Logger().Warnf(
+			"no notifier, profile %d is not sent the %s notification", notification.ProfileID, notification.Type.Value,
+		)
+		return
+	}
+
+	err := ctr.Container.Notifier.PublishNotification(ctx.Request().Context(), notification, true, true)
+	if err != nil {
+		log.Error().Err(err).
+			Int("selfProfileID", notification.ProfileID).
+			Str("type", notification.Type.Value).
+			Msg("failed to create notification")
+	}
+}
+
+// profileLink returns the link to the profile page of a profile
+func profileLink(ctx echo.Context, profileID int) string {
+	return fmt.Sprintf("%s?%s=%d", ctx.Echo().Reverse(routeNames.RouteNameProfile), PROFILE_ID_QUERY_PARAM, profileID)
+}
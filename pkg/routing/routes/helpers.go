@@ -1,6 +1,11 @@
 package routes
 
-import "net/url"
+import (
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
 
 // AddQueryParam takes a URL, key, and value and returns the URL with the added query parameter.
 func AddQueryParam(urlStr, key, value string) (string, error) {
@@ -22,3 +27,15 @@ func AddQueryParam(urlStr, key, value string) (string, error) {
 	// Return the updated URL as a string.
 	return parsedURL.String(), nil
 }
+
+// requestLocale returns the language the client prefers the most according to its Accept-Language header,
+// such as "fr-CA", or an empty string if it sent none.
+func requestLocale(ctx echo.Context) string {
+	first, _, _ := strings.Cut(ctx.Request().Header.Get("Accept-Language"), ",")
+	locale, _, _ := strings.Cut(first, ";")
+	locale = strings.TrimSpace(locale)
+	if locale == "*" {
+		return ""
+	}
+	return locale
+}
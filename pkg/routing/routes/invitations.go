@@ -0,0 +1,258 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/invitations"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+// invitationQueryParam carries the code of an invitation to the registration form
+const invitationQueryParam = "invitation"
+
+type (
+	invitationsRoute struct {
+		ctr             controller.Controller
+		invitationsRepo *invitations.InvitationsRepo
+	}
+)
+
+func NewInvitationsRoute(ctr controller.Controller, invitationsRepo *invitations.InvitationsRepo) invitationsRoute {
+	return invitationsRoute{
+		ctr:             ctr,
+		invitationsRepo: invitationsRepo,
+	}
+}
+
+// Get lists the pending invitations of the user, with a form to create one.
+func (c *invitationsRoute) Get(ctx echo.Context) error {
+	return c.render(ctx, nil)
+}
+
+// Create creates an invitation and shows its link to the user, ready to be shared.
+func (c *invitationsRoute) Create(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.InvitationForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse invitation form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	inv, err := c.invitationsRepo.CreateInvitation(ctx.Request().Context(), profile.ID, form.InviteeName)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to create invitation")
+	}
+
+	ctx.Set(context.FormKey, nil)
+	newInvitation := c.toInvitation(ctx, *inv)
+	return c.render(ctx, &newInvitation)
+}
+
+// Revoke revokes an invitation, after which its link cannot be used anymore.
+func (c *invitationsRoute) Revoke(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	invitationID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid invitation ID")
+	}
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	err = c.invitationsRepo.RevokeInvitation(ctx.Request().Context(), profile.ID, invitationID)
+	switch err {
+	case nil:
+		msg.Success(ctx, "The invitation was revoked.")
+	case invitations.ErrInvitationNotFound:
+		msg.Warning(ctx, "That invitation was already accepted or revoked.")
+	default:
+		return c.ctr.Fail(err, "unable to revoke invitation")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameInvitations)
+}
+
+// Landing is where invitation links lead. Signed in users are asked to confirm accepting the invitation, since
+// links can be followed without meaning to, the others are sent to the registration form, which accepts it
+// once their account is created.
+func (c *invitationsRoute) Landing(ctx echo.Context) error {
+	code := ctx.Param("code")
+
+	inv, err := c.invitationsRepo.GetInvitation(ctx.Request().Context(), code)
+	switch err {
+	case nil:
+	case invitations.ErrInvitationNotFound, invitations.ErrInvitationExpired:
+		msg.Warning(ctx, "This invitation is either invalid, already used or has expired.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLandingPage)
+	default:
+		return c.ctr.Fail(err, "unable to load invitation")
+	}
+
+	if ctx.Get(context.AuthenticatedUserKey) != nil {
+		page := controller.NewPage(ctx)
+		page.Layout = layouts.Main
+		page.Name = templates.PageInvitationAccept
+		page.Title = "Invitation"
+		page.Data = &types.InvitationAcceptData{
+			Code:        code,
+			InviterName: inviterName(inv),
+		}
+		page.Component = pages.InvitationAccept(&page)
+
+		return c.ctr.RenderPage(ctx, page)
+	}
+
+	msg.Info(ctx, fmt.Sprintf(
+		"%s invited you! Create your account to become friends, or log in and open the link again if you already have one.",
+		inviterName(inv),
+	))
+	return c.ctr.RedirectWithDetails(
+		ctx, routeNames.RouteNameRegister, "?"+invitationQueryParam+"="+url.QueryEscape(code), http.StatusFound,
+	)
+}
+
+// Accept accepts an invitation once the signed in user confirmed it, which befriends them with the inviter.
+func (c *invitationsRoute) Accept(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	inv, err := acceptInvitation(ctx, c.ctr, c.invitationsRepo, usr, ctx.Param("code"))
+	switch err {
+	case nil:
+		msg.Success(ctx, fmt.Sprintf("You are now friends with %s. 🎉", inviterName(inv)))
+	case invitations.ErrInvitationNotFound, invitations.ErrInvitationExpired:
+		msg.Warning(ctx, "This invitation is either invalid, already used or has expired.")
+	case invitations.ErrOwnInvitation:
+		msg.Info(ctx, "This is your own invitation, share it with the person you want to invite.")
+	default:
+		return c.ctr.Fail(err, "unable to accept invitation")
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
+}
+
+func (c *invitationsRoute) render(ctx echo.Context, newInvitation *types.Invitation) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	pending, err := c.invitationsRepo.GetPendingInvitations(ctx.Request().Context(), profile.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load invitations")
+	}
+
+	data := &types.InvitationsData{
+		Invitations:      make([]types.Invitation, len(pending)),
+		ExpirationInDays: c.ctr.Container.Config.App.OperationalConstants.InvitationExpirationInDays,
+		NewInvitation:    newInvitation,
+	}
+	for i, inv := range pending {
+		data.Invitations[i] = c.toInvitation(ctx, inv)
+	}
+	if newInvitation != nil {
+		data.InvitationText = fmt.Sprintf(
+			"Hey %s, join me on %s so we become friends: %s",
+			newInvitation.InviteeName, c.ctr.Container.Config.App.Name, newInvitation.Link,
+		)
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageInvitations
+	page.Title = "Invitations"
+	page.Data = data
+	page.Form = &types.InvitationForm{}
+	page.Component = pages.Invitations(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.InvitationForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+func (c *invitationsRoute) toInvitation(ctx echo.Context, inv domain.Invitation) types.Invitation {
+	return types.Invitation{
+		ID:          inv.ID,
+		InviteeName: inv.InviteeName,
+		Link: fmt.Sprintf(
+			"%s%s", c.ctr.Container.Config.HTTP.Domain,
+			ctx.Echo().Reverse(routeNames.RouteNameInvitationAccept, inv.ConfirmationCode),
+		),
+		CreatedAt: inv.CreatedAt,
+		ExpiresAt: inv.ExpiresAt,
+	}
+}
+
+// acceptInvitation makes a user accept an invitation, which befriends them with the inviter. The inviter
+// is notified, though failing to do so does not fail the acceptance.
+func acceptInvitation(
+	ctx echo.Context,
+	ctr controller.Controller,
+	invitationsRepo *invitations.InvitationsRepo,
+	usr *ent.User,
+	code string,
+) (*ent.Invitation, error) {
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := invitationsRepo.AcceptInvitation(ctx.Request().Context(), code, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyProfile(ctx, ctr, domain.Notification{
+		Type:                    domain.NotificationTypeInvitationAccepted,
+		ProfileID:               inv.Edges.Inviter.ID,
+		Title:                   "Invitation accepted",
+		Text:                    fmt.Sprintf("🎉 %s accepted your invitation, you are now friends!", usr.Name),
+		Link:                    profileLink(ctx, profile.ID),
+		ProfileIDWhoCausedNotif: profile.ID,
+		ResourceIDTiedToNotif:   inv.ID,
+	})
+
+	return inv, nil
+}
+
+// inviterName returns the name of whom created an invitation, which is loaded along with it
+func inviterName(inv *ent.Invitation) string {
+	if inv.Edges.Inviter != nil && inv.Edges.Inviter.Edges.User != nil {
+		return inv.Edges.Inviter.Edges.User.Name
+	}
+	return "A friend"
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/invitations"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptInvitation_NotifiesInviter(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/invitation/code")
+	profileRepo := profilerepo.NewProfileRepo(c.ORM, storagerepo.NewMockStorageClient(), nil)
+	createProfile := func() (*ent.User, *ent.Profile) {
+		usr, err := tests.CreateRandomUser(c.ORM)
+		require.NoError(t, err)
+		profile, err := profileRepo.CreateProfile(
+			ctx.Request().Context(), usr, "bio", time.Now().AddDate(-25, 0, 0), nil, nil,
+		)
+		require.NoError(t, err)
+		return usr, profile
+	}
+	_, inviter := createProfile()
+	invitee, _ := createProfile()
+
+	invitationsRepo := invitations.NewInvitationsRepo(c.ORM, profileRepo, 7)
+	inv, err := invitationsRepo.CreateInvitation(ctx.Request().Context(), inviter.ID, "Friend")
+	require.NoError(t, err)
+
+	_, err = acceptInvitation(ctx, controller.NewController(c), invitationsRepo, invitee, inv.ConfirmationCode)
+	require.NoError(t, err)
+
+	// The inviter finds out through the notifier of the container
+	notifications, err := c.Notifier.GetNotifications(ctx.Request().Context(), inviter.ID, true, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, domain.NotificationTypeInvitationAccepted, notifications[0].Type)
+	assert.Equal(t, "Invitation accepted", notifications[0].Title)
+}
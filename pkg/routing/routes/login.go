@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
 
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/types"
 	"github.com/mikestefanello/pagoda/templates"
 	"github.com/mikestefanello/pagoda/templates/layouts"
@@ -40,6 +42,9 @@ func (c *login) Get(ctx echo.Context) error {
 	page.Name = templates.PageLogin
 	page.Title = "Log in"
 	page.Form = &types.LoginForm{}
+	page.Data = &types.LoginData{
+		OAuthProviders: oauthProviders(c.ctr),
+	}
 	page.Component = pages.Login(&page)
 	page.HTMX.Request.Boosted = true
 
@@ -59,9 +64,12 @@ func (c *login) Post(ctx echo.Context) error {
 	var form types.LoginForm
 	ctx.Set(context.FormKey, &form)
 
-	authFailed := func() error {
+	authFailed := func(usr *ent.User) error {
 		// form.Submission.SetFieldError("Email", "")
 		// form.Submission.SetFieldError("Password", "")
+		if err := recordFailedAttempt(c.ctr, ctx, usr, services.AuditActionLoginFailed, c.throttleKeys(ctx, form.Email)...); err != nil {
+			return c.ctr.Fail(err, "unable to record failed login attempt")
+		}
 		msg.Danger(ctx, "Invalid credentials. Please try again.")
 		return c.Get(ctx)
 	}
@@ -79,6 +87,14 @@ func (c *login) Post(ctx echo.Context) error {
 		return c.Get(ctx)
 	}
 
+	// Slow down and eventually lock out repeated failed attempts
+	if err := c.ctr.Container.Throttler.Check(ctx.Request().Context(), c.throttleKeys(ctx, form.Email)...); err != nil {
+		if !showThrottledMessage(ctx, err) {
+			return c.ctr.Fail(err, "unable to check login throttle")
+		}
+		return c.Get(ctx)
+	}
+
 	// Attempt to load the user
 	usr, err := c.ctr.Container.ORM.User.
 		Query().
@@ -88,7 +104,7 @@ func (c *login) Post(ctx echo.Context) error {
 	switch err.(type) {
 	case *ent.NotFoundError:
 		ctx.Logger().Debug("ent user not found")
-		return authFailed()
+		return authFailed(nil)
 	case nil:
 	default:
 		return c.ctr.Fail(err, "error querying user during login")
@@ -98,17 +114,76 @@ func (c *login) Post(ctx echo.Context) error {
 	err = c.ctr.Container.Auth.CheckPassword(form.Password, usr.Password)
 	if err != nil {
 		ctx.Logger().Debug("password incorrect")
-		return authFailed()
+		return authFailed(usr)
+	}
+
+	// Users with two-factor authentication enabled need to provide their code before being logged in
+	twoFactorEnabled, err := c.ctr.Container.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to check two-factor status")
+	}
+	if twoFactorEnabled {
+		if err := c.ctr.Container.Auth.SetPendingTwoFactorLogin(ctx, usr.ID); err != nil {
+			return c.ctr.Fail(err, "unable to start two-factor login")
+		}
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLoginTwoFactor)
 	}
 
 	// Log the user in
 	err = c.ctr.Container.Auth.Login(ctx, usr.ID)
 	if err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.Get(ctx)
+		}
 		return c.ctr.Fail(err, "unable to log in user")
 	}
 
+	// Forget the failed attempts of this account only once fully logged in, since the ones of the second
+	// factor are counted against it too
+	err = c.ctr.Container.Throttler.Reset(ctx.Request().Context(), services.ThrottleKeyEmail(form.Email))
+	if err != nil {
+		return c.ctr.Fail(err, "unable to reset login throttle")
+	}
+
 	// msg.Success(ctx, fmt.Sprintf("Welcome back, <strong>%s</strong>. You are now logged in.", usr.Name))
 
+	return completeLogin(c.ctr, ctx, usr)
+}
+
+// throttleKeys returns the keys failed login attempts are counted against
+func (c *login) throttleKeys(ctx echo.Context, email string) []services.ThrottleKey {
+	return []services.ThrottleKey{
+		services.ThrottleKeyEmail(email),
+		services.ThrottleKeyIP(ctx.RealIP()),
+	}
+}
+
+// showLockedAccountMessage lets a user know that their account is locked, either until it gets deleted
+// or because it was suspended. It returns false if the error is not about that.
+func showLockedAccountMessage(ctx echo.Context, err error) bool {
+	switch e := err.(type) {
+	case services.AccountPendingDeletionError:
+		msg.Warning(ctx, pendingDeletionMessage(e))
+	case services.AccountSuspendedError:
+		msg.Danger(ctx, suspendedAccountMessage)
+	default:
+		return false
+	}
+	return true
+}
+
+// suspendedAccountMessage lets a user know that their account was suspended
+const suspendedAccountMessage = "This account was suspended for breaking our community guidelines."
+
+func pendingDeletionMessage(e services.AccountPendingDeletionError) string {
+	return fmt.Sprintf(
+		"This account will be deleted on %s. Use the link we emailed you to cancel the deletion and sign in again.",
+		e.ScheduledAt.Format("January 2, 2006"),
+	)
+}
+
+// completeLogin sends a user who was just logged in to the page they should land on.
+func completeLogin(ctr controller.Controller, ctx echo.Context, usr *ent.User) error {
 	redirect, err := redirectAfterLogin(ctx)
 	if err != nil {
 		return err
@@ -119,10 +194,10 @@ func (c *login) Post(ctx echo.Context) error {
 
 	profile := usr.QueryProfile().FirstX(ctx.Request().Context())
 	if !profilerepo.IsProfileFullyOnboarded(profile) {
-		return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+		return ctr.Redirect(ctx, routeNames.RouteNamePreferences)
 
 	}
-	return c.ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
+	return ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
 }
 
 // redirectAfterLogin redirects a now logged-in user to a previously requested page.
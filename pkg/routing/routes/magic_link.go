@@ -0,0 +1,183 @@
+package routes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/emails"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/mileusna/useragent"
+)
+
+type (
+	magicLink struct {
+		ctr controller.Controller
+	}
+)
+
+func NewMagicLinkRoute(ctr controller.Controller) magicLink {
+	return magicLink{
+		ctr: ctr,
+	}
+}
+
+// Get renders the form to request a sign-in link by email
+func (c *magicLink) Get(ctx echo.Context) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PageMagicLink
+	page.Title = "Sign in with email"
+	page.Form = &types.MagicLinkForm{}
+	page.Component = pages.MagicLink(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.MagicLinkForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Post emails a sign-in link. The same message is shown whether or not an account exists for the
+// email address, so that the form cannot be used to find out who is registered.
+func (c *magicLink) Post(ctx echo.Context) error {
+	var form types.MagicLinkForm
+	ctx.Set(context.FormKey, &form)
+
+	succeed := func() error {
+		ctx.Set(context.FormKey, nil)
+		msg.Success(ctx, "If an account exists for this email, a link to sign in was sent to it.")
+		return c.Get(ctx)
+	}
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse magic link form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	u, err := c.ctr.Container.ORM.User.
+		Query().
+		Where(user.Email(strings.ToLower(form.Email))).
+		Only(ctx.Request().Context())
+
+	switch err.(type) {
+	case *ent.NotFoundError:
+		return succeed()
+	case nil:
+	default:
+		return c.ctr.Fail(err, "error querying user during magic link request")
+	}
+
+	token, err := c.ctr.Container.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "error generating magic link token")
+	}
+
+	ctx.Logger().Infof("generated magic link token for user %d", u.ID)
+
+	url := ctx.Echo().Reverse(routeNames.RouteNameMagicLinkConfirm, token)
+	if err := c.sendMagicLinkEmail(ctx, u.Name, u.Email, url); err != nil {
+		return c.ctr.Fail(err, "unable to send magic link email")
+	}
+
+	return succeed()
+}
+
+// Confirm is where the emailed link points to. It does not consume the token, it only renders a
+// button which does, since email clients and scanners commonly prefetch links.
+func (c *magicLink) Confirm(ctx echo.Context) error {
+	// Links signed with a key which was rotated out since are submitted signed with the active key
+	token, err := c.ctr.Container.Keyring.ResignJWT(ctx.Param("token"))
+	if err != nil {
+		msg.Warning(ctx, "Your sign-in link is invalid, expired or was already used. Please request a new one.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameMagicLink)
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PageMagicLinkConfirm
+	page.Title = "Sign in"
+	page.Data = &types.MagicLinkConfirmData{
+		Token: token,
+	}
+	page.Component = pages.MagicLinkConfirm(&page)
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Consume uses up the token and logs the user in
+func (c *magicLink) Consume(ctx echo.Context) error {
+	usr, err := c.ctr.Container.Auth.ConsumeMagicLinkToken(ctx, ctx.Param("token"))
+	switch err.(type) {
+	case nil:
+	case services.InvalidMagicLinkTokenError:
+		msg.Warning(ctx, "Your sign-in link is invalid, expired or was already used. Please request a new one.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameMagicLink)
+	default:
+		return c.ctr.Fail(err, "unable to consume magic link token")
+	}
+
+	// Owning the email address is a single factor, two-factor authentication still applies
+	twoFactorEnabled, err := c.ctr.Container.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to check two-factor status")
+	}
+	if twoFactorEnabled {
+		if err := c.ctr.Container.Auth.SetPendingTwoFactorLogin(ctx, usr.ID); err != nil {
+			return c.ctr.Fail(err, "unable to start two-factor login")
+		}
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLoginTwoFactor)
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	return completeLogin(c.ctr, ctx, usr)
+}
+
+func (c *magicLink) sendMagicLinkEmail(ctx echo.Context, profileName, email, url string) error {
+	ua := useragent.Parse(ctx.Request().UserAgent())
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Data = types.EmailMagicLinkData{
+		AppName:         string(c.ctr.Container.Config.App.Name),
+		ProfileName:     profileName,
+		MagicLink:       fmt.Sprintf("%s%s", c.ctr.Container.Config.HTTP.Domain, url),
+		ExpiresIn:       fmt.Sprintf("%d minutes", int(c.ctr.Container.Config.App.MagicLinkTokenExpiration.Minutes())),
+		SupportEmail:    c.ctr.Container.Config.Mail.FromAddress,
+		OperatingSystem: ua.OS,
+		BrowserName:     ua.Name,
+		Domain:          c.ctr.Container.Config.HTTP.Domain,
+	}
+
+	return c.ctr.Container.Mail.
+		Compose().
+		To(email).
+		Subject("Your sign-in link").
+		TemplateLayout(layouts.Email).
+		Component(emails.MagicLink(&page)).
+		Send(ctx.Request().Context())
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMagicLink_ConfirmResignsToken(t *testing.T) {
+	token, err := c.Keyring.SignJWT(jwt.MapClaims{
+		"sub": 1,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	// Rotate the keys, keeping the one the link was signed with
+	cfg := *c.Config
+	cfg.Keyring.ActiveKey = "rotated"
+	cfg.Keyring.Keys = map[string]string{"rotated": "rotated-secret"}
+	rotated, err := services.NewKeyring(&cfg)
+	require.NoError(t, err)
+	original := c.Keyring
+	c.Keyring = rotated
+	t.Cleanup(func() { c.Keyring = original })
+
+	// The link still works, and is submitted signed with the active key
+	doc := request(t).
+		setRoute(routeNames.RouteNameMagicLinkConfirm, token).
+		get().
+		assertStatusCode(http.StatusOK).
+		toDoc()
+	action, exists := doc.Find(`form[action*="/login/link/"]`).Attr("action")
+	require.True(t, exists)
+	resigned := action[strings.LastIndex(action, "/")+1:]
+	assert.NotEqual(t, token, resigned)
+
+	parsed, err := rotated.ParseJWT(resigned)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", parsed.Header["kid"])
+
+	// Invalid links are turned down right away
+	resp := request(t).
+		setClient(http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}).
+		setRoute(routeNames.RouteNameMagicLinkConfirm, "invalid").
+		get().
+		assertStatusCode(http.StatusFound)
+	resp.assertRedirect(t, routeNames.RouteNameMagicLink)
+}
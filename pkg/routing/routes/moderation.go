@@ -0,0 +1,257 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/moderation"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
+)
+
+// moderationQueueShown is how many of the oldest pending reports the moderation queue lists
+const moderationQueueShown = 50
+
+type (
+	moderationRoute struct {
+		ctr            controller.Controller
+		moderationRepo *moderation.ModerationRepo
+		storageRepo    storagerepo.StorageClientInterface
+	}
+)
+
+func NewModerationRoute(
+	ctr controller.Controller, moderationRepo *moderation.ModerationRepo, storageRepo storagerepo.StorageClientInterface,
+) moderationRoute {
+	return moderationRoute{
+		ctr:            ctr,
+		moderationRepo: moderationRepo,
+		storageRepo:    storageRepo,
+	}
+}
+
+// Get shows the pending reports, oldest first, with the photos they are about.
+func (c *moderationRoute) Get(ctx echo.Context) error {
+	reports, err := c.moderationRepo.GetPendingReports(ctx.Request().Context(), moderationQueueShown)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load reports")
+	}
+
+	photoURLs, err := c.reportedPhotoURLs(ctx, reports)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load reported photos")
+	}
+
+	data := &types.ModerationData{
+		Reports: make([]types.ModerationReport, len(reports)),
+	}
+	for i, rep := range reports {
+		data.Reports[i] = types.ModerationReport{
+			ID:           rep.ID,
+			Reason:       types.ReportReasonLabel(rep.Reason.String()),
+			Details:      rep.Details,
+			TargetType:   rep.TargetType.String(),
+			TargetID:     rep.TargetID,
+			ReportedLink: profileLink(ctx, rep.Edges.Reported.ID),
+			CreatedAt:    rep.CreatedAt,
+		}
+		if rep.TargetType == report.TargetTypePhoto {
+			data.Reports[i].PhotoURL = photoURLs[rep.TargetID]
+		}
+		if u := rep.Edges.Reporter.Edges.User; u != nil {
+			data.Reports[i].ReporterName = u.Name
+			data.Reports[i].ReporterEmail = u.Email
+		}
+		if u := rep.Edges.Reported.Edges.User; u != nil {
+			data.Reports[i].ReportedName = u.Name
+			data.Reports[i].ReportedEmail = u.Email
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageModeration
+	page.Title = "Moderation"
+	page.Data = data
+	page.Component = pages.Moderation(&page)
+	page.HTMX.Request.Boosted = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Dismiss closes a report without acting on what was reported.
+func (c *moderationRoute) Dismiss(ctx echo.Context) error {
+	return c.act(ctx, func(moderatorID, reportID int) ([]*ent.Report, error) {
+		rep, err := c.moderationRepo.Dismiss(ctx.Request().Context(), moderatorID, reportID)
+		if err != nil {
+			return nil, err
+		}
+		return []*ent.Report{rep}, nil
+	})
+}
+
+// HidePhoto hides the reported photo, closing every pending report about it.
+func (c *moderationRoute) HidePhoto(ctx echo.Context) error {
+	return c.act(ctx, func(moderatorID, reportID int) ([]*ent.Report, error) {
+		return c.moderationRepo.HidePhoto(ctx.Request().Context(), moderatorID, reportID)
+	})
+}
+
+// Suspend suspends the reported user, closing every pending report about them.
+func (c *moderationRoute) Suspend(ctx echo.Context) error {
+	return c.act(ctx, func(moderatorID, reportID int) ([]*ent.Report, error) {
+		return c.moderationRepo.SuspendUser(ctx.Request().Context(), moderatorID, reportID)
+	})
+}
+
+// act applies the action of a moderator to a report, then records it in the audit log and lets the
+// reporters and the reported user know of the outcome.
+func (c *moderationRoute) act(ctx echo.Context, action func(moderatorID, reportID int) ([]*ent.Report, error)) error {
+	moderator := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	reportID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid report ID")
+	}
+
+	reports, err := action(moderator.ID, reportID)
+	switch err {
+	case nil:
+	case moderation.ErrReportNotFound:
+		msg.Warning(ctx, "This report was already handled.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameModerationQueue)
+	case moderation.ErrActionNotApplicable:
+		msg.Warning(ctx, "This action does not apply to what was reported.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameModerationQueue)
+	default:
+		return c.ctr.Fail(err, "unable to act on report")
+	}
+
+	outcome := reports[0].Status
+	if err := c.audit(ctx, reports[0], len(reports)); err != nil {
+		return c.ctr.Fail(err, "unable to record moderation action")
+	}
+
+	notifiedReporters := map[int]bool{}
+	for _, rep := range reports {
+		if notifiedReporters[rep.Edges.Reporter.ID] {
+			continue
+		}
+		notifiedReporters[rep.Edges.Reporter.ID] = true
+		notifyProfile(ctx, c.ctr, domain.Notification{
+			Type:                  domain.NotificationTypeReportResolved,
+			ProfileID:             rep.Edges.Reporter.ID,
+			Title:                 "Your report was reviewed",
+			Text:                  reporterOutcomeText(outcome),
+			ResourceIDTiedToNotif: rep.ID,
+		})
+	}
+	notifyProfile(ctx, c.ctr, domain.Notification{
+		Type:                  domain.NotificationTypeModerationAction,
+		ProfileID:             reports[0].Edges.Reported.ID,
+		Title:                 "A moderator reviewed your content",
+		Text:                  reportedOutcomeText(outcome),
+		ResourceIDTiedToNotif: reports[0].ID,
+	})
+
+	switch outcome {
+	case report.StatusPhotoHidden:
+		msg.Success(ctx, "The photo was hidden.")
+	case report.StatusUserSuspended:
+		msg.Success(ctx, "The user was suspended.")
+	default:
+		msg.Success(ctx, "The report was dismissed.")
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameModerationQueue)
+}
+
+func (c *moderationRoute) audit(ctx echo.Context, rep *ent.Report, resolved int) error {
+	event := services.AuditEvent{
+		Action:     services.AuditActionReportDismissed,
+		TargetType: services.AuditTargetReport,
+		TargetID:   &rep.ID,
+		Metadata: map[string]string{
+			"reason":           rep.Reason.String(),
+			"reported_profile": strconv.Itoa(rep.Edges.Reported.ID),
+			"reports_resolved": strconv.Itoa(resolved),
+		},
+	}
+	switch rep.Status {
+	case report.StatusPhotoHidden:
+		event.Action = services.AuditActionPhotoHidden
+		event.TargetType = services.AuditTargetImage
+		event.TargetID = &rep.TargetID
+		event.Metadata["report_id"] = strconv.Itoa(rep.ID)
+	case report.StatusUserSuspended:
+		event.Action = services.AuditActionUserSuspended
+		event.TargetType = services.AuditTargetProfile
+		event.TargetID = &rep.Edges.Reported.ID
+		event.Metadata["report_id"] = strconv.Itoa(rep.ID)
+	}
+	return c.ctr.Container.Audit.Record(ctx.Request().Context(), event)
+}
+
+// reportedPhotoURLs returns the thumbnail URL of the photos reports are about, by image ID
+func (c *moderationRoute) reportedPhotoURLs(ctx echo.Context, reports []*ent.Report) (map[int]string, error) {
+	var imageIDs []int
+	for _, rep := range reports {
+		if rep.TargetType == report.TargetTypePhoto {
+			imageIDs = append(imageIDs, rep.TargetID)
+		}
+	}
+	urls := map[int]string{}
+	if len(imageIDs) == 0 {
+		return urls, nil
+	}
+
+	images, err := c.moderationRepo.GetImages(ctx.Request().Context(), imageIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		photo, err := c.storageRepo.GetImageObjectFromFile(img)
+		if err != nil {
+			log.Error().Err(err).Int("imageID", img.ID).Msg("failed to get URL of reported photo")
+			continue
+		}
+		urls[img.ID] = photo.ThumbnailURL
+	}
+	return urls, nil
+}
+
+// reporterOutcomeText tells whom filed a report what came out of it
+func reporterOutcomeText(outcome report.Status) string {
+	switch outcome {
+	case report.StatusPhotoHidden:
+		return "Thank you for your report. The photo you reported was hidden."
+	case report.StatusUserSuspended:
+		return "Thank you for your report. The account you reported was suspended."
+	default:
+		return "Thank you for your report. Our moderators did not find anything breaking our community guidelines."
+	}
+}
+
+// reportedOutcomeText tells whom a report was about what came out of it, without revealing who filed it
+func reportedOutcomeText(outcome report.Status) string {
+	switch outcome {
+	case report.StatusPhotoHidden:
+		return "One of your photos was hidden for breaking our community guidelines."
+	case report.StatusUserSuspended:
+		return suspendedAccountMessage
+	default:
+		return "Some of your content was reported, our moderators found that it follows our community guidelines."
+	}
+}
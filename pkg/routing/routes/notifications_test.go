@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifier_DispatchesToAllowedPlatforms(t *testing.T) {
+	ctx := context.Background()
+	usr, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	profile := c.ORM.Profile.Create().SetUser(usr).SetBirthdate(time.Now().AddDate(-25, 0, 0)).SaveX(ctx)
+	require.NoError(t, notifierrepo.NewNotificationSendPermissionRepo(c.ORM).CreatePermission(
+		ctx, profile.ID, domain.NotificationPermissionNewFriendActivity, &domain.NotificationPlatformEmail,
+	))
+
+	sender := mailer.NewMockMailClient()
+	sender.On("Send", usr.Email, "New message").Return(nil)
+	original := c.Mail.MailSender
+	c.Mail.MailSender = sender
+	t.Cleanup(func() { c.Mail.MailSender = original })
+
+	// The notifier of the container sends notifications on the platforms their recipients allowed
+	err = c.Notifier.PublishNotification(ctx, domain.Notification{
+		Type:                    domain.NotificationTypeNewPrivateMessage,
+		ProfileID:               profile.ID,
+		Title:                   "New message",
+		Text:                    "You got a new message",
+		ProfileIDWhoCausedNotif: profile.ID,
+	}, true, true)
+	require.NoError(t, err)
+	sender.AssertExpectations(t)
+}
+
+func TestNotifier_QueuesPushDeliveries(t *testing.T) {
+	ctx := context.Background()
+	usr, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	profile := c.ORM.Profile.Create().SetUser(usr).SetBirthdate(time.Now().AddDate(-25, 0, 0)).SaveX(ctx)
+	pwaPushNotificationsRepo := notifierrepo.NewPwaPushNotificationsRepo(c.ORM, "", "", "")
+	require.NoError(t, pwaPushNotificationsRepo.AddPushSubscription(ctx, profile.ID, notifierrepo.Subscription{
+		Endpoint: "https://push.example.com/device",
+		P256dh:   "p256dh",
+		Auth:     "auth",
+	}))
+
+	// Push notifications are handed to the worker rather than delivered within the request, though
+	// queueing them fails when the task client cannot reach its broker
+	_ = c.Notifier.PublishNotification(ctx, domain.Notification{
+		Type:                    domain.NotificationTypeInvitationAccepted,
+		ProfileID:               profile.ID,
+		Title:                   "Invitation accepted",
+		Text:                    "A friend accepted your invitation",
+		ProfileIDWhoCausedNotif: profile.ID,
+	}, true, true)
+
+	delivery := c.ORM.PushDelivery.Query().Where(pushdelivery.ProfileIDEQ(profile.ID)).OnlyX(ctx)
+	assert.Equal(t, domain.NotificationPlatformPush.Value, string(delivery.Platform))
+	assert.Zero(t, delivery.Attempts)
+}
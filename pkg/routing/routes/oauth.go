@@ -0,0 +1,177 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+)
+
+type (
+	oauth struct {
+		ctr                            controller.Controller
+		subscriptionsRepo              subscriptions.SubscriptionsRepo
+		notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo
+	}
+)
+
+func NewOAuthRoute(
+	ctr controller.Controller,
+	subscriptionsRepo subscriptions.SubscriptionsRepo,
+	notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo,
+) oauth {
+	return oauth{
+		ctr:                            ctr,
+		subscriptionsRepo:              subscriptionsRepo,
+		notificationSendPermissionRepo: notificationSendPermissionRepo,
+	}
+}
+
+// Begin redirects the user to the login page of the provider.
+func (c *oauth) Begin(ctx echo.Context) error {
+	provider := ctx.Param("provider")
+
+	authURL, err := c.ctr.Container.OAuth.BeginLogin(ctx, provider, c.callbackURL(ctx, provider))
+	switch err.(type) {
+	case nil:
+	case services.UnknownOAuthProviderError:
+		return echo.NewHTTPError(http.StatusNotFound, "unknown login provider")
+	default:
+		return c.ctr.Fail(err, "unable to begin social login")
+	}
+
+	return ctx.Redirect(http.StatusFound, authURL)
+}
+
+// Callback completes the login once the provider sends the user back. Users logging in for the
+// first time get an account, the same as if they had registered with a password.
+func (c *oauth) Callback(ctx echo.Context) error {
+	provider := ctx.Param("provider")
+
+	ident, err := c.ctr.Container.OAuth.CompleteLogin(ctx, provider, c.callbackURL(ctx, provider))
+	switch err.(type) {
+	case nil:
+	case services.UnknownOAuthProviderError:
+		return echo.NewHTTPError(http.StatusNotFound, "unknown login provider")
+	case services.InvalidOAuthStateError:
+		msg.Danger(ctx, "Your login attempt expired or is invalid. Please try again.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	case services.OAuthProviderError:
+		msg.Warning(ctx, "The login was cancelled. Please try again.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	default:
+		return c.ctr.Fail(err, "unable to complete social login")
+	}
+
+	usr, err := c.ctr.Container.OAuth.FindUser(ctx.Request().Context(), ident)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return c.register(ctx, ident)
+	default:
+		return c.ctr.Fail(err, "unable to find user of social login")
+	}
+
+	// Two-factor authentication still applies to accounts which enabled it
+	twoFactorEnabled, err := c.ctr.Container.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to check two-factor status")
+	}
+	if twoFactorEnabled {
+		if err := c.ctr.Container.Auth.SetPendingTwoFactorLogin(ctx, usr.ID); err != nil {
+			return c.ctr.Fail(err, "unable to start two-factor login")
+		}
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLoginTwoFactor)
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	return completeLogin(c.ctr, ctx, usr)
+}
+
+// register creates an account for an identity seen for the first time.
+func (c *oauth) register(ctx echo.Context, ident *services.OAuthIdentity) error {
+	if !c.ctr.Container.Config.App.OperationalConstants.UserSignupEnabled {
+		msg.Warning(ctx, "Sign ups are currently closed.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	}
+
+	if ident.Email == "" {
+		msg.Warning(ctx, "Your account does not share an email address with us. Please register with your email instead.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameRegister)
+	}
+
+	name := ident.Name
+	if name == "" {
+		name = ident.Email
+	}
+
+	// Social users do not have a password, a random one is set which they can later reset
+	password, err := c.ctr.Container.Auth.RandomToken(32)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to generate password")
+	}
+	pwHash, err := c.ctr.Container.Auth.HashPassword(password)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to hash password")
+	}
+
+	usr, err := createAccount(ctx, c.ctr, c.subscriptionsRepo, c.notificationSendPermissionRepo, accountDetails{
+		name:         name,
+		email:        ident.Email,
+		passwordHash: pwHash,
+		verified:     ident.EmailVerified,
+		identity:     ident,
+	})
+
+	switch err.(type) {
+	case nil:
+	case *ent.ConstraintError:
+		// The email is taken, but the provider did not verify it so the accounts were not linked
+		msg.Warning(ctx, "A user with this email address already exists. Please log in with your password.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	default:
+		return c.ctr.Fail(err, "unable to create account of social login")
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	msg.Success(ctx, "Your account has been created. You are now logged in. 👌")
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+}
+
+// oauthProviders returns the configured social login providers, to display their buttons.
+func oauthProviders(ctr controller.Controller) []types.OAuthProvider {
+	providers := ctr.Container.OAuth.Providers()
+	list := make([]types.OAuthProvider, len(providers))
+	for i, p := range providers {
+		list[i] = types.OAuthProvider{
+			Name:        p.Name,
+			DisplayName: p.DisplayName,
+		}
+	}
+	return list
+}
+
+func (c *oauth) callbackURL(ctx echo.Context, provider string) string {
+	return fmt.Sprintf("%s%s", c.ctr.Container.Config.HTTP.Domain, ctx.Echo().Reverse(routeNames.RouteNameOAuthCallback, provider))
+}
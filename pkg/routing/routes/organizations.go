@@ -0,0 +1,488 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/organizations"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/emails"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+type (
+	organizationsRoute struct {
+		ctr               controller.Controller
+		organizationsRepo *organizations.OrganizationsRepo
+		subscriptionsRepo *subscriptions.SubscriptionsRepo
+	}
+)
+
+func NewOrganizationsRoute(
+	ctr controller.Controller,
+	organizationsRepo *organizations.OrganizationsRepo,
+	subscriptionsRepo *subscriptions.SubscriptionsRepo,
+) organizationsRoute {
+	return organizationsRoute{
+		ctr:               ctr,
+		organizationsRepo: organizationsRepo,
+		subscriptionsRepo: subscriptionsRepo,
+	}
+}
+
+// Get lists the organizations the user is part of, with a form to create one.
+func (c *organizationsRoute) Get(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	memberships, err := c.organizationsRepo.GetMemberships(ctx.Request().Context(), profile.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load organizations")
+	}
+
+	activeID := 0
+	if org, ok := ctx.Get(context.OrganizationKey).(*ent.Organization); ok {
+		activeID = org.ID
+	}
+	data := &types.OrganizationsData{
+		Organizations: make([]types.OrganizationSummary, len(memberships)),
+	}
+	for i, m := range memberships {
+		data.Organizations[i] = types.OrganizationSummary{
+			ID:       m.OrganizationID,
+			Name:     m.Edges.Organization.Name,
+			Role:     m.Role.String(),
+			IsActive: m.OrganizationID == activeID,
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageOrganizations
+	page.Title = "Organizations"
+	page.Data = data
+	page.Form = &types.OrganizationForm{}
+	page.Component = pages.Organizations(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.OrganizationForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Create creates an organization owned by the user, and switches to it.
+func (c *organizationsRoute) Create(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.OrganizationForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse organization form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	org, err := c.organizationsRepo.CreateOrganization(ctx.Request().Context(), profile.ID, form.Name)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to create organization")
+	}
+
+	if err := middleware.SetActiveOrganization(ctx, org.ID); err != nil {
+		return c.ctr.Fail(err, "unable to switch organization")
+	}
+
+	msg.Success(ctx, fmt.Sprintf("%s was created. Invite your team to join it!", org.Name))
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, org.ID)
+}
+
+// Show shows the members of an organization, its pending invitations and its subscription.
+func (c *organizationsRoute) Show(ctx echo.Context) error {
+	membership, err := c.membership(ctx)
+	if err != nil {
+		return err
+	}
+	return c.render(ctx, membership)
+}
+
+// Switch makes the user work in another organization they are part of.
+func (c *organizationsRoute) Switch(ctx echo.Context) error {
+	membership, err := c.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := middleware.SetActiveOrganization(ctx, membership.OrganizationID); err != nil {
+		return c.ctr.Fail(err, "unable to switch organization")
+	}
+
+	msg.Info(ctx, fmt.Sprintf("You are now working in %s.", membership.Edges.Organization.Name))
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+}
+
+// Invite invites someone to join the organization by email.
+func (c *organizationsRoute) Invite(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	membership, err := c.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	var form types.OrganizationInvitationForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse organization invitation form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.render(ctx, membership)
+	}
+
+	role := *domain.OrganizationRoles.Parse(form.Role)
+	inv, err := c.organizationsRepo.CreateInvitation(ctx.Request().Context(), membership, form.Email, role)
+	switch err {
+	case nil:
+	case organizations.ErrNotAllowed:
+		return echo.NewHTTPError(http.StatusForbidden, "your role does not allow inviting with this role")
+	case organizations.ErrAlreadyMember:
+		form.Submission.SetFieldError("Email", "This person is already part of the organization.")
+		return c.render(ctx, membership)
+	case organizations.ErrAlreadyInvited:
+		form.Submission.SetFieldError("Email", "An invitation was already sent to this email address.")
+		return c.render(ctx, membership)
+	default:
+		return c.ctr.Fail(err, "unable to create organization invitation")
+	}
+
+	if err := c.sendInvitationEmail(ctx, usr, membership.Edges.Organization, inv); err != nil {
+		return c.ctr.Fail(err, "unable to send organization invitation email")
+	}
+
+	msg.Success(ctx, fmt.Sprintf("An invitation was sent to %s.", inv.Email))
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+}
+
+// RevokeInvitation revokes an invitation which was not accepted yet.
+func (c *organizationsRoute) RevokeInvitation(ctx echo.Context) error {
+	membership, err := c.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitationID, err := strconv.Atoi(ctx.Param("invitation_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid invitation ID")
+	}
+
+	err = c.organizationsRepo.RevokeInvitation(ctx.Request().Context(), membership, invitationID)
+	switch err {
+	case nil:
+		msg.Success(ctx, "The invitation was revoked.")
+	case organizations.ErrNotAllowed:
+		return echo.NewHTTPError(http.StatusForbidden, "your role does not allow managing invitations")
+	case organizations.ErrInvitationNotFound:
+		msg.Warning(ctx, "That invitation was already accepted or revoked.")
+	default:
+		return c.ctr.Fail(err, "unable to revoke organization invitation")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+}
+
+// AcceptInvitation is where invitation emails lead. The user joins the organization with the role they were
+// invited with, and switches to it.
+func (c *organizationsRoute) AcceptInvitation(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	inv, err := c.organizationsRepo.AcceptInvitation(ctx.Request().Context(), ctx.Param("token"), profile.ID, usr.Email)
+	switch err {
+	case nil:
+	case organizations.ErrInvitationNotFound, organizations.ErrInvitationExpired:
+		msg.Warning(ctx, "This invitation is either invalid, already used or has expired.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameOrganizations)
+	case organizations.ErrInvitationEmailMismatch:
+		msg.Warning(ctx, "This invitation was sent to another email address. Sign in with the account it was sent to.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameOrganizations)
+	case organizations.ErrAlreadyMember:
+		msg.Info(ctx, "You are already part of this organization.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameOrganizations)
+	default:
+		return c.ctr.Fail(err, "unable to accept organization invitation")
+	}
+
+	if err := middleware.SetActiveOrganization(ctx, inv.Edges.Organization.ID); err != nil {
+		return c.ctr.Fail(err, "unable to switch organization")
+	}
+
+	msg.Success(ctx, fmt.Sprintf("Welcome to %s! 🎉", inv.Edges.Organization.Name))
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, inv.Edges.Organization.ID)
+}
+
+// UpdateMemberRole changes the role of a member of the organization.
+func (c *organizationsRoute) UpdateMemberRole(ctx echo.Context) error {
+	membership, err := c.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	memberProfileID, err := strconv.Atoi(ctx.Param("profile_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid profile ID")
+	}
+
+	var form types.OrganizationRoleForm
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse organization role form")
+	}
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+	if form.Submission.HasErrors() {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid role")
+	}
+
+	role := *domain.OrganizationRoles.Parse(form.Role)
+	err = c.organizationsRepo.UpdateMemberRole(ctx.Request().Context(), membership, memberProfileID, role)
+	switch err {
+	case nil:
+		if err := c.audit(ctx, services.AuditActionOrganizationRoleChanged, membership, memberProfileID, map[string]string{
+			"role": role.Value,
+		}); err != nil {
+			return c.ctr.Fail(err, "unable to record role change")
+		}
+		msg.Success(ctx, "The role was updated.")
+	case organizations.ErrNotAllowed:
+		return echo.NewHTTPError(http.StatusForbidden, "your role does not allow changing this role")
+	case organizations.ErrNotMember:
+		msg.Warning(ctx, "This person is not part of the organization anymore.")
+	case organizations.ErrLastOwner:
+		msg.Warning(ctx, "The organization needs at least one owner. Make someone else an owner first.")
+	default:
+		return c.ctr.Fail(err, "unable to update member role")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+}
+
+// RemoveMember removes a member from the organization. Members can also remove themselves to leave it.
+func (c *organizationsRoute) RemoveMember(ctx echo.Context) error {
+	membership, err := c.membership(ctx)
+	if err != nil {
+		return err
+	}
+
+	memberProfileID, err := strconv.Atoi(ctx.Param("profile_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid profile ID")
+	}
+	leaving := memberProfileID == membership.ProfileID
+
+	err = c.organizationsRepo.RemoveMember(ctx.Request().Context(), membership, memberProfileID)
+	switch err {
+	case nil:
+		if err := c.audit(ctx, services.AuditActionOrganizationMemberRemoved, membership, memberProfileID, map[string]string{
+			"left": strconv.FormatBool(leaving),
+		}); err != nil {
+			return c.ctr.Fail(err, "unable to record member removal")
+		}
+	case organizations.ErrNotAllowed:
+		return echo.NewHTTPError(http.StatusForbidden, "your role does not allow removing this member")
+	case organizations.ErrNotMember:
+		msg.Warning(ctx, "This person is not part of the organization anymore.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+	case organizations.ErrLastOwner:
+		msg.Warning(ctx, "The organization needs at least one owner. Make someone else an owner first.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+	default:
+		return c.ctr.Fail(err, "unable to remove member")
+	}
+
+	if leaving {
+		msg.Success(ctx, fmt.Sprintf("You left %s.", membership.Edges.Organization.Name))
+		return c.ctr.Redirect(ctx, routeNames.RouteNameOrganizations)
+	}
+	msg.Success(ctx, "The member was removed.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNameOrganization, membership.OrganizationID)
+}
+
+// membership returns the membership of the user in the organization of the request, which they must be part of
+func (c *organizationsRoute) membership(ctx echo.Context) (*ent.OrganizationMembership, error) {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	organizationID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	profile, err := usr.QueryProfile().Only(ctx.Request().Context())
+	if err != nil {
+		return nil, c.ctr.Fail(err, "unable to load profile")
+	}
+
+	membership, err := c.organizationsRepo.GetMembership(ctx.Request().Context(), organizationID, profile.ID)
+	switch err {
+	case nil:
+		return membership, nil
+	case organizations.ErrNotMember:
+		return nil, echo.NewHTTPError(http.StatusNotFound)
+	default:
+		return nil, c.ctr.Fail(err, "unable to load organization membership")
+	}
+}
+
+func (c *organizationsRoute) render(ctx echo.Context, membership *ent.OrganizationMembership) error {
+	org := membership.Edges.Organization
+	role := organizations.MembershipRole(membership)
+
+	members, err := c.organizationsRepo.GetMembers(ctx.Request().Context(), org.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load organization members")
+	}
+
+	activePlan, subscriptionExpiredOn, isTrial, err := c.subscriptionsRepo.GetOrganizationActiveProduct(
+		ctx.Request().Context(), org.ID,
+	)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load organization subscription")
+	}
+
+	data := &types.OrganizationData{
+		ID:                    org.ID,
+		Name:                  org.Name,
+		Role:                  role.Value,
+		CanManageMembers:      organizations.CanManageMembers(role),
+		CanManageBilling:      organizations.CanManageBilling(role),
+		Members:               make([]types.OrganizationMember, len(members)),
+		Roles:                 domain.OrganizationRoles.Values(),
+		ProductProCode:        c.ctr.Container.Config.App.OperationalConstants.ProductProCode,
+		ActivePlan:            *activePlan,
+		IsTrial:               isTrial,
+		SubscriptionExpiresOn: subscriptionExpiredOn,
+	}
+	if active, ok := ctx.Get(context.OrganizationKey).(*ent.Organization); ok {
+		data.IsActive = active.ID == org.ID
+	}
+	for i, m := range members {
+		data.Members[i] = types.OrganizationMember{
+			ProfileID: m.ProfileID,
+			Name:      m.Edges.Profile.Edges.User.Name,
+			Email:     m.Edges.Profile.Edges.User.Email,
+			Role:      m.Role.String(),
+			JoinedAt:  m.CreatedAt,
+			IsSelf:    m.ID == membership.ID,
+		}
+	}
+
+	if data.CanManageMembers {
+		invs, err := c.organizationsRepo.GetPendingInvitations(ctx.Request().Context(), org.ID)
+		if err != nil {
+			return c.ctr.Fail(err, "unable to load organization invitations")
+		}
+		data.Invitations = make([]types.OrganizationInvitation, len(invs))
+		for i, inv := range invs {
+			data.Invitations[i] = types.OrganizationInvitation{
+				ID:        inv.ID,
+				Email:     inv.Email,
+				Role:      inv.Role.String(),
+				CreatedAt: inv.CreatedAt,
+				ExpiresAt: inv.ExpiresAt,
+			}
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageOrganization
+	page.Title = org.Name
+	page.Data = data
+	page.Form = &types.OrganizationInvitationForm{Role: domain.OrganizationRoleMember.Value}
+	page.Component = pages.Organization(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form, ok := ctx.Get(context.FormKey).(*types.OrganizationInvitationForm); ok {
+		page.Form = form
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+func (c *organizationsRoute) audit(
+	ctx echo.Context, action string, actor *ent.OrganizationMembership, memberProfileID int, metadata map[string]string,
+) error {
+	metadata["profile_id"] = strconv.Itoa(memberProfileID)
+	return c.ctr.Container.Audit.Record(ctx.Request().Context(), services.AuditEvent{
+		Action:     action,
+		TargetType: services.AuditTargetOrganization,
+		TargetID:   &actor.OrganizationID,
+		Metadata:   metadata,
+	})
+}
+
+func (c *organizationsRoute) sendInvitationEmail(
+	ctx echo.Context, inviter *ent.User, org *ent.Organization, inv *ent.OrganizationInvitation,
+) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Data = types.EmailOrganizationInvitationData{
+		AppName:          string(c.ctr.Container.Config.App.Name),
+		SupportEmail:     c.ctr.Container.Config.Mail.FromAddress,
+		Domain:           c.ctr.Container.Config.HTTP.Domain,
+		InviterName:      inviter.Name,
+		OrganizationName: org.Name,
+		Role:             inv.Role.String(),
+		AcceptLink: fmt.Sprintf(
+			"%s%s", c.ctr.Container.Config.HTTP.Domain,
+			ctx.Echo().Reverse(routeNames.RouteNameOrganizationInvitationAccept, inv.Token),
+		),
+		ExpirationInDays: c.ctr.Container.Config.App.OperationalConstants.InvitationExpirationInDays,
+	}
+
+	return c.ctr.Container.Mail.
+		Compose().
+		To(inv.Email).
+		Subject(fmt.Sprintf("%s invited you to join %s", inviter.Name, org.Name)).
+		TemplateLayout(layouts.Email).
+		Component(emails.OrganizationInvitation(&page)).
+		Send(ctx.Request().Context())
+}
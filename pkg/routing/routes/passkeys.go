@@ -0,0 +1,148 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+type (
+	passkeys struct {
+		ctr controller.Controller
+	}
+)
+
+func NewPasskeysRoute(ctr controller.Controller) passkeys {
+	return passkeys{
+		ctr: ctr,
+	}
+}
+
+// LoginBegin returns the WebAuthn options the browser needs to sign in with a passkey.
+func (c *passkeys) LoginBegin(ctx echo.Context) error {
+	assertion, err := c.ctr.Container.Auth.BeginPasskeyLogin(ctx)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to begin passkey login")
+	}
+	return ctx.JSON(http.StatusOK, assertion)
+}
+
+// LoginFinish verifies the passkey assertion and logs the user in. Passkeys already combine
+// possession of a device with a biometric or PIN check, so the TOTP step is skipped.
+func (c *passkeys) LoginFinish(ctx echo.Context) error {
+	usr, err := c.ctr.Container.Auth.FinishPasskeyLogin(ctx)
+	switch err.(type) {
+	case nil:
+	case services.InvalidPasskeyError:
+		return ctx.JSON(http.StatusUnauthorized, map[string]string{"error": "This passkey could not be verified. Please try again."})
+	default:
+		return c.ctr.Fail(err, "unable to finish passkey login")
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		switch e := err.(type) {
+		case services.AccountPendingDeletionError:
+			return ctx.JSON(http.StatusForbidden, map[string]string{"error": pendingDeletionMessage(e)})
+		case services.AccountSuspendedError:
+			return ctx.JSON(http.StatusForbidden, map[string]string{"error": suspendedAccountMessage})
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	return completeLogin(c.ctr, ctx, usr)
+}
+
+// Get renders the list of passkeys of the user.
+func (c *passkeys) Get(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	pks, err := c.ctr.Container.Auth.GetPasskeys(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load passkeys")
+	}
+
+	data := &types.PasskeysData{
+		Passkeys: make([]types.Passkey, len(pks)),
+	}
+	for i, pk := range pks {
+		data.Passkeys[i] = types.Passkey{
+			ID:         pk.ID,
+			Name:       pk.Name,
+			CreatedAt:  pk.CreatedAt,
+			LastUsedAt: pk.LastUsedAt,
+			Synced:     pk.BackupState,
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PagePasskeys
+	page.Title = "Passkeys"
+	page.Data = data
+	page.Component = pages.Passkeys(&page)
+	page.HTMX.Request.Boosted = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// RegisterBegin returns the WebAuthn options the browser needs to create a new passkey.
+func (c *passkeys) RegisterBegin(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	creation, err := c.ctr.Container.Auth.BeginPasskeyRegistration(ctx, usr)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to begin passkey registration")
+	}
+	return ctx.JSON(http.StatusOK, creation)
+}
+
+// RegisterFinish verifies the new credential and saves it. The name of the passkey is passed
+// as a query parameter since the body holds the credential.
+func (c *passkeys) RegisterFinish(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	_, err := c.ctr.Container.Auth.FinishPasskeyRegistration(ctx, usr, ctx.QueryParam("name"))
+	switch err.(type) {
+	case nil:
+	case services.InvalidPasskeyError:
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "This passkey could not be saved. Please try again."})
+	default:
+		return c.ctr.Fail(err, "unable to finish passkey registration")
+	}
+
+	msg.Success(ctx, "Your passkey was added.")
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "success"})
+}
+
+// Delete revokes a passkey of the user.
+func (c *passkeys) Delete(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	passkeyID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid passkey ID")
+	}
+
+	err = c.ctr.Container.Auth.DeletePasskey(ctx, usr.ID, passkeyID)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return echo.NewHTTPError(http.StatusNotFound, "passkey not found")
+	default:
+		return c.ctr.Fail(err, "unable to delete passkey")
+	}
+
+	msg.Success(ctx, "Your passkey was removed.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNamePasskeys)
+}
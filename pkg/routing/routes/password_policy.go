@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/mikestefanello/pagoda/pkg/controller"
+)
+
+// applyPasswordPolicy sets an error on the password field of a form submission for each rule of the
+// password policy the password breaks. Personal holds the name and email address of the user.
+func applyPasswordPolicy(ctr controller.Controller, submission *controller.FormSubmission, password string, personal ...string) error {
+	violations, err := ctr.Container.PasswordPolicy.Check(password, personal...)
+	if err != nil {
+		return err
+	}
+	for _, violation := range violations {
+		submission.SetFieldError("Password", violation)
+	}
+	return nil
+}
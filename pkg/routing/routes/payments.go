@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -9,11 +10,14 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/organizationmembership"
 	internalContext "github.com/mikestefanello/pagoda/pkg/context"
 	"github.com/mikestefanello/pagoda/pkg/controller"
 	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/organizations"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
 	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/types"
 	"github.com/mikestefanello/pagoda/templates"
 	"github.com/mikestefanello/pagoda/templates/layouts"
@@ -69,6 +73,15 @@ func (p *paymentsRoute) CreateCheckoutSession(ctx echo.Context) error {
 	successURL := ctx.Echo().Reverse(routeNames.RouteNamePaymentProcessorSuccess)
 	fullSuccessUrl := fmt.Sprintf("%s%s", p.ctr.Container.Config.HTTP.Domain, successURL)
 	cancelURL := ctx.Echo().Reverse(routeNames.RouteNamePreferences)
+
+	var membership *ent.OrganizationMembership
+	if form.ForOrganization {
+		var err error
+		if membership, err = billingMembership(ctx); err != nil {
+			return err
+		}
+		cancelURL = ctx.Echo().Reverse(routeNames.RouteNameOrganization, membership.OrganizationID)
+	}
 	fullCancelUrl := fmt.Sprintf("%s%s", p.ctr.Container.Config.HTTP.Domain, cancelURL)
 
 	usr := ctx.Get(internalContext.AuthenticatedUserKey).(*ent.User)
@@ -84,7 +97,12 @@ func (p *paymentsRoute) CreateCheckoutSession(ctx echo.Context) error {
 	}
 
 	// Store the Stripe customer ID in your database
-	err = p.subscriptionsRepo.StoreStripeCustomerID(ctx.Request().Context(), profile.ID, customer.ID)
+	if membership != nil {
+		err = p.subscriptionsRepo.StoreOrganizationStripeCustomerID(
+			ctx.Request().Context(), membership.OrganizationID, customer.ID)
+	} else {
+		err = p.subscriptionsRepo.StoreStripeCustomerID(ctx.Request().Context(), profile.ID, customer.ID)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to store Stripe customer ID"})
 	}
@@ -117,11 +135,22 @@ func (p *paymentsRoute) CreatePortalSession(ctx echo.Context) error {
 	profile := usr.QueryProfile().FirstX(ctx.Request().Context())
 
 	returnURL := ctx.Echo().Reverse(routeNames.RouteNamePreferences)
+	stripeID := profile.StripeID
+
+	// The billing of the organization the user is working in is managed instead when asked for
+	if ctx.FormValue("for_organization") == "true" {
+		membership, err := billingMembership(ctx)
+		if err != nil {
+			return err
+		}
+		returnURL = ctx.Echo().Reverse(routeNames.RouteNameOrganization, membership.OrganizationID)
+		stripeID = membership.Edges.Organization.StripeID
+	}
 	fullReturnsUrl := fmt.Sprintf("%s%s", p.ctr.Container.Config.HTTP.Domain, returnURL)
 
 	// Authenticate your user.
 	params := &stripe.BillingPortalSessionParams{
-		Customer:  stripe.String(profile.StripeID),
+		Customer:  stripe.String(stripeID),
 		ReturnURL: stripe.String(fullReturnsUrl),
 	}
 	ps, err := portalsession.New(params)
@@ -161,15 +190,15 @@ func (p *paymentsRoute) HandleWebhook(c echo.Context) error {
 		}
 		log.Info().Str("subscriptionID", subscription.ID).Msg("Subscription deleted")
 
-		profileID, err := p.subscriptionsRepo.GetProfileIDFromStripeCustomerID(
-			c.Request().Context(), subscription.Customer.ID)
+		payer, err := p.getPayer(c.Request().Context(), subscription.Customer.ID)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
-		err = p.subscriptionsRepo.CancelWithGracePeriod(c.Request().Context(), profileID)
+		err = p.cancelWithGracePeriod(c.Request().Context(), payer)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
+		p.recordSubscriptionChange(c, payer, string(event.Type), subscription.ID)
 
 	case "customer.subscription.updated":
 		var subscription stripe.Subscription
@@ -179,9 +208,8 @@ func (p *paymentsRoute) HandleWebhook(c echo.Context) error {
 			return echo.ErrBadRequest
 		}
 
-		// Get the profile ID from Stripe customer ID
-		profileID, err := p.subscriptionsRepo.GetProfileIDFromStripeCustomerID(
-			c.Request().Context(), subscription.Customer.ID)
+		// Get who is billed from Stripe customer ID
+		payer, err := p.getPayer(c.Request().Context(), subscription.Customer.ID)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
@@ -196,11 +224,16 @@ func (p *paymentsRoute) HandleWebhook(c echo.Context) error {
 			cancelDate = &t
 		}
 		// Call function to handle subscription cancellation
-		err = p.subscriptionsRepo.CancelOrRenew(c.Request().Context(), profileID, cancelDate)
+		if payer.organizationID != 0 {
+			err = p.subscriptionsRepo.CancelOrganizationOrRenew(c.Request().Context(), payer.organizationID, cancelDate)
+		} else {
+			err = p.subscriptionsRepo.CancelOrRenew(c.Request().Context(), payer.profileID, cancelDate)
+		}
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
 		log.Info().Str("subscriptionID", subscription.ID).Msg("Subscription updated")
+		p.recordSubscriptionChange(c, payer, string(event.Type), subscription.ID)
 
 	case "customer.subscription.created":
 		var subscription stripe.Subscription
@@ -211,15 +244,19 @@ func (p *paymentsRoute) HandleWebhook(c echo.Context) error {
 		}
 		log.Info().Str("subscriptionID", subscription.ID).Msg("Subscription created")
 		// Change to pro by default, alert customer if payment failed later
-		profileID, err := p.subscriptionsRepo.GetProfileIDFromStripeCustomerID(
-			c.Request().Context(), subscription.Customer.ID)
+		payer, err := p.getPayer(c.Request().Context(), subscription.Customer.ID)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
-		err = p.subscriptionsRepo.UpdateToPaidPro(c.Request().Context(), profileID)
+		if payer.organizationID != 0 {
+			err = p.subscriptionsRepo.UpdateOrganizationToPaidPro(c.Request().Context(), payer.organizationID)
+		} else {
+			err = p.subscriptionsRepo.UpdateToPaidPro(c.Request().Context(), payer.profileID)
+		}
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
+		p.recordSubscriptionChange(c, payer, string(event.Type), subscription.ID)
 		// TODO: Listen to "invoice.payment_succeeded" to set the "paid" field. Then, we can have a daily task to check
 		// with Stripe API to see if we missed payments on granted subscriptions that were not yet paid.
 
@@ -232,38 +269,56 @@ func (p *paymentsRoute) HandleWebhook(c echo.Context) error {
 			return echo.ErrBadRequest
 		}
 		log.Info().Str("invoiceID", invoice.ID).Msg("Invoice payment failed")
-		profileID, err := p.subscriptionsRepo.GetProfileIDFromStripeCustomerID(
-			c.Request().Context(), invoice.Customer.ID)
+		payer, err := p.getPayer(c.Request().Context(), invoice.Customer.ID)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
 
+		// Organizations have their owners notified
 		fullURL := c.Echo().Reverse(routeNames.RouteNamePreferences)
-		err = p.ctr.Container.Notifier.PublishNotification(
-			c.Request().Context(),
-			domain.Notification{
-				Type:      domain.NotificationTypePaymentFailed,
-				ProfileID: profileID,
-				Title:     "Payment issue",
-				Text: fmt.Sprintf(fmt.Sprintf("💸 Oh no, your payment didn’t go through 🙁. Update your payment info within %d days to ensure uninterrupted pro membership.",
-					p.ctr.Container.Config.App.OperationalConstants.PaymentFailedGracePeriodInDays)),
-				Link:                    fullURL,
-				ProfileIDWhoCausedNotif: profileID,
-			},
-			true, true,
-		)
-
-		if err != nil {
-			log.Error().Err(err).
-				Int("selfProfileID", profileID).
-				Str("type", domain.NotificationTypePaymentFailed.Value).
-				Msg("failed to create notification")
-
+		notifiedProfileIDs := []int{payer.profileID}
+		if payer.organizationID != 0 {
+			fullURL = c.Echo().Reverse(routeNames.RouteNameOrganization, payer.organizationID)
+			notifiedProfileIDs, err = p.orm.OrganizationMembership.
+				Query().
+				Where(
+					organizationmembership.OrganizationID(payer.organizationID),
+					organizationmembership.RoleEQ(organizationmembership.Role(domain.OrganizationRoleOwner.Value)),
+				).
+				Select(organizationmembership.FieldProfileID).
+				Ints(c.Request().Context())
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError)
+			}
+		}
+		for _, profileID := range notifiedProfileIDs {
+			err = p.ctr.Container.Notifier.PublishNotification(
+				c.Request().Context(),
+				domain.Notification{
+					Type:      domain.NotificationTypePaymentFailed,
+					ProfileID: profileID,
+					Title:     "Payment issue",
+					Text: fmt.Sprintf(fmt.Sprintf("💸 Oh no, your payment didn’t go through 🙁. Update your payment info within %d days to ensure uninterrupted pro membership.",
+						p.ctr.Container.Config.App.OperationalConstants.PaymentFailedGracePeriodInDays)),
+					Link:                    fullURL,
+					ProfileIDWhoCausedNotif: profileID,
+				},
+				true, true,
+			)
+
+			if err != nil {
+				log.Error().Err(err).
+					Int("selfProfileID", profileID).
+					Str("type", domain.NotificationTypePaymentFailed.Value).
+					Msg("failed to create notification")
+
+			}
 		}
-		err = p.subscriptionsRepo.CancelWithGracePeriod(c.Request().Context(), profileID)
+		err = p.cancelWithGracePeriod(c.Request().Context(), payer)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
+		p.recordSubscriptionChange(c, payer, string(event.Type), invoice.ID)
 
 	default:
 		log.Error().Str("eventType", string(event.Type)).Msg("Unhandled event type")
@@ -272,6 +327,73 @@ func (p *paymentsRoute) HandleWebhook(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "success"})
 }
 
+// stripePayer is who a Stripe customer is billing, either a profile or an organization
+type stripePayer struct {
+	profileID      int
+	organizationID int
+}
+
+// getPayer returns who a Stripe customer is billing. Profiles are looked up first, then organizations.
+func (p *paymentsRoute) getPayer(ctx context.Context, stripeCustomerID string) (stripePayer, error) {
+	profileID, err := p.subscriptionsRepo.GetProfileIDFromStripeCustomerID(ctx, stripeCustomerID)
+	if err == nil {
+		return stripePayer{profileID: profileID}, nil
+	}
+	if !ent.IsNotFound(err) {
+		return stripePayer{}, err
+	}
+
+	organizationID, err := p.subscriptionsRepo.GetOrganizationIDFromStripeCustomerID(ctx, stripeCustomerID)
+	if err != nil {
+		return stripePayer{}, err
+	}
+	return stripePayer{organizationID: organizationID}, nil
+}
+
+// cancelWithGracePeriod cancels the subscription of a payer after a grace period
+func (p *paymentsRoute) cancelWithGracePeriod(ctx context.Context, payer stripePayer) error {
+	if payer.organizationID != 0 {
+		return p.subscriptionsRepo.CancelOrganizationWithGracePeriod(ctx, payer.organizationID)
+	}
+	return p.subscriptionsRepo.CancelWithGracePeriod(ctx, payer.profileID)
+}
+
+// recordSubscriptionChange records in the audit log a change the payment processor made to the subscription
+// of a payer. Failing to do so is only logged, so the processor does not retry an applied change.
+func (p *paymentsRoute) recordSubscriptionChange(c echo.Context, payer stripePayer, eventType, objectID string) {
+	event := services.AuditEvent{
+		Action:     services.AuditActionSubscriptionChanged,
+		TargetType: services.AuditTargetProfile,
+		TargetID:   &payer.profileID,
+		Metadata: map[string]string{
+			"event":     eventType,
+			"object_id": objectID,
+		},
+	}
+	if payer.organizationID != 0 {
+		event.TargetType = services.AuditTargetOrganization
+		event.TargetID = &payer.organizationID
+	}
+	err := p.ctr.Container.Audit.Record(c.Request().Context(), event)
+	if err != nil {
+		log.Error().Err(err).
+			Int("profileID", payer.profileID).
+			Int("organizationID", payer.organizationID).
+			Str("eventType", eventType).
+			Msg("failed to record subscription change in audit log")
+	}
+}
+
+// billingMembership returns the membership of the user in the organization they are working in, which must
+// allow them to manage its billing
+func billingMembership(ctx echo.Context) (*ent.OrganizationMembership, error) {
+	membership, ok := ctx.Get(internalContext.OrganizationMembershipKey).(*ent.OrganizationMembership)
+	if !ok || !organizations.CanManageBilling(organizations.MembershipRole(membership)) {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "only owners can manage the billing of an organization")
+	}
+	return membership, nil
+}
+
 func (p *paymentsRoute) PricingPage(ctx echo.Context) error {
 	page := controller.NewPage(ctx)
 	page.Layout = layouts.Main
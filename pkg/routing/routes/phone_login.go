@@ -0,0 +1,344 @@
+package routes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/nyaruka/phonenumbers"
+)
+
+type (
+	phoneLogin struct {
+		ctr                            controller.Controller
+		smsSender                      *notifierrepo.SMSSender
+		subscriptionsRepo              subscriptions.SubscriptionsRepo
+		notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo
+	}
+)
+
+func NewPhoneLoginRoute(
+	ctr controller.Controller,
+	smsSender *notifierrepo.SMSSender,
+	subscriptionsRepo subscriptions.SubscriptionsRepo,
+	notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo,
+) phoneLogin {
+	return phoneLogin{
+		ctr:                            ctr,
+		smsSender:                      smsSender,
+		subscriptionsRepo:              subscriptionsRepo,
+		notificationSendPermissionRepo: notificationSendPermissionRepo,
+	}
+}
+
+// Get renders the form to request a sign-in code by SMS
+func (c *phoneLogin) Get(ctx echo.Context) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PagePhoneLogin
+	page.Title = "Sign in with your phone"
+	page.Form = &types.PhoneLoginForm{}
+	page.Component = pages.PhoneLogin(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.PhoneLoginForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Post texts a sign-in code to a phone number. A code is sent whether or not an account has the number, since
+// it is also how one registers with it.
+func (c *phoneLogin) Post(ctx echo.Context) error {
+	var form types.PhoneLoginForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse phone login form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	phoneNumber, ok := parsePhoneNumber(form.PhoneNumber)
+	if !ok {
+		form.Submission.SetFieldError("PhoneNumber", "Enter a valid phone number, starting with + and your country code.")
+		return c.Get(ctx)
+	}
+
+	_, err := c.smsSender.CreateLoginCode(ctx.Request().Context(), phoneNumber, requestLocale(ctx))
+	switch e := err.(type) {
+	case nil:
+		msg.Info(ctx, "A sign-in code was sent to your phone.")
+	case notifierrepo.CodeCooldownError:
+		// The code sent moments ago still works
+		msg.Warning(ctx, fmt.Sprintf("A code was sent recently. You can request another one in %s.", formatWait(e.RetryIn)))
+	default:
+		if err == notifierrepo.ErrDailyCodeCapReached {
+			form.Submission.SetFieldError("PhoneNumber", "Too many codes were sent to this number today. Please try again tomorrow.")
+			return c.Get(ctx)
+		}
+		ctx.Logger().Errorf("unable to send sign-in code: %v", err)
+		msg.Danger(ctx, "The code could not be sent. Please check your number and try again.")
+		return c.Get(ctx)
+	}
+
+	ctx.Set(context.FormKey, nil)
+	return c.renderCode(ctx, &types.PhoneLoginCodeForm{PhoneNumber: phoneNumber})
+}
+
+// Verify checks the code entered, then signs in the account with this number or starts registering one
+func (c *phoneLogin) Verify(ctx echo.Context) error {
+	var form types.PhoneLoginCodeForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse phone login code form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	phoneNumber, ok := parsePhoneNumber(form.PhoneNumber)
+	if !ok {
+		return c.ctr.Redirect(ctx, routeNames.RouteNamePhoneLogin)
+	}
+	form.PhoneNumber = phoneNumber
+
+	if form.Submission.HasErrors() {
+		return c.renderCode(ctx, &form)
+	}
+
+	// Codes have their own attempt limit, guessing across many numbers is throttled per IP
+	throttleKeys := []services.ThrottleKey{services.ThrottleKeyIP(ctx.RealIP())}
+	if err := c.ctr.Container.Throttler.Check(ctx.Request().Context(), throttleKeys...); err != nil {
+		if !showThrottledMessage(ctx, err) {
+			return c.ctr.Fail(err, "unable to check phone login throttle")
+		}
+		return c.renderCode(ctx, &form)
+	}
+
+	err := c.smsSender.VerifyLoginCode(ctx.Request().Context(), phoneNumber, form.Code)
+	switch err {
+	case nil:
+	case notifierrepo.ErrInvalidCode, notifierrepo.ErrTooManyCodeAttempts:
+		if err := recordFailedAttempt(c.ctr, ctx, nil, services.AuditActionPhoneLoginFailed, throttleKeys...); err != nil {
+			return c.ctr.Fail(err, "unable to record failed phone login attempt")
+		}
+		if err == notifierrepo.ErrTooManyCodeAttempts {
+			form.Submission.SetFieldError("Code", "Too many wrong attempts. Please request a new code.")
+		} else {
+			form.Submission.SetFieldError("Code", "This code is invalid or has expired.")
+		}
+		return c.renderCode(ctx, &form)
+	default:
+		return c.ctr.Fail(err, "unable to verify sign-in code")
+	}
+
+	usr, err := c.ctr.Container.Auth.FindUserByPhoneNumber(ctx.Request().Context(), phoneNumber)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		if !c.ctr.Container.Config.App.OperationalConstants.UserSignupEnabled {
+			msg.Warning(ctx, "No account uses this phone number, and sign ups are currently closed.")
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		if err := c.ctr.Container.Auth.SetPendingPhoneRegistration(ctx, phoneNumber); err != nil {
+			return c.ctr.Fail(err, "unable to start phone registration")
+		}
+		return c.ctr.Redirect(ctx, routeNames.RouteNamePhoneRegister)
+	default:
+		return c.ctr.Fail(err, "unable to find user of phone number")
+	}
+
+	// Owning the phone number is a single factor, two-factor authentication still applies
+	twoFactorEnabled, err := c.ctr.Container.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to check two-factor status")
+	}
+	if twoFactorEnabled {
+		if err := c.ctr.Container.Auth.SetPendingTwoFactorLogin(ctx, usr.ID); err != nil {
+			return c.ctr.Fail(err, "unable to start two-factor login")
+		}
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLoginTwoFactor)
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	return completeLogin(c.ctr, ctx, usr)
+}
+
+// RegisterGet renders the form completing the account of a phone number which was just proven owned
+func (c *phoneLogin) RegisterGet(ctx echo.Context) error {
+	phoneNumber, err := c.ctr.Container.Auth.GetPendingPhoneRegistration(ctx)
+	switch err.(type) {
+	case nil:
+	case services.NoPendingPhoneRegistrationError:
+		msg.Warning(ctx, "Please confirm your phone number again to register with it.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNamePhoneLogin)
+	default:
+		return c.ctr.Fail(err, "unable to get pending phone registration")
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PagePhoneRegister
+	page.Title = "Register"
+	page.Form = &types.PhoneRegisterForm{}
+	page.Data = &types.PhoneRegisterData{
+		PhoneNumberInternational: formatPhoneNumber(phoneNumber),
+	}
+	page.Component = pages.PhoneRegister(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.PhoneRegisterForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// RegisterPost creates an account with the phone number already verified
+func (c *phoneLogin) RegisterPost(ctx echo.Context) error {
+	phoneNumber, err := c.ctr.Container.Auth.GetPendingPhoneRegistration(ctx)
+	if err != nil {
+		return c.RegisterGet(ctx)
+	}
+
+	var form types.PhoneRegisterForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse phone register form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.RegisterGet(ctx)
+	}
+
+	// Phone users do not have a password, a random one is set which they can later reset
+	password, err := c.ctr.Container.Auth.RandomToken(32)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to generate password")
+	}
+	pwHash, err := c.ctr.Container.Auth.HashPassword(password)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to hash password")
+	}
+
+	usr, err := createAccount(ctx, c.ctr, c.subscriptionsRepo, c.notificationSendPermissionRepo, accountDetails{
+		name:         form.Name,
+		email:        strings.ToLower(form.Email),
+		passwordHash: pwHash,
+	})
+	switch err.(type) {
+	case nil:
+	case *ent.ConstraintError:
+		form.Submission.SetFieldError("Email", "An account already uses this email address. Sign in with it, then add your phone number in your settings.")
+		return c.RegisterGet(ctx)
+	default:
+		return c.ctr.Fail(err, "unable to create account of phone registration")
+	}
+
+	// The phone number was verified, the email address still has to be
+	sendVerificationEmail(ctx, c.ctr, usr)
+
+	err = c.ctr.Container.ORM.Profile.
+		Update().
+		Where(profile.HasUserWith(user.ID(usr.ID))).
+		SetPhoneNumberE164(phoneNumber).
+		SetCountryCode(phoneNumberRegion(phoneNumber)).
+		SetPhoneVerified(true).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to save phone number of new account")
+	}
+
+	if err := c.ctr.Container.Auth.ClearPendingPhoneRegistration(ctx); err != nil {
+		return c.ctr.Fail(err, "unable to clear pending phone registration")
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	msg.Success(ctx, "Your account has been created. You are now logged in. 👌")
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+}
+
+func (c *phoneLogin) renderCode(ctx echo.Context, form *types.PhoneLoginCodeForm) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PagePhoneLoginCode
+	page.Title = "Enter your code"
+	page.Form = form
+	page.Data = &types.PhoneLoginCodeData{
+		PhoneNumberInternational: formatPhoneNumber(form.PhoneNumber),
+		ExpirationInMinutes:      c.ctr.Container.Config.Phone.ValidationCodeExpirationMinutes,
+	}
+	page.Component = pages.PhoneLoginCode(&page)
+	page.HTMX.Request.Boosted = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// parsePhoneNumber parses a phone number entered in international format, returning it in E164 format
+func parsePhoneNumber(input string) (string, bool) {
+	num, err := phonenumbers.Parse(input, "")
+	if err != nil || !phonenumbers.IsValidNumber(num) {
+		return "", false
+	}
+	return phonenumbers.Format(num, phonenumbers.E164), true
+}
+
+// formatPhoneNumber formats a phone number in E164 format for display
+func formatPhoneNumber(e164 string) string {
+	num, err := phonenumbers.Parse(e164, "")
+	if err != nil {
+		return e164
+	}
+	return phonenumbers.Format(num, phonenumbers.INTERNATIONAL)
+}
+
+// phoneNumberRegion returns the country of a phone number in E164 format, as an ISO 3166-1 alpha-2 code
+func phoneNumberRegion(e164 string) string {
+	num, err := phonenumbers.Parse(e164, "")
+	if err != nil {
+		return ""
+	}
+	return phonenumbers.GetRegionCodeForNumber(num)
+}
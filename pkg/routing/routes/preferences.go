@@ -265,10 +265,32 @@ func (p *preferences) GetPhoneComponent(ctx echo.Context) error {
 	return p.ctr.RenderPage(ctx, page)
 }
 
+// GetPhoneVerificationComponent texts a code to the phone number of the profile and renders the field to enter it
 func (p *preferences) GetPhoneVerificationComponent(ctx echo.Context) error {
 	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
 	profile := usr.QueryProfile().FirstX(ctx.Request().Context())
 
+	_, err := p.smsSenderRepo.CreateConfirmationCode(
+		ctx.Request().Context(), profile.ID, profile.PhoneNumberE164, requestLocale(ctx))
+	switch e := err.(type) {
+	case nil:
+	case notifierrepo.CodeCooldownError:
+		// The code sent moments ago still works
+		msg.Warning(ctx, fmt.Sprintf("A code was sent recently. You can request another one in %s.", formatWait(e.RetryIn)))
+	default:
+		if err == notifierrepo.ErrDailyCodeCapReached {
+			msg.Danger(ctx, "Too many codes were sent to this number today. Please try again tomorrow.")
+			break
+		}
+		log.Error().Err(err).Msg("failed to send verification code.")
+		msg.Danger(ctx, "Failed to send verification code 😨")
+	}
+
+	return p.renderPhoneVerification(ctx)
+}
+
+// renderPhoneVerification renders the field to enter the code texted to the profile, without sending a new one
+func (p *preferences) renderPhoneVerification(ctx echo.Context) error {
 	page := controller.NewPage(ctx)
 	page.Layout = layouts.Main
 	page.Name = templates.PagePhoneNumber
@@ -282,13 +304,6 @@ func (p *preferences) GetPhoneVerificationComponent(ctx echo.Context) error {
 		page.Form = form.(*types.PhoneNumberVerification)
 	}
 
-	_, err := p.smsSenderRepo.CreateConfirmationCode(ctx.Request().Context(), profile.ID, profile.PhoneNumberE164)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to send verification code.")
-		msg.Danger(ctx, "Failed to send verification code 😨")
-		return p.ctr.RenderPage(ctx, page)
-	}
-
 	return p.ctr.RenderPage(ctx, page)
 }
 
@@ -307,35 +322,84 @@ func (p *preferences) SubmitPhoneVerificationCode(ctx echo.Context) error {
 	}
 
 	if form.Submission.HasErrors() {
-		return p.GetPhoneVerificationComponent(ctx)
+		return p.renderPhoneVerification(ctx)
 	}
 
 	if form.VerificationCode == "" {
 		form.Submission.SetFieldError("VerificationCode", "Invalid code")
 		msg.Danger(ctx, "Invalid code. Please try again.")
-		return p.GetPhoneVerificationComponent(ctx)
+		return p.renderPhoneVerification(ctx)
 	}
 
 	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
-	profile := usr.QueryProfile().FirstX(ctx.Request().Context())
+	prof := usr.QueryProfile().FirstX(ctx.Request().Context())
 
-	valid, err := p.smsSenderRepo.VerifyConfirmationCode(ctx.Request().Context(), profile.ID, form.VerificationCode)
-	if err != nil || !valid {
+	// Codes are short, guessing them is throttled per profile and IP
+	throttleKeys := []services.ThrottleKey{
+		services.ThrottleKeyProfile(prof.ID),
+		services.ThrottleKeyIP(ctx.RealIP()),
+	}
+	if err := p.ctr.Container.Throttler.Check(ctx.Request().Context(), throttleKeys...); err != nil {
+		if !showThrottledMessage(ctx, err) {
+			return p.ctr.Fail(err, "unable to check verification throttle")
+		}
+		return p.renderPhoneVerification(ctx)
+	}
 
+	valid, err := p.smsSenderRepo.VerifyConfirmationCode(
+		ctx.Request().Context(), prof.ID, prof.PhoneNumberE164, form.VerificationCode,
+	)
+	if err != nil || !valid {
+		if err := recordFailedAttempt(p.ctr, ctx, usr, services.AuditActionPhoneVerificationFailed, throttleKeys...); err != nil {
+			return p.ctr.Fail(err, "unable to record failed verification attempt")
+		}
+
+		if err == notifierrepo.ErrTooManyCodeAttempts {
+			form.Submission.SetFieldError("VerificationCode", "Too many wrong attempts")
+			msg.Danger(ctx, "Too many wrong attempts. Please request a new code.")
+			return p.renderPhoneVerification(ctx)
+		}
 		form.Submission.SetFieldError("VerificationCode", "Invalid code")
 		msg.Danger(ctx, "Invalid code. Please try again.")
-		return p.GetPhoneVerificationComponent(ctx)
+		return p.renderPhoneVerification(ctx)
+	}
+
+	// A number can only be signed in with by a single account, the one which verified it last
+	err = p.ctr.Container.ORM.Profile.
+		Update().
+		Where(
+			profile.PhoneNumberE164(prof.PhoneNumberE164),
+			profile.IDNEQ(prof.ID),
+		).
+		SetPhoneVerified(false).
+		Exec(ctx.Request().Context())
+	if err == nil {
+		err = p.ctr.Container.ORM.Profile.
+			UpdateOneID(prof.ID).
+			SetPhoneVerified(true).
+			Exec(ctx.Request().Context())
+	}
+	if err != nil {
+		return p.ctr.Fail(err, "unable to mark phone number as verified")
 	}
 
-	page := controller.NewPage(ctx)
-	page.Layout = layouts.Main
-	page.Name = templates.PagePhoneNumber
-	page.Form = &types.PhoneNumberVerification{}
-	page.Component = pages.PhoneVerificationField(&page)
+	if err := p.ctr.Container.Throttler.Reset(ctx.Request().Context(), throttleKeys[0]); err != nil {
+		return p.ctr.Fail(err, "unable to reset verification throttle")
+	}
+
+	err = p.ctr.Container.Audit.Record(ctx.Request().Context(), services.AuditEvent{
+		Action:     services.AuditActionPhoneVerified,
+		TargetType: services.AuditTargetProfile,
+		TargetID:   &prof.ID,
+	})
+	if err != nil {
+		return p.ctr.Fail(err, "unable to record phone verification")
+	}
 
+	ctx.Set(context.FormKey, nil)
 	msg.Success(ctx, "Success! Your phone number was confirmed.")
 
-	return p.GetPhoneVerificationComponent(ctx)
+	return p.renderPhoneVerification(ctx)
 }
 
 type phoneNumberFormData struct {
@@ -364,11 +428,20 @@ func (p *preferences) SavePhoneInfo(ctx echo.Context) error {
 	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
 	profile := usr.QueryProfile().FirstX(ctx.Request().Context())
 
-	_, err := p.ctr.Container.ORM.Profile.
+	update := p.ctr.Container.ORM.Profile.
 		UpdateOneID(profile.ID).
 		SetCountryCode(phoneNumberFormData.CountryCode).
-		SetPhoneNumberE164(phoneNumberFormData.PhoneNumberE164Format).
-		Save(ctx.Request().Context())
+		SetPhoneNumberE164(phoneNumberFormData.PhoneNumberE164Format)
+	// A new number has to be verified again before it can be signed in with, and codes sent to the previous
+	// one must not confirm it
+	if phoneNumberFormData.PhoneNumberE164Format != profile.PhoneNumberE164 {
+		update.SetPhoneVerified(false)
+
+		if err := p.smsSenderRepo.CancelConfirmationCodes(ctx.Request().Context(), profile.ID); err != nil {
+			return p.ctr.Fail(err, "unable to cancel pending phone verification codes")
+		}
+	}
+	_, err := update.Save(ctx.Request().Context())
 
 	return err
 }
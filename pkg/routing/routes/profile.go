@@ -2,6 +2,7 @@ package routes
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/mikestefanello/pagoda/ent"
@@ -51,12 +52,21 @@ func (c *singleProfile) Get(ctx echo.Context) error {
 		FirstX(ctx.Request().Context()).ID
 
 	var profileData *domain.Profile
+	var friendship *domain.Friendship
 	otherProfileIdStr := ctx.QueryParam(PROFILE_ID_QUERY_PARAM)
 	if otherProfileIdStr != "" {
 		otherProfileID, err = strconv.Atoi(otherProfileIdStr)
 		if err != nil {
 			return err
 		}
+		friendship, err = c.profileRepo.GetFriendship(ctx.Request().Context(), selfProfileID, otherProfileID)
+		if err != nil {
+			return err
+		}
+		// Profiles are hidden from whom they blocked
+		if friendship.Status == domain.FriendshipStatusBlockedBy {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
 		profileData, err = c.profileRepo.GetProfileByID(
 			ctx.Request().Context(), otherProfileID, &selfProfileID,
 		)
@@ -99,6 +109,7 @@ func (c *singleProfile) Get(ctx echo.Context) error {
 		UploadGalleryPicUrl: uploadGalleryPicUrl,
 		UploadProfilePicUrl: uploadProfilePicUrl,
 		GalleryPicsMaxCount: galleryPicsMaxCount,
+		Friendship:          friendship,
 	}
 
 	page.Data = data
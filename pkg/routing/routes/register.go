@@ -12,7 +12,9 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/repos/msg"
 	"github.com/mikestefanello/pagoda/pkg/routing/routenames"
 	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
 
+	"github.com/mikestefanello/pagoda/pkg/repos/invitations"
 	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
@@ -32,6 +34,7 @@ type (
 		profileRepo                    profilerepo.ProfileRepo
 		subscriptionsRepo              subscriptions.SubscriptionsRepo
 		notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo
+		invitationsRepo                *invitations.InvitationsRepo
 	}
 )
 
@@ -40,12 +43,14 @@ func NewRegisterRoute(
 	profileRepo profilerepo.ProfileRepo,
 	subscriptionsRepo subscriptions.SubscriptionsRepo,
 	notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo,
+	invitationsRepo *invitations.InvitationsRepo,
 ) register {
 	return register{
 		ctr:                            ctr,
 		profileRepo:                    profileRepo,
 		subscriptionsRepo:              subscriptionsRepo,
 		notificationSendPermissionRepo: notificationSendPermissionRepo,
+		invitationsRepo:                invitationsRepo,
 	}
 }
 
@@ -57,7 +62,7 @@ func (c *register) Get(ctx echo.Context) error {
 	page.Name = templates.PageRegister
 	page.Component = pages.Register(&page)
 	page.Title = "Register"
-	page.Form = &types.RegisterForm{}
+	page.Form = &types.RegisterForm{InvitationCode: ctx.QueryParam(invitationQueryParam)}
 
 	// Get the current time
 	currentTime := time.Now()
@@ -70,12 +75,17 @@ func (c *register) Get(ctx echo.Context) error {
 		UserSignupEnabled:  c.ctr.Container.Config.App.OperationalConstants.UserSignupEnabled,
 		RelationshipStatus: mode,
 		MinDate:            formattedDate,
+		OAuthProviders:     oauthProviders(c.ctr),
 	}
 	if form := ctx.Get(context.FormKey); form != nil {
 		page.Form = form.(*types.RegisterForm)
 	}
 	page.HTMX.Request.Boosted = true
 
+	if err := addBotChallenge(c.ctr, &page, botFormRegister); err != nil {
+		return c.ctr.Fail(err, "unable to create bot challenge")
+	}
+
 	return c.ctr.RenderPage(ctx, page)
 }
 
@@ -92,9 +102,22 @@ func (c *register) Post(ctx echo.Context) error {
 		return c.ctr.Fail(err, "unable to process form submission")
 	}
 
+	if err := applyBotDefense(ctx, c.ctr, &form.Submission, botFormRegister, form.BotDefense); err != nil {
+		return c.ctr.Fail(err, "unable to check for bots")
+	}
+
 	if form.Submission.HasErrors() {
 		return c.Get(ctx)
 	}
+
+	if err := applyPasswordPolicy(c.ctr, &form.Submission, form.Password, form.Name, form.Email); err != nil {
+		return c.ctr.Fail(err, "unable to check password policy")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
 	// Hash the password
 	pwHash, err := c.ctr.Container.Auth.HashPassword(form.Password)
 	if err != nil {
@@ -118,113 +141,164 @@ func (c *register) Post(ctx echo.Context) error {
 		return c.Get(ctx) // Re-render the register page with the warning message
 	}
 
+	u, err := createAccount(ctx, c.ctr, c.subscriptionsRepo, c.notificationSendPermissionRepo, accountDetails{
+		name:         form.Name,
+		email:        form.Email,
+		passwordHash: pwHash,
+		birthdate:    &birthdate,
+	})
+
+	switch err.(type) {
+	case nil:
+	case *ent.ConstraintError:
+		msg.Warning(ctx, "A user with this email address already exists. Please log in.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	default:
+		ctx.Logger().Errorf("failed to create account: %v", err)
+		msg.Info(ctx, "unable to create user")
+		return c.ctr.Redirect(ctx, routenames.RouteNameLogin)
+	}
+
+	// Log the user in
+	err = c.ctr.Container.Auth.Login(ctx, u.ID)
+	if err != nil {
+		ctx.Logger().Errorf("unable to log in: %v", err)
+		msg.Info(ctx, "Your account has been created.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	}
+
+	msg.Success(ctx, "Your account has been created. You are now logged in. 👌")
+
+	if form.InvitationCode != "" {
+		c.acceptInvitation(ctx, u, form.InvitationCode)
+	}
+
+	// Send the verification email
+	sendVerificationEmail(ctx, c.ctr, u)
+
+	redirect, err := redirectAfterLogin(ctx)
+	if err != nil {
+		return err
+	}
+	if redirect {
+		return nil
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+}
+
+// accountDetails holds what is needed to create a new account
+type accountDetails struct {
+	name         string
+	email        string
+	passwordHash string
+	verified     bool
+
+	// birthdate is optional, e.g. social login providers do not share it
+	birthdate *time.Time
+
+	// identity, when set, is linked to the new user
+	identity *services.OAuthIdentity
+}
+
+// createAccount creates a user along with their profile, trial subscription and default
+// notification permissions.
+func createAccount(
+	ctx echo.Context,
+	ctr controller.Controller,
+	subscriptionsRepo subscriptions.SubscriptionsRepo,
+	notificationSendPermissionRepo *notifierrepo.NotificationSendPermissionRepo,
+	details accountDetails,
+) (*ent.User, error) {
 	// Start a transaction
-	tx, err := c.ctr.Container.ORM.Tx(ctx.Request().Context())
+	tx, err := ctr.Container.ORM.Tx(ctx.Request().Context())
 	if err != nil {
-		return c.ctr.Fail(err, "failed to start transaction")
+		return nil, err
 	}
 
 	// Attempt creating the user
 	u, err := tx.User.
 		Create().
-		SetName(form.Name).
-		SetEmail(form.Email).
-		SetPassword(pwHash).
+		SetName(details.name).
+		SetEmail(details.email).
+		SetPassword(details.passwordHash).
+		SetVerified(details.verified).
 		Save(ctx.Request().Context())
-
 	if err != nil {
 		tx.Rollback()
-		switch err.(type) {
-		case *ent.ConstraintError:
-			msg.Warning(ctx, "A user with this email address already exists. Please log in.")
-			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
-		default:
-			return c.ctr.Fail(err, "unable to create user")
+		return nil, err
+	}
+
+	if details.identity != nil {
+		err = ctr.Container.OAuth.LinkIdentity(ctx.Request().Context(), tx.Identity, u.ID, details.identity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
 		}
 	}
 
-	profile, err := tx.Profile.
+	profileCreate := tx.Profile.
 		Create().
 		SetUser(u).
-		SetBio(domain.DefaultBio).
-		SetBirthdate(birthdate).
-		SetAge(profilerepo.CalculateAge(birthdate)).
-		Save(ctx.Request().Context())
+		SetBio(domain.DefaultBio)
+	if details.birthdate != nil {
+		profileCreate.
+			SetBirthdate(*details.birthdate).
+			SetAge(profilerepo.CalculateAge(*details.birthdate))
+	}
 
+	profile, err := profileCreate.Save(ctx.Request().Context())
 	if err != nil {
 		tx.Rollback()
-		ctx.Logger().Errorf("failed to create profile: %v", err)
-		msg.Info(ctx, "unable to create user")
-		return c.ctr.Redirect(ctx, routenames.RouteNameLogin)
+		return nil, fmt.Errorf("failed to create profile: %w", err)
 	}
 
-	err = c.subscriptionsRepo.CreateSubscription(ctx.Request().Context(), tx, profile.ID)
+	err = subscriptionsRepo.CreateSubscription(ctx.Request().Context(), tx, profile.ID)
 	if err != nil {
 		tx.Rollback()
-		ctx.Logger().Errorf("failed to create trial pro subscription for profile: %v", err)
+		return nil, fmt.Errorf("failed to create trial pro subscription for profile: %w", err)
 	}
 
 	// If all operations were successful, commit the transaction
-	err = tx.Commit()
-	if err != nil {
-		return c.ctr.Fail(err, "failed to commit transaction")
+	if err = tx.Commit(); err != nil {
+		return nil, err
 	}
 
 	ctx.Logger().Infof("user and profile created successfully: %s", u.Name)
 
 	for _, perm := range domain.NotificationPermissions.Members() {
-		err := c.notificationSendPermissionRepo.CreatePermission(
+		err := notificationSendPermissionRepo.CreatePermission(
 			ctx.Request().Context(), profile.ID, perm, &domain.NotificationPlatformEmail)
 		if err != nil {
 			log.Error().Err(err).Int("profileID", profile.ID).Msg("failed to create notification permission")
 		}
 	}
 
-	// Log the user in
-	err = c.ctr.Container.Auth.Login(ctx, u.ID)
-	if err != nil {
-		ctx.Logger().Errorf("unable to log in: %v", err)
-		msg.Info(ctx, "Your account has been created.")
-		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
-	}
-
-	msg.Success(ctx, "Your account has been created. You are now logged in. 👌")
-
-	// Send the verification email
-	c.sendVerificationEmail(ctx, u)
-
-	redirect, err := redirectAfterLogin(ctx)
-	if err != nil {
-		return err
-	}
-	if redirect {
-		return nil
-	}
-
-	return c.ctr.Redirect(ctx, routeNames.RouteNamePreferences)
+	return u, nil
 }
 
-func (c *register) sendVerificationEmail(ctx echo.Context, usr *ent.User) {
+// sendVerificationEmail emails a new user the link confirming they own their email address
+func sendVerificationEmail(ctx echo.Context, ctr controller.Controller, usr *ent.User) {
 	// Generate a token
-	token, err := c.ctr.Container.Auth.GenerateEmailVerificationToken(usr.Email)
+	token, err := ctr.Container.Auth.GenerateEmailVerificationToken(usr.Email)
 	if err != nil {
 		ctx.Logger().Errorf("unable to generate email verification token: %v", err)
 		return
 	}
 
 	url := ctx.Echo().Reverse(routeNames.RouteNameVerifyEmail, token)
-	fullUrl := fmt.Sprintf("%s%s", c.ctr.Container.Config.HTTP.Domain, url)
+	fullUrl := fmt.Sprintf("%s%s", ctr.Container.Config.HTTP.Domain, url)
 
 	page := controller.NewPage(ctx)
 	page.Layout = layouts.Main
 	page.Data = types.EmailDefaultData{
-		AppName:          string(c.ctr.Container.Config.App.Name),
+		AppName:          string(ctr.Container.Config.App.Name),
 		ConfirmationLink: fullUrl,
-		SupportEmail:     c.ctr.Container.Config.Mail.FromAddress,
-		Domain:           c.ctr.Container.Config.HTTP.Domain,
+		SupportEmail:     ctr.Container.Config.Mail.FromAddress,
+		Domain:           ctr.Container.Config.HTTP.Domain,
 	}
 
-	err = c.ctr.Container.Mail.
+	err = ctr.Container.Mail.
 		Compose().
 		To(usr.Email).
 		Subject("Confirm your email address").
@@ -239,3 +313,18 @@ func (c *register) sendVerificationEmail(ctx echo.Context, usr *ent.User) {
 
 	msg.Info(ctx, "An email was sent to you to verify your email address.")
 }
+
+// acceptInvitation accepts the invitation a new user signed up with. The account is created either way,
+// so failing to accept the invitation is only reported to the user.
+func (c *register) acceptInvitation(ctx echo.Context, usr *ent.User, code string) {
+	inv, err := acceptInvitation(ctx, c.ctr, c.invitationsRepo, usr, code)
+	switch err {
+	case nil:
+		msg.Success(ctx, fmt.Sprintf("You are now friends with %s. 🎉", inviterName(inv)))
+	case invitations.ErrInvitationNotFound, invitations.ErrInvitationExpired:
+		msg.Warning(ctx, "Your invitation could not be accepted, it was either already used or has expired.")
+	default:
+		ctx.Logger().Errorf("unable to accept invitation: %v", err)
+		msg.Warning(ctx, "Your invitation could not be accepted.")
+	}
+}
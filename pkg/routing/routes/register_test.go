@@ -7,6 +7,7 @@ import (
 
 	"github.com/mikestefanello/pagoda/pkg/routing/routenames"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // func TestRegisterUser(t *testing.T) {
@@ -63,6 +64,11 @@ func TestRegisterUserFieldValidation(t *testing.T) {
 			// Remove the field being tested
 			formData.Del(tc.omitField)
 
+			// Pass the bot checks
+			challenge, err := c.BotDefense.NewChallenge(botFormRegister)
+			require.NoError(t, err)
+			formData.Set("bot_token", challenge.Token)
+
 			// Perform the HTTP POST request
 			postReq := request(t).setRoute(routenames.RouteNameRegister).setBody(formData)
 			response := postReq.post()
@@ -95,3 +101,24 @@ func TestRegisterUserFieldValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterBotDefense(t *testing.T) {
+	challenge, err := c.BotDefense.NewChallenge(botFormRegister)
+	require.NoError(t, err)
+
+	formData := url.Values{
+		"name":      []string{"John Doe"},
+		"email":     []string{"bot@example.com"},
+		"password":  []string{"12345678"},
+		"birthdate": []string{"2000-11-11"},
+		"bot_token": []string{challenge.Token},
+		// Bots fill every field, including the one hidden from people
+		"website": []string{"https://spam.example.com"},
+	}
+
+	response := request(t).setRoute(routenames.RouteNameRegister).setBody(formData).post()
+	response.assertStatusCode(http.StatusOK)
+
+	alerts := response.toDoc().Find("div[role='alert']").Text()
+	assert.Contains(t, alerts, "We could not verify that you are a person.")
+}
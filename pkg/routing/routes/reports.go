@@ -0,0 +1,108 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/report"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/moderation"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+type (
+	reportsRoute struct {
+		ctr            controller.Controller
+		moderationRepo *moderation.ModerationRepo
+	}
+)
+
+func NewReportsRoute(ctr controller.Controller, moderationRepo *moderation.ModerationRepo) reportsRoute {
+	return reportsRoute{
+		ctr:            ctr,
+		moderationRepo: moderationRepo,
+	}
+}
+
+// Get shows the form to report the profile or photo of the query parameters.
+func (c *reportsRoute) Get(ctx echo.Context) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageReport
+	page.Title = "Report"
+	page.Data = &types.ReportData{
+		Reasons: types.ReportReasons,
+	}
+	page.Component = pages.Report(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.ReportForm)
+	} else {
+		targetID, _ := strconv.Atoi(ctx.QueryParam("target_id"))
+		page.Form = &types.ReportForm{
+			TargetType: ctx.QueryParam("target_type"),
+			TargetID:   targetID,
+		}
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// Create files a report, which waits in the moderation queue until a moderator acts on it.
+func (c *reportsRoute) Create(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.ReportForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse report form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
+	profileID, err := usr.QueryProfile().OnlyID(ctx.Request().Context())
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load profile")
+	}
+
+	rep, err := c.moderationRepo.CreateReport(ctx.Request().Context(), profileID, moderation.ReportInput{
+		TargetType: report.TargetType(form.TargetType),
+		TargetID:   form.TargetID,
+		Reason:     report.Reason(form.Reason),
+		Details:    form.Details,
+	})
+	switch err {
+	case nil:
+		msg.Success(ctx, "Thank you, our moderators will review your report.")
+	case moderation.ErrAlreadyReported:
+		msg.Info(ctx, "You already reported this, our moderators will review it soon.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
+	case moderation.ErrOwnContent:
+		msg.Warning(ctx, "You cannot report your own profile or photos.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameHomeFeed)
+	case moderation.ErrReportTargetNotFound:
+		return echo.NewHTTPError(http.StatusNotFound)
+	default:
+		return c.ctr.Fail(err, "unable to create report")
+	}
+
+	return c.ctr.RedirectWithDetails(
+		ctx, routeNames.RouteNameProfile, "?"+PROFILE_ID_QUERY_PARAM+"="+strconv.Itoa(rep.Edges.Reported.ID), http.StatusFound,
+	)
+}
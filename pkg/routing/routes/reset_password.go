@@ -59,15 +59,23 @@ func (c *resetPassword) Post(ctx echo.Context) error {
 		return c.Get(ctx)
 	}
 
+	// Get the requesting user
+	usr := ctx.Get(context.UserKey).(*ent.User)
+
+	if err := applyPasswordPolicy(c.ctr, &form.Submission, form.Password, usr.Name, usr.Email); err != nil {
+		return c.ctr.Fail(err, "unable to check password policy")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Get(ctx)
+	}
+
 	// Hash the new password
 	hash, err := c.ctr.Container.Auth.HashPassword(form.Password)
 	if err != nil {
 		return c.ctr.Fail(err, "unable to hash password")
 	}
 
-	// Get the requesting user
-	usr := ctx.Get(context.UserKey).(*ent.User)
-
 	// Update the user
 	_, err = usr.
 		Update().
@@ -84,6 +92,12 @@ func (c *resetPassword) Post(ctx echo.Context) error {
 		return c.ctr.Fail(err, "unable to delete password tokens")
 	}
 
+	// Sign the user out everywhere, whoever knew the old password may still be logged in
+	err = c.ctr.Container.Auth.RevokeAllUserSessions(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to revoke sessions")
+	}
+
 	msg.Success(ctx, "Your password has been updated.")
 	return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
 }
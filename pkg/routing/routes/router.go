@@ -12,15 +12,18 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/controller"
 	"github.com/mikestefanello/pagoda/pkg/middleware"
 	"github.com/mikestefanello/pagoda/pkg/repos/emailsmanager"
+	"github.com/mikestefanello/pagoda/pkg/repos/invitations"
+	"github.com/mikestefanello/pagoda/pkg/repos/moderation"
 	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/organizations"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
 	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
 	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
 	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tasks"
 	"github.com/ziflex/lecho/v3"
 
-	"github.com/gorilla/sessions"
 	"github.com/labstack/echo-contrib/session"
 
 	"github.com/labstack/echo/v4"
@@ -40,6 +43,9 @@ func BuildRouter(c *services.Container) {
 	// TODO: add option to log to file
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
+	// Push notifications are delivered by the worker
+	c.SetPushDeliveryQueue(tasks.NewPushDeliveryQueue(c.Tasks, c.Config.Push))
+
 	// Static files with proper cache control
 	// funcmap.File() should be used in templates to append a cache key to the URL in order to break cache
 	// after each server restart
@@ -81,6 +87,12 @@ func BuildRouter(c *services.Container) {
 		c.Config.App.OperationalConstants.ProTrialTimespanInDays,
 		c.Config.App.OperationalConstants.PaymentFailedGracePeriodInDays,
 	)
+	organizationsRepo := organizations.NewOrganizationsRepo(
+		c.ORM, c.Config.App.OperationalConstants.InvitationExpirationInDays,
+	)
+	invitationsRepo := invitations.NewInvitationsRepo(
+		c.ORM, profileRepo, c.Config.App.OperationalConstants.InvitationExpirationInDays,
+	)
 
 	// Force HTTPS, if enabled
 	if c.Config.HTTP.TLS.Enabled {
@@ -110,14 +122,17 @@ func BuildRouter(c *services.Container) {
 			Skipper: sseSkipper,
 			Timeout: c.Config.App.Timeout,
 		}),
-		session.Middleware(sessions.NewCookieStore([]byte(c.Config.App.EncryptionKey))),
+		session.Middleware(c.Keyring.SessionStore()),
+		middleware.ResignSessions(c.Keyring),
 		middleware.LoadAuthenticatedUser(c.Auth, profileRepo, subscriptionsRepo),
+		middleware.LoadOrganization(organizationsRepo),
+		middleware.SetAuditRequest(),
 		// middleware.ServeCachedPage(c.Cache), // NOTE: turn on if you use a cache
 		echomw.CSRFWithConfig(echomw.CSRFConfig{
 			TokenLookup:  "form:csrf,header:X-CSRF-Token,query:csrf",
 			CookieMaxAge: 172800, // 48h
 		}),
-		// TODO: need to add rate limitter
+		middleware.RateLimit(c.RateLimiter, c.Config.RateLimit.Groups.Web),
 		lecho.Middleware(lecho.Config{
 			Logger: c.Logger,
 		}),
@@ -139,12 +154,15 @@ func BuildRouter(c *services.Container) {
 		middleware.LogRequestID(),
 		echomw.Secure(),
 		echomw.Logger(),
-		session.Middleware(sessions.NewCookieStore([]byte(c.Config.App.EncryptionKey))),
+		session.Middleware(c.Keyring.SessionStore()),
+		middleware.ResignSessions(c.Keyring),
 		middleware.LoadAuthenticatedUser(c.Auth, profileRepo, subscriptionsRepo),
+		middleware.SetAuditRequest(),
 		echomw.CSRFWithConfig(echomw.CSRFConfig{
 			TokenLookup:  "form:csrf,header:X-CSRF-Token,query:csrf",
 			CookieMaxAge: 172800, // 48h
 		}),
+		middleware.RateLimit(c.RateLimiter, c.Config.RateLimit.Groups.Realtime),
 		lecho.Middleware(lecho.Config{
 			Logger: c.Logger,
 		}),
@@ -171,6 +189,9 @@ func BuildRouter(c *services.Container) {
 			Timeout: c.Config.App.Timeout,
 		}),
 		middleware.LoadAuthenticatedUser(c.Auth, profileRepo, subscriptionsRepo),
+		middleware.LoadAPITokenUser(c.Auth, profileRepo),
+		middleware.SetAuditRequest(),
+		middleware.RateLimit(c.RateLimiter, c.Config.RateLimit.Groups.External),
 		lecho.Middleware(lecho.Config{
 			Logger: c.Logger,
 		}),
@@ -183,11 +204,11 @@ func BuildRouter(c *services.Container) {
 	err := NewErrorHandler(ctr)
 	c.Web.HTTPErrorHandler = err.Get
 
-	generalRoutes(c, g, ctr)
+	generalRoutes(c, g, ctr, invitationsRepo)
 	documentationRoutes(c, g, ctr)
 
 	if c.Config.App.OperationalConstants.UserSignupEnabled {
-		coreAuthRoutes(c, g, ctr)
+		coreAuthRoutes(c, g, ctr, invitationsRepo, organizationsRepo)
 		// sseRoutes(c, s, ctr)
 		externalRoutes(c, e, ctr)
 	}
@@ -213,9 +234,21 @@ func externalRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 	// Using obfuscation to not get trolls at my payment webhooks. Note, we do check integrity of stripe requests.
 	// TODO: make the first string an env var
 	g.POST("/Q2HBfAY7iid59J1SUN8h1Y3WxJcPWA/payments/webhooks", payments.HandleWebhook).Name = routeNames.RouteNamePaymentProcessorWebhook
+
+	// Providers which support it report the delivery of text messages here
+	smsRoute := NewSMSRoute(ctr, c.SMS)
+	g.POST("/sms/status", smsRoute.StatusCallback).Name = routeNames.RouteNameSMSStatusCallback
+	c.SMS.SetStatusCallbackURL(c.Config.HTTP.Domain + c.Web.Reverse(routeNames.RouteNameSMSStatusCallback))
+
+	// The API group is authenticated with personal access tokens sent as bearer tokens
+	apiGroup := g.Group("/api/v1", middleware.RequireAPIAuthentication())
+	apiRoute := NewAPIRoute(ctr)
+	apiGroup.GET("/me", apiRoute.Me).Name = routeNames.RouteNameAPIMe
 }
 
-func generalRoutes(c *services.Container, g *echo.Group, ctr controller.Controller) {
+func generalRoutes(
+	c *services.Container, g *echo.Group, ctr controller.Controller, invitationsRepo *invitations.InvitationsRepo,
+) {
 	emailRepo := *emailsmanager.NewEmailSubscriptionRepo(c.ORM)
 	storageRepo := storagerepo.NewStorageClient(c.Config, c.ORM)
 	profileRepo := *profilerepo.NewProfileRepo(c.ORM, storageRepo, nil)
@@ -263,10 +296,39 @@ func generalRoutes(c *services.Container, g *echo.Group, ctr controller.Controll
 	userGroup.GET("/login", login.Get).Name = routeNames.RouteNameLogin
 	userGroup.POST("/login", login.Post).Name = routeNames.RouteNameLoginSubmit
 
-	register := NewRegisterRoute(ctr, profileRepo, *subscriptionsRepo, notificationSendPermissionRepo)
+	twoFactor := NewTwoFactorRoute(ctr)
+	userGroup.GET("/login/2fa", twoFactor.LoginGet).Name = routeNames.RouteNameLoginTwoFactor
+	userGroup.POST("/login/2fa", twoFactor.LoginPost).Name = routeNames.RouteNameLoginTwoFactorSubmit
+
+	magicLink := NewMagicLinkRoute(ctr)
+	userGroup.GET("/login/link", magicLink.Get).Name = routeNames.RouteNameMagicLink
+	userGroup.POST("/login/link", magicLink.Post).Name = routeNames.RouteNameMagicLinkSubmit
+	userGroup.GET("/login/link/:token", magicLink.Confirm).Name = routeNames.RouteNameMagicLinkConfirm
+	userGroup.POST("/login/link/:token", magicLink.Consume).Name = routeNames.RouteNameMagicLinkConsume
+
+	phoneLogin := NewPhoneLoginRoute(ctr, c.SMS, *subscriptionsRepo, notificationSendPermissionRepo)
+	userGroup.GET("/login/phone", phoneLogin.Get).Name = routeNames.RouteNamePhoneLogin
+	userGroup.POST("/login/phone", phoneLogin.Post).Name = routeNames.RouteNamePhoneLoginSubmit
+	userGroup.POST("/login/phone/code", phoneLogin.Verify).Name = routeNames.RouteNamePhoneLoginCodeSubmit
+	userGroup.GET("/register/phone", phoneLogin.RegisterGet).Name = routeNames.RouteNamePhoneRegister
+	userGroup.POST("/register/phone", phoneLogin.RegisterPost).Name = routeNames.RouteNamePhoneRegisterSubmit
+
+	passkeys := NewPasskeysRoute(ctr)
+	userGroup.POST("/login/passkey/begin", passkeys.LoginBegin).Name = routeNames.RouteNamePasskeyLoginBegin
+	userGroup.POST("/login/passkey/finish", passkeys.LoginFinish).Name = routeNames.RouteNamePasskeyLoginFinish
+
+	invitationLanding := NewInvitationsRoute(ctr, invitationsRepo)
+	g.GET("/invitation/:code", invitationLanding.Landing).Name = routeNames.RouteNameInvitationAccept
+	g.POST("/invitation/:code", invitationLanding.Accept, middleware.RequireAuthentication(), middleware.RequireNotImpersonating()).Name = routeNames.RouteNameInvitationAcceptSubmit
+
+	register := NewRegisterRoute(ctr, profileRepo, *subscriptionsRepo, notificationSendPermissionRepo, invitationsRepo)
 	userGroup.GET("/register", register.Get).Name = routeNames.RouteNameRegister
 	userGroup.POST("/register", register.Post).Name = routeNames.RouteNameRegisterSubmit
 
+	oauth := NewOAuthRoute(ctr, *subscriptionsRepo, notificationSendPermissionRepo)
+	userGroup.GET("/oauth/:provider", oauth.Begin).Name = routeNames.RouteNameOAuthLogin
+	userGroup.GET("/oauth/:provider/callback", oauth.Callback).Name = routeNames.RouteNameOAuthCallback
+
 	forgot := NewForgotPasswordRoute(ctr)
 	userGroup.GET("/password", forgot.Get).Name = routeNames.RouteNameForgotPassword
 	userGroup.POST("/password", forgot.Post).Name = routeNames.RouteNameForgotPasswordSubmit
@@ -287,10 +349,17 @@ func generalRoutes(c *services.Container, g *echo.Group, ctr controller.Controll
 		g.GET("/error/403", err.GetHttp403Forbidden)
 		g.GET("/error/404", err.GetHttp404NotFound)
 		g.GET("/error/500", err.GetHttp500InternalServerError)
+
+		// Text messages written to the outbox instead of being sent
+		smsRoute := NewSMSRoute(ctr, c.SMS)
+		g.GET("/dev/sms", smsRoute.Outbox).Name = routeNames.RouteNameSMSOutbox
 	}
 }
 
-func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Controller) {
+func coreAuthRoutes(
+	c *services.Container, g *echo.Group, ctr controller.Controller,
+	invitationsRepo *invitations.InvitationsRepo, organizationsRepo *organizations.OrganizationsRepo,
+) {
 
 	storageRepo := storagerepo.NewStorageClient(c.Config, c.ORM)
 	profileRepo := *profilerepo.NewProfileRepo(c.ORM, storageRepo, nil)
@@ -314,29 +383,54 @@ func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 	notificationSendPermissionRepo := notifierrepo.NewNotificationSendPermissionRepo(c.ORM)
 	// notifierRepo := notifierrepo.NewNotifierRepo(
 	// 	pubsubRepo, notificationStorageRepo, pwaPushNotificationsRepo, fcmPushNotificationsRepo, profileRepo.GetCountOfUnseenNotifications)
-	smsSenderRepo, err := notifierrepo.NewSMSSender(
-		c.ORM, c.Config.Phone.Region, c.Config.Phone.SenderID, c.Config.Phone.ValidationCodeExpirationMinutes)
-	if err != nil {
-		log.Fatal().Err(err)
-	}
 
 	// The onboarding group is for all pages that should be accessible during onboarding.
 	// We use middleware in the other authenticated routes to redirect to the onboarding
 	// flow if the user has not completed it.
 	onboardingGroup := g.Group("/welcome", middleware.RequireAuthentication())
 	preferences := NewPreferencesRoute(
-		ctr, &profileRepo, pwaPushNotificationsRepo, notificationSendPermissionRepo, subscriptionsRepo, smsSenderRepo)
+		ctr, &profileRepo, pwaPushNotificationsRepo, notificationSendPermissionRepo, subscriptionsRepo, c.SMS)
 	onboardingGroup.GET("/preferences", preferences.Get).Name = routeNames.RouteNamePreferences
 	onboardingGroup.GET("/preferences/phone", preferences.GetPhoneComponent).Name = routeNames.RouteNameGetPhone
 	onboardingGroup.GET("/preferences/phone/verification", preferences.GetPhoneVerificationComponent).Name = routeNames.RouteNameGetPhoneVerification
-	onboardingGroup.POST("/preferences/phone/verification", preferences.SubmitPhoneVerificationCode).Name = routeNames.RouteNameSubmitPhoneVerification
-	onboardingGroup.POST("/preferences/phone/save", preferences.SavePhoneInfo).Name = routeNames.RouteNameUpdatePhoneNum
+	onboardingGroup.POST("/preferences/phone/verification", preferences.SubmitPhoneVerificationCode, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameSubmitPhoneVerification
+	onboardingGroup.POST("/preferences/phone/save", preferences.SavePhoneInfo, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameUpdatePhoneNum
 	onboardingGroup.GET("/preferences/display-name/get", preferences.GetDisplayName).Name = routeNames.RouteNameGetDisplayName
 	onboardingGroup.POST("/preferences/display-name/save", preferences.SaveDisplayName).Name = routeNames.RouteNameUpdateDisplayName
 
-	deleteAccountRoute := NewDeleteAccountRoute(ctr, &profileRepo, subscriptionsRepo)
-	onboardingGroup.GET("/preferences/delete-account", deleteAccountRoute.DeleteAccountPage).Name = routeNames.RouteNameDeleteAccountPage
-	onboardingGroup.GET("/preferences/delete-account/now", deleteAccountRoute.DeleteAccountRequest).Name = routeNames.RouteNameDeleteAccountRequest
+	deleteAccountRoute := NewDeleteAccountRoute(ctr, subscriptionsRepo, c.Tasks)
+	onboardingGroup.GET("/preferences/delete-account", deleteAccountRoute.DeleteAccountPage, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameDeleteAccountPage
+	onboardingGroup.POST("/preferences/delete-account", deleteAccountRoute.DeleteAccountRequest, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameDeleteAccountRequest
+
+	dataExportRoute := NewDataExportRoute(ctr, c.Tasks)
+	onboardingGroup.POST("/preferences/data-export", dataExportRoute.Request, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameDataExportRequest
+
+	twoFactor := NewTwoFactorRoute(ctr)
+	onboardingGroup.GET("/preferences/2fa", twoFactor.Settings).Name = routeNames.RouteNameTwoFactorSettings
+	onboardingGroup.POST("/preferences/2fa/enroll", twoFactor.Enroll, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameTwoFactorEnroll
+	onboardingGroup.POST("/preferences/2fa/confirm", twoFactor.Confirm, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameTwoFactorConfirm
+	onboardingGroup.POST("/preferences/2fa/disable", twoFactor.Disable, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameTwoFactorDisable
+	onboardingGroup.POST("/preferences/2fa/recovery-codes", twoFactor.RecoveryCodes, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameTwoFactorRecoveryCodes
+
+	passkeys := NewPasskeysRoute(ctr)
+	onboardingGroup.GET("/preferences/passkeys", passkeys.Get).Name = routeNames.RouteNamePasskeys
+	onboardingGroup.POST("/preferences/passkeys/register/begin", passkeys.RegisterBegin, middleware.RequireNotImpersonating()).Name = routeNames.RouteNamePasskeyRegisterBegin
+	onboardingGroup.POST("/preferences/passkeys/register/finish", passkeys.RegisterFinish, middleware.RequireNotImpersonating()).Name = routeNames.RouteNamePasskeyRegisterFinish
+	onboardingGroup.POST("/preferences/passkeys/:id/delete", passkeys.Delete, middleware.RequireNotImpersonating()).Name = routeNames.RouteNamePasskeyDelete
+
+	devices := NewDevicesRoute(ctr)
+	onboardingGroup.GET("/preferences/devices", devices.Get).Name = routeNames.RouteNameDevices
+	onboardingGroup.POST("/preferences/devices/:id/sign-out", devices.SignOut, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameDeviceSignOut
+	onboardingGroup.POST("/preferences/devices/sign-out-all", devices.SignOutAll, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameDevicesSignOutAll
+
+	changeEmail := NewChangeEmailRoute(ctr)
+	onboardingGroup.GET("/preferences/email", changeEmail.Get).Name = routeNames.RouteNameChangeEmail
+	onboardingGroup.POST("/preferences/email", changeEmail.Post, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameChangeEmailSubmit
+
+	apiTokens := NewAPITokensRoute(ctr)
+	onboardingGroup.GET("/preferences/api-tokens", apiTokens.Get).Name = routeNames.RouteNameAPITokens
+	onboardingGroup.POST("/preferences/api-tokens", apiTokens.Create, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameAPITokenCreate
+	onboardingGroup.POST("/preferences/api-tokens/:id/revoke", apiTokens.Revoke, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameAPITokenRevoke
 
 	// TODO: move all pref routes to the preferences route (and not have a gazillion different ..)
 	finishOnboarding := NewOnboardingRoute(ctr, c.ORM, c.Tasks)
@@ -362,6 +456,9 @@ func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 
 	verifyEmail := NewVerifyEmailRoute(ctr)
 	g.GET("/email/verify/:token", verifyEmail.Get).Name = routeNames.RouteNameVerifyEmail
+	g.GET("/email/change/:token", changeEmail.Confirm).Name = routeNames.RouteNameChangeEmailConfirm
+	g.GET("/account/deletion/cancel/:token", deleteAccountRoute.CancelDeletionConfirm).Name = routeNames.RouteNameCancelAccountDeletion
+	g.POST("/account/deletion/cancel/:token", deleteAccountRoute.CancelDeletion).Name = routeNames.RouteNameCancelDeletionSubmit
 
 	homeFeed := NewHomeFeedRoute(ctr, profileRepo, &c.Config.App.PageSize)
 	onboardedGroup.GET("/homeFeed", homeFeed.Get, middleware.SetLastSeenOnline(c.Auth)).Name = routeNames.RouteNameHomeFeed
@@ -370,6 +467,36 @@ func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 	singleProfile := NewProfileRoutes(ctr, &profileRepo)
 	onboardedGroup.GET("/profile", singleProfile.Get).Name = routeNames.RouteNameProfile
 
+	friendRequests := NewFriendRequestsRoute(ctr, &profileRepo)
+	onboardedGroup.GET("/friend-requests", friendRequests.Get).Name = routeNames.RouteNameFriendRequests
+	onboardedGroup.POST("/friends/:profile_id/request", friendRequests.Send, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameFriendRequestSend
+	onboardedGroup.POST("/friends/:profile_id/accept", friendRequests.Accept, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameFriendRequestAccept
+	onboardedGroup.POST("/friends/:profile_id/decline", friendRequests.Decline, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameFriendRequestDecline
+	onboardedGroup.POST("/friends/:profile_id/cancel", friendRequests.Cancel, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameFriendRequestCancel
+	onboardedGroup.POST("/friends/:profile_id/block", friendRequests.Block, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameProfileBlock
+	onboardedGroup.POST("/friends/:profile_id/unblock", friendRequests.Unblock, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameProfileUnblock
+
+	moderationRepo := moderation.NewModerationRepo(c.ORM)
+	reports := NewReportsRoute(ctr, moderationRepo)
+	onboardedGroup.GET("/reports/new", reports.Get).Name = routeNames.RouteNameReport
+	onboardedGroup.POST("/reports", reports.Create, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameReportCreate
+
+	invitationsPage := NewInvitationsRoute(ctr, invitationsRepo)
+	onboardedGroup.GET("/invitations", invitationsPage.Get).Name = routeNames.RouteNameInvitations
+	onboardedGroup.POST("/invitations", invitationsPage.Create, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameInvitationCreate
+	onboardedGroup.POST("/invitations/:id/revoke", invitationsPage.Revoke, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameInvitationRevoke
+
+	orgs := NewOrganizationsRoute(ctr, organizationsRepo, subscriptionsRepo)
+	onboardedGroup.GET("/organizations", orgs.Get).Name = routeNames.RouteNameOrganizations
+	onboardedGroup.POST("/organizations", orgs.Create, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameOrganizationCreate
+	onboardedGroup.GET("/organizations/join/:token", orgs.AcceptInvitation, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameOrganizationInvitationAccept
+	onboardedGroup.GET("/organizations/:id", orgs.Show).Name = routeNames.RouteNameOrganization
+	onboardedGroup.POST("/organizations/:id/switch", orgs.Switch).Name = routeNames.RouteNameOrganizationSwitch
+	onboardedGroup.POST("/organizations/:id/invitations", orgs.Invite, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameOrganizationInvite
+	onboardedGroup.POST("/organizations/:id/invitations/:invitation_id/revoke", orgs.RevokeInvitation, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameOrganizationInvitationRevoke
+	onboardedGroup.POST("/organizations/:id/members/:profile_id/role", orgs.UpdateMemberRole, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameOrganizationMemberRole
+	onboardedGroup.POST("/organizations/:id/members/:profile_id/remove", orgs.RemoveMember, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameOrganizationMemberRemove
+
 	uploadPhoto := NewUploadPhotoRoutes(ctr, &profileRepo, storageRepo, c.Config.Storage.PhotosMaxFileSizeMB)
 	onboardedGroup.GET("/uploadPhoto", uploadPhoto.Get).Name = "uploadPhoto"
 	onboardedGroup.POST("/uploadPhoto", uploadPhoto.Post).Name = "uploadPhoto.post"
@@ -397,11 +524,24 @@ func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 
 	payments := NewPaymentsRoute(ctr, c.ORM, subscriptionsRepo)
 	onboardedGroup.GET("/payments/get-public-key", payments.GetPaymentProcessorPublickey).Name = routeNames.RouteNamePaymentProcessorGetPublicKey
-	onboardedGroup.POST("/payments/create-checkout-session", payments.CreateCheckoutSession).Name = routeNames.RouteNameCreateCheckoutSession
-	onboardedGroup.POST("/payments/create-portal-session", payments.CreatePortalSession).Name = routeNames.RouteNameCreatePortalSession
+	onboardedGroup.POST("/payments/create-checkout-session", payments.CreateCheckoutSession, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameCreateCheckoutSession
+	onboardedGroup.POST("/payments/create-portal-session", payments.CreatePortalSession, middleware.RequireNotImpersonating()).Name = routeNames.RouteNameCreatePortalSession
 	onboardedGroup.GET("/payments/pricing", payments.PricingPage).Name = routeNames.RouteNamePricingPage
 	onboardedGroup.GET("/payments/success", payments.SuccessfullySubscribed).Name = routeNames.RouteNamePaymentProcessorSuccess
 
+	// The admin group is for internal tooling, only accessible to users whose roles grant admin access
+	adminGroup := g.Group("/admin", middleware.RequireAuthentication(), middleware.RequirePermission(services.PermissionAdminAccess))
+	adminArea := NewAdminRoute(ctr)
+	adminGroup.GET("", adminArea.Get).Name = routeNames.RouteNameAdmin
+	adminGroup.POST("/impersonate", adminArea.StartImpersonating, middleware.RequirePermission(services.PermissionImpersonate)).Name = routeNames.RouteNameImpersonationStart
+	allGroup.POST("/impersonation/stop", adminArea.StopImpersonating).Name = routeNames.RouteNameImpersonationStop
+
+	moderationQueue := NewModerationRoute(ctr, moderationRepo, storageRepo)
+	moderationGroup := adminGroup.Group("/moderation", middleware.RequirePermission(services.PermissionModerate))
+	moderationGroup.GET("", moderationQueue.Get).Name = routeNames.RouteNameModerationQueue
+	moderationGroup.POST("/:id/dismiss", moderationQueue.Dismiss).Name = routeNames.RouteNameModerationDismiss
+	moderationGroup.POST("/:id/hide-photo", moderationQueue.HidePhoto).Name = routeNames.RouteNameModerationHide
+	moderationGroup.POST("/:id/suspend", moderationQueue.Suspend).Name = routeNames.RouteNameModerationSuspend
 }
 
 // sseRoutes because they have no read timeout set on them
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/mikestefanello/pagoda/config"
 	"github.com/mikestefanello/pagoda/pkg/services"
@@ -29,6 +30,9 @@ func TestMain(m *testing.M) {
 	// Start a new container
 	c = services.NewContainer()
 
+	// Forms are submitted right away without a browser computing proofs of work
+	c.BotDefense = services.NewBotDefense(config.BotDefenseConfig{TokenExpiration: time.Hour}, c.Keyring, nil)
+
 	// Start a test HTTP server
 	BuildRouter(c)
 	srv = httptest.NewServer(c.Web)
@@ -71,7 +75,7 @@ func (h *httpRequest) setClient(client http.Client) *httpRequest {
 }
 
 func (h *httpRequest) setRoute(route string, params ...any) *httpRequest {
-	h.route = srv.URL + c.Web.Reverse(route, params)
+	h.route = srv.URL + c.Web.Reverse(route, params...)
 	return h
 }
 
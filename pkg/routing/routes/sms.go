@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
+)
+
+// smsOutboxPageSize is how many of the latest messages the outbox page lists
+const smsOutboxPageSize = 50
+
+type sms struct {
+	ctr       controller.Controller
+	smsSender *notifierrepo.SMSSender
+}
+
+func NewSMSRoute(ctr controller.Controller, smsSender *notifierrepo.SMSSender) sms {
+	return sms{
+		ctr:       ctr,
+		smsSender: smsSender,
+	}
+}
+
+// StatusCallback records the delivery status of a text message reported by the provider which sent it
+func (c *sms) StatusCallback(ctx echo.Context) error {
+	err := c.smsSender.HandleStatusCallback(ctx.Request())
+	switch {
+	case err == nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case errors.Is(err, notifierrepo.ErrInvalidStatusCallback):
+		return echo.ErrForbidden
+	case errors.Is(err, notifierrepo.ErrStatusCallbacksUnsupported):
+		return echo.ErrNotFound
+	default:
+		log.Error().Err(err).Msg("failed to record sms delivery status")
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+}
+
+// Outbox lists the latest text messages written to the outbox instead of being sent
+func (c *sms) Outbox(ctx echo.Context) error {
+	outbox, ok := c.smsSender.Provider().(*notifierrepo.OutboxSMSProvider)
+	if !ok {
+		return echo.ErrNotFound
+	}
+
+	messages, err := outbox.Messages(smsOutboxPageSize)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to read the sms outbox")
+	}
+
+	data := types.SMSOutboxData{}
+	for _, m := range messages {
+		data.Messages = append(data.Messages, types.SMSOutboxMessage{
+			To:     m.To,
+			Body:   m.Body,
+			SentAt: m.SentAt,
+		})
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageSMSOutbox
+	page.Title = "SMS outbox"
+	page.Data = &data
+	page.Component = pages.SMSOutbox(&page)
+	page.HTMX.Request.Boosted = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
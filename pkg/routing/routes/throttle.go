@@ -0,0 +1,134 @@
+package routes
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates/emails"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mileusna/useragent"
+)
+
+// showThrottledMessage tells the user to wait when the throttler refused an attempt. It returns
+// false if the error did not come from throttling.
+func showThrottledMessage(ctx echo.Context, err error) bool {
+	switch e := err.(type) {
+	case services.ThrottledError:
+		msg.Warning(ctx, fmt.Sprintf("Too many failed attempts. Please wait %s before trying again.", formatWait(e.RetryAfter)))
+	case services.LockedOutError:
+		msg.Danger(ctx, fmt.Sprintf("Too many failed attempts. Please try again in %s.", formatWait(time.Until(e.Until))))
+	default:
+		return false
+	}
+	return true
+}
+
+// recordFailedAttempt counts a failed attempt against the given keys, records it in the audit log under
+// the given action, and emails the owner of the account if it got locked out because of it. The user is
+// nil when the attempt matched no account.
+func recordFailedAttempt(ctr controller.Controller, ctx echo.Context, usr *ent.User, action string, keys ...services.ThrottleKey) error {
+	locked, err := ctr.Container.Throttler.Fail(ctx.Request().Context(), keys...)
+	if err != nil {
+		return err
+	}
+
+	event := services.AuditEvent{
+		Action:   action,
+		Metadata: make(map[string]string, len(keys)),
+	}
+	for _, key := range keys {
+		event.Metadata[string(key.Scope)] = key.Value
+	}
+	if usr != nil {
+		event.TargetType = services.AuditTargetUser
+		event.TargetID = &usr.ID
+	}
+	if err := ctr.Container.Audit.Record(ctx.Request().Context(), event); err != nil {
+		return err
+	}
+
+	if usr == nil {
+		return nil
+	}
+
+	for _, key := range locked {
+		if !key.IsAccount() {
+			continue
+		}
+		ctx.Logger().Warnf("locked out %s after too many failed attempts", key)
+		err := ctr.Container.Audit.Record(ctx.Request().Context(), services.AuditEvent{
+			Action:     services.AuditActionLockedOut,
+			TargetType: services.AuditTargetUser,
+			TargetID:   &usr.ID,
+			Metadata:   map[string]string{string(key.Scope): key.Value},
+		})
+		if err != nil {
+			return err
+		}
+		if err := sendAccountLockedEmail(ctr, ctx, usr); err != nil {
+			ctx.Logger().Errorf("unable to send account locked email: %v", err)
+		}
+		break
+	}
+	return nil
+}
+
+func sendAccountLockedEmail(ctr controller.Controller, ctx echo.Context, usr *ent.User) error {
+	ua := useragent.Parse(ctx.Request().UserAgent())
+	until := time.Now().Add(ctr.Container.Config.LoginThrottle.LockoutDuration)
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Data = types.EmailAccountLockedData{
+		AppName:           string(ctr.Container.Config.App.Name),
+		ProfileName:       usr.Name,
+		LockedUntil:       until.UTC().Format("Jan 2, 2006 at 15:04 UTC"),
+		PasswordResetLink: fmt.Sprintf("%s%s", ctr.Container.Config.HTTP.Domain, ctx.Echo().Reverse(routeNames.RouteNameForgotPassword)),
+		SupportEmail:      ctr.Container.Config.Mail.FromAddress,
+		IPAddress:         ctx.RealIP(),
+		OperatingSystem:   ua.OS,
+		BrowserName:       ua.Name,
+		Domain:            ctr.Container.Config.HTTP.Domain,
+	}
+
+	return ctr.Container.Mail.
+		Compose().
+		To(usr.Email).
+		Subject("Your account was temporarily locked").
+		TemplateLayout(layouts.Email).
+		Component(emails.AccountLocked(&page)).
+		Send(ctx.Request().Context())
+}
+
+// formatWait formats a duration to wait, rounded up, in a human readable way
+func formatWait(d time.Duration) string {
+	if d < time.Minute {
+		seconds := int(math.Ceil(d.Seconds()))
+		if seconds <= 1 {
+			return "1 second"
+		}
+		return fmt.Sprintf("%d seconds", seconds)
+	}
+
+	if d < time.Hour {
+		minutes := int(math.Ceil(d.Minutes()))
+		if minutes == 1 {
+			return "1 minute"
+		}
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+
+	hours := int(math.Ceil(d.Hours()))
+	if hours == 1 {
+		return "1 hour"
+	}
+	return fmt.Sprintf("%d hours", hours)
+}
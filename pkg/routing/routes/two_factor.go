@@ -0,0 +1,310 @@
+package routes
+
+import (
+	"encoding/base64"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/skip2/go-qrcode"
+)
+
+type (
+	twoFactor struct {
+		ctr controller.Controller
+	}
+)
+
+func NewTwoFactorRoute(ctr controller.Controller) twoFactor {
+	return twoFactor{
+		ctr: ctr,
+	}
+}
+
+// LoginGet renders the step of the login flow where the user enters their TOTP or recovery code.
+func (c *twoFactor) LoginGet(ctx echo.Context) error {
+	if _, err := c.ctr.Container.Auth.GetPendingTwoFactorUserID(ctx); err != nil {
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Auth
+	page.Name = templates.PageLoginTwoFactor
+	page.Title = "Two-factor authentication"
+	page.Form = &types.TwoFactorCodeForm{}
+	page.Component = pages.LoginTwoFactor(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.TwoFactorCodeForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// LoginPost validates the code of a user who passed the password check and logs them in.
+func (c *twoFactor) LoginPost(ctx echo.Context) error {
+	var form types.TwoFactorCodeForm
+	ctx.Set(context.FormKey, &form)
+
+	userID, err := c.ctr.Container.Auth.GetPendingTwoFactorUserID(ctx)
+	switch err.(type) {
+	case nil:
+	case services.NoPendingTwoFactorLoginError:
+		msg.Warning(ctx, "Your login attempt expired. Please log in again.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+	default:
+		return c.ctr.Fail(err, "unable to load pending two-factor login")
+	}
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse two-factor form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.LoginGet(ctx)
+	}
+
+	usr, err := c.ctr.Container.ORM.User.Get(ctx.Request().Context(), userID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load user during two-factor login")
+	}
+
+	// Codes are short, guessing them is throttled the same way as passwords
+	throttleKeys := []services.ThrottleKey{
+		services.ThrottleKeyEmail(usr.Email),
+		services.ThrottleKeyIP(ctx.RealIP()),
+	}
+	if err := c.ctr.Container.Throttler.Check(ctx.Request().Context(), throttleKeys...); err != nil {
+		if !showThrottledMessage(ctx, err) {
+			return c.ctr.Fail(err, "unable to check login throttle")
+		}
+		return c.LoginGet(ctx)
+	}
+
+	err = c.ctr.Container.Auth.ValidateTwoFactorCode(ctx, userID, form.Code)
+	switch err.(type) {
+	case nil:
+	case services.InvalidTwoFactorCodeError:
+		if err := recordFailedAttempt(c.ctr, ctx, usr, services.AuditActionTwoFactorFailed, throttleKeys...); err != nil {
+			return c.ctr.Fail(err, "unable to record failed two-factor attempt")
+		}
+		form.Submission.SetFieldError("Code", "Invalid code. Please try again.")
+		return c.LoginGet(ctx)
+	default:
+		return c.ctr.Fail(err, "unable to validate two-factor code")
+	}
+
+	if err := c.ctr.Container.Auth.ClearPendingTwoFactorLogin(ctx); err != nil {
+		return c.ctr.Fail(err, "unable to clear pending two-factor login")
+	}
+
+	if err := c.ctr.Container.Auth.Login(ctx, usr.ID); err != nil {
+		if showLockedAccountMessage(ctx, err) {
+			return c.ctr.Redirect(ctx, routeNames.RouteNameLogin)
+		}
+		return c.ctr.Fail(err, "unable to log in user")
+	}
+
+	if err := c.ctr.Container.Throttler.Reset(ctx.Request().Context(), throttleKeys[0]); err != nil {
+		return c.ctr.Fail(err, "unable to reset login throttle")
+	}
+
+	return completeLogin(c.ctr, ctx, usr)
+}
+
+// Settings renders the two-factor settings page, including any enrollment in progress.
+func (c *twoFactor) Settings(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	data, err := c.settingsData(ctx, usr)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load two-factor settings")
+	}
+
+	return c.renderSettings(ctx, data)
+}
+
+// Enroll generates a new TOTP secret which the user then confirms with a code from their app.
+func (c *twoFactor) Enroll(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if _, err := c.ctr.Container.Auth.BeginTOTPEnrollment(ctx, usr); err != nil {
+		return c.ctr.Fail(err, "unable to begin two-factor enrollment")
+	}
+
+	return c.ctr.Redirect(ctx, routeNames.RouteNameTwoFactorSettings)
+}
+
+// Confirm enables two-factor authentication and shows the recovery codes once.
+func (c *twoFactor) Confirm(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	var form types.TwoFactorCodeForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return c.ctr.Fail(err, "unable to parse two-factor form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return c.Settings(ctx)
+	}
+
+	codes, err := c.ctr.Container.Auth.ConfirmTOTPEnrollment(ctx, usr.ID, form.Code)
+	switch err.(type) {
+	case nil:
+	case services.InvalidTwoFactorCodeError:
+		form.Submission.SetFieldError("Code", "Invalid code. Please try again.")
+		return c.Settings(ctx)
+	default:
+		return c.ctr.Fail(err, "unable to confirm two-factor enrollment")
+	}
+
+	msg.Success(ctx, "Two-factor authentication is now enabled.")
+
+	data, err := c.settingsData(ctx, usr)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load two-factor settings")
+	}
+	data.RecoveryCodes = codes
+
+	return c.renderSettings(ctx, data)
+}
+
+// Disable turns off two-factor authentication after checking a current code.
+func (c *twoFactor) Disable(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if ok, err := c.checkCode(ctx, usr); err != nil || !ok {
+		return err
+	}
+
+	if err := c.ctr.Container.Auth.DisableTwoFactor(ctx, usr.ID); err != nil {
+		return c.ctr.Fail(err, "unable to disable two-factor authentication")
+	}
+
+	msg.Success(ctx, "Two-factor authentication is now disabled.")
+	return c.ctr.Redirect(ctx, routeNames.RouteNameTwoFactorSettings)
+}
+
+// RecoveryCodes replaces all recovery codes after checking a current code.
+func (c *twoFactor) RecoveryCodes(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if ok, err := c.checkCode(ctx, usr); err != nil || !ok {
+		return err
+	}
+
+	codes, err := c.ctr.Container.Auth.RegenerateRecoveryCodes(ctx, usr.ID)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to regenerate recovery codes")
+	}
+
+	data, err := c.settingsData(ctx, usr)
+	if err != nil {
+		return c.ctr.Fail(err, "unable to load two-factor settings")
+	}
+	data.RecoveryCodes = codes
+
+	return c.renderSettings(ctx, data)
+}
+
+// checkCode parses the code form and validates it. When false is returned without an error,
+// the settings page was already rendered with the validation errors.
+func (c *twoFactor) checkCode(ctx echo.Context, usr *ent.User) (bool, error) {
+	var form types.TwoFactorCodeForm
+	ctx.Set(context.FormKey, &form)
+
+	if err := ctx.Bind(&form); err != nil {
+		return false, c.ctr.Fail(err, "unable to parse two-factor form")
+	}
+
+	if err := form.Submission.Process(ctx, form); err != nil {
+		return false, c.ctr.Fail(err, "unable to process form submission")
+	}
+
+	if form.Submission.HasErrors() {
+		return false, c.Settings(ctx)
+	}
+
+	err := c.ctr.Container.Auth.ValidateTwoFactorCode(ctx, usr.ID, form.Code)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case services.InvalidTwoFactorCodeError:
+		form.Submission.SetFieldError("Code", "Invalid code. Please try again.")
+		return false, c.Settings(ctx)
+	default:
+		return false, c.ctr.Fail(err, "unable to validate two-factor code")
+	}
+}
+
+func (c *twoFactor) settingsData(ctx echo.Context, usr *ent.User) (*types.TwoFactorSettingsData, error) {
+	enabled, err := c.ctr.Container.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &types.TwoFactorSettingsData{
+		Enabled: enabled,
+	}
+
+	if enabled {
+		data.NumRecoveryCodesLeft, err = c.ctr.Container.Auth.CountUnusedRecoveryCodes(ctx, usr.ID)
+		return data, err
+	}
+
+	enrollment, err := c.ctr.Container.Auth.GetPendingTOTPEnrollment(ctx, usr)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return data, nil
+	default:
+		return nil, err
+	}
+
+	png, err := qrcode.Encode(enrollment.URI, qrcode.Medium, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Secret = enrollment.Secret
+	data.URI = enrollment.URI
+	data.QRCode = "data:image/png;base64," + base64.StdEncoding.EncodeToString(png)
+
+	return data, nil
+}
+
+func (c *twoFactor) renderSettings(ctx echo.Context, data *types.TwoFactorSettingsData) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageTwoFactor
+	page.Title = "Two-factor authentication"
+	page.Form = &types.TwoFactorCodeForm{}
+	page.Data = data
+	page.Component = pages.TwoFactorSettings(&page)
+	page.HTMX.Request.Boosted = true
+
+	if form := ctx.Get(context.FormKey); form != nil {
+		page.Form = form.(*types.TwoFactorCodeForm)
+	}
+
+	return c.ctr.RenderPage(ctx, page)
+}
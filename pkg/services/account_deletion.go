@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// cancelAccountDeletionTokenPurpose is the purpose claim of the JWTs cancelling an account deletion, so
+// that they cannot be mistaken for other tokens signed with the same key
+const cancelAccountDeletionTokenPurpose = "cancel_account_deletion"
+
+// AccountPendingDeletionError is an error returned when a user tries to sign in to an account which is
+// locked until it gets deleted
+type AccountPendingDeletionError struct {
+	ScheduledAt time.Time
+}
+
+// Error implements the error interface.
+func (e AccountPendingDeletionError) Error() string {
+	return fmt.Sprintf("account is scheduled for deletion on %s", e.ScheduledAt.Format(time.RFC3339))
+}
+
+// InvalidCancelAccountDeletionTokenError is an error returned when a link cancelling an account deletion
+// is invalid, expired, or the deletion it was sent for was already cancelled
+type InvalidCancelAccountDeletionTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidCancelAccountDeletionTokenError) Error() string {
+	return "invalid account deletion cancellation token"
+}
+
+// ScheduleAccountDeletion locks the account of a user until it gets deleted, once the grace period is
+// over. The user is signed out everywhere, and the returned token lets them cancel the deletion meanwhile.
+func (c *AuthClient) ScheduleAccountDeletion(ctx echo.Context, userID int, gracePeriod time.Duration) (time.Time, string, error) {
+	// Tokens only hold seconds, so the time is stored as such to be matched when cancelling
+	scheduledAt := time.Now().Add(gracePeriod).Truncate(time.Second)
+
+	err := c.orm.User.
+		UpdateOneID(userID).
+		SetDeletionScheduledAt(scheduledAt).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	if err := c.RevokeAllUserSessions(ctx, userID); err != nil {
+		return time.Time{}, "", err
+	}
+
+	err = c.audit.Record(ctx.Request().Context(), AuditEvent{
+		Action:     AuditActionAccountDeletionScheduled,
+		TargetType: AuditTargetUser,
+		TargetID:   &userID,
+		Metadata:   map[string]string{"scheduled_at": scheduledAt.Format(time.RFC3339)},
+	})
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	token, err := c.keyring.SignJWT(jwt.MapClaims{
+		"sub":          userID,
+		"purpose":      cancelAccountDeletionTokenPurpose,
+		"scheduled_at": scheduledAt.Unix(),
+		"exp":          scheduledAt.Unix(),
+	})
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	return scheduledAt, token, nil
+}
+
+// CancelAccountDeletion unlocks an account scheduled for deletion, as confirmed by a token sent to the user.
+// The user has to sign in again afterwards.
+func (c *AuthClient) CancelAccountDeletion(ctx context.Context, token string) (*ent.User, error) {
+	t, err := c.keyring.ParseJWT(token)
+	if err != nil || !t.Valid {
+		return nil, InvalidCancelAccountDeletionTokenError{}
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != cancelAccountDeletionTokenPurpose {
+		return nil, InvalidCancelAccountDeletionTokenError{}
+	}
+	userID, _ := claims["sub"].(float64)
+	scheduledAt, _ := claims["scheduled_at"].(float64)
+	if userID == 0 || scheduledAt == 0 {
+		return nil, InvalidCancelAccountDeletionTokenError{}
+	}
+
+	// Only the deletion the token was sent for can be cancelled with it
+	updated, err := c.orm.User.
+		Update().
+		Where(
+			user.ID(int(userID)),
+			user.DeletionScheduledAt(time.Unix(int64(scheduledAt), 0)),
+		).
+		ClearDeletionScheduledAt().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, InvalidCancelAccountDeletionTokenError{}
+	}
+
+	id := int(userID)
+	err = c.audit.Record(ctx, AuditEvent{
+		Action:     AuditActionAccountDeletionCancelled,
+		ActorID:    &id,
+		TargetType: AuditTargetUser,
+		TargetID:   &id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orm.User.Get(ctx, id)
+}
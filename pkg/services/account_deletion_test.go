@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_AccountDeletion(t *testing.T) {
+	bg := context.Background()
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	apiToken, _, err := c.Auth.CreateAPIToken(bg, u.ID, "test", []string{APITokenScopeRead}, nil)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	require.NoError(t, c.Auth.Login(ctx, u.ID))
+
+	scheduledAt, token, err := c.Auth.ScheduleAccountDeletion(ctx, u.ID, 14*24*time.Hour)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(14*24*time.Hour), scheduledAt, time.Minute)
+
+	// The account is locked until it gets deleted
+	_, err = c.Auth.GetAuthenticatedUser(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+	other, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(other)
+	err = c.Auth.Login(other, u.ID)
+	require.IsType(t, AccountPendingDeletionError{}, err)
+	assert.True(t, scheduledAt.Equal(err.(AccountPendingDeletionError).ScheduledAt))
+	_, _, err = c.Auth.AuthenticateAPIToken(bg, apiToken)
+	assert.Equal(t, InvalidAPITokenError{}, err)
+
+	// Other tokens cannot cancel the deletion
+	verification, err := c.Auth.GenerateEmailVerificationToken(u.Email)
+	require.NoError(t, err)
+	_, err = c.Auth.CancelAccountDeletion(bg, verification)
+	assert.Equal(t, InvalidCancelAccountDeletionTokenError{}, err)
+
+	cancelled, err := c.Auth.CancelAccountDeletion(bg, token)
+	require.NoError(t, err)
+	assert.Nil(t, cancelled.DeletionScheduledAt)
+	assert.NoError(t, c.Auth.Login(other, u.ID))
+	_, _, err = c.Auth.AuthenticateAPIToken(bg, apiToken)
+	assert.NoError(t, err)
+
+	// The link only cancels the deletion it was sent for
+	_, err = c.Auth.CancelAccountDeletion(bg, token)
+	assert.Equal(t, InvalidCancelAccountDeletionTokenError{}, err)
+}
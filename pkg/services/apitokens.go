@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+const (
+	// apiTokenPrefix starts every personal access token, so leaked tokens are easy to recognize
+	apiTokenPrefix = "pat_"
+
+	// apiTokenLength is the length of the random part of a personal access token
+	apiTokenLength = 40
+
+	// apiTokenHintLength is how much of the token is kept in clear to tell tokens apart
+	apiTokenHintLength = len(apiTokenPrefix) + 6
+
+	// apiTokenTouchInterval limits how often the last used time of a token is written
+	apiTokenTouchInterval = time.Minute
+
+	// APITokenScopeRead allows a token to make requests which do not change anything
+	APITokenScopeRead = "read"
+
+	// APITokenScopeWrite allows a token to make any request, it includes APITokenScopeRead
+	APITokenScopeWrite = "write"
+)
+
+// APITokenScopes lists the scopes a personal access token can be granted
+var APITokenScopes = []string{APITokenScopeRead, APITokenScopeWrite}
+
+// InvalidAPITokenError is an error returned when a personal access token is unknown, revoked or expired
+type InvalidAPITokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidAPITokenError) Error() string {
+	return "invalid API token"
+}
+
+// CreateAPIToken creates a personal access token for a user. The token is returned in clear only
+// this once, only its hash is stored. A nil expiry creates a token which never expires.
+func (c *AuthClient) CreateAPIToken(
+	ctx context.Context, userID int, name string, scopes []string, expiresAt *time.Time,
+) (string, *ent.APIToken, error) {
+	random, err := c.RandomToken(apiTokenLength)
+	if err != nil {
+		return "", nil, err
+	}
+	token := apiTokenPrefix + random
+
+	t, err := c.orm.APIToken.
+		Create().
+		SetUserID(userID).
+		SetName(name).
+		SetTokenHash(hashToken(token)).
+		SetHint(token[:apiTokenHintLength]).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, t, nil
+}
+
+// GetAPITokens returns the personal access tokens of a user, newest first
+func (c *AuthClient) GetAPITokens(ctx context.Context, userID int) ([]*ent.APIToken, error) {
+	return c.orm.APIToken.
+		Query().
+		Where(apitoken.UserIDEQ(userID)).
+		Order(ent.Desc(apitoken.FieldCreatedAt)).
+		All(ctx)
+}
+
+// RevokeAPIToken deletes a personal access token of a user
+func (c *AuthClient) RevokeAPIToken(ctx context.Context, userID, tokenID int) error {
+	deleted, err := c.orm.APIToken.
+		Delete().
+		Where(
+			apitoken.ID(tokenID),
+			apitoken.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// AuthenticateAPIToken returns the token matching a bearer token, along with its owner loaded the same
+// way as the authenticated user of a session
+func (c *AuthClient) AuthenticateAPIToken(ctx context.Context, token string) (*ent.User, *ent.APIToken, error) {
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		return nil, nil, InvalidAPITokenError{}
+	}
+
+	t, err := c.orm.APIToken.
+		Query().
+		Where(
+			apitoken.TokenHash(hashToken(token)),
+			apitoken.Or(
+				apitoken.ExpiresAtIsNil(),
+				apitoken.ExpiresAtGT(time.Now()),
+			),
+			// Accounts scheduled for deletion or suspended are locked
+			apitoken.HasUserWith(user.DeletionScheduledAtIsNil(), user.SuspendedAtIsNil()),
+		).
+		Only(ctx)
+
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return nil, nil, InvalidAPITokenError{}
+	default:
+		return nil, nil, err
+	}
+
+	if t.LastUsedAt == nil || time.Since(*t.LastUsedAt) >= apiTokenTouchInterval {
+		if t, err = t.Update().SetLastUsedAt(time.Now()).Save(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	usr, err := c.loadAuthenticatedUser(ctx, t.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usr, t, nil
+}
+
+// APITokenHasScope returns true if a personal access token was granted a scope
+func APITokenHasScope(t *ent.APIToken, scope string) bool {
+	if scope == APITokenScopeRead && slices.Contains(t.Scopes, APITokenScopeWrite) {
+		return true
+	}
+	return slices.Contains(t.Scopes, scope)
+}
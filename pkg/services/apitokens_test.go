@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_APITokens(t *testing.T) {
+	bg := context.Background()
+
+	token, created, err := c.Auth.CreateAPIToken(bg, usr.ID, "script", []string{APITokenScopeRead}, nil)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, apiTokenPrefix))
+	assert.Len(t, token, len(apiTokenPrefix)+apiTokenLength)
+	assert.Equal(t, token[:apiTokenHintLength], created.Hint)
+	assert.NotContains(t, created.TokenHash, token)
+
+	// The token authenticates its owner
+	u, authed, err := c.Auth.AuthenticateAPIToken(bg, token)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, u.ID)
+	assert.Equal(t, created.ID, authed.ID)
+	assert.NotNil(t, authed.LastUsedAt)
+
+	// Unknown tokens are rejected
+	_, _, err = c.Auth.AuthenticateAPIToken(bg, token+"x")
+	assert.Equal(t, InvalidAPITokenError{}, err)
+	_, _, err = c.Auth.AuthenticateAPIToken(bg, "not-a-token")
+	assert.Equal(t, InvalidAPITokenError{}, err)
+
+	// Expired tokens are rejected
+	past := time.Now().Add(-time.Minute)
+	expired, _, err := c.Auth.CreateAPIToken(bg, usr.ID, "old", []string{APITokenScopeRead}, &past)
+	require.NoError(t, err)
+	_, _, err = c.Auth.AuthenticateAPIToken(bg, expired)
+	assert.Equal(t, InvalidAPITokenError{}, err)
+
+	tokens, err := c.Auth.GetAPITokens(bg, usr.ID)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(tokens), 2)
+
+	// Revoked tokens are rejected
+	require.NoError(t, c.Auth.RevokeAPIToken(bg, usr.ID, created.ID))
+	_, _, err = c.Auth.AuthenticateAPIToken(bg, token)
+	assert.Equal(t, InvalidAPITokenError{}, err)
+
+	err = c.Auth.RevokeAPIToken(bg, usr.ID, created.ID)
+	assert.IsType(t, &ent.NotFoundError{}, err)
+}
+
+func TestAPITokenHasScope(t *testing.T) {
+	read := &ent.APIToken{Scopes: []string{APITokenScopeRead}}
+	write := &ent.APIToken{Scopes: []string{APITokenScopeWrite}}
+
+	assert.True(t, APITokenHasScope(read, APITokenScopeRead))
+	assert.False(t, APITokenHasScope(read, APITokenScopeWrite))
+	assert.True(t, APITokenHasScope(write, APITokenScopeRead))
+	assert.True(t, APITokenHasScope(write, APITokenScopeWrite))
+}
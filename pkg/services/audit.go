@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/auditlog"
+	"github.com/mikestefanello/pagoda/ent/hook"
+	"github.com/mikestefanello/pagoda/ent/notificationpermission"
+)
+
+// Actions recorded in the audit log
+const (
+	AuditActionLogin                         = "auth.login"
+	AuditActionLoginFailed                   = "auth.login_failed"
+	AuditActionLockedOut                     = "auth.locked_out"
+	AuditActionTwoFactorFailed               = "auth.two_factor_failed"
+	AuditActionReauthenticationFailed        = "auth.reauthentication_failed"
+	AuditActionLogout                        = "auth.logout"
+	AuditActionPasswordReset                 = "auth.password_reset"
+	AuditActionPhoneVerified                 = "phone.verified"
+	AuditActionPhoneVerificationFailed       = "phone.verification_failed"
+	AuditActionPhoneLoginFailed              = "phone.login_failed"
+	AuditActionSubscriptionChanged           = "subscription.changed"
+	AuditActionAccountDeleted                = "account.deleted"
+	AuditActionAccountDeletionScheduled      = "account.deletion_scheduled"
+	AuditActionAccountDeletionCancelled      = "account.deletion_cancelled"
+	AuditActionDataExported                  = "account.data_exported"
+	AuditActionNotificationPermissionGranted = "notification_permission.granted"
+	AuditActionNotificationPermissionRevoked = "notification_permission.revoked"
+	AuditActionRoleGranted                   = "role.granted"
+	AuditActionRoleRevoked                   = "role.revoked"
+	AuditActionImpersonationStarted          = "impersonation.started"
+	AuditActionImpersonationStopped          = "impersonation.stopped"
+	AuditActionReportDismissed               = "moderation.report_dismissed"
+	AuditActionPhotoHidden                   = "moderation.photo_hidden"
+	AuditActionUserSuspended                 = "moderation.user_suspended"
+	AuditActionOrganizationRoleChanged       = "organization.role_changed"
+	AuditActionOrganizationMemberRemoved     = "organization.member_removed"
+)
+
+// Kinds of targets of audited actions
+const (
+	AuditTargetUser         = "user"
+	AuditTargetProfile      = "profile"
+	AuditTargetSubscription = "subscription"
+	AuditTargetImage        = "image"
+	AuditTargetReport       = "report"
+	AuditTargetOrganization = "organization"
+)
+
+// auditLogMaxLimit caps how many records a query returns
+const auditLogMaxLimit = 1000
+
+type (
+	// AuditLogger records security-relevant events in the append-only audit log
+	AuditLogger struct {
+		orm *ent.Client
+	}
+
+	// AuditRequest describes the request an event happened during. It is stored in the context of the
+	// request, so events recorded from anywhere down the stack carry it.
+	AuditRequest struct {
+		ActorID        *int
+		ImpersonatorID *int
+		IPAddress      string
+		UserAgent      string
+		RequestID      string
+	}
+
+	// AuditEvent is an event to record
+	AuditEvent struct {
+		Action string
+		// ActorID overrides the actor of the request, e.g. for the user who just logged in
+		ActorID    *int
+		TargetType string
+		TargetID   *int
+		Metadata   map[string]string
+	}
+
+	// AuditLogFilter narrows down audit log queries, empty fields match everything
+	AuditLogFilter struct {
+		Actions    []string
+		ActorID    *int
+		TargetType string
+		TargetID   *int
+		IPAddress  string
+		RequestID  string
+		Since      time.Time
+		Until      time.Time
+		Limit      int
+		Offset     int
+	}
+
+	auditRequestKey struct{}
+)
+
+// NewAuditLogger creates a new AuditLogger
+func NewAuditLogger(orm *ent.Client) *AuditLogger {
+	return &AuditLogger{orm: orm}
+}
+
+// WithAuditRequest returns a copy of the context carrying the request events are recorded for
+func WithAuditRequest(ctx context.Context, r AuditRequest) context.Context {
+	return context.WithValue(ctx, auditRequestKey{}, r)
+}
+
+// AuditRequestFromContext returns the request stored in the context, if any
+func AuditRequestFromContext(ctx context.Context) (AuditRequest, bool) {
+	r, ok := ctx.Value(auditRequestKey{}).(AuditRequest)
+	return r, ok
+}
+
+// Record appends an event to the audit log, along with the request it happened during
+func (a *AuditLogger) Record(ctx context.Context, event AuditEvent) error {
+	r, _ := AuditRequestFromContext(ctx)
+	actorID := r.ActorID
+	if event.ActorID != nil {
+		actorID = event.ActorID
+	}
+
+	return a.orm.AuditLog.
+		Create().
+		SetAction(event.Action).
+		SetNillableActorID(actorID).
+		SetNillableImpersonatorID(r.ImpersonatorID).
+		SetTargetType(event.TargetType).
+		SetNillableTargetID(event.TargetID).
+		SetIPAddress(r.IPAddress).
+		SetUserAgent(r.UserAgent).
+		SetRequestID(r.RequestID).
+		SetMetadata(event.Metadata).
+		Exec(ctx)
+}
+
+// Query returns the audit log records matching a filter, most recent first
+func (a *AuditLogger) Query(ctx context.Context, filter AuditLogFilter) ([]*ent.AuditLog, error) {
+	q := a.orm.AuditLog.Query()
+
+	if len(filter.Actions) > 0 {
+		q.Where(auditlog.ActionIn(filter.Actions...))
+	}
+	if filter.ActorID != nil {
+		q.Where(auditlog.ActorID(*filter.ActorID))
+	}
+	if filter.TargetType != "" {
+		q.Where(auditlog.TargetType(filter.TargetType))
+	}
+	if filter.TargetID != nil {
+		q.Where(auditlog.TargetID(*filter.TargetID))
+	}
+	if filter.IPAddress != "" {
+		q.Where(auditlog.IPAddress(filter.IPAddress))
+	}
+	if filter.RequestID != "" {
+		q.Where(auditlog.RequestID(filter.RequestID))
+	}
+	if !filter.Since.IsZero() {
+		q.Where(auditlog.CreatedAtGTE(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		q.Where(auditlog.CreatedAtLT(filter.Until))
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > auditLogMaxLimit {
+		limit = auditLogMaxLimit
+	}
+
+	return q.
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+		Limit(limit).
+		Offset(filter.Offset).
+		All(ctx)
+}
+
+// Prune deletes the records older than the retention and returns how many were deleted
+func (a *AuditLogger) Prune(ctx context.Context, retention time.Duration) (int, error) {
+	return a.orm.AuditLog.
+		Delete().
+		Where(auditlog.CreatedAtLT(time.Now().Add(-retention))).
+		Exec(ctx)
+}
+
+// NotificationPermissionHook records the notification permissions of profiles being granted and revoked,
+// whichever code path changes them
+func (a *AuditLogger) NotificationPermissionHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.NotificationPermissionFunc(func(ctx context.Context, m *ent.NotificationPermissionMutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				if p, ok := v.(*ent.NotificationPermission); ok {
+					if err := a.recordNotificationPermission(ctx, AuditActionNotificationPermissionGranted, p); err != nil {
+						return nil, err
+					}
+				}
+				return v, nil
+			}
+
+			if !m.Op().Is(ent.OpDelete) && !m.Op().Is(ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			// The permissions are loaded before being deleted, to know whose they were
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			deleted, err := m.Client().NotificationPermission.
+				Query().
+				Where(notificationpermission.IDIn(ids...)).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			for _, p := range deleted {
+				if err := a.recordNotificationPermission(ctx, AuditActionNotificationPermissionRevoked, p); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+func (a *AuditLogger) recordNotificationPermission(ctx context.Context, action string, p *ent.NotificationPermission) error {
+	return a.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: AuditTargetProfile,
+		TargetID:   &p.ProfileID,
+		Metadata: map[string]string{
+			"permission": p.Permission.String(),
+			"platform":   p.Platform.String(),
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/auditlog"
+	"github.com/mikestefanello/pagoda/ent/notificationpermission"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogger_RecordAndQuery(t *testing.T) {
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	admin, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+
+	requestID := fmt.Sprintf("req-%d", time.Now().UnixNano())
+	bg := WithAuditRequest(context.Background(), AuditRequest{
+		ActorID:        &u.ID,
+		ImpersonatorID: &admin.ID,
+		IPAddress:      "203.0.113.9",
+		UserAgent:      "test-agent",
+		RequestID:      requestID,
+	})
+
+	require.NoError(t, c.Audit.Record(bg, AuditEvent{
+		Action:     AuditActionPhoneVerified,
+		TargetType: AuditTargetUser,
+		TargetID:   &u.ID,
+		Metadata:   map[string]string{"key": "value"},
+	}))
+	// The actor of an event overrides the one of the request
+	require.NoError(t, c.Audit.Record(bg, AuditEvent{
+		Action:  AuditActionLogin,
+		ActorID: &admin.ID,
+	}))
+
+	logs, err := c.Audit.Query(context.Background(), AuditLogFilter{RequestID: requestID})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, AuditActionLogin, logs[0].Action)
+	require.NotNil(t, logs[0].ActorID)
+	assert.Equal(t, admin.ID, *logs[0].ActorID)
+
+	entry := logs[1]
+	assert.Equal(t, AuditActionPhoneVerified, entry.Action)
+	require.NotNil(t, entry.ActorID)
+	assert.Equal(t, u.ID, *entry.ActorID)
+	require.NotNil(t, entry.ImpersonatorID)
+	assert.Equal(t, admin.ID, *entry.ImpersonatorID)
+	assert.Equal(t, AuditTargetUser, entry.TargetType)
+	require.NotNil(t, entry.TargetID)
+	assert.Equal(t, u.ID, *entry.TargetID)
+	assert.Equal(t, "203.0.113.9", entry.IPAddress)
+	assert.Equal(t, "test-agent", entry.UserAgent)
+	assert.Equal(t, "value", entry.Metadata["key"])
+
+	// Filters narrow the results down
+	logs, err = c.Audit.Query(context.Background(), AuditLogFilter{
+		RequestID: requestID,
+		Actions:   []string{AuditActionPhoneVerified},
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, entry.ID, logs[0].ID)
+
+	logs, err = c.Audit.Query(context.Background(), AuditLogFilter{
+		TargetType: AuditTargetUser,
+		TargetID:   &u.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, entry.ID, logs[0].ID)
+
+	logs, err = c.Audit.Query(context.Background(), AuditLogFilter{
+		RequestID: requestID,
+		Since:     time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Len(t, logs, 0)
+
+	// Records cannot be changed once written
+	err = c.ORM.AuditLog.UpdateOne(entry).Exec(context.Background())
+	assert.Error(t, err)
+	err = c.ORM.AuditLog.DeleteOne(entry).Exec(context.Background())
+	assert.Error(t, err)
+}
+
+func TestAuditLogger_Prune(t *testing.T) {
+	old, err := c.ORM.AuditLog.
+		Create().
+		SetAction(AuditActionLogin).
+		SetCreatedAt(time.Now().Add(-48 * time.Hour)).
+		Save(context.Background())
+	require.NoError(t, err)
+	recent, err := c.ORM.AuditLog.
+		Create().
+		SetAction(AuditActionLogin).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	deleted, err := c.Audit.Prune(context.Background(), 24*time.Hour)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, 1)
+
+	exists, err := c.ORM.AuditLog.Query().Where(auditlog.ID(old.ID)).Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = c.ORM.AuditLog.Query().Where(auditlog.ID(recent.ID)).Exist(context.Background())
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestAuditLogger_NotificationPermissionHook(t *testing.T) {
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	p, err := c.ORM.Profile.
+		Create().
+		SetUser(u).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	perm, err := c.ORM.NotificationPermission.
+		Create().
+		SetProfileID(p.ID).
+		SetPermission(notificationpermission.PermissionDailyReminder).
+		SetPlatform(notificationpermission.PlatformEmail).
+		SetToken("token").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	_, err = c.ORM.NotificationPermission.
+		Delete().
+		Where(notificationpermission.ID(perm.ID)).
+		Exec(context.Background())
+	require.NoError(t, err)
+
+	logs, err := c.Audit.Query(context.Background(), AuditLogFilter{
+		TargetType: AuditTargetProfile,
+		TargetID:   &p.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, AuditActionNotificationPermissionRevoked, logs[0].Action)
+	assert.Equal(t, AuditActionNotificationPermissionGranted, logs[1].Action)
+	assert.Equal(t, "daily_reminder", logs[1].Metadata["permission"])
+	assert.Equal(t, "email", logs[1].Metadata["platform"])
+}
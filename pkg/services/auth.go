@@ -1,10 +1,11 @@
 package services
 
 import (
+	stdcontext "context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -29,6 +30,9 @@ const (
 
 	// authSessionKeyAuthenticated stores the key used to store the authentication status in the session
 	authSessionKeyAuthenticated = "authenticated"
+
+	// authSessionKeySessionToken stores the key used to store the token of the server-side session
+	authSessionKeySessionToken = "session_token"
 )
 
 // NotAuthenticatedError is an error returned when a user is not authenticated
@@ -49,20 +53,35 @@ func (e InvalidPasswordTokenError) Error() string {
 
 // AuthClient is the client that handles authentication requests
 type AuthClient struct {
-	config *config.Config
-	orm    *ent.Client
+	config  *config.Config
+	orm     *ent.Client
+	audit   *AuditLogger
+	keyring *Keyring
 }
 
 // NewAuthClient creates a new authentication client
-func NewAuthClient(cfg *config.Config, orm *ent.Client) *AuthClient {
+func NewAuthClient(cfg *config.Config, orm *ent.Client, audit *AuditLogger, keyring *Keyring) *AuthClient {
 	return &AuthClient{
-		config: cfg,
-		orm:    orm,
+		config:  cfg,
+		orm:     orm,
+		audit:   audit,
+		keyring: keyring,
 	}
 }
 
-// Login logs in a user of a given ID
+// Login logs in a user of a given ID. A server-side session is created for the device, and only its
+// token is stored in the session cookie, so the session can later be listed and revoked.
+// Accounts scheduled for deletion are locked, so an AccountPendingDeletionError is returned for them, as is
+// an AccountSuspendedError for suspended ones.
 func (c *AuthClient) Login(ctx echo.Context, userID int) error {
+	if err := c.checkAccountNotLocked(ctx.Request().Context(), userID); err != nil {
+		return err
+	}
+
+	token, err := c.createUserSession(ctx, userID)
+	if err != nil {
+		return err
+	}
 
 	sess, err := session.Get(authSessionName, ctx)
 	if err != nil {
@@ -70,8 +89,18 @@ func (c *AuthClient) Login(ctx echo.Context, userID int) error {
 	}
 
 	sess.Values[authSessionKeyUserID] = userID
+	sess.Values[authSessionKeySessionToken] = token
 	sess.Values[authSessionKeyAuthenticated] = true
-	return sess.Save(ctx.Request(), ctx.Response())
+	if err := sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return err
+	}
+
+	return c.audit.Record(ctx.Request().Context(), AuditEvent{
+		Action:     AuditActionLogin,
+		ActorID:    &userID,
+		TargetType: AuditTargetUser,
+		TargetID:   &userID,
+	})
 }
 
 // Logout logs the requesting user out
@@ -81,8 +110,40 @@ func (c *AuthClient) Logout(ctx echo.Context) error {
 		return err
 	}
 
+	if userID, ok := sess.Values[authSessionKeyUserID].(int); ok && sess.Values[authSessionKeyAuthenticated] == true {
+		err := c.audit.Record(ctx.Request().Context(), AuditEvent{
+			Action:     AuditActionLogout,
+			ActorID:    &userID,
+			TargetType: AuditTargetUser,
+			TargetID:   &userID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// An admin logging out while acting as a user stops doing so
+	if impersonationID, ok := sess.Values[authSessionKeyImpersonationID].(int); ok {
+		imp, err := c.endImpersonation(ctx, impersonationID)
+		if err != nil {
+			return err
+		}
+		if err := c.recordImpersonation(ctx, AuditActionImpersonationStopped, imp); err != nil {
+			return err
+		}
+		delete(sess.Values, authSessionKeyImpersonationID)
+	}
+
+	// Revoke the server-side session so that a copy of the cookie can no longer be used
+	if token, ok := sess.Values[authSessionKeySessionToken].(string); ok {
+		if err := c.deleteUserSession(ctx, token); err != nil {
+			return err
+		}
+	}
+
 	// Overwrite session values
 	sess.Values[authSessionKeyAuthenticated] = false
+	delete(sess.Values, authSessionKeySessionToken)
 
 	// TODO: not quite sure why, but resetting the cookie is not needed in the vanilla
 	// starter kit from Pagoda. Not sure which one of my changes broke that.
@@ -97,37 +158,59 @@ func (c *AuthClient) Logout(ctx echo.Context) error {
 }
 
 // GetAuthenticatedUserID returns the authenticated user's ID, if the user is logged in
+// and their server-side session has not been revoked. When an admin is impersonating a user,
+// that user's ID is returned instead.
 func (c *AuthClient) GetAuthenticatedUserID(ctx echo.Context) (int, error) {
 	sess, err := session.Get(authSessionName, ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	if sess.Values[authSessionKeyAuthenticated] == true {
-		return sess.Values[authSessionKeyUserID].(int), nil
+	if sess.Values[authSessionKeyAuthenticated] != true {
+		return 0, NotAuthenticatedError{}
 	}
 
-	return 0, NotAuthenticatedError{}
+	userID, _ := sess.Values[authSessionKeyUserID].(int)
+	token, _ := sess.Values[authSessionKeySessionToken].(string)
+	if err := c.touchUserSession(ctx, userID, token); err != nil {
+		return 0, err
+	}
+
+	imp, err := c.activeImpersonation(ctx, sess.Values[authSessionKeyImpersonationID], userID)
+	switch err.(type) {
+	case nil:
+		return imp.UserID, nil
+	case NotImpersonatingError:
+		return userID, nil
+	default:
+		return 0, err
+	}
 }
 
 // GetAuthenticatedUser returns the authenticated user if the user is logged in
 func (c *AuthClient) GetAuthenticatedUser(ctx echo.Context) (*ent.User, error) {
 	if userID, err := c.GetAuthenticatedUserID(ctx); err == nil {
-		return c.orm.User.Query().
-			Where(user.ID(userID)).
-			WithProfile(func(q *ent.ProfileQuery) {
-				q.WithProfileImage(func(pi *ent.ImageQuery) {
-					pi.WithSizes(func(s *ent.ImageSizeQuery) {
-						s.WithFile()
-					})
-				})
-			}).
-			Only(ctx.Request().Context())
+		return c.loadAuthenticatedUser(ctx.Request().Context(), userID)
 	}
 
 	return nil, NotAuthenticatedError{}
 }
 
+// loadAuthenticatedUser loads a user along with everything the app expects of the authenticated user
+func (c *AuthClient) loadAuthenticatedUser(ctx stdcontext.Context, userID int) (*ent.User, error) {
+	return c.orm.User.Query().
+		Where(user.ID(userID)).
+		WithProfile(func(q *ent.ProfileQuery) {
+			q.WithProfileImage(func(pi *ent.ImageQuery) {
+				pi.WithSizes(func(s *ent.ImageSizeQuery) {
+					s.WithFile()
+				})
+			})
+		}).
+		WithRoles().
+		Only(ctx)
+}
+
 // SetLastOnlineTimestamp sets the last online time for a user
 func (c *AuthClient) SetLastOnlineTimestamp(ctx echo.Context, userID int) error {
 
@@ -213,14 +296,22 @@ func (c *AuthClient) GetValidPasswordToken(ctx echo.Context, userID, tokenID int
 }
 
 // DeletePasswordTokens deletes all password tokens in the database for a belonging to a given user.
-// This should be called after a successful password reset.
+// This should be called after a successful password reset, which it records in the audit log.
 func (c *AuthClient) DeletePasswordTokens(ctx echo.Context, userID int) error {
 	_, err := c.orm.PasswordToken.
 		Delete().
 		Where(passwordtoken.HasUserWith(user.ID(userID))).
 		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
 
-	return err
+	return c.audit.Record(ctx.Request().Context(), AuditEvent{
+		Action:     AuditActionPasswordReset,
+		ActorID:    &userID,
+		TargetType: AuditTargetUser,
+		TargetID:   &userID,
+	})
 }
 
 // RandomToken generates a random token string of a given length
@@ -233,34 +324,35 @@ func (c *AuthClient) RandomToken(length int) (string, error) {
 	return token[:length], nil
 }
 
+// hashToken hashes a random, high entropy token before it is stored. Unlike passwords a fast hash is
+// sufficient, and it lets the token be looked up directly.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // GenerateEmailVerificationToken generates an email verification token for a given email address using JWT which
 // is set to expire based on the duration stored in configuration
 func (c *AuthClient) GenerateEmailVerificationToken(email string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	return c.keyring.SignJWT(jwt.MapClaims{
 		"email": email,
 		"exp":   time.Now().Add(c.config.App.EmailVerificationTokenExpiration).Unix(),
 	})
-
-	return token.SignedString([]byte(c.config.App.EncryptionKey))
 }
 
 // ValidateEmailVerificationToken validates an email verification token and returns the associated email address if
 // the token is valid and has not expired
 func (c *AuthClient) ValidateEmailVerificationToken(token string) (string, error) {
-	t, err := jwt.Parse(token, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
-		}
-
-		return []byte(c.config.App.EncryptionKey), nil
-	})
-
+	t, err := c.keyring.ParseJWT(token)
 	if err != nil {
 		return "", err
 	}
 
-	if claims, ok := t.Claims.(jwt.MapClaims); ok && t.Valid {
-		return claims["email"].(string), nil
+	// Tokens signed for another purpose, such as changing the email address, are not verification tokens
+	if claims, ok := t.Claims.(jwt.MapClaims); ok && t.Valid && claims["purpose"] == nil {
+		if email, ok := claims["email"].(string); ok {
+			return email, nil
+		}
 	}
 
 	return "", errors.New("invalid or expired token")
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/mikestefanello/pagoda/config"
+)
+
+const (
+	// botChallengePurpose marks the tokens of bot challenges so they cannot be used for anything else
+	botChallengePurpose = "bot_challenge"
+
+	CaptchaProviderHCaptcha  = "hcaptcha"
+	CaptchaProviderTurnstile = "turnstile"
+	CaptchaProviderFake      = "fake"
+
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+	// FakeCaptchaResponse is the only response the fake captcha verifier accepts
+	FakeCaptchaResponse = "fake-captcha-pass"
+)
+
+// Checks a form submission can fail, see BotCheckError
+const (
+	BotCheckHoneypot    = "honeypot"
+	BotCheckFillTime    = "fill_time"
+	BotCheckToken       = "token"
+	BotCheckProofOfWork = "proof_of_work"
+	BotCheckCaptcha     = "captcha"
+)
+
+// BotCheckError is returned when a form submission looks like it was made by a bot. Check names the check it
+// failed and Message explains what to do to the person who submitted it, if they were one.
+type BotCheckError struct {
+	Check   string
+	Message string
+}
+
+// Error implements the error interface.
+func (e BotCheckError) Error() string {
+	return fmt.Sprintf("bot check failed: %s", e.Check)
+}
+
+// BotChallenge is what a form protected against bots embeds, see BotDefense.NewChallenge
+type BotChallenge struct {
+	// ID is unique to the challenge
+	ID string
+
+	// Token is submitted back with the form, it proves when the form was shown and which form it was
+	Token string
+
+	// Difficulty is the number of leading zero bits the hash of the token and the nonce the browser
+	// finds must have, 0 when no proof of work is needed
+	Difficulty int
+
+	// CaptchaProvider and CaptchaSiteKey set the captcha widget to show, if any
+	CaptchaProvider string
+	CaptchaSiteKey  string
+}
+
+// BotSubmission holds what a form protected against bots submitted along with its own fields
+type BotSubmission struct {
+	// Form names the form which was submitted, it has to match the one the challenge was created for
+	Form string
+
+	// Honeypot is a field hidden from people, which only bots filling every field fill
+	Honeypot string
+
+	Token           string
+	Nonce           string
+	CaptchaResponse string
+	RemoteIP        string
+}
+
+// CaptchaVerifier checks the response of a captcha widget with the service which showed it
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, response, remoteIP string) (bool, error)
+}
+
+// BotDefense tells people from bots submitting public forms without any third party, with honeypot fields,
+// a minimum time to fill forms and a proof of work computed by browsers, and optionally with a captcha.
+// Challenges can only be used once, which is tracked in memory and so per instance of the app.
+type BotDefense struct {
+	config   config.BotDefenseConfig
+	keyring  *Keyring
+	verifier CaptchaVerifier
+
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+// NewBotDefense creates a new BotDefense. The verifier checks captcha responses, and no captcha is shown
+// when it is nil.
+func NewBotDefense(cfg config.BotDefenseConfig, keyring *Keyring, verifier CaptchaVerifier) *BotDefense {
+	return &BotDefense{
+		config:   cfg,
+		keyring:  keyring,
+		verifier: verifier,
+		used:     make(map[string]time.Time),
+	}
+}
+
+// NewChallenge creates a challenge for a form about to be shown, named so its challenge cannot be used for
+// another form
+func (b *BotDefense) NewChallenge(form string) (*BotChallenge, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	token, err := b.keyring.SignJWT(jwt.MapClaims{
+		"purpose": botChallengePurpose,
+		"form":    form,
+		"jti":     hex.EncodeToString(id),
+		"iat":     now.Unix(),
+		"exp":     now.Add(b.config.TokenExpiration).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &BotChallenge{
+		ID:         hex.EncodeToString(id),
+		Token:      token,
+		Difficulty: b.config.ProofOfWorkDifficulty,
+	}
+	if b.verifier != nil {
+		challenge.CaptchaProvider = b.config.Captcha.Provider
+		challenge.CaptchaSiteKey = b.config.Captcha.SiteKey
+	}
+	return challenge, nil
+}
+
+// Verify checks a form submission was made by a person, returning a BotCheckError if it does not look so
+func (b *BotDefense) Verify(ctx context.Context, sub BotSubmission) error {
+	if sub.Honeypot != "" {
+		return BotCheckError{
+			Check:   BotCheckHoneypot,
+			Message: "We could not verify that you are a person. Please try again.",
+		}
+	}
+
+	t, err := b.keyring.ParseJWT(sub.Token)
+	if err != nil {
+		return BotCheckError{
+			Check:   BotCheckToken,
+			Message: "This form expired. Please submit it again.",
+		}
+	}
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != botChallengePurpose || claims["form"] != sub.Form {
+		return BotCheckError{
+			Check:   BotCheckToken,
+			Message: "This form expired. Please submit it again.",
+		}
+	}
+	id, _ := claims["jti"].(string)
+	issuedAt, _ := claims["iat"].(float64)
+	expiresAt, _ := claims["exp"].(float64)
+	if id == "" || issuedAt == 0 || expiresAt == 0 {
+		return BotCheckError{
+			Check:   BotCheckToken,
+			Message: "This form expired. Please submit it again.",
+		}
+	}
+
+	if time.Since(time.Unix(int64(issuedAt), 0)) < b.config.MinFillTime {
+		return BotCheckError{
+			Check:   BotCheckFillTime,
+			Message: "That was fast! Please take a moment and submit the form again.",
+		}
+	}
+
+	if !hasLeadingZeroBits(sub.Token, sub.Nonce, b.config.ProofOfWorkDifficulty) {
+		return BotCheckError{
+			Check:   BotCheckProofOfWork,
+			Message: "Your browser could not verify that you are a person. Please enable JavaScript and try again.",
+		}
+	}
+
+	if b.verifier != nil {
+		ok, err := b.verifier.Verify(ctx, sub.CaptchaResponse, sub.RemoteIP)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return BotCheckError{
+				Check:   BotCheckCaptcha,
+				Message: "Please complete the captcha.",
+			}
+		}
+	}
+
+	// Challenges are only used up once every other check passed, so that a person can fix a failed captcha
+	// and submit the same form again
+	if !b.use(id, time.Unix(int64(expiresAt), 0)) {
+		return BotCheckError{
+			Check:   BotCheckToken,
+			Message: "This form was already submitted. Please submit it again.",
+		}
+	}
+
+	return nil
+}
+
+// use marks a challenge as used until it expires, returning false if it was used already
+func (b *BotDefense) use(id string, expiresAt time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	for usedID, exp := range b.used {
+		if now.After(exp) {
+			delete(b.used, usedID)
+		}
+	}
+
+	if _, ok := b.used[id]; ok {
+		return false
+	}
+	b.used[id] = expiresAt
+	return true
+}
+
+// hasLeadingZeroBits checks that the SHA-256 hash of a token and a nonce, joined by a colon, starts with
+// at least difficulty zero bits
+func hasLeadingZeroBits(token, nonce string, difficulty int) bool {
+	if difficulty <= 0 {
+		return true
+	}
+
+	sum := sha256.Sum256([]byte(token + ":" + nonce))
+	zeros := 0
+	for _, by := range sum {
+		if by != 0 {
+			zeros += bits.LeadingZeros8(by)
+			break
+		}
+		zeros += 8
+	}
+	return zeros >= difficulty
+}
+
+// SiteVerifyCaptchaVerifier checks captcha responses with a siteverify API, which hCaptcha and Turnstile share
+type SiteVerifyCaptchaVerifier struct {
+	client    *http.Client
+	verifyURL string
+	secretKey string
+}
+
+// NewSiteVerifyCaptchaVerifier creates a new SiteVerifyCaptchaVerifier, see HCaptchaVerifyURL and
+// TurnstileVerifyURL
+func NewSiteVerifyCaptchaVerifier(verifyURL, secretKey string) *SiteVerifyCaptchaVerifier {
+	return &SiteVerifyCaptchaVerifier{
+		client:    &http.Client{Timeout: 10 * time.Second},
+		verifyURL: verifyURL,
+		secretKey: secretKey,
+	}
+}
+
+// Verify implements CaptchaVerifier.
+func (v *SiteVerifyCaptchaVerifier) Verify(ctx context.Context, response, remoteIP string) (bool, error) {
+	if response == "" {
+		return false, nil
+	}
+
+	form := url.Values{}
+	form.Set("secret", v.secretKey)
+	form.Set("response", response)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify captcha: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("failed to verify captcha: status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return false, fmt.Errorf("failed to verify captcha: %w", err)
+	}
+	return body.Success, nil
+}
+
+// FakeCaptchaVerifier accepts FakeCaptchaResponse only, standing in for a captcha service while developing
+type FakeCaptchaVerifier struct{}
+
+// Verify implements CaptchaVerifier.
+func (FakeCaptchaVerifier) Verify(_ context.Context, response, _ string) (bool, error) {
+	return response == FakeCaptchaResponse, nil
+}
+
+// NewCaptchaVerifier creates the captcha verifier of the configured provider, which is nil when no captcha
+// is configured
+func NewCaptchaVerifier(cfg *config.Config) (CaptchaVerifier, error) {
+	captcha := cfg.BotDefense.Captcha
+	switch captcha.Provider {
+	case "":
+		return nil, nil
+	case CaptchaProviderHCaptcha:
+		return NewSiteVerifyCaptchaVerifier(HCaptchaVerifyURL, captcha.SecretKey), nil
+	case CaptchaProviderTurnstile:
+		return NewSiteVerifyCaptchaVerifier(TurnstileVerifyURL, captcha.SecretKey), nil
+	case CaptchaProviderFake:
+		if cfg.App.Environment == config.EnvProduction {
+			return nil, errors.New("the fake captcha cannot be used in production")
+		}
+		return FakeCaptchaVerifier{}, nil
+	default:
+		return nil, fmt.Errorf("unknown captcha provider: %s", captcha.Provider)
+	}
+}
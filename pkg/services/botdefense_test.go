@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// solveProofOfWork finds a nonce the way browsers do
+func solveProofOfWork(token string, difficulty int) string {
+	for nonce := 0; ; nonce++ {
+		if hasLeadingZeroBits(token, strconv.Itoa(nonce), difficulty) {
+			return strconv.Itoa(nonce)
+		}
+	}
+}
+
+func TestBotDefense_Verify(t *testing.T) {
+	b := NewBotDefense(config.BotDefenseConfig{
+		TokenExpiration:       time.Hour,
+		ProofOfWorkDifficulty: 8,
+	}, c.Keyring, nil)
+
+	challenge, err := b.NewChallenge("register")
+	require.NoError(t, err)
+	assert.Equal(t, 8, challenge.Difficulty)
+	assert.Empty(t, challenge.CaptchaProvider)
+
+	submission := BotSubmission{
+		Form:  "register",
+		Token: challenge.Token,
+		Nonce: solveProofOfWork(challenge.Token, 8),
+	}
+
+	check := func(sub BotSubmission) string {
+		err := b.Verify(context.Background(), sub)
+		if err == nil {
+			return ""
+		}
+		require.IsType(t, BotCheckError{}, err)
+		return err.(BotCheckError).Check
+	}
+
+	honeypot := submission
+	honeypot.Honeypot = "https://spam.example.com"
+	assert.Equal(t, BotCheckHoneypot, check(honeypot))
+
+	otherForm := submission
+	otherForm.Form = "email_subscribe"
+	assert.Equal(t, BotCheckToken, check(otherForm))
+
+	forged := submission
+	forged.Token = "forged"
+	assert.Equal(t, BotCheckToken, check(forged))
+
+	noWork := submission
+	noWork.Nonce = ""
+	if hasLeadingZeroBits(noWork.Token, noWork.Nonce, 8) {
+		noWork.Nonce = "-1"
+	}
+	assert.Equal(t, BotCheckProofOfWork, check(noWork))
+
+	// Challenges can only be used once
+	assert.Empty(t, check(submission))
+	assert.Equal(t, BotCheckToken, check(submission))
+}
+
+func TestBotDefense_MinFillTime(t *testing.T) {
+	b := NewBotDefense(config.BotDefenseConfig{
+		MinFillTime:     time.Minute,
+		TokenExpiration: time.Hour,
+	}, c.Keyring, nil)
+
+	challenge, err := b.NewChallenge("register")
+	require.NoError(t, err)
+
+	err = b.Verify(context.Background(), BotSubmission{Form: "register", Token: challenge.Token})
+	assert.Equal(t, BotCheckFillTime, err.(BotCheckError).Check)
+}
+
+func TestBotDefense_Captcha(t *testing.T) {
+	b := NewBotDefense(config.BotDefenseConfig{
+		TokenExpiration: time.Hour,
+		Captcha:         config.CaptchaConfig{Provider: CaptchaProviderFake, SiteKey: "site"},
+	}, c.Keyring, FakeCaptchaVerifier{})
+
+	challenge, err := b.NewChallenge("register")
+	require.NoError(t, err)
+	assert.Equal(t, CaptchaProviderFake, challenge.CaptchaProvider)
+	assert.Equal(t, "site", challenge.CaptchaSiteKey)
+
+	// A failed captcha can be retried with the same challenge
+	err = b.Verify(context.Background(), BotSubmission{Form: "register", Token: challenge.Token})
+	assert.Equal(t, BotCheckCaptcha, err.(BotCheckError).Check)
+
+	err = b.Verify(context.Background(), BotSubmission{
+		Form:            "register",
+		Token:           challenge.Token,
+		CaptchaResponse: FakeCaptchaResponse,
+	})
+	assert.NoError(t, err)
+}
+
+func TestSiteVerifyCaptchaVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "1.2.3.4", r.PostForm.Get("remoteip"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("response") == "passed" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+		} else {
+			_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+		}
+	}))
+	defer server.Close()
+
+	v := NewSiteVerifyCaptchaVerifier(server.URL, "secret")
+
+	ok, err := v.Verify(context.Background(), "passed", "1.2.3.4")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = v.Verify(context.Background(), "failed", "1.2.3.4")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestHasLeadingZeroBits(t *testing.T) {
+	sum := sha256.Sum256([]byte("token:nonce"))
+	zeros := 0
+	for sum[zeros/8]&(0x80>>(zeros%8)) == 0 {
+		zeros++
+	}
+
+	assert.True(t, hasLeadingZeroBits("token", "nonce", zeros))
+	assert.False(t, hasLeadingZeroBits("token", "nonce", zeros+1))
+	assert.True(t, hasLeadingZeroBits("token", "nonce", 0))
+}
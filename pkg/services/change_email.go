@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/emailsubscription"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// changeEmailTokenPurpose is the purpose claim of email change JWTs, so that they cannot be mistaken
+// for other tokens signed with the same key
+const changeEmailTokenPurpose = "change_email"
+
+// InvalidChangeEmailTokenError is an error returned when an email change link is invalid, expired,
+// or the email address of the user changed since it was sent
+type InvalidChangeEmailTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidChangeEmailTokenError) Error() string {
+	return "invalid email change token"
+}
+
+// EmailTakenError is an error returned when an email address is already used by another user
+type EmailTakenError struct{}
+
+// Error implements the error interface.
+func (e EmailTakenError) Error() string {
+	return "email address already in use"
+}
+
+// GenerateChangeEmailToken generates a token to confirm the change of the email address of a user.
+// It is bound to the current address so that it stops working if the address changes in the meantime.
+func (c *AuthClient) GenerateChangeEmailToken(userID int, oldEmail, newEmail string) (string, error) {
+	return c.keyring.SignJWT(jwt.MapClaims{
+		"sub":       userID,
+		"email":     strings.ToLower(newEmail),
+		"old_email": strings.ToLower(oldEmail),
+		"purpose":   changeEmailTokenPurpose,
+		"exp":       time.Now().Add(c.config.App.EmailVerificationTokenExpiration).Unix(),
+	})
+}
+
+// IsEmailTaken returns true if an email address is used by a user other than the given one
+func (c *AuthClient) IsEmailTaken(ctx context.Context, userID int, email string) (bool, error) {
+	return c.orm.User.
+		Query().
+		Where(
+			user.Email(strings.ToLower(email)),
+			user.IDNEQ(userID),
+		).
+		Exist(ctx)
+}
+
+// ChangeEmail applies the email change confirmed by a token. The new address is verified since the
+// user received the link there, and the email subscriptions of the old address move to the new one.
+// It returns the updated user and their previous email address.
+func (c *AuthClient) ChangeEmail(ctx context.Context, token string) (*ent.User, string, error) {
+	t, err := c.keyring.ParseJWT(token)
+	if err != nil || !t.Valid {
+		return nil, "", InvalidChangeEmailTokenError{}
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != changeEmailTokenPurpose {
+		return nil, "", InvalidChangeEmailTokenError{}
+	}
+	userID, _ := claims["sub"].(float64)
+	newEmail, _ := claims["email"].(string)
+	oldEmail, _ := claims["old_email"].(string)
+	if userID == 0 || newEmail == "" || oldEmail == "" {
+		return nil, "", InvalidChangeEmailTokenError{}
+	}
+
+	taken, err := c.IsEmailTaken(ctx, int(userID), newEmail)
+	if err != nil {
+		return nil, "", err
+	}
+	if taken {
+		return nil, "", EmailTakenError{}
+	}
+
+	tx, err := c.orm.Tx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	updated, err := tx.User.
+		Update().
+		Where(
+			user.ID(int(userID)),
+			user.Email(oldEmail),
+		).
+		SetEmail(newEmail).
+		SetVerified(true).
+		Save(ctx)
+	if err != nil {
+		return nil, "", rollback(tx, err)
+	}
+	if updated == 0 {
+		return nil, "", rollback(tx, InvalidChangeEmailTokenError{})
+	}
+
+	if err := moveEmailSubscription(ctx, tx, oldEmail, newEmail); err != nil {
+		return nil, "", rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", err
+	}
+
+	usr, err := c.orm.User.Get(ctx, int(userID))
+	if err != nil {
+		return nil, "", err
+	}
+	return usr, oldEmail, nil
+}
+
+// moveEmailSubscription moves the email subscription of an address to another one. If the new address
+// already has a subscription, the lists of both are merged into it.
+func moveEmailSubscription(ctx context.Context, tx *ent.Tx, oldEmail, newEmail string) error {
+	old, err := tx.EmailSubscription.
+		Query().
+		Where(emailsubscription.Email(oldEmail)).
+		WithSubscriptions().
+		Only(ctx)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return nil
+	default:
+		return err
+	}
+
+	existing, err := tx.EmailSubscription.
+		Query().
+		Where(emailsubscription.Email(newEmail)).
+		WithSubscriptions().
+		Only(ctx)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return old.Update().
+			SetEmail(newEmail).
+			SetVerified(true).
+			Exec(ctx)
+	default:
+		return err
+	}
+
+	subscribed := make(map[int]bool, len(existing.Edges.Subscriptions))
+	for _, s := range existing.Edges.Subscriptions {
+		subscribed[s.ID] = true
+	}
+	update := existing.Update().SetVerified(true)
+	for _, s := range old.Edges.Subscriptions {
+		if !subscribed[s.ID] {
+			update.AddSubscriptionIDs(s.ID)
+		}
+	}
+	if err := update.Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.EmailSubscription.DeleteOne(old).Exec(ctx)
+}
+
+// rollback rolls a transaction back, keeping the error which caused it
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
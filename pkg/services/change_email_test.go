@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/emailsubscription"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_ChangeEmail(t *testing.T) {
+	bg := context.Background()
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	oldEmail := u.Email
+	newEmail := fmt.Sprintf("changed-%d@localhost.localhost", time.Now().UnixNano())
+
+	_, err = c.ORM.EmailSubscription.
+		Create().
+		SetEmail(oldEmail).
+		SetConfirmationCode(fmt.Sprintf("code-%d", time.Now().UnixNano())).
+		Save(bg)
+	require.NoError(t, err)
+
+	token, err := c.Auth.GenerateChangeEmailToken(u.ID, oldEmail, newEmail)
+	require.NoError(t, err)
+
+	// The token is not an email verification token
+	_, err = c.Auth.ValidateEmailVerificationToken(token)
+	assert.Error(t, err)
+
+	// Email verification tokens cannot change the email
+	verification, err := c.Auth.GenerateEmailVerificationToken(newEmail)
+	require.NoError(t, err)
+	_, _, err = c.Auth.ChangeEmail(bg, verification)
+	assert.Equal(t, InvalidChangeEmailTokenError{}, err)
+
+	changed, previous, err := c.Auth.ChangeEmail(bg, token)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, changed.ID)
+	assert.Equal(t, newEmail, changed.Email)
+	assert.True(t, changed.Verified)
+	assert.Equal(t, oldEmail, previous)
+
+	// The email subscription moved to the new address
+	sub, err := c.ORM.EmailSubscription.Query().Where(emailsubscription.Email(newEmail)).Only(bg)
+	require.NoError(t, err)
+	assert.True(t, sub.Verified)
+	exists, err := c.ORM.EmailSubscription.Query().Where(emailsubscription.Email(oldEmail)).Exist(bg)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// The link stops working once the address changed
+	_, _, err = c.Auth.ChangeEmail(bg, token)
+	assert.Equal(t, InvalidChangeEmailTokenError{}, err)
+
+	// Addresses of other users cannot be taken
+	taken, err := c.Auth.IsEmailTaken(bg, usr.ID, newEmail)
+	require.NoError(t, err)
+	assert.True(t, taken)
+	token, err = c.Auth.GenerateChangeEmailToken(usr.ID, usr.Email, newEmail)
+	require.NoError(t, err)
+	_, _, err = c.Auth.ChangeEmail(bg, token)
+	assert.Equal(t, EmailTakenError{}, err)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"os"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/mikestefanello/pagoda/config"
 	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
 	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
@@ -77,12 +79,39 @@ type Container struct {
 	// Mail stores an email sending client
 	Mail *mailer.MailClient
 
+	// SMS stores a text message sending client
+	SMS *notifierrepo.SMSSender
+
+	// Keyring stores the keys signing sessions and tokens
+	Keyring *Keyring
+
 	// Auth stores an authentication client
 	Auth *AuthClient
 
+	// OAuth stores a client for social login providers
+	OAuth *OAuthClient
+
+	// Throttler slows down and locks out repeated failed login and verification attempts
+	Throttler *Throttler
+
+	// RateLimiter limits how many requests clients can make
+	RateLimiter *RateLimiter
+
+	// Audit records security-relevant events
+	Audit *AuditLogger
+
+	// PasswordPolicy checks that new passwords are strong enough
+	PasswordPolicy *PasswordPolicy
+
+	// BotDefense tells people from bots submitting public forms
+	BotDefense *BotDefense
+
 	// Notifier handles all notifications to clients
 	Notifier *notifierrepo.NotifierRepo
 
+	// pushNotificationsRepos are the notifier's push senders, see SetPushDeliveryQueue
+	pushNotificationsRepos []pushDeliveryQueueSetter
+
 	// Tasks stores the task client
 	Tasks *TaskClient
 }
@@ -96,21 +125,34 @@ func NewContainer() *Container {
 	// c.initCache()
 	c.initDatabase()
 	c.initORM()
+	c.initAudit()
+	c.initKeyring()
 	c.initAuth()
-	// c.initNotifier()
+	c.initOAuth()
+	c.initThrottler()
+	c.initRateLimiter()
+	c.initPasswordPolicy()
+	c.initBotDefense()
 	c.initMail()
+	c.initSMS()
+	c.initTasks()
+	c.initNotifier()
 	c.initPaymentProcessor()
-	// c.initTasks()
 	return c
 }
 
 // Shutdown shuts the Container down and disconnects all connections
 func (c *Container) Shutdown() error {
-	if err := c.Tasks.Close(); err != nil {
-		return err
+	// The tasks and cache clients are not initialized in embedded mode
+	if c.Tasks != nil {
+		if err := c.Tasks.Close(); err != nil {
+			return err
+		}
 	}
-	if err := c.Cache.Close(); err != nil {
-		return err
+	if c.Cache != nil {
+		if err := c.Cache.Close(); err != nil {
+			return err
+		}
 	}
 	if err := c.ORM.Close(); err != nil {
 		return err
@@ -140,6 +182,14 @@ func (c *Container) initValidator() {
 func (c *Container) initWeb() {
 
 	c.Web = echo.New()
+
+	// Client IPs are used to rate limit and throttle requests, so they cannot come from headers set by clients
+	ipExtractor, err := newIPExtractor(c.Config.HTTP.TrustedProxies)
+	if err != nil {
+		panic(fmt.Sprintf("failed to configure client IP extraction: %v", err))
+	}
+	c.Web.IPExtractor = ipExtractor
+
 	if c.Config.App.Environment == config.EnvProduction {
 		// TODO: Haven't set up sentry for GoShip yet
 		// sentryDsn := c.Config.App.SentryDsn
@@ -194,6 +244,29 @@ func (c *Container) initWeb() {
 	c.Web.Validator = c.Validator
 }
 
+// newIPExtractor returns how the IP of clients is found. Without trusted proxies it is the address the
+// request came from, otherwise it is read from the X-Forwarded-For header, trusting only the given ranges.
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// Echo trusts loopback and private addresses by default, only the configured ranges should be
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, cidr := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q: %w", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
 // initCache initializes the cache
 func (c *Container) initCache() {
 	var err error
@@ -360,13 +433,79 @@ func renameColumnHook(next schema.Differ) schema.Differ {
 	})
 }
 
+// initKeyring initializes the keyring from configuration
+func (c *Container) initKeyring() {
+	var err error
+	c.Keyring, err = NewKeyring(c.Config)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load keyring: %v", err))
+	}
+}
+
 // initAuth initializes the authentication client
 func (c *Container) initAuth() {
-	c.Auth = NewAuthClient(c.Config, c.ORM)
+	c.Auth = NewAuthClient(c.Config, c.ORM, c.Audit, c.Keyring)
 }
 
+// initOAuth initializes the social login client
+func (c *Container) initOAuth() {
+	c.OAuth = NewOAuthClient(c.Config, c.ORM)
+}
+
+// initThrottler initializes the throttler of failed attempts, which is backed by the cache when
+// there is one and otherwise by the database
+func (c *Container) initThrottler() {
+	var store AttemptStore
+	if c.Cache != nil {
+		store = NewRedisAttemptStore(c.Cache.Client)
+	} else {
+		store = NewEntAttemptStore(c.ORM)
+	}
+	c.Throttler = NewThrottler(c.Config.LoginThrottle, store)
+}
+
+// initRateLimiter initializes the rate limiter with the configured backend
+func (c *Container) initRateLimiter() {
+	var store RateLimitStore
+	switch {
+	case c.Config.RateLimit.Backend == RateLimitBackendRedis && c.Cache != nil:
+		store = NewRedisRateLimitStore(c.Cache.Client)
+	case c.Config.RateLimit.Backend == RateLimitBackendRedis:
+		log.Warn().Msg("rate limiting falls back to memory since the cache is not initialized")
+		fallthrough
+	default:
+		store = NewMemoryRateLimitStore()
+	}
+	c.RateLimiter = NewRateLimiter(c.Config.RateLimit, store)
+}
+
+// initAudit initializes the audit logger, and records the changes made to notification permissions
+func (c *Container) initAudit() {
+	c.Audit = NewAuditLogger(c.ORM)
+	c.ORM.NotificationPermission.Use(c.Audit.NotificationPermissionHook())
+}
+
+// initPasswordPolicy initializes the password policy
+func (c *Container) initPasswordPolicy() {
+	c.PasswordPolicy = NewPasswordPolicy(c.Config.PasswordPolicy)
+}
+
+// initBotDefense initializes the bot defense of public forms
+func (c *Container) initBotDefense() {
+	verifier, err := NewCaptchaVerifier(c.Config)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create captcha verifier: %v", err))
+	}
+	c.BotDefense = NewBotDefense(c.Config.BotDefense, c.Keyring, verifier)
+}
+
+// initNotifier initializes the notifier storing notifications and sending them to their recipients
 func (c *Container) initNotifier() {
-	pubsubRepo := pubsub.NewRedisPubSubClient(c.Cache.Client)
+	// Notifications are only published live to the app through the cache
+	var pubsubRepo pubsub.PubSubClient
+	if c.Cache != nil {
+		pubsubRepo = pubsub.NewRedisPubSubClient(c.Cache.Client)
+	}
 	notificationStorageRepo := notifierrepo.NewNotificationStorageRepo(c.ORM)
 	pwaPushNotificationsRepo := notifierrepo.NewPwaPushNotificationsRepo(
 		c.ORM, c.Config.App.VapidPublicKey, c.Config.App.VapidPrivateKey, c.Config.Mail.FromAddress,
@@ -374,12 +513,35 @@ func (c *Container) initNotifier() {
 	fcmPushNotificationsRepo, err := notifierrepo.NewFcmPushNotificationsRepo(
 		c.ORM, &c.Config.App.FirebaseJSONAccessKeys)
 	if err != nil {
-		log.Fatal().Err(err)
+		panic(fmt.Sprintf("failed to create fcm push notifications repo: %v", err))
 	}
+	c.pushNotificationsRepos = []pushDeliveryQueueSetter{pwaPushNotificationsRepo, fcmPushNotificationsRepo}
+	router := notifierrepo.NewNotificationRouter(
+		notifierrepo.NewNotificationSendPermissionRepo(c.ORM),
+		map[domain.NotificationPlatform]notifierrepo.NotificationSender{
+			domain.NotificationPlatformPush:    pwaPushNotificationsRepo,
+			domain.NotificationPlatformFCMPush: fcmPushNotificationsRepo,
+			domain.NotificationPlatformEmail:   notifierrepo.NewEmailNotificationSender(c.ORM, c.Mail, c.Config.HTTP.Domain),
+			domain.NotificationPlatformSMS:     notifierrepo.NewSMSNotificationSender(c.ORM, c.SMS, c.Config.HTTP.Domain),
+		},
+	)
 	storageRepo := storagerepo.NewStorageClient(c.Config, c.ORM)
 	profileRepo := *profilerepo.NewProfileRepo(c.ORM, storageRepo, nil)
 	c.Notifier = notifierrepo.NewNotifierRepo(
-		pubsubRepo, notificationStorageRepo, pwaPushNotificationsRepo, fcmPushNotificationsRepo, profileRepo.GetCountOfUnseenNotifications)
+		pubsubRepo, notificationStorageRepo, router, fcmPushNotificationsRepo, profileRepo.GetCountOfUnseenNotifications)
+}
+
+// pushDeliveryQueueSetter is a push notification sender which can deliver through a queue
+type pushDeliveryQueueSetter interface {
+	SetDeliveryQueue(queue notifierrepo.PushDeliveryQueue)
+}
+
+// SetPushDeliveryQueue makes the notifier deliver push notifications through queue rather than right away.
+// The queue is set once the tasks delivering them are known, since they are defined on top of the container.
+func (c *Container) SetPushDeliveryQueue(queue notifierrepo.PushDeliveryQueue) {
+	for _, repo := range c.pushNotificationsRepos {
+		repo.SetDeliveryQueue(queue)
+	}
 }
 
 // initMail initialize the mail client
@@ -398,6 +560,15 @@ func (c *Container) initMail() {
 	}
 }
 
+// initSMS initializes the text message client
+func (c *Container) initSMS() {
+	provider, err := notifierrepo.NewSMSProvider(c.Config)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create sms provider: %v", err))
+	}
+	c.SMS = notifierrepo.NewSMSSender(c.ORM, provider, c.Config)
+}
+
 func (c *Container) initPaymentProcessor() {
 	stripe.Key = c.Config.App.PrivateStripeKey
 }
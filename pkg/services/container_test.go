@@ -1,9 +1,14 @@
 package services
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/labstack/echo/v4"
+
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewContainer(t *testing.T) {
@@ -17,3 +22,20 @@ func TestNewContainer(t *testing.T) {
 	assert.NotNil(t, c.Auth)
 	assert.NotNil(t, c.Tasks)
 }
+
+func TestNewIPExtractor(t *testing.T) {
+	_, err := newIPExtractor([]string{"10.0.0.1"})
+	assert.Error(t, err)
+
+	extract, err := newIPExtractor([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	// The forwarding header is only read when set by a trusted proxy
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.1.2.3:4321"
+	req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.7")
+	assert.Equal(t, "203.0.113.7", extract(req))
+
+	req.RemoteAddr = "198.51.100.1:4321"
+	assert.Equal(t, "198.51.100.1", extract(req))
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// authSessionKeyImpersonationID stores the ID of the impersonation the admin of the session is in
+	authSessionKeyImpersonationID = "impersonation_id"
+
+	// ImpersonationTimeout is how long an admin can act as a user before being switched back to themselves
+	ImpersonationTimeout = time.Hour
+)
+
+// NotImpersonatingError is an error returned when the requesting admin is not acting as another user
+type NotImpersonatingError struct{}
+
+// Error implements the error interface.
+func (e NotImpersonatingError) Error() string {
+	return "not impersonating a user"
+}
+
+// ImpersonationNotAllowedError is an error returned when an admin cannot act as a given user
+type ImpersonationNotAllowedError struct {
+	Reason string
+}
+
+// Error implements the error interface.
+func (e ImpersonationNotAllowedError) Error() string {
+	return "impersonation not allowed: " + e.Reason
+}
+
+// StartImpersonation lets an admin act as another user. The session stays the admin's, so the admin
+// is who gets logged out, and the impersonation is recorded for auditing until it is stopped.
+// Other admins cannot be impersonated.
+func (c *AuthClient) StartImpersonation(ctx echo.Context, admin *ent.User, userID int, reason string) (*ent.Impersonation, error) {
+	if !UserHasPermission(admin, PermissionImpersonate) {
+		return nil, ImpersonationNotAllowedError{Reason: "missing the " + PermissionImpersonate + " permission"}
+	}
+	if admin.ID == userID {
+		return nil, ImpersonationNotAllowedError{Reason: "cannot impersonate yourself"}
+	}
+
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := sess.Values[authSessionKeyImpersonationID]; ok {
+		if _, err := c.StopImpersonation(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	target, err := c.orm.User.
+		Query().
+		Where(user.ID(userID)).
+		WithRoles().
+		Only(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	if UserHasPermission(target, PermissionAdminAccess) {
+		return nil, ImpersonationNotAllowedError{Reason: "admins cannot be impersonated"}
+	}
+
+	imp, err := c.orm.Impersonation.
+		Create().
+		SetAdminID(admin.ID).
+		SetUserID(target.ID).
+		SetReason(reason).
+		SetIPAddress(ctx.RealIP()).
+		SetUserAgent(ctx.Request().UserAgent()).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	sess.Values[authSessionKeyImpersonationID] = imp.ID
+	if err := sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	if err := c.recordImpersonation(ctx, AuditActionImpersonationStarted, imp); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Int("adminID", admin.ID).
+		Int("userID", target.ID).
+		Int("impersonationID", imp.ID).
+		Str("reason", reason).
+		Msg("admin started impersonating user")
+
+	return imp, nil
+}
+
+// StopImpersonation switches the requesting admin back to themselves and returns the impersonation that ended
+func (c *AuthClient) StopImpersonation(ctx echo.Context) (*ent.Impersonation, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	impersonationID, ok := sess.Values[authSessionKeyImpersonationID].(int)
+	if !ok {
+		return nil, NotImpersonatingError{}
+	}
+
+	delete(sess.Values, authSessionKeyImpersonationID)
+	if err := sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	imp, err := c.endImpersonation(ctx, impersonationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.recordImpersonation(ctx, AuditActionImpersonationStopped, imp); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Int("adminID", imp.AdminID).
+		Int("userID", imp.UserID).
+		Int("impersonationID", imp.ID).
+		Msg("admin stopped impersonating user")
+
+	return imp, nil
+}
+
+// GetImpersonation returns the ongoing impersonation of the requesting admin, with the admin loaded
+func (c *AuthClient) GetImpersonation(ctx echo.Context) (*ent.Impersonation, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	adminID, _ := sess.Values[authSessionKeyUserID].(int)
+	return c.activeImpersonation(ctx, sess.Values[authSessionKeyImpersonationID], adminID)
+}
+
+// GetImpersonations returns the most recent impersonations, with who was involved
+func (c *AuthClient) GetImpersonations(ctx echo.Context, limit int) ([]*ent.Impersonation, error) {
+	return c.orm.Impersonation.
+		Query().
+		WithAdmin().
+		WithUser().
+		Order(ent.Desc(impersonation.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx.Request().Context())
+}
+
+// activeImpersonation loads an impersonation stored in a session, as long as it was started by the
+// admin of the session, was not stopped and did not time out
+func (c *AuthClient) activeImpersonation(ctx echo.Context, value any, adminID int) (*ent.Impersonation, error) {
+	impersonationID, ok := value.(int)
+	if !ok {
+		return nil, NotImpersonatingError{}
+	}
+
+	imp, err := c.orm.Impersonation.
+		Query().
+		Where(
+			impersonation.ID(impersonationID),
+			impersonation.AdminID(adminID),
+			impersonation.EndedAtIsNil(),
+			impersonation.CreatedAtGT(time.Now().Add(-ImpersonationTimeout)),
+		).
+		WithAdmin().
+		Only(ctx.Request().Context())
+
+	switch err.(type) {
+	case nil:
+		return imp, nil
+	case *ent.NotFoundError:
+		return nil, NotImpersonatingError{}
+	default:
+		return nil, err
+	}
+}
+
+// endImpersonation records that an impersonation was stopped, unless it already was
+func (c *AuthClient) endImpersonation(ctx echo.Context, impersonationID int) (*ent.Impersonation, error) {
+	_, err := c.orm.Impersonation.
+		Update().
+		Where(
+			impersonation.ID(impersonationID),
+			impersonation.EndedAtIsNil(),
+		).
+		SetEndedAt(time.Now()).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orm.Impersonation.Get(ctx.Request().Context(), impersonationID)
+}
+
+// recordImpersonation records an impersonation starting or stopping in the audit log, as done by the admin
+func (c *AuthClient) recordImpersonation(ctx echo.Context, action string, imp *ent.Impersonation) error {
+	return c.audit.Record(ctx.Request().Context(), AuditEvent{
+		Action:     action,
+		ActorID:    &imp.AdminID,
+		TargetType: AuditTargetUser,
+		TargetID:   &imp.UserID,
+		Metadata: map[string]string{
+			"impersonation_id": strconv.Itoa(imp.ID),
+			"reason":           imp.Reason,
+		},
+	})
+}
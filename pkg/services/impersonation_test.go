@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Impersonation(t *testing.T) {
+	bg := context.Background()
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	adminUsr, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	otherAdmin, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+	require.NoError(t, c.Auth.GrantRole(bg, adminUsr.ID, RoleAdmin))
+	require.NoError(t, c.Auth.GrantRole(bg, otherAdmin.ID, RoleAdmin))
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	require.NoError(t, c.Auth.Login(ctx, adminUsr.ID))
+	admin, err := c.Auth.GetAuthenticatedUser(ctx)
+	require.NoError(t, err)
+
+	_, err = c.Auth.GetImpersonation(ctx)
+	assert.Equal(t, NotImpersonatingError{}, err)
+
+	// Users without the permission, themselves and other admins cannot be impersonated
+	_, err = c.Auth.StartImpersonation(ctx, u, adminUsr.ID, "test")
+	assert.IsType(t, ImpersonationNotAllowedError{}, err)
+	_, err = c.Auth.StartImpersonation(ctx, admin, admin.ID, "test")
+	assert.IsType(t, ImpersonationNotAllowedError{}, err)
+	_, err = c.Auth.StartImpersonation(ctx, admin, otherAdmin.ID, "test")
+	assert.IsType(t, ImpersonationNotAllowedError{}, err)
+
+	// While impersonating, the user is authenticated but the session stays the admin's
+	imp, err := c.Auth.StartImpersonation(ctx, admin, u.ID, "ticket 42")
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, imp.AdminID)
+	assert.Equal(t, u.ID, imp.UserID)
+	assert.Equal(t, "ticket 42", imp.Reason)
+	assert.Nil(t, imp.EndedAt)
+
+	uid, err := c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, uid)
+
+	current, err := c.Auth.GetImpersonation(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, imp.ID, current.ID)
+	assert.Equal(t, admin.ID, current.Edges.Admin.ID)
+
+	// Stopping switches back to the admin and records when it ended
+	stopped, err := c.Auth.StopImpersonation(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, imp.ID, stopped.ID)
+	assert.NotNil(t, stopped.EndedAt)
+
+	uid, err = c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, uid)
+
+	_, err = c.Auth.StopImpersonation(ctx)
+	assert.Equal(t, NotImpersonatingError{}, err)
+
+	// Logging out ends an ongoing impersonation
+	imp, err = c.Auth.StartImpersonation(ctx, admin, u.ID, "ticket 43")
+	require.NoError(t, err)
+	require.NoError(t, c.Auth.Logout(ctx))
+	imp, err = c.ORM.Impersonation.Get(bg, imp.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, imp.EndedAt)
+
+	impersonations, err := c.Auth.GetImpersonations(ctx, 100)
+	require.NoError(t, err)
+	found := 0
+	for _, i := range impersonations {
+		if i.AdminID == admin.ID {
+			found++
+			assert.Equal(t, u.Email, i.Edges.User.Email)
+		}
+	}
+	assert.Equal(t, 2, found)
+}
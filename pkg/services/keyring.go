@@ -0,0 +1,220 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/mikestefanello/pagoda/config"
+)
+
+const (
+	// DefaultKeyID is the ID of App.EncryptionKey in the keyring, which is also what signed tokens and
+	// encrypted values from before the keyring carry no key ID
+	DefaultKeyID = "default"
+
+	// keyringCiphertextSeparator separates the ID of the key from the ciphertext it encrypted
+	keyringCiphertextSeparator = ":"
+)
+
+// UnknownKeyError is an error returned when something was signed or encrypted with a key that is not in
+// the keyring, e.g. one that was dropped after being rotated out
+type UnknownKeyError struct {
+	KeyID string
+}
+
+// Error implements the error interface.
+func (e UnknownKeyError) Error() string {
+	return fmt.Sprintf("unknown key %q", e.KeyID)
+}
+
+// Keyring holds the keys signing sessions and tokens and encrypting secrets. Only the active key signs
+// and encrypts, the others are kept to verify and decrypt what they did before being rotated out.
+type Keyring struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// NewKeyring creates a new Keyring from configuration
+func NewKeyring(cfg *config.Config) (*Keyring, error) {
+	k := &Keyring{
+		activeID: strings.ToLower(cfg.Keyring.ActiveKey),
+		keys:     make(map[string][]byte),
+	}
+
+	if cfg.App.EncryptionKey != "" {
+		k.keys[DefaultKeyID] = []byte(cfg.App.EncryptionKey)
+	}
+	for id, secret := range cfg.Keyring.Keys {
+		// Configuration keys are case-insensitive
+		id = strings.ToLower(id)
+		if id == "" || strings.Contains(id, keyringCiphertextSeparator) {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		if secret == "" {
+			return nil, fmt.Errorf("key %q is empty", id)
+		}
+		k.keys[id] = []byte(secret)
+	}
+
+	if k.activeID == "" {
+		k.activeID = DefaultKeyID
+	}
+	if _, ok := k.keys[k.activeID]; !ok {
+		return nil, fmt.Errorf("active key %q is not in the keyring", k.activeID)
+	}
+
+	return k, nil
+}
+
+// ActiveKeyID returns the ID of the key signing and encrypting everything new
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeID
+}
+
+// Key returns the key with a given ID
+func (k *Keyring) Key(id string) ([]byte, error) {
+	if key, ok := k.keys[id]; ok {
+		return key, nil
+	}
+	return nil, UnknownKeyError{KeyID: id}
+}
+
+// SignJWT signs claims with the active key, naming it in the kid header of the token
+func (k *Keyring) SignJWT(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = k.activeID
+	return token.SignedString(k.keys[k.activeID])
+}
+
+// ParseJWT parses a token signed with any key of the keyring. Tokens without a kid header predate the
+// keyring and were signed with the default key.
+func (k *Keyring) ParseJWT(token string) (*jwt.Token, error) {
+	return jwt.Parse(token, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return k.Key(jwtKeyID(t))
+	})
+}
+
+// ResignJWT re-signs a valid token with the active key if an older key signed it, keeping its claims.
+// The token is returned as is when the active key signed it already.
+func (k *Keyring) ResignJWT(token string) (string, error) {
+	t, err := k.ParseJWT(token)
+	if err != nil {
+		return "", err
+	}
+	if !t.Valid {
+		return "", errors.New("invalid token")
+	}
+	if jwtKeyID(t) == k.activeID {
+		return token, nil
+	}
+	return k.SignJWT(t.Claims)
+}
+
+// Encrypt encrypts a value with the active key, prefixing the ciphertext with the ID of the key
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	gcm, err := keyringGCM(k.keys[k.activeID])
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return k.activeID + keyringCiphertextSeparator + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value encrypted with any key of the keyring. Stale reports whether an older key
+// encrypted it, in which case it should be encrypted again with the active key and stored.
+func (k *Keyring) Decrypt(ciphertext string) (plaintext string, stale bool, err error) {
+	// Ciphertexts without a key ID predate the keyring and were encrypted with the default key
+	id, data, found := strings.Cut(ciphertext, keyringCiphertextSeparator)
+	if !found {
+		id, data = DefaultKeyID, ciphertext
+	}
+
+	key, err := k.Key(id)
+	if err != nil {
+		return "", false, err
+	}
+	gcm, err := keyringGCM(key)
+	if err != nil {
+		return "", false, err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", false, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", false, errors.New("ciphertext too short")
+	}
+
+	opened, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", false, err
+	}
+	return string(opened), !found || id != k.activeID, nil
+}
+
+// SessionStore creates a cookie session store signing cookies with the active key and accepting the ones
+// signed with older keys
+func (k *Keyring) SessionStore() *sessions.CookieStore {
+	return sessions.NewCookieStore(k.cookieKeyPairs()...)
+}
+
+// CookieCodecs returns the codecs of the session cookies, starting with the one of the active key
+func (k *Keyring) CookieCodecs() []securecookie.Codec {
+	return securecookie.CodecsFromPairs(k.cookieKeyPairs()...)
+}
+
+// cookieKeyPairs returns the hash and block key pairs of the session cookies, starting with the active key.
+// Cookies are signed but not encrypted, so the block keys are empty.
+func (k *Keyring) cookieKeyPairs() [][]byte {
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		if id != k.activeID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	pairs := [][]byte{k.keys[k.activeID], nil}
+	for _, id := range ids {
+		pairs = append(pairs, k.keys[id], nil)
+	}
+	return pairs
+}
+
+// jwtKeyID returns the ID of the key which signed a token
+func jwtKeyID(t *jwt.Token) string {
+	if id, ok := t.Header["kid"].(string); ok && id != "" {
+		return id
+	}
+	return DefaultKeyID
+}
+
+func keyringGCM(key []byte) (cipher.AEAD, error) {
+	sum := sha256.Sum256(key)
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
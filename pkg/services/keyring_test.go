@@ -0,0 +1,122 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/securecookie"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyring(t *testing.T, active string, keys map[string]string) *Keyring {
+	cfg := &config.Config{}
+	cfg.App.EncryptionKey = "legacy-secret"
+	cfg.Keyring.ActiveKey = active
+	cfg.Keyring.Keys = keys
+	k, err := NewKeyring(cfg)
+	require.NoError(t, err)
+	return k
+}
+
+func TestNewKeyring(t *testing.T) {
+	k := newTestKeyring(t, "", nil)
+	assert.Equal(t, DefaultKeyID, k.ActiveKeyID())
+
+	// Key IDs are case-insensitive, like configuration keys
+	k = newTestKeyring(t, "K2", map[string]string{"k2": "secret-2"})
+	assert.Equal(t, "k2", k.ActiveKeyID())
+
+	cfg := &config.Config{}
+	cfg.Keyring.ActiveKey = "missing"
+	cfg.Keyring.Keys = map[string]string{"k1": "secret-1"}
+	_, err := NewKeyring(cfg)
+	assert.Error(t, err)
+
+	cfg.Keyring.ActiveKey = "k1"
+	cfg.Keyring.Keys = map[string]string{"k1": "secret-1", "k:2": "secret-2"}
+	_, err = NewKeyring(cfg)
+	assert.Error(t, err)
+}
+
+func TestKeyring_JWT(t *testing.T) {
+	old := newTestKeyring(t, "k1", map[string]string{"k1": "secret-1"})
+	rotated := newTestKeyring(t, "k2", map[string]string{"k1": "secret-1", "k2": "secret-2"})
+	claims := jwt.MapClaims{
+		"email": "test@example.com",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+
+	token, err := old.SignJWT(claims)
+	require.NoError(t, err)
+
+	// Tokens signed with a key that was rotated out are still valid
+	parsed, err := rotated.ParseJWT(token)
+	require.NoError(t, err)
+	assert.Equal(t, "k1", parsed.Header["kid"])
+
+	// and get re-signed with the active key, keeping their claims
+	resigned, err := rotated.ResignJWT(token)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, resigned)
+	parsed, err = rotated.ParseJWT(resigned)
+	require.NoError(t, err)
+	assert.Equal(t, "k2", parsed.Header["kid"])
+	assert.Equal(t, "test@example.com", parsed.Claims.(jwt.MapClaims)["email"])
+
+	same, err := rotated.ResignJWT(resigned)
+	require.NoError(t, err)
+	assert.Equal(t, resigned, same)
+
+	// Tokens signed with a key that was dropped are not
+	dropped := newTestKeyring(t, "k2", map[string]string{"k2": "secret-2"})
+	_, err = dropped.ParseJWT(token)
+	assert.Error(t, err)
+
+	// Tokens without a key ID predate the keyring and were signed with the default key
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("legacy-secret"))
+	require.NoError(t, err)
+	_, err = rotated.ParseJWT(legacy)
+	assert.NoError(t, err)
+}
+
+func TestKeyring_Encrypt(t *testing.T) {
+	old := newTestKeyring(t, "k1", map[string]string{"k1": "secret-1"})
+	rotated := newTestKeyring(t, "k2", map[string]string{"k1": "secret-1", "k2": "secret-2"})
+
+	encrypted, err := old.Encrypt("value")
+	require.NoError(t, err)
+	assert.NotContains(t, encrypted, "value")
+
+	plaintext, stale, err := old.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "value", plaintext)
+	assert.False(t, stale)
+
+	plaintext, stale, err = rotated.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "value", plaintext)
+	assert.True(t, stale)
+
+	dropped := newTestKeyring(t, "k2", map[string]string{"k2": "secret-2"})
+	_, _, err = dropped.Decrypt(encrypted)
+	assert.Equal(t, UnknownKeyError{KeyID: "k1"}, err)
+}
+
+func TestKeyring_CookieCodecs(t *testing.T) {
+	old := newTestKeyring(t, "k1", map[string]string{"k1": "secret-1"})
+	rotated := newTestKeyring(t, "k2", map[string]string{"k1": "secret-1", "k2": "secret-2"})
+
+	value, err := old.CookieCodecs()[0].Encode("ua", map[any]any{"user_id": 1})
+	require.NoError(t, err)
+
+	// Only the active key signs, the others verify
+	codecs := rotated.CookieCodecs()
+	require.Len(t, codecs, 3)
+	decoded := make(map[any]any)
+	assert.Error(t, codecs[0].Decode("ua", value, &decoded))
+	require.NoError(t, securecookie.DecodeMulti("ua", value, &decoded, codecs[1:]...))
+	assert.Equal(t, 1, decoded["user_id"])
+}
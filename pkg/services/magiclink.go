@@ -0,0 +1,116 @@
+package services
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
+)
+
+const (
+	// magicLinkTokenPurpose is the purpose claim of magic-link JWTs, so that other tokens signed with the
+	// same key, such as email verification tokens, cannot be used to sign in
+	magicLinkTokenPurpose = "magic_link"
+
+	// magicLinkTokenIDLength is the length of the random token ID (jti) embedded in a magic link
+	magicLinkTokenIDLength = 32
+)
+
+// InvalidMagicLinkTokenError is an error returned when a magic link is invalid, expired or was already used
+type InvalidMagicLinkTokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidMagicLinkTokenError) Error() string {
+	return "invalid magic link token"
+}
+
+// GenerateMagicLinkToken generates a signed, short-lived token that signs a user in when they follow the
+// link sent to their email address. The token ID is recorded in the database so that the link can only
+// be used once, and like password tokens only a hash of it is stored.
+func (c *AuthClient) GenerateMagicLinkToken(ctx echo.Context, userID int) (string, error) {
+	jti, err := c.RandomToken(magicLinkTokenIDLength)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(c.config.App.MagicLinkTokenExpiration)
+
+	// Remove links of this user which can no longer be used, so the table does not grow forever
+	_, err = c.orm.MagicLinkToken.
+		Delete().
+		Where(
+			magiclinktoken.UserIDEQ(userID),
+			magiclinktoken.Or(
+				magiclinktoken.ExpiresAtLTE(now),
+				magiclinktoken.UsedAtNotNil(),
+			),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.orm.MagicLinkToken.
+		Create().
+		SetTokenHash(hashToken(jti)).
+		SetExpiresAt(expiresAt).
+		SetUserID(userID).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	return c.keyring.SignJWT(jwt.MapClaims{
+		"sub":     userID,
+		"jti":     jti,
+		"purpose": magicLinkTokenPurpose,
+		"exp":     expiresAt.Unix(),
+	})
+}
+
+// ConsumeMagicLinkToken validates a magic-link token, marks it as used and returns the user it belongs to.
+// Marking the token as used is done atomically so that a link cannot be replayed, even concurrently.
+func (c *AuthClient) ConsumeMagicLinkToken(ctx echo.Context, token string) (*ent.User, error) {
+	t, err := c.keyring.ParseJWT(token)
+	if err != nil || !t.Valid {
+		return nil, InvalidMagicLinkTokenError{}
+	}
+
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != magicLinkTokenPurpose {
+		return nil, InvalidMagicLinkTokenError{}
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok || jti == "" {
+		return nil, InvalidMagicLinkTokenError{}
+	}
+
+	hash := hashToken(jti)
+	now := time.Now()
+
+	updated, err := c.orm.MagicLinkToken.
+		Update().
+		Where(
+			magiclinktoken.TokenHash(hash),
+			magiclinktoken.UsedAtIsNil(),
+			magiclinktoken.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, InvalidMagicLinkTokenError{}
+	}
+
+	return c.orm.MagicLinkToken.
+		Query().
+		Where(magiclinktoken.TokenHash(hash)).
+		QueryUser().
+		Only(ctx.Request().Context())
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/magiclinktoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_MagicLink(t *testing.T) {
+	token, err := c.Auth.GenerateMagicLinkToken(ctx, usr.ID)
+	require.NoError(t, err)
+
+	u, err := c.Auth.ConsumeMagicLinkToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, u.ID)
+
+	// A link can only be used once
+	_, err = c.Auth.ConsumeMagicLinkToken(ctx, token)
+	assert.Equal(t, InvalidMagicLinkTokenError{}, err)
+
+	// Tampered tokens are rejected
+	token, err = c.Auth.GenerateMagicLinkToken(ctx, usr.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.ConsumeMagicLinkToken(ctx, token+"x")
+	assert.Equal(t, InvalidMagicLinkTokenError{}, err)
+
+	// Other tokens signed with the same key cannot be used to sign in
+	verification, err := c.Auth.GenerateEmailVerificationToken(usr.Email)
+	require.NoError(t, err)
+	_, err = c.Auth.ConsumeMagicLinkToken(ctx, verification)
+	assert.Equal(t, InvalidMagicLinkTokenError{}, err)
+
+	// Links which expired server-side are rejected even if the JWT is still valid
+	_, err = c.ORM.MagicLinkToken.
+		Update().
+		Where(magiclinktoken.UserIDEQ(usr.ID)).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		Save(ctx.Request().Context())
+	require.NoError(t, err)
+	_, err = c.Auth.ConsumeMagicLinkToken(ctx, token)
+	assert.Equal(t, InvalidMagicLinkTokenError{}, err)
+
+	// Generating a new link removes the ones which can no longer be used
+	_, err = c.Auth.GenerateMagicLinkToken(ctx, usr.ID)
+	require.NoError(t, err)
+	count, err := c.ORM.MagicLinkToken.
+		Query().
+		Where(magiclinktoken.UserIDEQ(usr.ID)).
+		Count(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
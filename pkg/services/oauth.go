@@ -0,0 +1,408 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/identity"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// authSessionKeyOAuthLogin stores the state, nonce and PKCE verifier of an ongoing social login
+	authSessionKeyOAuthLogin = "oauth_login"
+
+	// oauthLoginExpiration is how long a user has to complete the login at the provider
+	oauthLoginExpiration = 10 * time.Minute
+)
+
+// UnknownOAuthProviderError is an error returned when no provider is configured under a given name
+type UnknownOAuthProviderError struct{}
+
+// Error implements the error interface.
+func (e UnknownOAuthProviderError) Error() string {
+	return "unknown oauth provider"
+}
+
+// InvalidOAuthStateError is an error returned when a callback does not match the login that was started,
+// which happens when it was forged, replayed or took too long
+type InvalidOAuthStateError struct{}
+
+// Error implements the error interface.
+func (e InvalidOAuthStateError) Error() string {
+	return "invalid oauth state"
+}
+
+// OAuthProviderError is an error returned when the provider refused the login, e.g. the user denied access
+type OAuthProviderError struct {
+	Code string
+}
+
+// Error implements the error interface.
+func (e OAuthProviderError) Error() string {
+	return fmt.Sprintf("oauth provider returned an error: %s", e.Code)
+}
+
+// OAuthProviderInfo describes a configured provider, to display login buttons
+type OAuthProviderInfo struct {
+	Name        string
+	DisplayName string
+}
+
+// OAuthIdentity is the user as described by a provider once they logged in
+type OAuthIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// oauthLogin is what is kept in the session between the redirect to the provider and the callback
+type oauthLogin struct {
+	Provider  string    `json:"provider"`
+	State     string    `json:"state"`
+	Nonce     string    `json:"nonce"`
+	Verifier  string    `json:"verifier"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// oauthProvider is a provider with its endpoints resolved
+type oauthProvider struct {
+	cfg      config.OAuthProviderConfig
+	endpoint oauth2.Endpoint
+
+	// verifier is only set for OIDC providers, which return a signed ID token
+	verifier *oidc.IDTokenVerifier
+}
+
+// OAuthClient handles logins through external OAuth2/OIDC providers
+type OAuthClient struct {
+	config *config.Config
+	orm    *ent.Client
+
+	// HTTPClient is used for all requests to the providers
+	HTTPClient *http.Client
+
+	mu        sync.Mutex
+	providers map[string]*oauthProvider
+}
+
+// NewOAuthClient creates a new OAuth client. Providers are resolved on first use so that
+// an unreachable discovery endpoint does not prevent the app from starting.
+func NewOAuthClient(cfg *config.Config, orm *ent.Client) *OAuthClient {
+	return &OAuthClient{
+		config:     cfg,
+		orm:        orm,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		providers:  make(map[string]*oauthProvider),
+	}
+}
+
+// Providers returns the configured providers, sorted by name
+func (c *OAuthClient) Providers() []OAuthProviderInfo {
+	providers := make([]OAuthProviderInfo, 0, len(c.config.OAuth.Providers))
+	for name, p := range c.config.OAuth.Providers {
+		displayName := p.DisplayName
+		if displayName == "" {
+			displayName = name
+		}
+		providers = append(providers, OAuthProviderInfo{Name: name, DisplayName: displayName})
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name < providers[j].Name
+	})
+	return providers
+}
+
+// BeginLogin starts a login with a provider and returns the URL to redirect the user to.
+// The state, nonce and PKCE verifier are kept in the session until the provider calls back.
+func (c *OAuthClient) BeginLogin(ctx echo.Context, name, redirectURL string) (string, error) {
+	p, err := c.provider(ctx.Request().Context(), name)
+	if err != nil {
+		return "", err
+	}
+
+	login := oauthLogin{
+		Provider:  name,
+		Verifier:  oauth2.GenerateVerifier(),
+		StartedAt: time.Now(),
+	}
+	if login.State, err = randomURLToken(); err != nil {
+		return "", err
+	}
+	if login.Nonce, err = randomURLToken(); err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(login)
+	if err != nil {
+		return "", err
+	}
+
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return "", err
+	}
+	sess.Values[authSessionKeyOAuthLogin] = string(encoded)
+	if err := sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return "", err
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.S256ChallengeOption(login.Verifier)}
+	if p.verifier != nil {
+		opts = append(opts, oidc.Nonce(login.Nonce))
+	}
+
+	return c.oauth2Config(p, redirectURL).AuthCodeURL(login.State, opts...), nil
+}
+
+// CompleteLogin handles the callback of a provider. It checks the state against the session,
+// exchanges the code using the PKCE verifier and returns the identity of the user.
+func (c *OAuthClient) CompleteLogin(ctx echo.Context, name, redirectURL string) (*OAuthIdentity, error) {
+	login, err := c.popLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := ctx.QueryParam("state")
+	if login.Provider != name ||
+		time.Since(login.StartedAt) > oauthLoginExpiration ||
+		subtle.ConstantTimeCompare([]byte(state), []byte(login.State)) != 1 {
+		return nil, InvalidOAuthStateError{}
+	}
+
+	if code := ctx.QueryParam("error"); code != "" {
+		return nil, OAuthProviderError{Code: code}
+	}
+
+	p, err := c.provider(ctx.Request().Context(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	reqCtx := oidc.ClientContext(ctx.Request().Context(), c.HTTPClient)
+	token, err := c.oauth2Config(p, redirectURL).Exchange(
+		reqCtx, ctx.QueryParam("code"), oauth2.VerifierOption(login.Verifier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims struct {
+		Subject       string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+
+	if p.verifier != nil {
+		rawIDToken, ok := token.Extra("id_token").(string)
+		if !ok {
+			return nil, fmt.Errorf("no id_token in token response of %s", name)
+		}
+		idToken, err := p.verifier.Verify(reqCtx, rawIDToken)
+		if err != nil {
+			return nil, err
+		}
+		if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(login.Nonce)) != 1 {
+			return nil, InvalidOAuthStateError{}
+		}
+		if err := idToken.Claims(&claims); err != nil {
+			return nil, err
+		}
+	} else {
+		info, err := c.fetchUserInfo(reqCtx, p, token)
+		if err != nil {
+			return nil, err
+		}
+		claims.Subject, claims.Email, claims.EmailVerified, claims.Name = info.Subject, info.Email, info.EmailVerified, info.Name
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("no subject returned by %s", name)
+	}
+
+	return &OAuthIdentity{
+		Provider:      name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// FindUser returns the user linked to an identity. An identity seen for the first time is linked
+// to the user with the same email, but only if the provider verified that email.
+func (c *OAuthClient) FindUser(ctx context.Context, ident *OAuthIdentity) (*ent.User, error) {
+	usr, err := c.orm.Identity.
+		Query().
+		Where(
+			identity.Provider(ident.Provider),
+			identity.Subject(ident.Subject),
+		).
+		QueryUser().
+		Only(ctx)
+	if _, ok := err.(*ent.NotFoundError); !ok || !ident.EmailVerified || ident.Email == "" {
+		return usr, err
+	}
+
+	usr, err = c.orm.User.
+		Query().
+		Where(user.EmailEqualFold(ident.Email)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.LinkIdentity(ctx, c.orm.Identity, usr.ID, ident); err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+// LinkIdentity records that an identity belongs to a user. The identity client is passed in
+// so the link can be created as part of a transaction.
+func (c *OAuthClient) LinkIdentity(ctx context.Context, identities *ent.IdentityClient, userID int, ident *OAuthIdentity) error {
+	return identities.
+		Create().
+		SetUserID(userID).
+		SetProvider(ident.Provider).
+		SetSubject(ident.Subject).
+		SetEmail(ident.Email).
+		Exec(ctx)
+}
+
+// provider returns a provider with its endpoints resolved, running OIDC discovery if needed
+func (c *OAuthClient) provider(ctx context.Context, name string) (*oauthProvider, error) {
+	cfg, ok := c.config.OAuth.Providers[name]
+	if !ok {
+		return nil, UnknownOAuthProviderError{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p, ok := c.providers[name]; ok {
+		return p, nil
+	}
+
+	p := &oauthProvider{
+		cfg: cfg,
+		endpoint: oauth2.Endpoint{
+			AuthURL:  cfg.AuthURL,
+			TokenURL: cfg.TokenURL,
+		},
+	}
+
+	if cfg.Issuer != "" {
+		discovered, err := oidc.NewProvider(oidc.ClientContext(ctx, c.HTTPClient), cfg.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("oidc discovery failed for %s: %w", name, err)
+		}
+		p.endpoint = discovered.Endpoint()
+		p.verifier = discovered.Verifier(&oidc.Config{ClientID: cfg.ClientID})
+	}
+
+	c.providers[name] = p
+	return p, nil
+}
+
+func (c *OAuthClient) oauth2Config(p *oauthProvider, redirectURL string) *oauth2.Config {
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 && p.verifier != nil {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     p.endpoint,
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	}
+}
+
+// fetchUserInfo loads the user from the userinfo endpoint of a plain OAuth2 provider. Not all
+// providers use the OIDC claim names, so an "id" is accepted when there is no "sub".
+func (c *OAuthClient) fetchUserInfo(ctx context.Context, p *oauthProvider, token *oauth2.Token) (*OAuthIdentity, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.UserInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	token.SetAuthHeader(req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo request failed with status %d", resp.StatusCode)
+	}
+
+	var info map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, err
+	}
+
+	ident := &OAuthIdentity{}
+	if sub, ok := info["sub"]; ok {
+		ident.Subject = fmt.Sprint(sub)
+	} else if id, ok := info["id"]; ok {
+		ident.Subject = fmt.Sprint(id)
+	}
+	ident.Email, _ = info["email"].(string)
+	ident.EmailVerified, _ = info["email_verified"].(bool)
+	ident.Name, _ = info["name"].(string)
+
+	return ident, nil
+}
+
+// popLogin loads and removes the ongoing login from the session, so a callback can only be used once
+func (c *OAuthClient) popLogin(ctx echo.Context) (*oauthLogin, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, ok := sess.Values[authSessionKeyOAuthLogin].(string)
+	if !ok {
+		return nil, InvalidOAuthStateError{}
+	}
+
+	delete(sess.Values, authSessionKeyOAuthLogin)
+	if err := sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	var login oauthLogin
+	if err := json.Unmarshal([]byte(encoded), &login); err != nil {
+		return nil, InvalidOAuthStateError{}
+	}
+	return &login, nil
+}
+
+// randomURLToken generates a random token which is safe to use in URLs
+func randomURLToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
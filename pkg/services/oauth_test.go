@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oauthTestRedirectURL = "http://localhost:8000/user/oauth/mock/callback"
+
+func newTestOAuthClient(t *testing.T) (*OAuthClient, *tests.MockOIDCServer) {
+	srv := tests.NewMockOIDCServer(t)
+
+	cfg := *c.Config
+	cfg.OAuth.Providers = map[string]config.OAuthProviderConfig{
+		"mock": {
+			DisplayName:  "Mock",
+			Issuer:       srv.URL,
+			ClientID:     srv.ClientID,
+			ClientSecret: srv.ClientSecret,
+		},
+	}
+	return NewOAuthClient(&cfg, c.ORM), srv
+}
+
+// beginOAuthLogin starts a login and follows the provider redirect, returning a context for the callback
+func beginOAuthLogin(t *testing.T, client *OAuthClient) (echo.Context, url.Values) {
+	ctx, rec := tests.NewContext(c.Web, "/user/oauth/mock")
+	tests.InitSession(ctx)
+
+	authURL, err := client.BeginLogin(ctx, "mock", oauthTestRedirectURL)
+	require.NoError(t, err)
+
+	noRedirect := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := noRedirect.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+
+	callbackCtx, _ := tests.NewContext(c.Web, "/user/oauth/mock/callback")
+	for _, cookie := range rec.Result().Cookies() {
+		callbackCtx.Request().AddCookie(cookie)
+	}
+	tests.InitSession(callbackCtx)
+
+	return callbackCtx, location.Query()
+}
+
+func TestOAuthClient_CompleteLogin(t *testing.T) {
+	client, srv := newTestOAuthClient(t)
+
+	ctx, params := beginOAuthLogin(t, client)
+	ctx.Request().URL.RawQuery = params.Encode()
+
+	ident, err := client.CompleteLogin(ctx, "mock", oauthTestRedirectURL)
+	require.NoError(t, err)
+	assert.Equal(t, "mock", ident.Provider)
+	assert.Equal(t, srv.User.Subject, ident.Subject)
+	assert.Equal(t, srv.User.Email, ident.Email)
+	assert.True(t, ident.EmailVerified)
+
+	// The callback cannot be replayed
+	_, err = client.CompleteLogin(ctx, "mock", oauthTestRedirectURL)
+	assert.Equal(t, InvalidOAuthStateError{}, err)
+}
+
+func TestOAuthClient_CompleteLogin_InvalidState(t *testing.T) {
+	client, _ := newTestOAuthClient(t)
+
+	ctx, params := beginOAuthLogin(t, client)
+	params.Set("state", "forged")
+	ctx.Request().URL.RawQuery = params.Encode()
+
+	_, err := client.CompleteLogin(ctx, "mock", oauthTestRedirectURL)
+	assert.Equal(t, InvalidOAuthStateError{}, err)
+}
+
+func TestOAuthClient_UnknownProvider(t *testing.T) {
+	client, _ := newTestOAuthClient(t)
+
+	_, err := client.BeginLogin(ctx, "unknown", oauthTestRedirectURL)
+	assert.Equal(t, UnknownOAuthProviderError{}, err)
+	assert.Equal(t, []OAuthProviderInfo{{Name: "mock", DisplayName: "Mock"}}, client.Providers())
+}
+
+func TestOAuthClient_FindUser(t *testing.T) {
+	client, _ := newTestOAuthClient(t)
+	reqCtx := context.Background()
+
+	existing, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+
+	ident := &OAuthIdentity{
+		Provider: "mock",
+		Subject:  "subject-" + existing.Email,
+		Email:    existing.Email,
+	}
+
+	// An unverified email is never trusted to link accounts
+	_, err = client.FindUser(reqCtx, ident)
+	assert.IsType(t, &ent.NotFoundError{}, err)
+
+	ident.EmailVerified = true
+	usr, err := client.FindUser(reqCtx, ident)
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID, usr.ID)
+
+	// Once linked, the identity is found even if the email at the provider changes
+	ident.Email = "changed@localhost.localhost"
+	usr, err = client.FindUser(reqCtx, ident)
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID, usr.ID)
+}
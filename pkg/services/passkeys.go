@@ -0,0 +1,329 @@
+package services
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/passkey"
+)
+
+const (
+	// authSessionKeyPasskeyRegistration stores the WebAuthn ceremony data while a passkey is being registered
+	authSessionKeyPasskeyRegistration = "passkey_registration"
+
+	// authSessionKeyPasskeyLogin stores the WebAuthn ceremony data while a user signs in with a passkey
+	authSessionKeyPasskeyLogin = "passkey_login"
+
+	// passkeyDefaultName is the name given to a passkey when the user did not provide one
+	passkeyDefaultName = "Passkey"
+)
+
+// InvalidPasskeyError is an error returned when a passkey ceremony cannot be completed
+type InvalidPasskeyError struct{}
+
+// Error implements the error interface.
+func (e InvalidPasskeyError) Error() string {
+	return "invalid passkey"
+}
+
+// webAuthnUser adapts a user and their passkeys to the webauthn.User interface
+type webAuthnUser struct {
+	usr      *ent.User
+	passkeys []*ent.Passkey
+}
+
+// WebAuthnID returns the user handle stored on the authenticator, which maps back to the user ID
+func (u webAuthnUser) WebAuthnID() []byte {
+	return []byte(strconv.Itoa(u.usr.ID))
+}
+
+// WebAuthnName returns the name shown by the authenticator when choosing an account
+func (u webAuthnUser) WebAuthnName() string {
+	return u.usr.Email
+}
+
+// WebAuthnDisplayName returns the display name shown by the authenticator
+func (u webAuthnUser) WebAuthnDisplayName() string {
+	return u.usr.Name
+}
+
+// WebAuthnCredentials returns the registered passkeys of the user
+func (u webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(u.passkeys))
+	for i, pk := range u.passkeys {
+		transports := make([]protocol.AuthenticatorTransport, len(pk.Transports))
+		for j, t := range pk.Transports {
+			transports[j] = protocol.AuthenticatorTransport(t)
+		}
+		credentials[i] = webauthn.Credential{
+			ID:              pk.CredentialID,
+			PublicKey:       pk.PublicKey,
+			AttestationType: pk.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: pk.BackupEligible,
+				BackupState:    pk.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    pk.Aaguid,
+				SignCount: pk.SignCount,
+			},
+		}
+	}
+	return credentials
+}
+
+// BeginPasskeyRegistration starts the registration of a new passkey for a user and returns
+// the options to pass to navigator.credentials.create()
+func (c *AuthClient) BeginPasskeyRegistration(ctx echo.Context, usr *ent.User) (*protocol.CredentialCreation, error) {
+	wa, err := c.webAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	wu, err := c.loadWebAuthnUser(ctx, usr)
+	if err != nil {
+		return nil, err
+	}
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(wu.passkeys))
+	for _, cred := range wu.WebAuthnCredentials() {
+		exclusions = append(exclusions, cred.Descriptor())
+	}
+
+	creation, sessionData, err := wa.BeginRegistration(
+		wu,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithExclusions(exclusions),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.saveWebAuthnSession(ctx, authSessionKeyPasskeyRegistration, sessionData); err != nil {
+		return nil, err
+	}
+
+	return creation, nil
+}
+
+// FinishPasskeyRegistration verifies the response of navigator.credentials.create(), contained
+// in the request body, and stores the new passkey
+func (c *AuthClient) FinishPasskeyRegistration(ctx echo.Context, usr *ent.User, name string) (*ent.Passkey, error) {
+	wa, err := c.webAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionData, err := c.popWebAuthnSession(ctx, authSessionKeyPasskeyRegistration)
+	if err != nil {
+		return nil, err
+	}
+
+	wu, err := c.loadWebAuthnUser(ctx, usr)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := wa.FinishRegistration(wu, *sessionData, ctx.Request())
+	if err != nil {
+		ctx.Logger().Infof("passkey registration failed: %v", err)
+		return nil, InvalidPasskeyError{}
+	}
+
+	if name == "" {
+		name = passkeyDefaultName
+	}
+
+	transports := make([]string, len(cred.Transport))
+	for i, t := range cred.Transport {
+		transports[i] = string(t)
+	}
+
+	return c.orm.Passkey.
+		Create().
+		SetUserID(usr.ID).
+		SetName(name).
+		SetCredentialID(cred.ID).
+		SetPublicKey(cred.PublicKey).
+		SetAttestationType(cred.AttestationType).
+		SetTransports(transports).
+		SetAaguid(cred.Authenticator.AAGUID).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetBackupEligible(cred.Flags.BackupEligible).
+		SetBackupState(cred.Flags.BackupState).
+		Save(ctx.Request().Context())
+}
+
+// BeginPasskeyLogin starts a passwordless sign-in and returns the options to pass to
+// navigator.credentials.get(). No user is given, the authenticator lets the user pick an account.
+func (c *AuthClient) BeginPasskeyLogin(ctx echo.Context) (*protocol.CredentialAssertion, error) {
+	wa, err := c.webAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, sessionData, err := wa.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.saveWebAuthnSession(ctx, authSessionKeyPasskeyLogin, sessionData); err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// FinishPasskeyLogin verifies the response of navigator.credentials.get(), contained in the
+// request body, and returns the user who owns the passkey. The caller is responsible for logging them in.
+func (c *AuthClient) FinishPasskeyLogin(ctx echo.Context) (*ent.User, error) {
+	wa, err := c.webAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionData, err := c.popWebAuthnSession(ctx, authSessionKeyPasskeyLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	var wu *webAuthnUser
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		userID, err := strconv.Atoi(string(userHandle))
+		if err != nil {
+			return nil, err
+		}
+		usr, err := c.orm.User.Get(ctx.Request().Context(), userID)
+		if err != nil {
+			return nil, err
+		}
+		wu, err = c.loadWebAuthnUser(ctx, usr)
+		return wu, err
+	}
+
+	cred, err := wa.FinishDiscoverableLogin(handler, *sessionData, ctx.Request())
+	if err != nil {
+		ctx.Logger().Infof("passkey login failed: %v", err)
+		return nil, InvalidPasskeyError{}
+	}
+
+	if cred.Authenticator.CloneWarning {
+		ctx.Logger().Warnf("passkey sign count went backwards for user %d, the credential may be cloned", wu.usr.ID)
+		return nil, InvalidPasskeyError{}
+	}
+
+	_, err = c.orm.Passkey.
+		Update().
+		Where(passkey.CredentialID(cred.ID)).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetBackupState(cred.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return wu.usr, nil
+}
+
+// GetPasskeys returns the passkeys registered by a user
+func (c *AuthClient) GetPasskeys(ctx echo.Context, userID int) ([]*ent.Passkey, error) {
+	return c.orm.Passkey.
+		Query().
+		Where(passkey.UserIDEQ(userID)).
+		Order(ent.Desc(passkey.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// DeletePasskey revokes a passkey of a user
+func (c *AuthClient) DeletePasskey(ctx echo.Context, userID, passkeyID int) error {
+	deleted, err := c.orm.Passkey.
+		Delete().
+		Where(
+			passkey.ID(passkeyID),
+			passkey.UserIDEQ(userID),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// webAuthn creates the WebAuthn relying party from the HTTP configuration
+func (c *AuthClient) webAuthn() (*webauthn.WebAuthn, error) {
+	domain, err := url.Parse(c.config.HTTP.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          domain.Hostname(),
+		RPDisplayName: string(c.config.App.Name),
+		RPOrigins:     []string{c.config.HTTP.Domain},
+		// Passkey sign-ins skip the second factor, so the authenticator must verify the user with a PIN or
+		// biometrics for the passkey to count as two factors on its own
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			UserVerification: protocol.VerificationRequired,
+		},
+	})
+}
+
+func (c *AuthClient) loadWebAuthnUser(ctx echo.Context, usr *ent.User) (*webAuthnUser, error) {
+	passkeys, err := c.GetPasskeys(ctx, usr.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &webAuthnUser{usr: usr, passkeys: passkeys}, nil
+}
+
+// saveWebAuthnSession stores the ceremony data in the session until the browser responds
+func (c *AuthClient) saveWebAuthnSession(ctx echo.Context, key string, data *webauthn.SessionData) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return err
+	}
+
+	sess.Values[key] = string(encoded)
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// popWebAuthnSession loads and removes the ceremony data from the session, so a challenge can only be used once
+func (c *AuthClient) popWebAuthnSession(ctx echo.Context, key string) (*webauthn.SessionData, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, ok := sess.Values[key].(string)
+	if !ok {
+		return nil, InvalidPasskeyError{}
+	}
+
+	delete(sess.Values, key)
+	if err := sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	var data webauthn.SessionData
+	if err := json.Unmarshal([]byte(encoded), &data); err != nil {
+		return nil, InvalidPasskeyError{}
+	}
+	return &data, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_BeginPasskeyRegistration(t *testing.T) {
+	creation, err := c.Auth.BeginPasskeyRegistration(ctx, usr)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(strconv.Itoa(usr.ID)), []byte(creation.Response.User.ID.(protocol.URLEncodedBase64)))
+	assert.Equal(t, protocol.ResidentKeyRequirementRequired, creation.Response.AuthenticatorSelection.ResidentKey)
+	assert.Equal(t, protocol.VerificationRequired, creation.Response.AuthenticatorSelection.UserVerification)
+	assert.NotEmpty(t, creation.Response.Challenge)
+
+	// The challenge can only be consumed once
+	_, err = c.Auth.popWebAuthnSession(ctx, authSessionKeyPasskeyRegistration)
+	require.NoError(t, err)
+	_, err = c.Auth.popWebAuthnSession(ctx, authSessionKeyPasskeyRegistration)
+	assert.Equal(t, InvalidPasskeyError{}, err)
+}
+
+func TestAuthClient_BeginPasskeyLogin(t *testing.T) {
+	assertion, err := c.Auth.BeginPasskeyLogin(ctx)
+	require.NoError(t, err)
+	// Passkey sign-ins skip the second factor, so the user has to be verified by the authenticator
+	assert.Equal(t, protocol.VerificationRequired, assertion.Response.UserVerification)
+
+	sessionData, err := c.Auth.popWebAuthnSession(ctx, authSessionKeyPasskeyLogin)
+	require.NoError(t, err)
+	assert.Equal(t, protocol.VerificationRequired, sessionData.UserVerification)
+}
+
+func TestAuthClient_FinishPasskeyLogin_NoSession(t *testing.T) {
+	_, err := c.Auth.FinishPasskeyLogin(ctx)
+	assert.Equal(t, InvalidPasskeyError{}, err)
+}
+
+func TestAuthClient_DeletePasskey(t *testing.T) {
+	other, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+
+	pk := c.ORM.Passkey.
+		Create().
+		SetUserID(usr.ID).
+		SetName("Laptop").
+		SetCredentialID([]byte("credential-" + strconv.Itoa(usr.ID))).
+		SetPublicKey([]byte("key")).
+		SaveX(ctx.Request().Context())
+
+	pks, err := c.Auth.GetPasskeys(ctx, usr.ID)
+	require.NoError(t, err)
+	require.Len(t, pks, 1)
+	assert.Len(t, webAuthnUser{usr: usr, passkeys: pks}.WebAuthnCredentials(), 1)
+
+	// A user cannot revoke someone else's passkey
+	err = c.Auth.DeletePasskey(ctx, other.ID, pk.ID)
+	assert.IsType(t, &ent.NotFoundError{}, err)
+
+	require.NoError(t, c.Auth.DeletePasskey(ctx, usr.ID, pk.ID))
+	pks, err = c.Auth.GetPasskeys(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pks)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/nbutton23/zxcvbn-go"
+)
+
+const (
+	// breachedPasswordPrefixLength is how many hex characters of the SHA-1 hash name a range file
+	breachedPasswordPrefixLength = 5
+
+	// personalInfoMinLength is the shortest part of a name or email a password may not contain,
+	// shorter parts match too many unrelated passwords
+	personalInfoMinLength = 3
+)
+
+// PasswordPolicy checks that new passwords are long, hard to guess, unrelated to the user and
+// absent from known data breaches
+type PasswordPolicy struct {
+	config config.PasswordPolicyConfig
+}
+
+// NewPasswordPolicy creates a new PasswordPolicy
+func NewPasswordPolicy(cfg config.PasswordPolicyConfig) *PasswordPolicy {
+	return &PasswordPolicy{config: cfg}
+}
+
+// Check returns the rules a password breaks, as messages to show to the user. Personal holds what the
+// password may not contain, such as the name and email address of the user. An error is only returned
+// when the breached passwords cannot be read.
+func (p *PasswordPolicy) Check(password string, personal ...string) ([]string, error) {
+	var violations []string
+
+	if len([]rune(password)) < p.config.MinLength {
+		violations = append(violations, fmt.Sprintf("Password must be at least %d characters long.", p.config.MinLength))
+	}
+
+	if p.config.RejectPersonalInfo && containsPersonalInfo(password, personal) {
+		violations = append(violations, "Password must not contain your name or email address.")
+	}
+
+	if p.config.MinStrength > 0 && zxcvbn.PasswordStrength(password, personal).Score < p.config.MinStrength {
+		violations = append(violations, "Password is too easy to guess, try a longer phrase of unrelated words.")
+	}
+
+	breached, err := p.IsBreached(password)
+	if err != nil {
+		return nil, err
+	}
+	if breached {
+		violations = append(violations, "Password has appeared in a data breach, please choose another one.")
+	}
+
+	return violations, nil
+}
+
+// IsBreached checks if a password is listed in the breached passwords. Only the range file sharing the
+// prefix of its SHA-1 is read, so the corpus can be far larger than memory.
+func (p *PasswordPolicy) IsBreached(password string) (bool, error) {
+	if p.config.BreachedPasswordsDir == "" {
+		return false, nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:breachedPasswordPrefixLength], hash[breachedPasswordPrefixLength:]
+
+	f, err := os.Open(filepath.Join(p.config.BreachedPasswordsDir, prefix+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !strings.EqualFold(entry, suffix) {
+			continue
+		}
+		// Range files may be padded with entries seen zero times
+		if found {
+			if n, err := strconv.Atoi(strings.TrimSpace(count)); err == nil && n == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	return false, scanner.Err()
+}
+
+// containsPersonalInfo checks case-insensitively if a password contains any of the personal values,
+// or any word of them
+func containsPersonalInfo(password string, personal []string) bool {
+	password = strings.ToLower(password)
+
+	for _, value := range personal {
+		value = strings.ToLower(strings.TrimSpace(value))
+		parts := []string{value}
+		// The domain of an email address is shared with many people, only its local part is personal
+		if local, _, ok := strings.Cut(value, "@"); ok {
+			value = local
+			parts = append(parts, local)
+		}
+		parts = append(parts, strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})...)
+
+		for _, part := range parts {
+			if len([]rune(part)) >= personalInfoMinLength && strings.Contains(password, part) {
+				return true
+			}
+		}
+	}
+	return false
+}
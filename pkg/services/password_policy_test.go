@@ -0,0 +1,86 @@
+package services
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	p := NewPasswordPolicy(config.PasswordPolicyConfig{
+		MinLength:          10,
+		MinStrength:        3,
+		RejectPersonalInfo: true,
+	})
+
+	violations, err := p.Check("correct horse battery staple", "Jane Doe", "jane.doe@example.com")
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = p.Check("short")
+	require.NoError(t, err)
+	assert.Len(t, violations, 2)
+	assert.Contains(t, violations[0], "at least 10 characters")
+
+	violations, err = p.Check("password123")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Password is too easy to guess, try a longer phrase of unrelated words."}, violations)
+
+	// Names and email local parts are matched case-insensitively, the email domain is not
+	for _, password := range []string{"quietly-DOE-marching-lantern", "jane.doe-marching-lantern", "zxq-Jane-marching-lantern"} {
+		violations, err = p.Check(password, "Jane Doe", "jane.doe@example.com")
+		require.NoError(t, err)
+		assert.Contains(t, violations, "Password must not contain your name or email address.", password)
+	}
+	violations, err = p.Check("example marching lantern quietly", "Jane Doe", "jane.doe@example.com")
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestPasswordPolicy_IsBreached(t *testing.T) {
+	dir := t.TempDir()
+	breached := "correct horse battery staple"
+	sum := sha1.Sum([]byte(breached))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	padded := "fresh lantern marching quietly"
+	sum = sha1.Sum([]byte(padded))
+	paddedHash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, hash[:5]+".txt"), []byte(
+		"0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n"+strings.ToLower(hash[5:])+":3861493\r\n",
+	), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, paddedHash[:5]+".txt"), []byte(
+		paddedHash[5:]+":0\n",
+	), 0o644))
+
+	p := NewPasswordPolicy(config.PasswordPolicyConfig{BreachedPasswordsDir: dir})
+
+	ok, err := p.IsBreached(breached)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = p.IsBreached(padded)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = p.IsBreached("a password without a range file")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	violations, err := p.Check(breached)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Password has appeared in a data breach, please choose another one."}, violations)
+
+	// Without a directory the check is disabled
+	ok, err = NewPasswordPolicy(config.PasswordPolicyConfig{}).IsBreached(breached)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
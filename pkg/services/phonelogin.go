@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+const (
+	// authSessionKeyPendingPhoneRegistration stores a phone number which was proven owned with a one-time code,
+	// but which no account has yet, while its owner registers
+	authSessionKeyPendingPhoneRegistration = "pending_phone_registration"
+
+	// authSessionKeyPendingPhoneRegistrationAt stores when the phone number was proven owned, as a unix timestamp
+	authSessionKeyPendingPhoneRegistrationAt = "pending_phone_registration_at"
+
+	// pendingPhoneRegistrationExpiration is how long a user has to register after proving they own a number
+	pendingPhoneRegistrationExpiration = 15 * time.Minute
+)
+
+// NoPendingPhoneRegistrationError is an error returned when no proven phone number is waiting on a registration
+type NoPendingPhoneRegistrationError struct{}
+
+// Error implements the error interface.
+func (e NoPendingPhoneRegistrationError) Error() string {
+	return "no pending phone registration"
+}
+
+// FindUserByPhoneNumber returns the user whose profile has verified the given phone number, in E164 format
+func (c *AuthClient) FindUserByPhoneNumber(ctx context.Context, phoneNumber string) (*ent.User, error) {
+	return c.orm.User.
+		Query().
+		Where(
+			user.HasProfileWith(
+				profile.PhoneNumberE164(phoneNumber),
+				profile.PhoneVerified(true),
+			),
+		).
+		First(ctx)
+}
+
+// SetPendingPhoneRegistration remembers in session a phone number whose owner proved it with a one-time code
+// and can now register with it
+func (c *AuthClient) SetPendingPhoneRegistration(ctx echo.Context, phoneNumber string) error {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return err
+	}
+
+	sess.Values[authSessionKeyPendingPhoneRegistration] = phoneNumber
+	sess.Values[authSessionKeyPendingPhoneRegistrationAt] = time.Now().Unix()
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// GetPendingPhoneRegistration returns the phone number waiting on a registration, if any
+func (c *AuthClient) GetPendingPhoneRegistration(ctx echo.Context) (string, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	phoneNumber, ok := sess.Values[authSessionKeyPendingPhoneRegistration].(string)
+	if !ok || phoneNumber == "" {
+		return "", NoPendingPhoneRegistrationError{}
+	}
+
+	at, ok := sess.Values[authSessionKeyPendingPhoneRegistrationAt].(int64)
+	if !ok || time.Since(time.Unix(at, 0)) > pendingPhoneRegistrationExpiration {
+		return "", NoPendingPhoneRegistrationError{}
+	}
+
+	return phoneNumber, nil
+}
+
+// ClearPendingPhoneRegistration removes the phone number waiting on a registration from the session
+func (c *AuthClient) ClearPendingPhoneRegistration(ctx echo.Context) error {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return err
+	}
+
+	delete(sess.Values, authSessionKeyPendingPhoneRegistration)
+	delete(sess.Values, authSessionKeyPendingPhoneRegistrationAt)
+	return sess.Save(ctx.Request(), ctx.Response())
+}
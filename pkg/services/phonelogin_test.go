@@ -0,0 +1,49 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_FindUserByPhoneNumber(t *testing.T) {
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+
+	// The test database is kept between runs, so each run uses its own number
+	phoneNumber := fmt.Sprintf("+1514%07d", time.Now().UnixNano()%10000000)
+	p := c.ORM.Profile.
+		Create().
+		SetUser(u).
+		SetBio("bio").
+		SetPhoneNumberE164(phoneNumber).
+		SaveX(ctx.Request().Context())
+
+	// Numbers which were not verified cannot be signed in with
+	_, err = c.Auth.FindUserByPhoneNumber(ctx.Request().Context(), phoneNumber)
+	assert.True(t, ent.IsNotFound(err))
+
+	c.ORM.Profile.UpdateOne(p).SetPhoneVerified(true).ExecX(ctx.Request().Context())
+	found, err := c.Auth.FindUserByPhoneNumber(ctx.Request().Context(), phoneNumber)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, found.ID)
+}
+
+func TestAuthClient_PendingPhoneRegistration(t *testing.T) {
+	_, err := c.Auth.GetPendingPhoneRegistration(ctx)
+	assert.Equal(t, NoPendingPhoneRegistrationError{}, err)
+
+	require.NoError(t, c.Auth.SetPendingPhoneRegistration(ctx, "+15145550102"))
+	phoneNumber, err := c.Auth.GetPendingPhoneRegistration(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "+15145550102", phoneNumber)
+
+	require.NoError(t, c.Auth.ClearPendingPhoneRegistration(ctx))
+	_, err = c.Auth.GetPendingPhoneRegistration(ctx)
+	assert.Equal(t, NoPendingPhoneRegistrationError{}, err)
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/mikestefanello/pagoda/config"
+)
+
+const (
+	// RateLimitBackendRedis keeps the token buckets in Redis, so that limits are shared between nodes
+	RateLimitBackendRedis = "redis"
+
+	// RateLimitBackendMemory keeps the token buckets in memory
+	RateLimitBackendMemory = "memory"
+
+	// memoryBucketsSweepInterval is how many requests the in-memory store handles between removals of full buckets
+	memoryBucketsSweepInterval = 10000
+)
+
+type (
+	// RateLimiter limits how many requests can be made using named token bucket policies
+	RateLimiter struct {
+		config config.RateLimitConfig
+		store  RateLimitStore
+		now    func() time.Time
+	}
+
+	// RateLimitResult is the outcome of taking a token from a bucket
+	RateLimitResult struct {
+		Allowed bool
+
+		// Limit is the size of the bucket
+		Limit int
+
+		// Remaining is the number of requests which can still be made right away
+		Remaining int
+
+		// RetryAfter is how long to wait until a request is allowed, when it was not
+		RetryAfter time.Duration
+
+		// Reset is how long until the bucket is full again
+		Reset time.Duration
+
+		// Period is the window the policy refills Requests over
+		Period time.Duration
+	}
+
+	// RateLimitStore stores token buckets
+	RateLimitStore interface {
+		// Take takes a token from the bucket of a key if there is one, refilling it first at a rate of tokens
+		// per second up to its capacity. It returns whether a token was taken and how many are left.
+		Take(ctx context.Context, key string, capacity int, rate float64, now time.Time) (bool, float64, error)
+
+		// Refund puts back a token taken from the bucket of a key, up to its capacity
+		Refund(ctx context.Context, key string, capacity int) error
+	}
+
+	// memoryRateLimitStore keeps token buckets in memory
+	memoryRateLimitStore struct {
+		mu      sync.Mutex
+		buckets map[string]*tokenBucket
+		takes   int
+	}
+
+	tokenBucket struct {
+		tokens   float64
+		capacity int
+		rate     float64
+		updated  time.Time
+	}
+
+	// redisRateLimitStore keeps token buckets in Redis hashes
+	redisRateLimitStore struct {
+		client *redis.Client
+	}
+)
+
+// redisTakeScript refills and takes from a bucket atomically. Times are in milliseconds.
+var redisTakeScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(bucket[1]) or capacity
+local updated = tonumber(bucket[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - updated) * rate)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tokens, "updated", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((capacity - tokens) / rate) + 1000)
+return {allowed, tostring(tokens)}
+`)
+
+// redisRefundScript puts a token back into a bucket atomically, unless it expired since it is then full
+var redisRefundScript = redis.NewScript(`
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+if tokens then
+	redis.call("HSET", KEYS[1], "tokens", math.min(tonumber(ARGV[1]), tokens + 1))
+end
+return 1
+`)
+
+// NewRateLimiter creates a new rate limiter
+func NewRateLimiter(cfg config.RateLimitConfig, store RateLimitStore) *RateLimiter {
+	return &RateLimiter{
+		config: cfg,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+// NewMemoryRateLimitStore creates a rate limit store which keeps buckets in memory
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// NewRedisRateLimitStore creates a rate limit store backed by Redis
+func NewRedisRateLimitStore(client *redis.Client) RateLimitStore {
+	return &redisRateLimitStore{client: client}
+}
+
+// Enabled returns true if requests should be rate limited
+func (r *RateLimiter) Enabled() bool {
+	return r.config.Enabled
+}
+
+// Policy returns a policy by name
+func (r *RateLimiter) Policy(name string) (config.RateLimitPolicy, bool) {
+	policy, ok := r.config.Policies[name]
+	return policy, ok
+}
+
+// RoutePolicies returns the names of the policies which apply to a given route name
+func (r *RateLimiter) RoutePolicies(routeName string) []string {
+	var names []string
+	for name, policy := range r.config.Policies {
+		if slices.Contains(policy.Routes, routeName) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Allow takes a token from the bucket of a key under a given policy
+func (r *RateLimiter) Allow(ctx context.Context, policyName, key string) (RateLimitResult, error) {
+	policy, ok := r.Policy(policyName)
+	if !ok || policy.Requests <= 0 || policy.Period <= 0 {
+		return RateLimitResult{Allowed: true}, nil
+	}
+
+	capacity := policy.Burst
+	if capacity <= 0 {
+		capacity = policy.Requests
+	}
+	rate := float64(policy.Requests) / policy.Period.Seconds()
+
+	allowed, tokens, err := r.store.Take(ctx, "ratelimit::"+policyName+"::"+key, capacity, rate, r.now())
+	if err != nil {
+		return RateLimitResult{}, err
+	}
+
+	res := RateLimitResult{
+		Allowed:   allowed,
+		Limit:     capacity,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration((float64(capacity) - tokens) / rate * float64(time.Second)),
+		Period:    policy.Period,
+	}
+	if !allowed {
+		res.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+	return res, nil
+}
+
+// Refund puts back a token taken from the bucket of a key under a given policy, for requests which ended up
+// not being allowed
+func (r *RateLimiter) Refund(ctx context.Context, policyName, key string) error {
+	policy, ok := r.Policy(policyName)
+	if !ok || policy.Requests <= 0 || policy.Period <= 0 {
+		return nil
+	}
+
+	capacity := policy.Burst
+	if capacity <= 0 {
+		capacity = policy.Requests
+	}
+	return r.store.Refund(ctx, "ratelimit::"+policyName+"::"+key, capacity)
+}
+
+func (s *memoryRateLimitStore) Take(_ context.Context, key string, capacity int, rate float64, now time.Time) (bool, float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.takes++
+	if s.takes%memoryBucketsSweepInterval == 0 {
+		s.sweep(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(capacity), updated: now}
+		s.buckets[key] = b
+	}
+	b.capacity, b.rate = capacity, rate
+	b.refill(now)
+
+	if b.tokens < 1 {
+		return false, b.tokens, nil
+	}
+	b.tokens--
+	return true, b.tokens, nil
+}
+
+func (s *memoryRateLimitStore) Refund(_ context.Context, key string, capacity int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Missing buckets are full
+	if b, ok := s.buckets[key]; ok {
+		b.tokens = math.Min(float64(capacity), b.tokens+1)
+	}
+	return nil
+}
+
+// sweep removes the buckets which are full, since they behave the same as missing ones
+func (s *memoryRateLimitStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if b.refill(now); b.tokens >= float64(b.capacity) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(b.capacity), b.tokens+elapsed*b.rate)
+		b.updated = now
+	}
+}
+
+func (s *redisRateLimitStore) Take(ctx context.Context, key string, capacity int, rate float64, now time.Time) (bool, float64, error) {
+	res, err := redisTakeScript.Run(ctx, s.client, []string{key}, capacity, rate/1000, now.UnixMilli()).Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	allowed, _ := res[0].(int64)
+	remaining, _ := res[1].(string)
+	tokens, err := strconv.ParseFloat(remaining, 64)
+	if err != nil {
+		return false, 0, err
+	}
+	return allowed == 1, tokens, nil
+}
+
+func (s *redisRateLimitStore) Refund(ctx context.Context, key string, capacity int) error {
+	return redisRefundScript.Run(ctx, s.client, []string{key}, capacity).Err()
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	bg := context.Background()
+	now := time.Now()
+	rl := NewRateLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Policies: map[string]config.RateLimitPolicy{
+			"web": {
+				Requests: 60,
+				Period:   time.Minute,
+				Burst:    3,
+			},
+		},
+	}, NewMemoryRateLimitStore())
+	rl.now = func() time.Time { return now }
+
+	// The burst is available right away
+	for i := 2; i >= 0; i-- {
+		res, err := rl.Allow(bg, "web", "a")
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 3, res.Limit)
+		assert.Equal(t, i, res.Remaining)
+		assert.Equal(t, time.Minute, res.Period)
+	}
+
+	// The bucket is empty
+	res, err := rl.Allow(bg, "web", "a")
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.Reset)
+
+	// Other keys have their own bucket
+	res, err = rl.Allow(bg, "web", "b")
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// Tokens refill over time
+	now = now.Add(time.Second)
+	res, err = rl.Allow(bg, "web", "a")
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	res, err = rl.Allow(bg, "web", "a")
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	// Refunded tokens can be taken again, but buckets never hold more than their capacity
+	require.NoError(t, rl.Refund(bg, "web", "a"))
+	res, err = rl.Allow(bg, "web", "a")
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, rl.Refund(bg, "web", "b"))
+	}
+	res, err = rl.Allow(bg, "web", "b")
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Remaining)
+
+	// Unknown policies do not limit
+	res, err = rl.Allow(bg, "missing", "a")
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestRateLimiter_RoutePolicies(t *testing.T) {
+	rl := NewRateLimiter(config.RateLimitConfig{
+		Policies: map[string]config.RateLimitPolicy{
+			"web":    {},
+			"login":  {Routes: []string{"login.submit"}},
+			"auth":   {Routes: []string{"login.submit", "register.submit"}},
+			"signup": {Routes: []string{"register.submit"}},
+		},
+	}, NewMemoryRateLimitStore())
+
+	assert.Equal(t, []string{"auth", "login"}, rl.RoutePolicies("login.submit"))
+	assert.Equal(t, []string{"auth", "signup"}, rl.RoutePolicies("register.submit"))
+	assert.Empty(t, rl.RoutePolicies("home"))
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/role"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+const (
+	// RoleAdmin is the role of the people running the app, it grants every permission
+	RoleAdmin = "admin"
+
+	// PermissionAll matches every permission
+	PermissionAll = "*"
+
+	// PermissionAdminAccess allows access to the admin area
+	PermissionAdminAccess = "admin:access"
+
+	// PermissionImpersonate allows acting as another user, to see the app as they do
+	PermissionImpersonate = "admin:impersonate"
+
+	// PermissionModerate allows acting on the reports users file about each other
+	PermissionModerate = "admin:moderate"
+)
+
+// DefaultRolePermissions holds the permissions of the built-in roles, which are created the first time they are granted
+var DefaultRolePermissions = map[string][]string{
+	RoleAdmin: {PermissionAll},
+}
+
+// UnknownRoleError is an error returned when a role which was never defined is granted
+type UnknownRoleError struct {
+	Name string
+}
+
+// Error implements the error interface.
+func (e UnknownRoleError) Error() string {
+	return "unknown role " + e.Name
+}
+
+// GetRoles returns every role, with the users they were granted to
+func (c *AuthClient) GetRoles(ctx context.Context) ([]*ent.Role, error) {
+	return c.orm.Role.
+		Query().
+		WithUsers().
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+}
+
+// GetUserRoles returns the roles granted to a user
+func (c *AuthClient) GetUserRoles(ctx context.Context, userID int) ([]*ent.Role, error) {
+	return c.orm.Role.
+		Query().
+		Where(role.HasUsersWith(user.ID(userID))).
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+}
+
+// SetRolePermissions defines a role, or replaces the permissions of an existing one
+func (c *AuthClient) SetRolePermissions(ctx context.Context, name string, permissions []string) error {
+	return c.orm.Role.
+		Create().
+		SetName(name).
+		SetPermissions(permissions).
+		OnConflictColumns(role.FieldName).
+		UpdatePermissions().
+		Exec(ctx)
+}
+
+// GrantRole grants a role to a user. Built-in roles are created if they were never granted before.
+func (c *AuthClient) GrantRole(ctx context.Context, userID int, name string) error {
+	r, err := c.orm.Role.
+		Query().
+		Where(role.Name(name)).
+		Only(ctx)
+
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		permissions, ok := DefaultRolePermissions[name]
+		if !ok {
+			return UnknownRoleError{Name: name}
+		}
+		if r, err = c.orm.Role.Create().SetName(name).SetPermissions(permissions).Save(ctx); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	granted, err := r.QueryUsers().Where(user.ID(userID)).Exist(ctx)
+	if err != nil || granted {
+		return err
+	}
+
+	if err := r.Update().AddUserIDs(userID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return c.recordRoleChange(ctx, AuditActionRoleGranted, userID, name)
+}
+
+// RevokeRole removes a role from a user
+func (c *AuthClient) RevokeRole(ctx context.Context, userID int, name string) error {
+	r, err := c.orm.Role.
+		Query().
+		Where(role.Name(name)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.Update().RemoveUserIDs(userID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return c.recordRoleChange(ctx, AuditActionRoleRevoked, userID, name)
+}
+
+func (c *AuthClient) recordRoleChange(ctx context.Context, action string, userID int, name string) error {
+	return c.audit.Record(ctx, AuditEvent{
+		Action:     action,
+		TargetType: AuditTargetUser,
+		TargetID:   &userID,
+		Metadata:   map[string]string{"role": name},
+	})
+}
+
+// UserHasRole returns true if the user was granted any of the given roles.
+// The roles of the user must be loaded, as done for the authenticated user.
+func UserHasRole(u *ent.User, names ...string) bool {
+	if u == nil {
+		return false
+	}
+	for _, r := range u.Edges.Roles {
+		for _, name := range names {
+			if r.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UserHasPermission returns true if any role of the user grants the given permission.
+// The roles of the user must be loaded, as done for the authenticated user.
+func UserHasPermission(u *ent.User, permission string) bool {
+	if u == nil {
+		return false
+	}
+	for _, r := range u.Edges.Roles {
+		for _, granted := range r.Permissions {
+			if permissionMatches(granted, permission) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// permissionMatches checks a granted permission against a required one, where a trailing *
+// in the granted permission matches anything, ie: "users:*" matches "users:suspend"
+func permissionMatches(granted, permission string) bool {
+	if prefix, ok := strings.CutSuffix(granted, "*"); ok {
+		return strings.HasPrefix(permission, prefix)
+	}
+	return granted == permission
+}
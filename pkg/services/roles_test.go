@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Roles(t *testing.T) {
+	bg := context.Background()
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+
+	// Unknown roles must be defined before being granted
+	err = c.Auth.GrantRole(bg, u.ID, "role-test-undefined")
+	assert.Equal(t, UnknownRoleError{Name: "role-test-undefined"}, err)
+
+	// Built-in roles are created on demand, and granting twice is a no-op
+	require.NoError(t, c.Auth.GrantRole(bg, u.ID, RoleAdmin))
+	require.NoError(t, c.Auth.GrantRole(bg, u.ID, RoleAdmin))
+
+	require.NoError(t, c.Auth.SetRolePermissions(bg, "role-test-moderator", []string{"reports:*"}))
+	require.NoError(t, c.Auth.GrantRole(bg, u.ID, "role-test-moderator"))
+
+	roles, err := c.Auth.GetUserRoles(bg, u.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, RoleAdmin, roles[0].Name)
+	assert.Equal(t, []string{PermissionAll}, roles[0].Permissions)
+	assert.Equal(t, "role-test-moderator", roles[1].Name)
+
+	// Permissions of an existing role can be replaced
+	require.NoError(t, c.Auth.SetRolePermissions(bg, "role-test-moderator", []string{"reports:read"}))
+	roles, err = c.Auth.GetUserRoles(bg, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reports:read"}, roles[1].Permissions)
+
+	require.NoError(t, c.Auth.RevokeRole(bg, u.ID, RoleAdmin))
+	roles, err = c.Auth.GetUserRoles(bg, u.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, "role-test-moderator", roles[0].Name)
+}
+
+func TestUserHasPermission(t *testing.T) {
+	u := &ent.User{
+		Edges: ent.UserEdges{
+			Roles: []*ent.Role{
+				{Name: "moderator", Permissions: []string{"reports:*", "users:suspend"}},
+			},
+		},
+	}
+
+	assert.True(t, UserHasRole(u, "moderator"))
+	assert.True(t, UserHasRole(u, RoleAdmin, "moderator"))
+	assert.False(t, UserHasRole(u, RoleAdmin))
+	assert.False(t, UserHasRole(nil, RoleAdmin))
+
+	assert.True(t, UserHasPermission(u, "reports:dismiss"))
+	assert.True(t, UserHasPermission(u, "users:suspend"))
+	assert.False(t, UserHasPermission(u, "users:impersonate"))
+	assert.False(t, UserHasPermission(u, PermissionAdminAccess))
+	assert.False(t, UserHasPermission(nil, PermissionAdminAccess))
+
+	u.Edges.Roles = append(u.Edges.Roles, &ent.Role{Name: RoleAdmin, Permissions: []string{PermissionAll}})
+	assert.True(t, UserHasPermission(u, PermissionAdminAccess))
+}
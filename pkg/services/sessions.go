@@ -0,0 +1,157 @@
+package services
+
+import (
+	"time"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/usersession"
+	"github.com/mileusna/useragent"
+)
+
+const (
+	// userSessionTokenLength is the length of the random token identifying a server-side session
+	userSessionTokenLength = 64
+
+	// UserSessionIdleTimeout is how long a session stays valid without being used. It matches the
+	// default max age of the session cookie.
+	UserSessionIdleTimeout = 30 * 24 * time.Hour
+
+	// userSessionTouchInterval limits how often the last seen time of a session is written
+	userSessionTouchInterval = time.Minute
+)
+
+// GetUserSessions returns the active sessions of a user, most recently seen first
+func (c *AuthClient) GetUserSessions(ctx echo.Context, userID int) ([]*ent.UserSession, error) {
+	return c.orm.UserSession.
+		Query().
+		Where(
+			usersession.UserIDEQ(userID),
+			usersession.LastSeenAtGT(time.Now().Add(-UserSessionIdleTimeout)),
+		).
+		Order(ent.Desc(usersession.FieldLastSeenAt)).
+		All(ctx.Request().Context())
+}
+
+// GetCurrentUserSessionID returns the ID of the session the request was made with
+func (c *AuthClient) GetCurrentUserSessionID(ctx echo.Context) (int, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	token, ok := sess.Values[authSessionKeySessionToken].(string)
+	if !ok {
+		return 0, NotAuthenticatedError{}
+	}
+
+	return c.orm.UserSession.
+		Query().
+		Where(usersession.TokenHash(hashToken(token))).
+		OnlyID(ctx.Request().Context())
+}
+
+// RevokeUserSession signs a user out of one of their sessions
+func (c *AuthClient) RevokeUserSession(ctx echo.Context, userID, sessionID int) error {
+	deleted, err := c.orm.UserSession.
+		Delete().
+		Where(
+			usersession.ID(sessionID),
+			usersession.UserIDEQ(userID),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// RevokeAllUserSessions signs a user out everywhere. This should be called whenever their credentials change.
+func (c *AuthClient) RevokeAllUserSessions(ctx echo.Context, userID int) error {
+	_, err := c.orm.UserSession.
+		Delete().
+		Where(usersession.UserIDEQ(userID)).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// createUserSession stores a new session for the requesting device and returns its token
+func (c *AuthClient) createUserSession(ctx echo.Context, userID int) (string, error) {
+	token, err := c.RandomToken(userSessionTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	// Remove the sessions of this user which expired, so the table does not grow forever
+	_, err = c.orm.UserSession.
+		Delete().
+		Where(
+			usersession.UserIDEQ(userID),
+			usersession.LastSeenAtLTE(time.Now().Add(-UserSessionIdleTimeout)),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	ua := useragent.Parse(ctx.Request().UserAgent())
+
+	_, err = c.orm.UserSession.
+		Create().
+		SetTokenHash(hashToken(token)).
+		SetUserAgent(ctx.Request().UserAgent()).
+		SetBrowser(ua.Name).
+		SetOs(ua.OS).
+		SetDevice(ua.Device).
+		SetIPAddress(ctx.RealIP()).
+		SetUserID(userID).
+		Save(ctx.Request().Context())
+
+	return token, err
+}
+
+// touchUserSession ensures the session of a given token is still active and records that it was just seen
+func (c *AuthClient) touchUserSession(ctx echo.Context, userID int, token string) error {
+	if token == "" {
+		return NotAuthenticatedError{}
+	}
+
+	us, err := c.orm.UserSession.
+		Query().
+		Where(
+			usersession.TokenHash(hashToken(token)),
+			usersession.UserIDEQ(userID),
+			usersession.LastSeenAtGT(time.Now().Add(-UserSessionIdleTimeout)),
+		).
+		Only(ctx.Request().Context())
+
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return NotAuthenticatedError{}
+	default:
+		return err
+	}
+
+	if time.Since(us.LastSeenAt) < userSessionTouchInterval {
+		return nil
+	}
+
+	return us.Update().
+		SetLastSeenAt(time.Now()).
+		SetIPAddress(ctx.RealIP()).
+		Exec(ctx.Request().Context())
+}
+
+// deleteUserSession deletes the session of a given token
+func (c *AuthClient) deleteUserSession(ctx echo.Context, token string) error {
+	_, err := c.orm.UserSession.
+		Delete().
+		Where(usersession.TokenHash(hashToken(token))).
+		Exec(ctx.Request().Context())
+	return err
+}
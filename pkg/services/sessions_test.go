@@ -0,0 +1,61 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_UserSessions(t *testing.T) {
+	// Log in from two devices
+	ctx1, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx1)
+	ctx1.Request().Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15")
+	require.NoError(t, c.Auth.Login(ctx1, usr.ID))
+
+	ctx2, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx2)
+	require.NoError(t, c.Auth.Login(ctx2, usr.ID))
+
+	sessions, err := c.Auth.GetUserSessions(ctx1, usr.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	currentID, err := c.Auth.GetCurrentUserSessionID(ctx1)
+	require.NoError(t, err)
+	var current *ent.UserSession
+	for _, s := range sessions {
+		if s.ID == currentID {
+			current = s
+		}
+	}
+	require.NotNil(t, current)
+	assert.Equal(t, "Safari", current.Browser)
+	assert.Equal(t, "macOS", current.Os)
+
+	// Other users cannot revoke the session
+	err = c.Auth.RevokeUserSession(ctx1, usr.ID+1000, currentID)
+	assert.IsType(t, &ent.NotFoundError{}, err)
+
+	// Revoking a session signs that device out, even though its cookie is still around
+	require.NoError(t, c.Auth.RevokeUserSession(ctx2, usr.ID, currentID))
+	_, err = c.Auth.GetAuthenticatedUserID(ctx1)
+	assert.True(t, errors.Is(err, NotAuthenticatedError{}))
+
+	uid, err := c.Auth.GetAuthenticatedUserID(ctx2)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, uid)
+
+	// Signing out everywhere
+	require.NoError(t, c.Auth.RevokeAllUserSessions(ctx2, usr.ID))
+	_, err = c.Auth.GetAuthenticatedUserID(ctx2)
+	assert.True(t, errors.Is(err, NotAuthenticatedError{}))
+
+	sessions, err = c.Auth.GetUserSessions(ctx2, usr.ID)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+)
+
+// AccountSuspendedError is an error returned when a user tries to sign in to an account which a moderator
+// suspended
+type AccountSuspendedError struct{}
+
+// Error implements the error interface.
+func (e AccountSuspendedError) Error() string {
+	return "account is suspended"
+}
+
+// checkAccountNotLocked returns an AccountPendingDeletionError if the account of a user is locked until it
+// gets deleted, or an AccountSuspendedError if it was suspended
+func (c *AuthClient) checkAccountNotLocked(ctx context.Context, userID int) error {
+	u, err := c.orm.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.DeletionScheduledAt != nil {
+		return AccountPendingDeletionError{ScheduledAt: *u.DeletionScheduledAt}
+	}
+	if u.SuspendedAt != nil {
+		return AccountSuspendedError{}
+	}
+	return nil
+}
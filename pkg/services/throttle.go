@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/throttleattempt"
+	"github.com/mikestefanello/pagoda/ent/throttlelock"
+)
+
+// ThrottleScope is what a throttle key counts attempts of
+type ThrottleScope string
+
+const (
+	ThrottleScopeEmail   ThrottleScope = "email"
+	ThrottleScopeIP      ThrottleScope = "ip"
+	ThrottleScopeProfile ThrottleScope = "profile"
+)
+
+type (
+	// ThrottleKey identifies what failed attempts are counted against, e.g. an email address or an IP
+	ThrottleKey struct {
+		Scope ThrottleScope
+		Value string
+	}
+
+	// Throttler slows down and eventually locks out repeated failed attempts, such as password guesses
+	// or verification code guesses. Attempts are counted in a sliding window per key.
+	Throttler struct {
+		config config.LoginThrottleConfig
+		store  AttemptStore
+		now    func() time.Time
+	}
+
+	// AttemptStore stores the attempts and locks the Throttler relies on
+	AttemptStore interface {
+		// AddAttempt records an attempt for a key. Attempts older than the window can be forgotten.
+		AddAttempt(ctx context.Context, key string, at time.Time, window time.Duration) error
+
+		// GetAttempts returns the number of attempts for a key since a given time, and when the last one was made
+		GetAttempts(ctx context.Context, key string, since time.Time) (int, time.Time, error)
+
+		// ClearAttempts forgets all attempts of a key
+		ClearAttempts(ctx context.Context, key string) error
+
+		// SetLock locks a key until a given time
+		SetLock(ctx context.Context, key string, until time.Time) error
+
+		// GetLock returns until when a key is locked, which is the zero time if it is not
+		GetLock(ctx context.Context, key string) (time.Time, error)
+
+		// ClearLock unlocks a key
+		ClearLock(ctx context.Context, key string) error
+	}
+
+	// redisAttemptStore stores attempts in Redis sorted sets, scored by the time of the attempt
+	redisAttemptStore struct {
+		client *redis.Client
+	}
+
+	// entAttemptStore stores attempts in the database, for when Redis is not available
+	entAttemptStore struct {
+		orm *ent.Client
+	}
+)
+
+// ThrottledError is an error returned when an attempt is made too soon after previous failed attempts
+type ThrottledError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e ThrottledError) Error() string {
+	return fmt.Sprintf("too many attempts, retry after %s", e.RetryAfter)
+}
+
+// LockedOutError is an error returned when a key is locked out after too many failed attempts
+type LockedOutError struct {
+	Key   ThrottleKey
+	Until time.Time
+}
+
+// Error implements the error interface.
+func (e LockedOutError) Error() string {
+	return fmt.Sprintf("%s is locked out until %s", e.Key, e.Until.Format(time.RFC3339))
+}
+
+// ThrottleKeyEmail returns the throttle key of an email address
+func ThrottleKeyEmail(email string) ThrottleKey {
+	return ThrottleKey{Scope: ThrottleScopeEmail, Value: strings.ToLower(email)}
+}
+
+// ThrottleKeyIP returns the throttle key of an IP address
+func ThrottleKeyIP(ip string) ThrottleKey {
+	return ThrottleKey{Scope: ThrottleScopeIP, Value: ip}
+}
+
+// ThrottleKeyProfile returns the throttle key of a profile
+func ThrottleKeyProfile(profileID int) ThrottleKey {
+	return ThrottleKey{Scope: ThrottleScopeProfile, Value: strconv.Itoa(profileID)}
+}
+
+// String returns the key as stored
+func (k ThrottleKey) String() string {
+	return fmt.Sprintf("%s:%s", k.Scope, k.Value)
+}
+
+// IsAccount returns true if the key identifies an account rather than where the attempts come from
+func (k ThrottleKey) IsAccount() bool {
+	return k.Scope != ThrottleScopeIP
+}
+
+// NewThrottler creates a new throttler
+func NewThrottler(cfg config.LoginThrottleConfig, store AttemptStore) *Throttler {
+	return &Throttler{
+		config: cfg,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+// NewRedisAttemptStore creates an attempt store backed by Redis
+func NewRedisAttemptStore(client *redis.Client) AttemptStore {
+	return &redisAttemptStore{client: client}
+}
+
+// NewEntAttemptStore creates an attempt store backed by the database
+func NewEntAttemptStore(orm *ent.Client) AttemptStore {
+	return &entAttemptStore{orm: orm}
+}
+
+// Check returns an error if an attempt cannot be made right now for any of the keys, either
+// a LockedOutError or a ThrottledError telling how long to wait
+func (t *Throttler) Check(ctx context.Context, keys ...ThrottleKey) error {
+	now := t.now()
+	var retryAfter time.Duration
+
+	for _, key := range keys {
+		until, err := t.store.GetLock(ctx, key.String())
+		if err != nil {
+			return err
+		}
+		if until.After(now) {
+			return LockedOutError{Key: key, Until: until}
+		}
+
+		count, last, err := t.store.GetAttempts(ctx, key.String(), now.Add(-t.config.Window))
+		if err != nil {
+			return err
+		}
+		if wait := last.Add(t.backoff(count)).Sub(now); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	if retryAfter > 0 {
+		return ThrottledError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// Fail records a failed attempt for each key and returns the keys which got locked out because of it
+func (t *Throttler) Fail(ctx context.Context, keys ...ThrottleKey) ([]ThrottleKey, error) {
+	now := t.now()
+	var locked []ThrottleKey
+
+	for _, key := range keys {
+		if err := t.store.AddAttempt(ctx, key.String(), now, t.config.Window); err != nil {
+			return nil, err
+		}
+
+		count, _, err := t.store.GetAttempts(ctx, key.String(), now.Add(-t.config.Window))
+		if err != nil {
+			return nil, err
+		}
+		if count < t.maxAttempts(key) {
+			continue
+		}
+
+		if err := t.store.SetLock(ctx, key.String(), now.Add(t.config.LockoutDuration)); err != nil {
+			return nil, err
+		}
+		if err := t.store.ClearAttempts(ctx, key.String()); err != nil {
+			return nil, err
+		}
+		locked = append(locked, key)
+	}
+
+	return locked, nil
+}
+
+// Reset forgets the failed attempts of keys, which should be done after a successful attempt
+func (t *Throttler) Reset(ctx context.Context, keys ...ThrottleKey) error {
+	for _, key := range keys {
+		if err := t.store.ClearAttempts(ctx, key.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unlock lifts the lockout of keys and forgets their failed attempts
+func (t *Throttler) Unlock(ctx context.Context, keys ...ThrottleKey) error {
+	for _, key := range keys {
+		if err := t.store.ClearLock(ctx, key.String()); err != nil {
+			return err
+		}
+	}
+	return t.Reset(ctx, keys...)
+}
+
+// backoff returns how long to wait after the last of a number of failed attempts
+func (t *Throttler) backoff(attempts int) time.Duration {
+	if attempts < t.config.FreeAttempts || attempts == 0 {
+		return 0
+	}
+
+	delay := t.config.BaseDelay
+	for i := t.config.FreeAttempts; i < attempts && delay < t.config.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, t.config.MaxDelay)
+}
+
+// maxAttempts returns how many failed attempts lock a key out
+func (t *Throttler) maxAttempts(key ThrottleKey) int {
+	if key.IsAccount() {
+		return t.config.MaxAttemptsPerAccount
+	}
+	return t.config.MaxAttemptsPerIP
+}
+
+func (s *redisAttemptStore) attemptsKey(key string) string {
+	return fmt.Sprintf("throttle::attempts::%s", key)
+}
+
+func (s *redisAttemptStore) lockKey(key string) string {
+	return fmt.Sprintf("throttle::lock::%s", key)
+}
+
+func (s *redisAttemptStore) AddAttempt(ctx context.Context, key string, at time.Time, window time.Duration) error {
+	k := s.attemptsKey(key)
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, k, "-inf", fmt.Sprintf("(%d", at.Add(-window).UnixNano()))
+		pipe.ZAdd(ctx, k, &redis.Z{Score: float64(at.UnixNano()), Member: at.UnixNano()})
+		pipe.Expire(ctx, k, window)
+		return nil
+	})
+	return err
+}
+
+func (s *redisAttemptStore) GetAttempts(ctx context.Context, key string, since time.Time) (int, time.Time, error) {
+	k := s.attemptsKey(key)
+	count, err := s.client.ZCount(ctx, k, fmt.Sprint(since.UnixNano()), "+inf").Result()
+	if err != nil || count == 0 {
+		return 0, time.Time{}, err
+	}
+
+	last, err := s.client.ZRevRangeWithScores(ctx, k, 0, 0).Result()
+	if err != nil || len(last) == 0 {
+		return 0, time.Time{}, err
+	}
+	return int(count), time.Unix(0, int64(last[0].Score)), nil
+}
+
+func (s *redisAttemptStore) ClearAttempts(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.attemptsKey(key)).Err()
+}
+
+func (s *redisAttemptStore) SetLock(ctx context.Context, key string, until time.Time) error {
+	return s.client.Set(ctx, s.lockKey(key), until.Unix(), time.Until(until)).Err()
+}
+
+func (s *redisAttemptStore) GetLock(ctx context.Context, key string) (time.Time, error) {
+	until, err := s.client.Get(ctx, s.lockKey(key)).Int64()
+	switch {
+	case err == redis.Nil:
+		return time.Time{}, nil
+	case err != nil:
+		return time.Time{}, err
+	}
+	return time.Unix(until, 0), nil
+}
+
+func (s *redisAttemptStore) ClearLock(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.lockKey(key)).Err()
+}
+
+func (s *entAttemptStore) AddAttempt(ctx context.Context, key string, at time.Time, window time.Duration) error {
+	_, err := s.orm.ThrottleAttempt.
+		Delete().
+		Where(
+			throttleattempt.Key(key),
+			throttleattempt.AttemptedAtLT(at.Add(-window)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.orm.ThrottleAttempt.
+		Create().
+		SetKey(key).
+		SetAttemptedAt(at).
+		Exec(ctx)
+}
+
+func (s *entAttemptStore) GetAttempts(ctx context.Context, key string, since time.Time) (int, time.Time, error) {
+	attempts, err := s.orm.ThrottleAttempt.
+		Query().
+		Where(
+			throttleattempt.Key(key),
+			throttleattempt.AttemptedAtGTE(since),
+		).
+		Order(ent.Desc(throttleattempt.FieldAttemptedAt)).
+		All(ctx)
+	if err != nil || len(attempts) == 0 {
+		return 0, time.Time{}, err
+	}
+	return len(attempts), attempts[0].AttemptedAt, nil
+}
+
+func (s *entAttemptStore) ClearAttempts(ctx context.Context, key string) error {
+	_, err := s.orm.ThrottleAttempt.
+		Delete().
+		Where(throttleattempt.Key(key)).
+		Exec(ctx)
+	return err
+}
+
+func (s *entAttemptStore) SetLock(ctx context.Context, key string, until time.Time) error {
+	return s.orm.ThrottleLock.
+		Create().
+		SetKey(key).
+		SetLockedUntil(until).
+		OnConflictColumns(throttlelock.FieldKey).
+		UpdateLockedUntil().
+		Exec(ctx)
+}
+
+func (s *entAttemptStore) GetLock(ctx context.Context, key string) (time.Time, error) {
+	lock, err := s.orm.ThrottleLock.
+		Query().
+		Where(throttlelock.Key(key)).
+		Only(ctx)
+	switch err.(type) {
+	case nil:
+		return lock.LockedUntil, nil
+	case *ent.NotFoundError:
+		return time.Time{}, nil
+	default:
+		return time.Time{}, err
+	}
+}
+
+func (s *entAttemptStore) ClearLock(ctx context.Context, key string) error {
+	_, err := s.orm.ThrottleLock.
+		Delete().
+		Where(throttlelock.Key(key)).
+		Exec(ctx)
+	return err
+}
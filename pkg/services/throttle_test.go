@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottler_Backoff(t *testing.T) {
+	th := NewThrottler(config.LoginThrottleConfig{
+		FreeAttempts: 3,
+		BaseDelay:    time.Second,
+		MaxDelay:     10 * time.Second,
+	}, nil)
+
+	assert.Equal(t, time.Duration(0), th.backoff(0))
+	assert.Equal(t, time.Duration(0), th.backoff(2))
+	assert.Equal(t, time.Second, th.backoff(3))
+	assert.Equal(t, 2*time.Second, th.backoff(4))
+	assert.Equal(t, 8*time.Second, th.backoff(6))
+	assert.Equal(t, 10*time.Second, th.backoff(7))
+	assert.Equal(t, 10*time.Second, th.backoff(100))
+}
+
+func TestThrottler(t *testing.T) {
+	bg := context.Background()
+	now := time.Now()
+	th := NewThrottler(config.LoginThrottleConfig{
+		Window:                time.Hour,
+		FreeAttempts:          2,
+		BaseDelay:             time.Second,
+		MaxDelay:              time.Minute,
+		MaxAttemptsPerAccount: 4,
+		MaxAttemptsPerIP:      100,
+		LockoutDuration:       30 * time.Minute,
+	}, NewEntAttemptStore(c.ORM))
+	th.now = func() time.Time { return now }
+
+	email := ThrottleKeyEmail("Throttled@Example.com")
+	ip := ThrottleKeyIP("10.0.0.1")
+	assert.Equal(t, "email:throttled@example.com", email.String())
+	require.NoError(t, th.Unlock(bg, email, ip))
+
+	// Free attempts are not slowed down
+	require.NoError(t, th.Check(bg, email, ip))
+	locked, err := th.Fail(bg, email, ip)
+	require.NoError(t, err)
+	assert.Empty(t, locked)
+	require.NoError(t, th.Check(bg, email, ip))
+
+	// Then each attempt has to wait longer
+	_, err = th.Fail(bg, email, ip)
+	require.NoError(t, err)
+	assert.Equal(t, ThrottledError{RetryAfter: time.Second}, th.Check(bg, email, ip))
+
+	now = now.Add(time.Second)
+	require.NoError(t, th.Check(bg, email, ip))
+	_, err = th.Fail(bg, email, ip)
+	require.NoError(t, err)
+	assert.Equal(t, ThrottledError{RetryAfter: 2 * time.Second}, th.Check(bg, email, ip))
+
+	// Reaching the max attempts locks the account out, but not the IP
+	now = now.Add(2 * time.Second)
+	locked, err = th.Fail(bg, email, ip)
+	require.NoError(t, err)
+	assert.Equal(t, []ThrottleKey{email}, locked)
+	err = th.Check(bg, email)
+	require.IsType(t, LockedOutError{}, err)
+	assert.Equal(t, email, err.(LockedOutError).Key)
+	assert.True(t, now.Add(30*time.Minute).Equal(err.(LockedOutError).Until))
+
+	// Locks expire
+	now = now.Add(31 * time.Minute)
+	require.NoError(t, th.Check(bg, email))
+
+	// Admins can unlock
+	now = now.Add(time.Minute)
+	for range 4 {
+		_, err = th.Fail(bg, email)
+		require.NoError(t, err)
+	}
+	assert.IsType(t, LockedOutError{}, th.Check(bg, email))
+	require.NoError(t, th.Unlock(bg, email))
+	require.NoError(t, th.Check(bg, email))
+
+	// Attempts outside of the window are forgotten
+	_, err = th.Fail(bg, email)
+	require.NoError(t, err)
+	_, err = th.Fail(bg, email)
+	require.NoError(t, err)
+	now = now.Add(2 * time.Hour)
+	require.NoError(t, th.Check(bg, email))
+
+	// A successful attempt resets the count
+	_, err = th.Fail(bg, ip)
+	require.NoError(t, err)
+	require.NoError(t, th.Reset(bg, ip))
+	require.NoError(t, th.Check(bg, ip))
+}
@@ -0,0 +1,459 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
+)
+
+const (
+	// authSessionKeyPendingTwoFactorUserID stores the ID of a user who passed the password check
+	// but still needs to provide a second factor before being logged in
+	authSessionKeyPendingTwoFactorUserID = "pending_2fa_user_id"
+
+	// authSessionKeyPendingTwoFactorAt stores when the password check succeeded, as a unix timestamp
+	authSessionKeyPendingTwoFactorAt = "pending_2fa_at"
+
+	// totpPeriod is the duration of a single TOTP time step, as recommended by RFC 6238
+	totpPeriod = 30
+
+	// totpDigits is the number of digits of a TOTP code
+	totpDigits = 6
+
+	// totpSkew is the number of time steps before and after the current one that are accepted
+	// to account for clock drift between the server and the authenticator app
+	totpSkew = 1
+
+	// totpSecretSize is the size in bytes of a generated TOTP secret (160 bits, per RFC 4226)
+	totpSecretSize = 20
+
+	// pendingTwoFactorExpiration is how long a user has to enter their code after the password check
+	pendingTwoFactorExpiration = 5 * time.Minute
+
+	// NumRecoveryCodes is the number of one-time recovery codes generated on enrollment
+	NumRecoveryCodes = 10
+
+	// recoveryCodeLength is the length of a single recovery code
+	recoveryCodeLength = 10
+)
+
+// InvalidTwoFactorCodeError is an error returned when a TOTP or recovery code is invalid
+type InvalidTwoFactorCodeError struct{}
+
+// Error implements the error interface.
+func (e InvalidTwoFactorCodeError) Error() string {
+	return "invalid two-factor code"
+}
+
+// NoPendingTwoFactorLoginError is an error returned when no user is waiting on a second factor
+type NoPendingTwoFactorLoginError struct{}
+
+// Error implements the error interface.
+func (e NoPendingTwoFactorLoginError) Error() string {
+	return "no pending two-factor login"
+}
+
+// TOTPEnrollment holds what a user needs to add the app to their authenticator
+type TOTPEnrollment struct {
+	// Secret is the base32 encoded secret, for manual entry
+	Secret string
+
+	// URI is the otpauth:// URI, usually displayed as a QR code
+	URI string
+}
+
+// IsTwoFactorEnabled checks whether a user has a confirmed TOTP secret
+func (c *AuthClient) IsTwoFactorEnabled(ctx echo.Context, userID int) (bool, error) {
+	return c.orm.TotpSecret.
+		Query().
+		Where(
+			totpsecret.UserIDEQ(userID),
+			totpsecret.Enabled(true),
+		).
+		Exist(ctx.Request().Context())
+}
+
+// BeginTOTPEnrollment generates a new TOTP secret for a user. The secret stays disabled until
+// ConfirmTOTPEnrollment is called with a valid code. Any previous unconfirmed secret is replaced.
+func (c *AuthClient) BeginTOTPEnrollment(ctx echo.Context, usr *ent.User) (*TOTPEnrollment, error) {
+	enabled, err := c.IsTwoFactorEnabled(ctx, usr.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	raw := make([]byte, totpSecretSize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw)
+
+	encrypted, err := c.keyring.Encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.orm.TotpSecret.
+		Create().
+		SetUserID(usr.ID).
+		SetEncryptedSecret(encrypted).
+		SetEnabled(false).
+		OnConflictColumns(totpsecret.FieldUserID).
+		UpdateNewValues().
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    c.totpURI(usr.Email, secret),
+	}, nil
+}
+
+// GetPendingTOTPEnrollment returns the enrollment data of a secret which was generated but not yet confirmed
+func (c *AuthClient) GetPendingTOTPEnrollment(ctx echo.Context, usr *ent.User) (*TOTPEnrollment, error) {
+	ts, err := c.orm.TotpSecret.
+		Query().
+		Where(
+			totpsecret.UserIDEQ(usr.ID),
+			totpsecret.Enabled(false),
+		).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	secret, _, err := c.keyring.Decrypt(ts.EncryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    c.totpURI(usr.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment enables two-factor authentication if the code matches the pending secret.
+// It returns a fresh set of recovery codes, which must be shown to the user since only their hashes are stored.
+func (c *AuthClient) ConfirmTOTPEnrollment(ctx echo.Context, userID int, code string) ([]string, error) {
+	ts, err := c.orm.TotpSecret.
+		Query().
+		Where(
+			totpsecret.UserIDEQ(userID),
+			totpsecret.Enabled(false),
+		).
+		Only(ctx.Request().Context())
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return nil, InvalidTwoFactorCodeError{}
+	default:
+		return nil, err
+	}
+
+	err = c.useTOTP(ctx.Request().Context(), ts, code, func(update *ent.TotpSecretUpdate) {
+		update.
+			SetEnabled(true).
+			SetEnabledAt(time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RegenerateRecoveryCodes(ctx, userID)
+}
+
+// DisableTwoFactor removes the TOTP secret and all recovery codes of a user
+func (c *AuthClient) DisableTwoFactor(ctx echo.Context, userID int) error {
+	_, err := c.orm.TotpSecret.
+		Delete().
+		Where(totpsecret.UserIDEQ(userID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.orm.RecoveryCode.
+		Delete().
+		Where(recoverycode.UserIDEQ(userID)).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// ValidateTwoFactorCode checks a code against the user's TOTP secret and, failing that, against
+// their unused recovery codes. A recovery code is consumed when it matches.
+func (c *AuthClient) ValidateTwoFactorCode(ctx echo.Context, userID int, code string) error {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+
+	ts, err := c.orm.TotpSecret.
+		Query().
+		Where(
+			totpsecret.UserIDEQ(userID),
+			totpsecret.Enabled(true),
+		).
+		Only(ctx.Request().Context())
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return InvalidTwoFactorCodeError{}
+	default:
+		return err
+	}
+
+	if len(code) == totpDigits {
+		return c.useTOTP(ctx.Request().Context(), ts, code, nil)
+	}
+
+	return c.useRecoveryCode(ctx, userID, code)
+}
+
+// RegenerateRecoveryCodes deletes all existing recovery codes of a user and creates new ones
+func (c *AuthClient) RegenerateRecoveryCodes(ctx echo.Context, userID int) ([]string, error) {
+	_, err := c.orm.RecoveryCode.
+		Delete().
+		Where(recoverycode.UserIDEQ(userID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, NumRecoveryCodes)
+	builders := make([]*ent.RecoveryCodeCreate, NumRecoveryCodes)
+	for i := range codes {
+		if codes[i], err = c.RandomToken(recoveryCodeLength); err != nil {
+			return nil, err
+		}
+		hash, err := c.HashPassword(codes[i])
+		if err != nil {
+			return nil, err
+		}
+		builders[i] = c.orm.RecoveryCode.
+			Create().
+			SetUserID(userID).
+			SetHash(hash)
+	}
+
+	if err := c.orm.RecoveryCode.CreateBulk(builders...).Exec(ctx.Request().Context()); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// CountUnusedRecoveryCodes returns how many recovery codes a user has left
+func (c *AuthClient) CountUnusedRecoveryCodes(ctx echo.Context, userID int) (int, error) {
+	return c.orm.RecoveryCode.
+		Query().
+		Where(
+			recoverycode.UserIDEQ(userID),
+			recoverycode.UsedAtIsNil(),
+		).
+		Count(ctx.Request().Context())
+}
+
+// SetPendingTwoFactorLogin records in the session that a user passed the password check and
+// now needs to provide their second factor
+func (c *AuthClient) SetPendingTwoFactorLogin(ctx echo.Context, userID int) error {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return err
+	}
+
+	sess.Values[authSessionKeyPendingTwoFactorUserID] = userID
+	sess.Values[authSessionKeyPendingTwoFactorAt] = time.Now().Unix()
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// GetPendingTwoFactorUserID returns the ID of the user waiting on a second factor, if any
+func (c *AuthClient) GetPendingTwoFactorUserID(ctx echo.Context) (int, error) {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, ok := sess.Values[authSessionKeyPendingTwoFactorUserID].(int)
+	if !ok {
+		return 0, NoPendingTwoFactorLoginError{}
+	}
+
+	at, ok := sess.Values[authSessionKeyPendingTwoFactorAt].(int64)
+	if !ok || time.Since(time.Unix(at, 0)) > pendingTwoFactorExpiration {
+		return 0, NoPendingTwoFactorLoginError{}
+	}
+
+	return userID, nil
+}
+
+// ClearPendingTwoFactorLogin removes the pending second factor step from the session
+func (c *AuthClient) ClearPendingTwoFactorLogin(ctx echo.Context) error {
+	sess, err := session.Get(authSessionName, ctx)
+	if err != nil {
+		return err
+	}
+
+	delete(sess.Values, authSessionKeyPendingTwoFactorUserID)
+	delete(sess.Values, authSessionKeyPendingTwoFactorAt)
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// useRecoveryCode marks the first unused recovery code matching the given code as used
+func (c *AuthClient) useRecoveryCode(ctx echo.Context, userID int, code string) error {
+	rcs, err := c.orm.RecoveryCode.
+		Query().
+		Where(
+			recoverycode.UserIDEQ(userID),
+			recoverycode.UsedAtIsNil(),
+		).
+		All(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	for _, rc := range rcs {
+		if err := c.CheckPassword(strings.ToLower(code), rc.Hash); err == nil {
+			// Only mark the code as used if nobody else did in the meantime
+			n, err := c.orm.RecoveryCode.
+				Update().
+				Where(
+					recoverycode.ID(rc.ID),
+					recoverycode.UsedAtIsNil(),
+				).
+				SetUsedAt(time.Now()).
+				Save(ctx.Request().Context())
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return InvalidTwoFactorCodeError{}
+			}
+			return nil
+		}
+	}
+
+	return InvalidTwoFactorCodeError{}
+}
+
+// useTOTP checks a code against a TOTP secret and records its time step, along with the changes made by
+// update if any. The step is only recorded if no other request recorded it or a later one in the meantime,
+// so that a code is accepted once even when submitted concurrently.
+func (c *AuthClient) useTOTP(
+	ctx context.Context, ts *ent.TotpSecret, code string, update func(*ent.TotpSecretUpdate),
+) error {
+	step, encrypted, err := c.validateTOTP(ts, code)
+	if err != nil {
+		return err
+	}
+
+	u := c.orm.TotpSecret.
+		Update().
+		Where(
+			totpsecret.ID(ts.ID),
+			totpsecret.LastUsedStepLT(step),
+		).
+		SetEncryptedSecret(encrypted).
+		SetLastUsedStep(step)
+	if update != nil {
+		update(u)
+	}
+	n, err := u.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return InvalidTwoFactorCodeError{}
+	}
+	return nil
+}
+
+// validateTOTP checks a code against a TOTP secret and returns the matching time step, along with the
+// encrypted secret to store, which is encrypted again if a key that was rotated out encrypted it.
+// Steps at or before the last accepted one are rejected so that a code can only be used once.
+func (c *AuthClient) validateTOTP(ts *ent.TotpSecret, code string) (int64, string, error) {
+	secret, stale, err := c.keyring.Decrypt(ts.EncryptedSecret)
+	if err != nil {
+		return 0, "", err
+	}
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return 0, "", err
+	}
+
+	current := time.Now().Unix() / totpPeriod
+	for i := int64(-totpSkew); i <= totpSkew; i++ {
+		step := current + i
+		if step <= ts.LastUsedStep {
+			continue
+		}
+		if !hmac.Equal([]byte(GenerateTOTPCode(key, step)), []byte(code)) {
+			continue
+		}
+		if !stale {
+			return step, ts.EncryptedSecret, nil
+		}
+		encrypted, err := c.keyring.Encrypt(secret)
+		if err != nil {
+			return 0, "", err
+		}
+		return step, encrypted, nil
+	}
+
+	return 0, "", InvalidTwoFactorCodeError{}
+}
+
+// totpURI builds the otpauth:// URI understood by authenticator apps
+func (c *AuthClient) totpURI(email, secret string) string {
+	issuer := string(c.config.App.Name)
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(totpPeriod))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + email,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// GenerateTOTPCode computes the RFC 6238 code of a given key for a given time step
+func GenerateTOTPCode(key []byte, step int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, see RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
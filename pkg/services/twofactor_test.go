@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/totpsecret"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTOTPCode(t *testing.T) {
+	// Test vector from RFC 6238, appendix B (SHA1, T = 59s)
+	assert.Equal(t, "287082", GenerateTOTPCode([]byte("12345678901234567890"), 59/totpPeriod))
+}
+
+func TestAuthClient_EncryptDecrypt(t *testing.T) {
+	encrypted, err := c.Auth.keyring.Encrypt("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, "secret", encrypted)
+
+	decrypted, _, err := c.Auth.keyring.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", decrypted)
+}
+
+func TestAuthClient_TwoFactor(t *testing.T) {
+	enabled, err := c.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	enrollment, err := c.Auth.BeginTOTPEnrollment(ctx, usr)
+	require.NoError(t, err)
+	assert.Contains(t, enrollment.URI, "otpauth://totp/")
+
+	pending, err := c.Auth.GetPendingTOTPEnrollment(ctx, usr)
+	require.NoError(t, err)
+	assert.Equal(t, enrollment.Secret, pending.Secret)
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	require.NoError(t, err)
+	code := GenerateTOTPCode(key, time.Now().Unix()/totpPeriod)
+
+	_, err = c.Auth.ConfirmTOTPEnrollment(ctx, usr.ID, "000000x")
+	assert.Equal(t, InvalidTwoFactorCodeError{}, err)
+
+	codes, err := c.Auth.ConfirmTOTPEnrollment(ctx, usr.ID, code)
+	require.NoError(t, err)
+	assert.Len(t, codes, NumRecoveryCodes)
+
+	enabled, err = c.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	// A code cannot be replayed
+	err = c.Auth.ValidateTwoFactorCode(ctx, usr.ID, code)
+	assert.Equal(t, InvalidTwoFactorCodeError{}, err)
+
+	// Not even by requests which loaded the secret before the code was used
+	stale := c.ORM.TotpSecret.Query().Where(totpsecret.UserIDEQ(usr.ID)).OnlyX(context.Background())
+	stale.LastUsedStep = 0
+	err = c.Auth.useTOTP(context.Background(), stale, code, nil)
+	assert.Equal(t, InvalidTwoFactorCodeError{}, err)
+
+	// Recovery codes can only be used once
+	require.NoError(t, c.Auth.ValidateTwoFactorCode(ctx, usr.ID, codes[0]))
+	err = c.Auth.ValidateTwoFactorCode(ctx, usr.ID, codes[0])
+	assert.Equal(t, InvalidTwoFactorCodeError{}, err)
+
+	left, err := c.Auth.CountUnusedRecoveryCodes(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, NumRecoveryCodes-1, left)
+
+	require.NoError(t, c.Auth.DisableTwoFactor(ctx, usr.ID))
+	enabled, err = c.Auth.IsTwoFactorEnabled(ctx, usr.ID)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+}
+
+func TestAuthClient_TwoFactorKeyRotation(t *testing.T) {
+	u, err := tests.CreateRandomUser(c.ORM)
+	require.NoError(t, err)
+
+	enrollment, err := c.Auth.BeginTOTPEnrollment(ctx, u)
+	require.NoError(t, err)
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	require.NoError(t, err)
+
+	// Secrets encrypted before the keyring carry no key ID
+	encrypted, err := c.Keyring.Encrypt(enrollment.Secret)
+	require.NoError(t, err)
+	legacy := strings.TrimPrefix(encrypted, DefaultKeyID+":")
+	require.NoError(t, c.ORM.TotpSecret.
+		Update().
+		Where(totpsecret.UserID(u.ID)).
+		SetEncryptedSecret(legacy).
+		Exec(context.Background()))
+
+	// and are encrypted again with the active key once used
+	_, err = c.Auth.ConfirmTOTPEnrollment(ctx, u.ID, GenerateTOTPCode(key, time.Now().Unix()/totpPeriod))
+	require.NoError(t, err)
+	ts, err := c.ORM.TotpSecret.Query().Where(totpsecret.UserID(u.ID)).Only(context.Background())
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ts.EncryptedSecret, c.Keyring.ActiveKeyID()+":"))
+	secret, stale, err := c.Keyring.Decrypt(ts.EncryptedSecret)
+	require.NoError(t, err)
+	assert.False(t, stale)
+	assert.Equal(t, enrollment.Secret, secret)
+}
+
+func TestAuthClient_PendingTwoFactorLogin(t *testing.T) {
+	_, err := c.Auth.GetPendingTwoFactorUserID(ctx)
+	assert.Equal(t, NoPendingTwoFactorLoginError{}, err)
+
+	require.NoError(t, c.Auth.SetPendingTwoFactorLogin(ctx, usr.ID))
+	userID, err := c.Auth.GetPendingTwoFactorUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, userID)
+
+	require.NoError(t, c.Auth.ClearPendingTwoFactorLogin(ctx))
+	_, err = c.Auth.GetPendingTwoFactorUserID(ctx)
+	assert.Equal(t, NoPendingTwoFactorLoginError{}, err)
+}
@@ -0,0 +1,155 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/rs/zerolog/log"
+	"github.com/stripe/stripe-go/v78"
+	"github.com/stripe/stripe-go/v78/subscription"
+)
+
+const TypeDeleteAccount = "account.delete"
+
+type (
+	DeleteAccountProcessor struct {
+		orm             *ent.Client
+		profileRepo     *profilerepo.ProfileRepo
+		audit           *services.AuditLogger
+		paymentsEnabled bool
+	}
+
+	DeleteAccountPayload struct {
+		UserID int
+	}
+)
+
+// NewDeleteAccountProcessor creates a processor deleting an account once its grace period is over
+func NewDeleteAccountProcessor(
+	orm *ent.Client, profileRepo *profilerepo.ProfileRepo, audit *services.AuditLogger, paymentsEnabled bool,
+) *DeleteAccountProcessor {
+	return &DeleteAccountProcessor{
+		orm:             orm,
+		profileRepo:     profileRepo,
+		audit:           audit,
+		paymentsEnabled: paymentsEnabled,
+	}
+}
+
+func (d *DeleteAccountProcessor) ProcessTask(
+	ctx context.Context, t *asynq.Task,
+) error {
+	var p DeleteAccountPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return err
+	}
+
+	return d.DeleteAccount(ctx, p.UserID)
+}
+
+// DeleteAccount deletes the account of a user along with all their data, as long as its deletion is due.
+// Accounts whose deletion was cancelled or pushed back in the meantime are left as is.
+func (d *DeleteAccountProcessor) DeleteAccount(ctx context.Context, userID int) error {
+	usr, err := d.orm.User.
+		Query().
+		Where(user.ID(userID)).
+		WithProfile().
+		Only(ctx)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return nil
+	default:
+		return err
+	}
+
+	if usr.DeletionScheduledAt == nil || usr.DeletionScheduledAt.After(time.Now()) {
+		return nil
+	}
+
+	metadata := map[string]string{}
+	if profile := usr.Edges.Profile; profile != nil {
+		// Cancelling first keeps the user from being charged again if deleting their data fails midway
+		if d.paymentsEnabled && profile.StripeID != "" {
+			if err := cancelStripeSubscriptions(profile.StripeID); err != nil {
+				return err
+			}
+		}
+		if err := d.profileRepo.DeleteUserData(ctx, profile.ID); err != nil {
+			return err
+		}
+		metadata["profile_id"] = strconv.Itoa(profile.ID)
+	} else if err := d.orm.User.DeleteOneID(usr.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	log.Info().Int("userID", usr.ID).Msg("deleted account")
+
+	return d.audit.Record(ctx, services.AuditEvent{
+		Action:     services.AuditActionAccountDeleted,
+		ActorID:    &usr.ID,
+		TargetType: services.AuditTargetUser,
+		TargetID:   &usr.ID,
+		Metadata:   metadata,
+	})
+}
+
+// cancelStripeSubscriptions immediately cancels the subscriptions of a Stripe customer which are not
+// cancelled yet
+func cancelStripeSubscriptions(customerID string) error {
+	i := subscription.List(&stripe.SubscriptionListParams{
+		Customer: stripe.String(customerID),
+	})
+	for i.Next() {
+		if _, err := subscription.Cancel(i.Subscription().ID, nil); err != nil {
+			return err
+		}
+	}
+	return i.Err()
+}
+
+// -------------------------------------------------------------
+
+const TypeDeleteDueAccounts = "account.delete_all_due"
+
+type (
+	DeleteDueAccountsProcessor struct {
+		orm             *ent.Client
+		deleteProcessor *DeleteAccountProcessor
+	}
+)
+
+// NewDeleteDueAccountsProcessor creates a processor deleting every account whose grace period is over.
+// It catches the accounts whose own deletion task was lost or failed for good.
+func NewDeleteDueAccountsProcessor(orm *ent.Client, deleteProcessor *DeleteAccountProcessor) *DeleteDueAccountsProcessor {
+	return &DeleteDueAccountsProcessor{
+		orm:             orm,
+		deleteProcessor: deleteProcessor,
+	}
+}
+
+func (d *DeleteDueAccountsProcessor) ProcessTask(
+	ctx context.Context, t *asynq.Task,
+) error {
+	userIDs, err := d.orm.User.
+		Query().
+		Where(user.DeletionScheduledAtLTE(time.Now())).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if err := d.deleteProcessor.DeleteAccount(ctx, userID); err != nil {
+			log.Error().Err(err).Int("userID", userID).Msg("failed to delete account")
+		}
+	}
+	return nil
+}
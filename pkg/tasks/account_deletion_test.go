@@ -0,0 +1,60 @@
+package tasks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/auditlog"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tasks"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteDueAccounts(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	subscriptionsRepo := subscriptions.NewSubscriptionsRepo(client, 10, 10)
+	profileRepo := profilerepo.NewProfileRepo(client, storagerepo.NewMockStorageClient(), subscriptionsRepo)
+
+	createAccount := func(email string, deletionScheduledAt *time.Time) *ent.User {
+		usr := tests.CreateUser(ctx, client, "User", email, "password", true)
+		_, err := profileRepo.CreateProfile(ctx, usr, "bio", time.Now().AddDate(-25, 0, 0), nil, nil)
+		require.NoError(t, err)
+		if deletionScheduledAt != nil {
+			usr = client.User.UpdateOneID(usr.ID).SetDeletionScheduledAt(*deletionScheduledAt).SaveX(ctx)
+		}
+		return usr
+	}
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(24*time.Hour)
+	due := createAccount("due@example.com", &past)
+	pending := createAccount("pending@example.com", &future)
+	kept := createAccount("kept@example.com", nil)
+
+	processor := tasks.NewDeleteDueAccountsProcessor(
+		client, tasks.NewDeleteAccountProcessor(client, profileRepo, services.NewAuditLogger(client), false),
+	)
+	require.NoError(t, processor.ProcessTask(ctx, asynq.NewTask(tasks.TypeDeleteDueAccounts, nil)))
+
+	// Only the account whose grace period is over was purged, along with its profile
+	_, err := client.User.Get(ctx, due.ID)
+	assert.True(t, ent.IsNotFound(err))
+	assert.Equal(t, 2, client.User.Query().CountX(ctx))
+	assert.Equal(t, 2, client.Profile.Query().CountX(ctx))
+	assert.NotNil(t, client.User.GetX(ctx, pending.ID).DeletionScheduledAt)
+	assert.Nil(t, client.User.GetX(ctx, kept.ID).DeletionScheduledAt)
+
+	deleted := client.AuditLog.Query().Where(auditlog.Action(services.AuditActionAccountDeleted)).OnlyX(ctx)
+	assert.Equal(t, due.ID, *deleted.TargetID)
+
+	// Running again deletes nothing more
+	require.NoError(t, processor.ProcessTask(ctx, asynq.NewTask(tasks.TypeDeleteDueAccounts, nil)))
+	assert.Equal(t, 2, client.User.Query().CountX(ctx))
+}
@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/rs/zerolog/log"
+)
+
+const TypePruneAuditLog = "audit.prune"
+
+type (
+	PruneAuditLogProcessor struct {
+		audit     *services.AuditLogger
+		retention time.Duration
+	}
+)
+
+// NewPruneAuditLogProcessor creates a processor deleting the audit log records older than the retention
+func NewPruneAuditLogProcessor(audit *services.AuditLogger, retention time.Duration) *PruneAuditLogProcessor {
+	return &PruneAuditLogProcessor{
+		audit:     audit,
+		retention: retention,
+	}
+}
+
+func (p *PruneAuditLogProcessor) ProcessTask(
+	ctx context.Context, t *asynq.Task,
+) error {
+	// Never delete everything because of a missing retention
+	if p.retention <= 0 {
+		return nil
+	}
+
+	deleted, err := p.audit.Prune(ctx, p.retention)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Int("deleted", deleted).Dur("retention", p.retention).Msg("pruned audit log")
+	return nil
+}
@@ -0,0 +1,195 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates/emails"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeExportUserData = "account.export_data"
+
+type (
+	ExportUserDataProcessor struct {
+		orm         *ent.Client
+		profileRepo *profilerepo.ProfileRepo
+		storageRepo storagerepo.StorageClientInterface
+		mailer      *mailer.MailClient
+		audit       *services.AuditLogger
+		taskRunner  *services.TaskClient
+		config      *config.Config
+	}
+
+	ExportUserDataPayload struct {
+		UserID int
+	}
+)
+
+// NewExportUserDataProcessor creates a processor archiving the personal data of a user and emailing them
+// a link to download it
+func NewExportUserDataProcessor(
+	orm *ent.Client,
+	profileRepo *profilerepo.ProfileRepo,
+	storageRepo storagerepo.StorageClientInterface,
+	mailer *mailer.MailClient,
+	audit *services.AuditLogger,
+	taskRunner *services.TaskClient,
+	config *config.Config,
+) *ExportUserDataProcessor {
+	return &ExportUserDataProcessor{
+		orm:         orm,
+		profileRepo: profileRepo,
+		storageRepo: storageRepo,
+		mailer:      mailer,
+		audit:       audit,
+		taskRunner:  taskRunner,
+		config:      config,
+	}
+}
+
+func (e *ExportUserDataProcessor) ProcessTask(
+	ctx context.Context, t *asynq.Task,
+) error {
+	var p ExportUserDataPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return err
+	}
+
+	usr, err := e.orm.User.Get(ctx, p.UserID)
+	switch err.(type) {
+	case nil:
+	case *ent.NotFoundError:
+		return nil
+	default:
+		return err
+	}
+
+	// The archive goes through a temporary file since uploads are read twice, to be hashed then sent
+	archive, err := os.CreateTemp("", "data-export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	manifest, err := e.profileRepo.ExportUserData(ctx, usr.ID, archive)
+	if err != nil {
+		return err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	objectKey := fmt.Sprintf("exports/%d/%s.zip", usr.ID, uuid.New().String())
+	if _, err := e.storageRepo.UploadFile(storagerepo.BucketMainApp, objectKey, archive); err != nil {
+		return err
+	}
+
+	expiration := e.config.Storage.DataExportLinkExpiration
+	link, err := e.storageRepo.GetPresignedURL(storagerepo.BucketMainApp, objectKey, expiration)
+	if err != nil {
+		return err
+	}
+
+	if e.taskRunner != nil {
+		err = e.taskRunner.
+			New(TypeDeleteDataExport).
+			Payload(DeleteDataExportPayload{ObjectKey: objectKey}).
+			At(time.Now().Add(expiration)).
+			Save()
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Warn().Str("objectKey", objectKey).Msg("no task client, data export will not be deleted once expired")
+	}
+
+	if err := e.sendReadyEmail(ctx, usr, link, time.Now().Add(expiration)); err != nil {
+		return err
+	}
+
+	log.Info().Int("userID", usr.ID).Int("files", len(manifest.Files)).Msg("exported user data")
+
+	return e.audit.Record(ctx, services.AuditEvent{
+		Action:     services.AuditActionDataExported,
+		ActorID:    &usr.ID,
+		TargetType: services.AuditTargetUser,
+		TargetID:   &usr.ID,
+		Metadata:   map[string]string{"files": strconv.Itoa(len(manifest.Files))},
+	})
+}
+
+func (e *ExportUserDataProcessor) sendReadyEmail(ctx context.Context, usr *ent.User, link string, expiresAt time.Time) error {
+	// Pages are built from a request, so emails rendered outside of one use a dummy request
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	page := controller.NewPage(echo.New().NewContext(req, httptest.NewRecorder()))
+	page.Layout = layouts.Main
+	page.Data = types.EmailDataExportReadyData{
+		AppName:      string(e.config.App.Name),
+		SupportEmail: e.config.Mail.FromAddress,
+		Domain:       e.config.HTTP.Domain,
+		ProfileName:  usr.Name,
+		DownloadLink: link,
+		ExpiresOn:    expiresAt.Format("January 2, 2006"),
+	}
+
+	return e.mailer.
+		Compose().
+		To(usr.Email).
+		Subject("Your data is ready to download").
+		TemplateLayout(layouts.Email).
+		Component(emails.DataExportReady(&page)).
+		Send(ctx)
+}
+
+// -------------------------------------------------------------
+
+const TypeDeleteDataExport = "account.delete_data_export"
+
+type (
+	DeleteDataExportProcessor struct {
+		storageRepo storagerepo.StorageClientInterface
+	}
+
+	DeleteDataExportPayload struct {
+		ObjectKey string
+	}
+)
+
+// NewDeleteDataExportProcessor creates a processor deleting a personal data export once its link expired
+func NewDeleteDataExportProcessor(storageRepo storagerepo.StorageClientInterface) *DeleteDataExportProcessor {
+	return &DeleteDataExportProcessor{
+		storageRepo: storageRepo,
+	}
+}
+
+func (d *DeleteDataExportProcessor) ProcessTask(
+	ctx context.Context, t *asynq.Task,
+) error {
+	var p DeleteDataExportPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return err
+	}
+
+	return d.storageRepo.DeleteFile(storagerepo.BucketMainApp, p.ObjectKey)
+}
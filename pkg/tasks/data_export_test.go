@@ -0,0 +1,58 @@
+package tasks_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent/auditlog"
+	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
+	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
+	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
+	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tasks"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportUserData(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	cfg := &config.Config{}
+	cfg.App.Name = "Pagoda"
+	cfg.Mail.FromAddress = "admin@example.com"
+	cfg.Storage.DataExportLinkExpiration = time.Hour
+
+	fileID := 1
+	storage := storagerepo.NewMockStorageClient()
+	storage.On("UploadFile", storagerepo.BucketMainApp, mock.Anything, mock.Anything).Return(&fileID, nil)
+	sender := mailer.NewMockMailClient()
+	sender.On("Send", "export@example.com", "Your data is ready to download").Return(nil)
+	mail, err := mailer.NewMailClient(cfg, sender)
+	require.NoError(t, err)
+
+	subscriptionsRepo := subscriptions.NewSubscriptionsRepo(client, 10, 10)
+	profileRepo := profilerepo.NewProfileRepo(client, storage, subscriptionsRepo)
+	usr := tests.CreateUser(ctx, client, "User", "export@example.com", "password", true)
+	_, err = profileRepo.CreateProfile(ctx, usr, "bio", time.Now().AddDate(-25, 0, 0), nil, nil)
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(tasks.ExportUserDataPayload{UserID: usr.ID})
+	require.NoError(t, err)
+	processor := tasks.NewExportUserDataProcessor(
+		client, profileRepo, storage, mail, services.NewAuditLogger(client), nil, cfg,
+	)
+	require.NoError(t, processor.ProcessTask(ctx, asynq.NewTask(tasks.TypeExportUserData, payload)))
+
+	// The archive was uploaded and the user emailed a link to download it
+	storage.AssertExpectations(t)
+	sender.AssertExpectations(t)
+	exported := client.AuditLog.Query().Where(auditlog.Action(services.AuditActionDataExported)).OnlyX(ctx)
+	assert.Equal(t, usr.ID, *exported.TargetID)
+}
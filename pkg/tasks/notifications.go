@@ -11,6 +11,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/notification"
+	"github.com/mikestefanello/pagoda/ent/pushdelivery"
 	"github.com/mikestefanello/pagoda/pkg/domain"
 	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
@@ -235,5 +236,17 @@ func (d *DeleteStaleNotificationsProcessor) ProcessTask(
 		return err
 	}
 
+	// Push delivery results are only useful as long as the notifications they delivered
+	_, err = d.orm.PushDelivery.
+		Delete().
+		Where(
+			pushdelivery.CreatedAtLT(time.Now().Add(time.Hour * -24 * time.Duration(d.numDays))),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
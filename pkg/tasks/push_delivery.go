@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+// TypeDeliverPush is the task delivering a push notification to a single device
+const TypeDeliverPush = "notification.deliver_push"
+
+type (
+	DeliverPushProcessor struct {
+		deliveries *notifierrepo.PushDeliveryRepo
+		transports map[string]notifierrepo.PushTransport
+	}
+)
+
+// NewDeliverPushProcessor creates a processor delivering push notifications to a single PWA or FCM device
+func NewDeliverPushProcessor(
+	deliveries *notifierrepo.PushDeliveryRepo,
+	pwaPushNotificationsRepo *notifierrepo.PwaPushNotificationsRepo,
+	fcmPushNotificationsRepo *notifierrepo.FcmPushNotificationsRepo,
+) *DeliverPushProcessor {
+	return &DeliverPushProcessor{
+		deliveries: deliveries,
+		transports: map[string]notifierrepo.PushTransport{
+			domain.NotificationPlatformPush.Value:    pwaPushNotificationsRepo,
+			domain.NotificationPlatformFCMPush.Value: fcmPushNotificationsRepo,
+		},
+	}
+}
+
+func (d *DeliverPushProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	var p notifierrepo.PushDelivery
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+	}
+
+	transport, ok := d.transports[p.Platform]
+	if !ok {
+		return fmt.Errorf("no transport for push platform %q: %w", p.Platform, asynq.SkipRetry)
+	}
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return d.deliveries.Deliver(ctx, transport, p, retried >= maxRetry)
+}
+
+// NewRetryDelayFunc returns how long the worker waits before retrying a failed task. Push deliveries wait as
+// long as the push service asked them to or back off exponentially, other tasks use the asynq default.
+func NewRetryDelayFunc(cfg config.PushConfig) asynq.RetryDelayFunc {
+	return func(n int, err error, t *asynq.Task) time.Duration {
+		if t.Type() == TypeDeliverPush {
+			return notifierrepo.PushRetryDelay(n, err, cfg.RetryBaseDelay, cfg.RetryMaxDelay)
+		}
+		return asynq.DefaultRetryDelayFunc(n, err, t)
+	}
+}
+
+// PushDeliveryQueue queues push notifications to be delivered by the worker, one task per device
+type PushDeliveryQueue struct {
+	tasks  *services.TaskClient
+	config config.PushConfig
+}
+
+// NewPushDeliveryQueue creates a queue of push notifications backed by the task client
+func NewPushDeliveryQueue(tasks *services.TaskClient, cfg config.PushConfig) *PushDeliveryQueue {
+	return &PushDeliveryQueue{
+		tasks:  tasks,
+		config: cfg,
+	}
+}
+
+// EnqueuePushDelivery implements notifierrepo.PushDeliveryQueue.
+func (q *PushDeliveryQueue) EnqueuePushDelivery(_ context.Context, delivery notifierrepo.PushDelivery) error {
+	return q.tasks.
+		New(TypeDeliverPush).
+		Payload(delivery).
+		MaxRetries(q.config.MaxRetries).
+		Timeout(q.config.Timeout).
+		Save()
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/controller"
+)
+
+type (
+	AdminRole struct {
+		Name        string
+		Permissions []string
+		Members     []AdminRoleMember
+	}
+
+	AdminRoleMember struct {
+		ID    int
+		Name  string
+		Email string
+	}
+
+	AdminImpersonation struct {
+		AdminName  string
+		AdminEmail string
+		UserName   string
+		UserEmail  string
+		Reason     string
+		IPAddress  string
+		StartedAt  time.Time
+		EndedAt    *time.Time
+	}
+
+	AdminData struct {
+		Roles          []AdminRole
+		Impersonations []AdminImpersonation
+	}
+
+	ImpersonateForm struct {
+		Email      string `form:"email" validate:"required,email"`
+		Reason     string `form:"reason" validate:"required,max=255"`
+		Submission controller.FormSubmission
+	}
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/controller"
+)
+
+type (
+	APIToken struct {
+		ID         int
+		Name       string
+		Hint       string
+		Scopes     []string
+		CreatedAt  time.Time
+		LastUsedAt *time.Time
+		ExpiresAt  *time.Time
+		Expired    bool
+	}
+
+	APITokenExpiration struct {
+		Value string
+		Label string
+	}
+
+	APITokensData struct {
+		Tokens      []APIToken
+		Scopes      []string
+		Expirations []APITokenExpiration
+
+		// NewToken holds a token which was just created, it is only ever shown this once
+		NewToken string
+	}
+
+	APITokenForm struct {
+		Name       string   `form:"name" validate:"required,max=64"`
+		Scopes     []string `form:"scopes" validate:"required,min=1"`
+		ExpiresIn  string   `form:"expires_in" validate:"required"`
+		Submission controller.FormSubmission
+	}
+
+	// APIMe is the authenticated user, as returned by the API
+	APIMe struct {
+		ID    int      `json:"id"`
+		Name  string   `json:"name"`
+		Email string   `json:"email"`
+		Roles []string `json:"roles"`
+	}
+)
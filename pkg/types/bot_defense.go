@@ -0,0 +1,14 @@
+package types
+
+type (
+	// BotDefenseFields are submitted by forms protected against bots along with their own fields, see
+	// components.FormBotDefense
+	BotDefenseFields struct {
+		// Website is a honeypot, hidden from people
+		Website           string `form:"website"`
+		Token             string `form:"bot_token"`
+		Nonce             string `form:"bot_nonce"`
+		HCaptchaResponse  string `form:"h-captcha-response"`
+		TurnstileResponse string `form:"cf-turnstile-response"`
+	}
+)
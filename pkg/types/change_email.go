@@ -0,0 +1,15 @@
+package types
+
+import "github.com/mikestefanello/pagoda/pkg/controller"
+
+type (
+	ChangeEmailForm struct {
+		NewEmail   string `form:"new_email" validate:"required,email"`
+		Password   string `form:"password" validate:"required"`
+		Submission controller.FormSubmission
+	}
+
+	ChangeEmailData struct {
+		CurrentEmail string
+	}
+)
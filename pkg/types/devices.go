@@ -0,0 +1,22 @@
+package types
+
+import "time"
+
+type (
+	Device struct {
+		ID         int
+		Browser    string
+		OS         string
+		Device     string
+		IPAddress  string
+		CreatedAt  time.Time
+		LastSeenAt time.Time
+
+		// Current is true for the device the page is being viewed on
+		Current bool
+	}
+
+	DevicesData struct {
+		Devices []Device
+	}
+)
@@ -14,6 +14,7 @@ type (
 		Email      string  `form:"email" validate:"required"`
 		Latitude   float64 `form:"latitude" validate:"required"`
 		Longitude  float64 `form:"longitude" validate:"required"`
+		BotDefense BotDefenseFields
 		Submission controller.FormSubmission
 	}
 )
@@ -18,6 +18,80 @@ type (
 		BrowserName       string
 	}
 
+	EmailMagicLinkData struct {
+		AppName         string
+		SupportEmail    string
+		Domain          string
+		ProfileName     string
+		MagicLink       string
+		ExpiresIn       string
+		OperatingSystem string
+		BrowserName     string
+	}
+
+	EmailAccountLockedData struct {
+		AppName           string
+		SupportEmail      string
+		Domain            string
+		ProfileName       string
+		LockedUntil       string
+		PasswordResetLink string
+		IPAddress         string
+		OperatingSystem   string
+		BrowserName       string
+	}
+
+	EmailChangeEmailConfirmData struct {
+		AppName      string
+		SupportEmail string
+		Domain       string
+		ProfileName  string
+		NewEmail     string
+		ConfirmLink  string
+		ExpiresIn    string
+	}
+
+	EmailChangeEmailNoticeData struct {
+		AppName           string
+		SupportEmail      string
+		Domain            string
+		ProfileName       string
+		NewEmail          string
+		PasswordResetLink string
+		IPAddress         string
+		OperatingSystem   string
+		BrowserName       string
+	}
+
+	EmailAccountDeletionScheduledData struct {
+		AppName      string
+		SupportEmail string
+		Domain       string
+		ProfileName  string
+		DeletionDate string
+		CancelLink   string
+	}
+
+	EmailDataExportReadyData struct {
+		AppName      string
+		SupportEmail string
+		Domain       string
+		ProfileName  string
+		DownloadLink string
+		ExpiresOn    string
+	}
+
+	EmailOrganizationInvitationData struct {
+		AppName          string
+		SupportEmail     string
+		Domain           string
+		InviterName      string
+		OrganizationName string
+		Role             string
+		AcceptLink       string
+		ExpirationInDays int
+	}
+
 	QuestionInEmail struct {
 		Question       string
 		WriteAnswerURL string
@@ -1,7 +1,37 @@
 package types
 
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/controller"
+)
+
 type (
+	Invitation struct {
+		ID          int
+		InviteeName string
+		Link        string
+		CreatedAt   time.Time
+		ExpiresAt   time.Time
+	}
+
 	InvitationsData struct {
+		Invitations      []Invitation
+		ExpirationInDays int
+
+		// NewInvitation is an invitation which was just created, ready to be shared
+		NewInvitation *Invitation
+		// InvitationText is the message copied to share the new invitation
 		InvitationText string
 	}
+
+	InvitationAcceptData struct {
+		Code        string
+		InviterName string
+	}
+
+	InvitationForm struct {
+		InviteeName string `form:"invitee_name" validate:"required,max=64"`
+		Submission  controller.FormSubmission
+	}
 )
@@ -8,4 +8,13 @@ type (
 		Password   string `form:"password" validate:"required"`
 		Submission controller.FormSubmission
 	}
+
+	LoginData struct {
+		OAuthProviders []OAuthProvider
+	}
+
+	OAuthProvider struct {
+		Name        string
+		DisplayName string
+	}
 )
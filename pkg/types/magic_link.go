@@ -0,0 +1,14 @@
+package types
+
+import "github.com/mikestefanello/pagoda/pkg/controller"
+
+type (
+	MagicLinkForm struct {
+		Email      string `form:"email" validate:"required,email"`
+		Submission controller.FormSubmission
+	}
+
+	MagicLinkConfirmData struct {
+		Token string
+	}
+)
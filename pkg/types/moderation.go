@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/controller"
+)
+
+type (
+	// ReportReason is a reason users can pick when reporting a profile or a photo
+	ReportReason struct {
+		Value string
+		Label string
+	}
+
+	ReportData struct {
+		Reasons []ReportReason
+	}
+
+	ReportForm struct {
+		TargetType string `form:"target_type" validate:"required,oneof=profile photo"`
+		TargetID   int    `form:"target_id" validate:"required"`
+		Reason     string `form:"reason" validate:"required,oneof=spam harassment inappropriate_content impersonation other"`
+		Details    string `form:"details" validate:"max=1000"`
+		Submission controller.FormSubmission
+	}
+
+	ModerationReport struct {
+		ID            int
+		Reason        string
+		Details       string
+		TargetType    string
+		TargetID      int
+		PhotoURL      string
+		ReporterName  string
+		ReporterEmail string
+		ReportedName  string
+		ReportedEmail string
+		ReportedLink  string
+		CreatedAt     time.Time
+	}
+
+	ModerationData struct {
+		Reports []ModerationReport
+	}
+)
+
+// ReportReasons are the reasons users can pick when reporting, in the order they are offered
+var ReportReasons = []ReportReason{
+	{Value: "spam", Label: "Spam or scam"},
+	{Value: "harassment", Label: "Harassment or bullying"},
+	{Value: "inappropriate_content", Label: "Inappropriate content"},
+	{Value: "impersonation", Label: "Pretending to be someone else"},
+	{Value: "other", Label: "Something else"},
+}
+
+// ReportReasonLabel returns the label of a report reason
+func ReportReasonLabel(value string) string {
+	for _, r := range ReportReasons {
+		if r.Value == value {
+			return r.Label
+		}
+	}
+	return value
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+)
+
+type (
+	// OrganizationSummary is an organization the user is part of, as listed in the organizations page
+	OrganizationSummary struct {
+		ID       int
+		Name     string
+		Role     string
+		IsActive bool
+	}
+
+	OrganizationsData struct {
+		Organizations []OrganizationSummary
+	}
+
+	OrganizationForm struct {
+		Name       string `form:"name" validate:"required,max=100"`
+		Submission controller.FormSubmission
+	}
+
+	OrganizationMember struct {
+		ProfileID int
+		Name      string
+		Email     string
+		Role      string
+		JoinedAt  time.Time
+		IsSelf    bool
+	}
+
+	OrganizationInvitation struct {
+		ID        int
+		Email     string
+		Role      string
+		CreatedAt time.Time
+		ExpiresAt time.Time
+	}
+
+	OrganizationData struct {
+		ID               int
+		Name             string
+		Role             string
+		IsActive         bool
+		CanManageMembers bool
+		CanManageBilling bool
+		Members          []OrganizationMember
+		Invitations      []OrganizationInvitation
+		Roles            []string
+
+		ProductProCode        string
+		ActivePlan            domain.ProductType
+		IsTrial               bool
+		SubscriptionExpiresOn *time.Time
+	}
+
+	OrganizationInvitationForm struct {
+		Email      string `form:"email" validate:"required,email"`
+		Role       string `form:"role" validate:"required,oneof=owner admin member"`
+		Submission controller.FormSubmission
+	}
+
+	OrganizationRoleForm struct {
+		Role       string `form:"role" validate:"required,oneof=owner admin member"`
+		Submission controller.FormSubmission
+	}
+)
@@ -0,0 +1,19 @@
+package types
+
+import "time"
+
+type (
+	Passkey struct {
+		ID         int
+		Name       string
+		CreatedAt  time.Time
+		LastUsedAt *time.Time
+
+		// Synced is true when the passkey is backed up to the cloud, e.g. iCloud Keychain or Google Password Manager
+		Synced bool
+	}
+
+	PasskeysData struct {
+		Passkeys []Passkey
+	}
+)
@@ -15,6 +15,8 @@ type (
 	CreateCheckoutSessionForm struct {
 		Submission controller.FormSubmission
 		PriceID    string `form:"price_id", validate:required`
+		// ForOrganization bills the organization the user is working in rather than the user
+		ForOrganization bool `form:"for_organization"`
 	}
 
 	ProductDescription struct {
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/controller"
+)
+
+type (
+	PhoneLoginForm struct {
+		PhoneNumber string `form:"phone_number" validate:"required"`
+		Submission  controller.FormSubmission
+	}
+
+	PhoneLoginCodeForm struct {
+		PhoneNumber string `form:"phone_number" validate:"required"`
+		Code        string `form:"code" validate:"required,numeric"`
+		Submission  controller.FormSubmission
+	}
+
+	PhoneLoginCodeData struct {
+		PhoneNumberInternational string
+		ExpirationInMinutes      int
+	}
+
+	PhoneRegisterForm struct {
+		Name       string `form:"name" validate:"required"`
+		Email      string `form:"email" validate:"required,email"`
+		Submission controller.FormSubmission
+	}
+
+	PhoneRegisterData struct {
+		PhoneNumberInternational string
+	}
+
+	SMSOutboxData struct {
+		Messages []SMSOutboxMessage
+	}
+
+	SMSOutboxMessage struct {
+		To     string
+		Body   string
+		SentAt time.Time
+	}
+)
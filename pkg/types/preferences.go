@@ -31,6 +31,11 @@ type (
 	DeleteAccountData struct {
 		IsPaymentsEnabled          bool
 		HasUncancelledSubscription bool
+		GracePeriodInDays          int
+	}
+
+	CancelAccountDeletionData struct {
+		Token string
 	}
 
 	NotificationPermissionsData struct {
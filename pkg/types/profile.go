@@ -14,6 +14,14 @@ type ProfilePageData struct {
 	CanUploadMoreGalleryPics    bool
 	GalleryPicsMaxCount         int
 	JustAcceptedCommitedRequest bool
+	// Friendship is how the user relates to the profile, when it is not their own
+	Friendship *domain.Friendship
+}
+
+// FriendRequestsData holds the pending friend requests of a user
+type FriendRequestsData struct {
+	Received []domain.FriendRequest
+	Sent     []domain.FriendRequest
 }
 
 type ProfileCalendarHeatmap struct {
@@ -9,6 +9,8 @@ type (
 		Email              string `form:"email" validate:"required,email"`
 		Password           string `form:"password" validate:"required"`
 		Birthdate          string `form:"birthdate" validate:"required"`
+		InvitationCode     string `form:"invitation_code"`
+		BotDefense         BotDefenseFields
 		Submission         controller.FormSubmission
 	}
 
@@ -16,5 +18,6 @@ type (
 		RelationshipStatus string
 		UserSignupEnabled  bool
 		MinDate            string
+		OAuthProviders     []OAuthProvider
 	}
 )
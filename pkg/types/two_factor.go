@@ -0,0 +1,23 @@
+package types
+
+import "github.com/mikestefanello/pagoda/pkg/controller"
+
+type (
+	TwoFactorCodeForm struct {
+		Code       string `form:"code" validate:"required"`
+		Submission controller.FormSubmission
+	}
+
+	TwoFactorSettingsData struct {
+		Enabled bool
+
+		// Enrollment data, only set while the user is adding an authenticator
+		Secret string
+		URI    string
+		QRCode string
+
+		// RecoveryCodes are only set right after they were generated, as they are stored hashed
+		RecoveryCodes        []string
+		NumRecoveryCodesLeft int
+	}
+)
@@ -12,12 +12,26 @@ const (
 	PageForgotPassword         Page = "forgot-password"
 	PageHome                   Page = "home"
 	PageLogin                  Page = "login"
+	PageLoginTwoFactor         Page = "login.two_factor"
+	PageMagicLink              Page = "login.magic_link"
+	PageMagicLinkConfirm       Page = "login.magic_link.confirm"
+	PagePhoneLogin             Page = "login.phone"
+	PagePhoneLoginCode         Page = "login.phone.code"
+	PagePhoneRegister          Page = "register.phone"
 	PageRegister               Page = "register"
 	PageResetPassword          Page = "reset-password"
 	PageEmailSubscribe         Page = "email-subscribe"
 	PagePreferences            Page = "preferences"
 	PagePhoneNumber            Page = "preferences.phone"
 	PageDisplayName            Page = "preferences.display_name"
+	PageTwoFactor              Page = "preferences.two_factor"
+	PagePasskeys               Page = "preferences.passkeys"
+	PageDevices                Page = "preferences.devices"
+	PageAPITokens              Page = "preferences.api_tokens"
+	PageInvitations            Page = "invitations"
+	PageInvitationAccept       Page = "invitations.accept"
+	PageFriendRequests         Page = "friend_requests"
+	PageChangeEmail            Page = "preferences.change_email"
 	PageHomeFeed               Page = "home_feed"
 	PageInstallApp             Page = "install_app"
 	PageProfile                Page = "profile"
@@ -26,8 +40,15 @@ const (
 	PagePricing                Page = "pricing"
 	PageSuccessfullySubscribed Page = "successfully_subscribed"
 	PageDeleteAccount          Page = "delete_account.page"
+	PageCancelAccountDeletion  Page = "delete_account.cancel"
 	PagePrivacyPolicy          Page = "privacy_policy"
 	PageWiki                   Page = "wiki"
+	PageAdmin                  Page = "admin"
+	PageModeration             Page = "admin.moderation"
+	PageReport                 Page = "report"
+	PageOrganizations          Page = "organizations"
+	PageOrganization           Page = "organizations.show"
+	PageSMSOutbox              Page = "dev.sms_outbox"
 
 	SSEAnsweredByFriend Page = "sse_answered_by_friend"
 )